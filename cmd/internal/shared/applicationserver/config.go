@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/applicationserver"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
 )
 
 // DefaultApplicationServerConfig is the default configuration for the Application Server.
@@ -28,9 +29,23 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 		ListenTLS: ":8883",
 	},
 	Webhooks: applicationserver.WebhooksConfig{
-		Target:    "direct",
-		Timeout:   5 * time.Second,
-		QueueSize: 16,
-		Workers:   16,
+		Target:        "direct",
+		Timeout:       5 * time.Second,
+		QueueSize:     16,
+		Workers:       16,
+		ListCacheSize: 4096,
+		Transport: applicationserver.WebhooksTransportConfig{
+			MaxIdleConns:        web.DefaultHTTPTransportConfig.MaxIdleConns,
+			MaxIdleConnsPerHost: web.DefaultHTTPTransportConfig.MaxIdleConnsPerHost,
+			IdleConnTimeout:     web.DefaultHTTPTransportConfig.IdleConnTimeout,
+			KeepAlive:           web.DefaultHTTPTransportConfig.KeepAlive,
+		},
+		Queue: applicationserver.WebhooksQueueConfig{
+			SubjectPrefix: "ttn.webhooks",
+		},
+	},
+	Uplinks: applicationserver.UplinksConfig{
+		MaxCount: 1000,
+		TTL:      24 * time.Hour,
 	},
 }
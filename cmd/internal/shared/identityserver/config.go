@@ -44,6 +44,9 @@ var DefaultIdentityServerConfig = identityserver.Config{
 
 func init() {
 	DefaultIdentityServerConfig.AuthCache.MembershipTTL = 10 * time.Minute
+	DefaultIdentityServerConfig.APIKeyJanitor.Interval = 24 * time.Hour
+	DefaultIdentityServerConfig.APIKeyJanitor.RestoreWindow = 7 * 24 * time.Hour
+	DefaultIdentityServerConfig.MaxAPIKeys = 256
 	DefaultIdentityServerConfig.UserRegistration.Invitation.TokenTTL = 7 * 24 * time.Hour
 	DefaultIdentityServerConfig.UserRegistration.PasswordRequirements.MinLength = 8
 	DefaultIdentityServerConfig.UserRegistration.PasswordRequirements.MinUppercase = 1
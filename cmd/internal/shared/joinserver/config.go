@@ -24,4 +24,12 @@ var DefaultJoinServerConfig = joinserver.Config{
 	JoinEUIPrefixes: []*types.EUI64Prefix{
 		{},
 	},
+	KeyWriteBehind: joinserver.KeyWriteBehindConfig{
+		RetryInterval: joinserver.DefaultKeyWriteBehindRetryInterval,
+		QueueSize:     1024,
+	},
+	PrecomputedKeys: joinserver.PrecomputedKeysConfig{
+		Size: joinserver.DefaultPrecomputedKeysSize,
+		TTL:  joinserver.DefaultPrecomputedKeysTTL,
+	},
 }
@@ -170,7 +170,8 @@ func getEndDevicePathFromAS(pathParts ...string) bool {
 	switch pathParts[0] {
 	case
 		"formatters",
-		"queued_application_downlinks":
+		"queued_application_downlinks",
+		"webhooks":
 		return true
 	case "session":
 		if len(pathParts) == 1 {
@@ -198,7 +199,9 @@ func getEndDevicePathFromAS(pathParts ...string) bool {
 // set in the Application Server.
 func setEndDevicePathToAS(pathParts ...string) bool {
 	switch pathParts[0] {
-	case "formatters":
+	case
+		"formatters",
+		"webhooks":
 		return true
 	case "session":
 		if len(pathParts) == 1 {
@@ -0,0 +1,59 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	jsredis "go.thethings.network/lorawan-stack/pkg/joinserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/redis"
+)
+
+var (
+	jsDBCommand = &cobra.Command{
+		Use:   "js-db",
+		Short: "Manage the Join Server database",
+	}
+	jsDBMigrateCommand = &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the Join Server database",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := log.NewContext(context.Background(), logger)
+
+			logger.Info("Connecting to Join Server database...")
+			cl := redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"js", "keys"},
+			})
+			defer cl.Close()
+
+			logger.Info("Rebuilding index of session keys...")
+			n, err := (&jsredis.KeyRegistry{Redis: cl}).RebuildIndex(ctx)
+			if err != nil {
+				return err
+			}
+
+			logger.WithField("count", n).Info("Successfully migrated")
+			return nil
+		},
+	}
+)
+
+func init() {
+	Root.AddCommand(jsDBCommand)
+	jsDBCommand.AddCommand(jsDBMigrateCommand)
+}
@@ -153,6 +153,16 @@ var (
 						Namespace: []string{"as", "io", "webhooks"},
 					})}
 				}
+				if config.AS.Uplinks.Enable {
+					config.AS.Uplinks.Store = &asredis.MessageStore{
+						Redis: redis.New(&redis.Config{
+							Redis:     config.Redis,
+							Namespace: []string{"as", "uplinks"},
+						}),
+						MaxLen: config.AS.Uplinks.MaxCount,
+						TTL:    config.AS.Uplinks.TTL,
+					}
+				}
 				as, err := applicationserver.New(c, &config.AS)
 				if err != nil {
 					return shared.ErrInitializeApplicationServer.WithCause(err)
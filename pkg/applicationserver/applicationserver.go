@@ -52,6 +52,7 @@ type ApplicationServer struct {
 	linkMode       LinkMode
 	linkRegistry   LinkRegistry
 	deviceRegistry DeviceRegistry
+	uplinks        MessageStore
 	formatter      payloadFormatter
 	webhooks       web.Webhooks
 
@@ -77,6 +78,7 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		linkMode:       linkMode,
 		linkRegistry:   conf.Links,
 		deviceRegistry: conf.Devices,
+		uplinks:        conf.Uplinks.Store,
 		formatter: payloadFormatter{
 			repository: c.GetBaseConfig(c.Context()).DeviceRepository.Client(),
 			upFormatters: map[ttnpb.PayloadFormatter]messageprocessors.PayloadDecoder{
@@ -141,7 +143,9 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		}
 	}
 
-	if webhooks, err := conf.Webhooks.NewWebhooks(as.FillContext(as.Context()), as); err != nil {
+	if webhooks, err := conf.Webhooks.NewWebhooks(as.FillContext(as.Context()), as, as.KeyVault,
+		web.WithDeviceWebhooks(deviceWebhookRegistry{as.deviceRegistry}),
+	); err != nil {
 		return nil, err
 	} else if webhooks != nil {
 		as.webhooks = webhooks
@@ -164,7 +168,7 @@ func (as *ApplicationServer) RegisterServices(s *grpc.Server) {
 	})
 	ttnpb.RegisterAppAsServer(s, iogrpc.New(as))
 	if as.webhooks != nil {
-		ttnpb.RegisterApplicationWebhookRegistryServer(s, web.NewWebhookRegistryRPC(as.webhooks.Registry()))
+		ttnpb.RegisterApplicationWebhookRegistryServer(s, web.NewWebhookRegistryRPC(as.webhooks.Registry(), as.webhooks))
 	}
 }
 
@@ -16,11 +16,14 @@ package applicationserver
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/formatters"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/log"
 )
@@ -42,6 +45,15 @@ type Config struct {
 	Links    LinkRegistry   `name:"-"`
 	MQTT     MQTTConfig     `name:"mqtt" description:"MQTT configuration"`
 	Webhooks WebhooksConfig `name:"webhooks" description:"Webhooks configuration"`
+	Uplinks  UplinksConfig  `name:"uplinks" description:"Uplink storage configuration"`
+}
+
+// UplinksConfig defines the storage of uplink messages.
+type UplinksConfig struct {
+	Store    MessageStore  `name:"-"`
+	Enable   bool          `name:"enable" description:"Store uplink messages"`
+	MaxCount int64         `name:"max-count" description:"Maximum number of uplink messages stored per application (0 is unlimited)"`
+	TTL      time.Duration `name:"ttl" description:"Time to store uplink messages (0 is unlimited)"`
 }
 
 var errLinkMode = errors.DefineInvalidArgument("link_mode", "invalid link mode `{value}`")
@@ -65,31 +77,153 @@ type MQTTConfig struct {
 }
 
 var (
-	errWebhooksRegistry = errors.DefineInvalidArgument("webhooks_registry", "invalid webhooks registry")
-	errWebhooksTarget   = errors.DefineInvalidArgument("webhooks_target", "invalid webhooks target `{target}`")
+	errWebhooksRegistry      = errors.DefineInvalidArgument("webhooks_registry", "invalid webhooks registry")
+	errWebhooksTarget        = errors.DefineInvalidArgument("webhooks_target", "invalid webhooks target `{target}`")
+	errWebhooksAllowedTarget = errors.DefineInvalidArgument("webhooks_allowed_target", "invalid allowed webhooks target `{target}`")
+	errWebhooksPublisher     = errors.DefineInvalidArgument("webhooks_publisher", "no publisher for the webhooks queue target")
 )
 
 // WebhooksConfig defines the configuration of the webhooks integration.
 type WebhooksConfig struct {
-	Registry  web.WebhookRegistry `name:"-"`
-	Target    string              `name:"target" description:"Target of the integration (direct)"`
-	Timeout   time.Duration       `name:"timeout" description:"Wait timeout of the target to process the request"`
-	QueueSize int                 `name:"queue-size" description:"Number of requests to queue"`
-	Workers   int                 `name:"workers" description:"Number of workers to process requests"`
+	Registry            web.WebhookRegistry     `name:"-"`
+	Target              string                  `name:"target" description:"Target of the integration (direct, queue)"`
+	Timeout             time.Duration           `name:"timeout" description:"Wait timeout of the target to process the request"`
+	QueueSize           int                     `name:"queue-size" description:"Number of requests to queue"`
+	Workers             int                     `name:"workers" description:"Number of workers to process requests"`
+	ListCacheTTL        time.Duration           `name:"list-cache-ttl" description:"Time to cache the webhooks of an application (0 is disabled)"`
+	ListCacheSize       int                     `name:"list-cache-size" description:"Maximum number of applications of which the webhooks are cached (0 is unlimited)"`
+	BreakerThreshold    int                     `name:"breaker-threshold" description:"Number of consecutive failures after which requests to a host are short-circuited (0 is disabled)"`
+	BreakerCooldown     time.Duration           `name:"breaker-cooldown" description:"Time after which a request to a short-circuited host is retried"`
+	MaxRetries          int                     `name:"max-retries" description:"Number of times a request is retried if the receiver is overloaded (0 is disabled)"`
+	MaxBackoff          time.Duration           `name:"max-backoff" description:"Maximum delay before a retry, also if the receiver indicates a longer delay"`
+	MaxRequestBodySize  int64                   `name:"max-request-body-size" description:"Maximum size of request bodies in bytes (0 is unlimited)"`
+	MaxResponseBodySize int64                   `name:"max-response-body-size" description:"Maximum size of response bodies in bytes (0 is unlimited)"`
+	BlockPrivateTargets bool                    `name:"block-private-targets" description:"Refuse requests to hosts that resolve to private, loopback or link-local addresses"`
+	AllowedTargets      []string                `name:"allowed-targets" description:"Networks (CIDR) that may be targeted even if private targets are blocked"`
+	ValidatePayloads    bool                    `name:"validate-payloads" description:"Validate JSON payloads against the schema before sending them"`
+	Ordered             bool                    `name:"ordered" description:"Deliver the messages of each end device to each webhook in order"`
+	MaxInFlight         int                     `name:"max-in-flight" description:"Maximum number of messages that are delivered concurrently, after which consumption slows down (0 is unlimited)"`
+	DeduplicationTTL    time.Duration           `name:"deduplication-ttl" description:"Time to suppress duplicate deliveries of a message to a webhook (0 is disabled)"`
+	SecretKEKLabel      string                  `name:"secret-kek-label" description:"Label of the KEK to encrypt the base URL of secret webhooks"`
+	CSVColumns          []string                `name:"csv-columns" description:"Columns of the CSV format (device_id, f_port, f_cnt, frm_payload, received_at, rssi, snr, application_id, dev_eui)"`
+	Transport           WebhooksTransportConfig `name:"transport" description:"Connections of the direct target"`
+	Queue               WebhooksQueueConfig     `name:"queue" description:"Queue target configuration"`
+	ApplicationLimits   WebhooksLimitsConfig    `name:"application-limits" description:"Limits of the deliveries per application"`
+	Retention           WebhooksRetentionConfig `name:"retention" description:"Retention of messages for redelivery"`
+	Quota               WebhooksQuotaConfig     `name:"quota" description:"Quota of the deliveries per application per period"`
+	CaptureSize         int                     `name:"capture-size" description:"Number of requests captured per webhook for debugging (0 is disabled)"`
+}
+
+// WebhooksQuotaConfig defines the quota of the webhook deliveries per application per period.
+type WebhooksQuotaConfig struct {
+	Store  web.QuotaStore `name:"-"`
+	Budget uint64         `name:"budget" description:"Maximum number of deliveries per application per period (0 is unlimited)"`
+	Period time.Duration  `name:"period" description:"Duration of the periods (0 is calendar months)"`
+}
+
+// WebhooksRetentionConfig defines the retention of messages for redelivery.
+type WebhooksRetentionConfig struct {
+	MaxCount int           `name:"max-count" description:"Maximum number of messages retained per application (0 is unlimited)"`
+	MaxAge   time.Duration `name:"max-age" description:"Maximum age of retained messages (0 is unlimited)"`
+}
+
+// WebhooksLimitsConfig defines the limits of the webhook deliveries per application.
+type WebhooksLimitsConfig struct {
+	MaxConcurrent int     `name:"max-concurrent" description:"Maximum number of concurrent deliveries per application (0 is unlimited)"`
+	Rate          float64 `name:"rate" description:"Maximum number of messages per second per application (0 is unlimited)"`
+	Burst         int     `name:"burst" description:"Number of messages per application that can be delivered at once within the rate"`
+}
+
+// WebhooksTransportConfig defines the connections of the direct target of the webhooks integration.
+type WebhooksTransportConfig struct {
+	MaxIdleConns        int           `name:"max-idle-conns" description:"Maximum number of idle connections across all hosts (0 is unlimited)"`
+	MaxIdleConnsPerHost int           `name:"max-idle-conns-per-host" description:"Maximum number of idle connections per host that are kept for reuse"`
+	IdleConnTimeout     time.Duration `name:"idle-conn-timeout" description:"Time after which idle connections are closed (0 is disabled)"`
+	KeepAlive           time.Duration `name:"keep-alive" description:"Interval of TCP keep-alive probes (0 is disabled)"`
+	DisableHTTP2        bool          `name:"disable-http2" description:"Disable HTTP/2 to webhook hosts"`
+	ProxyURL            string        `name:"proxy-url" description:"URL of the HTTP or HTTPS proxy for requests to webhook hosts (default from environment)"`
+	ProxyUsername       string        `name:"proxy-username" description:"Username for the proxy"`
+	ProxyPassword       string        `name:"proxy-password" description:"Password for the proxy"`
+	NoProxy             string        `name:"no-proxy" description:"Comma-separated hosts, domains and networks that are not proxied"`
+}
+
+// WebhooksQueueConfig defines the configuration of the queue target of the webhooks integration.
+type WebhooksQueueConfig struct {
+	Publisher     web.Publisher `name:"-"`
+	SubjectPrefix string        `name:"subject-prefix" description:"Prefix of the subjects that messages are published to"`
 }
 
 // NewWebhooks returns a new web.Webhooks based on the configuration.
+// The key vault is used to encrypt and decrypt the base URL of secret webhooks.
+// The given options are applied after the options based on the configuration.
 // If Target is empty, this method returns nil.
-func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.Webhooks, error) {
+func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server, keyVault crypto.KeyVault, extraOpts ...web.Option) (web.Webhooks, error) {
+	if c.Target == "" {
+		return nil, nil
+	}
+	var csvFormatter formatters.Formatter
+	if len(c.CSVColumns) > 0 {
+		var err error
+		csvFormatter, err = formatters.NewCSV(c.CSVColumns...)
+		if err != nil {
+			return nil, err
+		}
+	}
 	var target web.Sink
 	switch c.Target {
-	case "":
-		return nil, nil
 	case "direct":
+		allowedTargets := make([]*net.IPNet, 0, len(c.AllowedTargets))
+		for _, cidr := range c.AllowedTargets {
+			_, network, err := net.ParseCIDR(cidr)
+			if err != nil {
+				return nil, errWebhooksAllowedTarget.WithCause(err).WithAttributes("target", cidr)
+			}
+			allowedTargets = append(allowedTargets, network)
+		}
+		transport, err := web.NewHTTPTransport(web.HTTPTransportConfig{
+			MaxIdleConns:        c.Transport.MaxIdleConns,
+			MaxIdleConnsPerHost: c.Transport.MaxIdleConnsPerHost,
+			IdleConnTimeout:     c.Transport.IdleConnTimeout,
+			KeepAlive:           c.Transport.KeepAlive,
+			DisableHTTP2:        c.Transport.DisableHTTP2,
+			ProxyURL:            c.Transport.ProxyURL,
+			ProxyUsername:       c.Transport.ProxyUsername,
+			ProxyPassword:       c.Transport.ProxyPassword,
+			NoProxy:             c.Transport.NoProxy,
+			BlockPrivateTargets: c.BlockPrivateTargets,
+			AllowedTargets:      allowedTargets,
+		})
+		if err != nil {
+			return nil, err
+		}
+		client := &http.Client{
+			Timeout:   c.Timeout,
+			Transport: transport,
+		}
+		if c.BlockPrivateTargets {
+			client.CheckRedirect = web.TargetRedirectPolicy(allowedTargets)
+			extraOpts = append([]web.Option{web.WithTestClient(&http.Client{
+				Timeout:       c.Timeout,
+				Transport:     transport,
+				CheckRedirect: client.CheckRedirect,
+			})}, extraOpts...)
+		}
 		target = &web.HTTPClientSink{
-			Client: &http.Client{
-				Timeout: c.Timeout,
-			},
+			Client:              client,
+			BreakerThreshold:    c.BreakerThreshold,
+			BreakerCooldown:     c.BreakerCooldown,
+			MaxRetries:          c.MaxRetries,
+			MaxBackoff:          c.MaxBackoff,
+			MaxRequestBodySize:  c.MaxRequestBodySize,
+			MaxResponseBodySize: c.MaxResponseBodySize,
+		}
+	case "queue":
+		if c.Queue.Publisher == nil {
+			return nil, errWebhooksPublisher
+		}
+		target = &web.QueueSink{
+			Publisher:     c.Queue.Publisher,
+			SubjectPrefix: c.Queue.SubjectPrefix,
 		}
 	default:
 		return nil, errWebhooksTarget.WithAttributes("target", c.Target)
@@ -97,11 +231,22 @@ func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.
 	if c.Registry == nil {
 		return nil, errWebhooksRegistry
 	}
+	registry := web.NewSecretWebhookRegistry(c.Registry, keyVault, c.SecretKEKLabel)
+	if c.ListCacheTTL > 0 {
+		registry = web.NewCachedWebhookRegistry(registry, c.ListCacheTTL, c.ListCacheSize)
+	}
+	if c.DeduplicationTTL > 0 {
+		target = &web.DeduplicatingSink{
+			Target: target,
+			TTL:    c.DeduplicationTTL,
+		}
+	}
 	if c.QueueSize > 0 || c.Workers > 0 {
 		target = &web.QueuedSink{
 			Target:  target,
 			Queue:   make(chan *http.Request, c.QueueSize),
 			Workers: c.Workers,
+			Ordered: c.Ordered,
 		}
 	}
 	if controllable, ok := target.(web.ControllableSink); ok {
@@ -111,5 +256,49 @@ func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.
 			}
 		}()
 	}
-	return web.NewWebhooks(ctx, server, c.Registry, target), nil
+	opts := []web.Option{web.WithKeyVault(keyVault)}
+	if c.ValidatePayloads {
+		opts = append(opts, web.WithPayloadValidator("json", web.JSONUpSchema))
+	}
+	if limits := c.ApplicationLimits; limits.MaxConcurrent > 0 || limits.Rate > 0 {
+		opts = append(opts, web.WithApplicationLimits(web.ApplicationLimits{
+			MaxConcurrent: limits.MaxConcurrent,
+			Rate:          limits.Rate,
+			Burst:         limits.Burst,
+		}))
+	}
+	if c.Ordered {
+		opts = append(opts, web.WithOrderedDelivery())
+	}
+	if c.MaxInFlight > 0 {
+		opts = append(opts, web.WithBackpressure(c.MaxInFlight))
+	}
+	if csvFormatter != nil {
+		opts = append(opts, web.WithFormat("csv", web.Format{
+			Formatter:   csvFormatter,
+			Name:        "CSV",
+			ContentType: "text/csv",
+		}))
+	}
+	if retention := c.Retention; retention.MaxCount > 0 || retention.MaxAge > 0 {
+		opts = append(opts, web.WithRetention(web.Retention{
+			MaxCount: retention.MaxCount,
+			MaxAge:   retention.MaxAge,
+		}))
+	}
+	if quota := c.Quota; quota.Budget > 0 {
+		store := quota.Store
+		if store == nil {
+			store = web.NewMemoryQuotaStore()
+		}
+		opts = append(opts, web.WithQuota(web.Quota{
+			Budget: quota.Budget,
+			Period: quota.Period,
+		}, store))
+	}
+	if c.CaptureSize > 0 {
+		opts = append(opts, web.WithCapture(c.CaptureSize))
+	}
+	opts = append(opts, extraOpts...)
+	return web.NewWebhooks(ctx, server, registry, target, opts...), nil
 }
@@ -0,0 +1,152 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	errCSVColumn    = errors.DefineInvalidArgument("csv_column", "unknown CSV column `{column}`")
+	errCSVDownlinks = errors.DefineInvalidArgument("csv_downlinks", "downlinks are not supported in CSV")
+)
+
+// DefaultCSVColumns are the columns of the CSV formatter.
+var DefaultCSVColumns = []string{
+	"device_id",
+	"f_port",
+	"f_cnt",
+	"frm_payload",
+	"received_at",
+	"rssi",
+	"snr",
+}
+
+// csvColumns maps the supported CSV columns to their value in an upstream message.
+// The columns that do not apply to the message are empty.
+var csvColumns = map[string]func(*ttnpb.ApplicationUp) string{
+	"application_id": func(msg *ttnpb.ApplicationUp) string {
+		return msg.ApplicationID
+	},
+	"device_id": func(msg *ttnpb.ApplicationUp) string {
+		return msg.DeviceID
+	},
+	"dev_eui": func(msg *ttnpb.ApplicationUp) string {
+		if msg.DevEUI == nil {
+			return ""
+		}
+		return msg.DevEUI.String()
+	},
+	"f_port": func(msg *ttnpb.ApplicationUp) string {
+		if up := msg.GetUplinkMessage(); up != nil {
+			return strconv.FormatUint(uint64(up.FPort), 10)
+		}
+		return ""
+	},
+	"f_cnt": func(msg *ttnpb.ApplicationUp) string {
+		if up := msg.GetUplinkMessage(); up != nil {
+			return strconv.FormatUint(uint64(up.FCnt), 10)
+		}
+		return ""
+	},
+	"frm_payload": func(msg *ttnpb.ApplicationUp) string {
+		if up := msg.GetUplinkMessage(); up != nil {
+			return base64.StdEncoding.EncodeToString(up.FRMPayload)
+		}
+		return ""
+	},
+	"received_at": func(msg *ttnpb.ApplicationUp) string {
+		if md := firstRxMetadata(msg); md != nil && md.Time != nil {
+			return md.Time.UTC().Format(time.RFC3339Nano)
+		}
+		return ""
+	},
+	"rssi": func(msg *ttnpb.ApplicationUp) string {
+		if md := firstRxMetadata(msg); md != nil {
+			return strconv.FormatFloat(float64(md.RSSI), 'f', -1, 32)
+		}
+		return ""
+	},
+	"snr": func(msg *ttnpb.ApplicationUp) string {
+		if md := firstRxMetadata(msg); md != nil {
+			return strconv.FormatFloat(float64(md.SNR), 'f', -1, 32)
+		}
+		return ""
+	},
+}
+
+func firstRxMetadata(msg *ttnpb.ApplicationUp) *ttnpb.RxMetadata {
+	if up := msg.GetUplinkMessage(); up != nil && len(up.RxMetadata) > 0 {
+		return up.RxMetadata[0]
+	}
+	return nil
+}
+
+type csvFormatter struct {
+	columns []string
+}
+
+// NewCSV returns a formatter that formats upstream messages as a single CSV row with the given columns.
+// If no columns are given, DefaultCSVColumns are used.
+// The CSV formatter does not support downlinks.
+func NewCSV(columns ...string) (Formatter, error) {
+	if len(columns) == 0 {
+		columns = DefaultCSVColumns
+	}
+	for _, column := range columns {
+		if _, ok := csvColumns[column]; !ok {
+			return nil, errCSVColumn.WithAttributes("column", column)
+		}
+	}
+	return &csvFormatter{columns: columns}, nil
+}
+
+// csvPayloadVersion is the version of the schema of CSV payloads. The columns are configured separately.
+const csvPayloadVersion = "1"
+
+func (csvFormatter) PayloadVersion() string {
+	return csvPayloadVersion
+}
+
+func (f csvFormatter) FromUp(msg *ttnpb.ApplicationUp) ([]byte, error) {
+	row := make([]string, len(f.columns))
+	for i, column := range f.columns {
+		row[i] = csvColumns[column](msg)
+	}
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(row); err != nil {
+		return nil, err
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (csvFormatter) ToDownlinks([]byte) (*ttnpb.ApplicationDownlinks, error) {
+	return nil, errCSVDownlinks
+}
+
+// CSV is a formatter that formats upstream messages as a single CSV row with DefaultCSVColumns.
+var CSV Formatter = &csvFormatter{columns: DefaultCSVColumns}
@@ -0,0 +1,121 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/formatters"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestCSVUpstream(t *testing.T) {
+	receivedAt := time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)
+	uplink := func(deviceID string) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+					ApplicationID: "foo-app",
+				},
+				DeviceID: deviceID,
+				DevEUI:   &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+			},
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FPort:      42,
+					FCnt:       43,
+					FRMPayload: []byte{0x1, 0x2, 0x3},
+					RxMetadata: []*ttnpb.RxMetadata{
+						{
+							Time: &receivedAt,
+							RSSI: -42.5,
+							SNR:  5.25,
+						},
+						{
+							RSSI: -100,
+							SNR:  -10,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	for i, tc := range []struct {
+		Columns []string
+		Message *ttnpb.ApplicationUp
+		Result  string
+	}{
+		{
+			Message: uplink("foo-device"),
+			Result:  "foo-device,42,43,AQID,2019-01-02T03:04:05Z,-42.5,5.25\n",
+		},
+		{
+			Columns: []string{"application_id", "dev_eui", "f_cnt"},
+			Message: uplink("foo-device"),
+			Result:  "foo-app,4242424242424242,43\n",
+		},
+		{
+			Columns: []string{"device_id", "frm_payload"},
+			Message: uplink(`foo,"bar"`),
+			Result:  "\"foo,\"\"bar\"\"\",AQID\n",
+		},
+		{
+			Message: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+						ApplicationID: "foo-app",
+					},
+					DeviceID: "foo-device",
+				},
+				Up: &ttnpb.ApplicationUp_JoinAccept{
+					JoinAccept: &ttnpb.ApplicationJoinAccept{
+						SessionKeyID: []byte{0x11, 0x22, 0x33, 0x44},
+					},
+				},
+			},
+			Result: "foo-device,,,,,,\n",
+		},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			a := assertions.New(t)
+			formatter, err := formatters.NewCSV(tc.Columns...)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			buf, err := formatter.FromUp(tc.Message)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(string(buf), should.Equal, tc.Result)
+		})
+	}
+}
+
+func TestCSVInvalid(t *testing.T) {
+	a := assertions.New(t)
+
+	_, err := formatters.NewCSV("device_id", "unknown")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = formatters.CSV.ToDownlinks([]byte("42,AQID"))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
@@ -23,3 +23,35 @@ type Formatter interface {
 	FromUp(*ttnpb.ApplicationUp) ([]byte, error)
 	ToDownlinks([]byte) (*ttnpb.ApplicationDownlinks, error)
 }
+
+// VersionedFormatter is a Formatter that declares the version of the schema of the payloads that it formats.
+// The version must be bumped when the shape of the output of the formatter changes.
+type VersionedFormatter interface {
+	Formatter
+	PayloadVersion() string
+}
+
+// PayloadVersion returns the payload schema version that the formatter declares.
+// If the formatter does not declare a version, this function returns an empty string.
+func PayloadVersion(f Formatter) string {
+	if versioned, ok := f.(VersionedFormatter); ok {
+		return versioned.PayloadVersion()
+	}
+	return ""
+}
+
+// ProjectingFormatter is a Formatter that can reduce formatted messages to the fields at the given paths.
+// Paths are dot-separated field names, for example end_device_ids.dev_eui.
+type ProjectingFormatter interface {
+	Formatter
+	// Project returns the formatted message with only the fields at the given paths.
+	// If strict is true, paths that are not present in the message result in an error. Otherwise, they are ignored.
+	Project(data []byte, paths []string, strict bool) ([]byte, error)
+}
+
+// BatchFormatter is a Formatter that can combine formatted messages into a single payload.
+type BatchFormatter interface {
+	Formatter
+	// Batch combines the messages formatted by FromUp into a single payload.
+	Batch(items [][]byte) ([]byte, error)
+}
@@ -15,6 +15,8 @@
 package formatters
 
 import (
+	"bytes"
+
 	"go.thethings.network/lorawan-stack/pkg/jsonpb"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
@@ -22,10 +24,22 @@ import (
 type json struct {
 }
 
+// jsonPayloadVersion is the version of the schema of JSON payloads.
+const jsonPayloadVersion = "1"
+
+func (json) PayloadVersion() string {
+	return jsonPayloadVersion
+}
+
 func (json) FromUp(msg *ttnpb.ApplicationUp) ([]byte, error) {
 	return jsonpb.TTN().Marshal(msg)
 }
 
+// Batch combines the messages into a JSON array.
+func (json) Batch(items [][]byte) ([]byte, error) {
+	return append(append([]byte{'['}, bytes.Join(items, []byte{','})...), ']'), nil
+}
+
 func (json) ToDownlinks(data []byte) (*ttnpb.ApplicationDownlinks, error) {
 	res := &ttnpb.ApplicationDownlinks{}
 	if err := jsonpb.TTN().Unmarshal(data, &res); err != nil {
@@ -124,3 +124,37 @@ func TestJSONDownstream(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONBatch(t *testing.T) {
+	a := assertions.New(t)
+	formatter := formatters.JSON.(formatters.BatchFormatter)
+
+	var items [][]byte
+	for _, devID := range []string{"foo-device", "bar-device"} {
+		item, err := formatter.FromUp(&ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+					ApplicationID: "foo-app",
+				},
+				DeviceID: devID,
+			},
+			Up: &ttnpb.ApplicationUp_JoinAccept{
+				JoinAccept: &ttnpb.ApplicationJoinAccept{
+					SessionKeyID: []byte{0x11, 0x22, 0x33, 0x44},
+				},
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		items = append(items, item)
+	}
+
+	buf, err := formatter.Batch(items)
+	a.So(err, should.BeNil)
+	a.So(string(buf), should.Equal, `[{"end_device_ids":{"device_id":"foo-device","application_ids":{"application_id":"foo-app"}},"join_accept":{"session_key_id":"ESIzRA=="}},{"end_device_ids":{"device_id":"bar-device","application_ids":{"application_id":"foo-app"}},"join_accept":{"session_key_id":"ESIzRA=="}}]`)
+
+	buf, err = formatter.Batch(nil)
+	a.So(err, should.BeNil)
+	a.So(string(buf), should.Equal, `[]`)
+}
@@ -0,0 +1,74 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// PayloadOptions configures which payload sections of uplink messages are formatted.
+type PayloadOptions struct {
+	ExcludeRawPayload     bool
+	ExcludeDecodedPayload bool
+	// ProjectionPaths are the paths of the fields to include. If empty, all fields are included.
+	// The paths are only honored by ProjectingFormatters.
+	ProjectionPaths []string
+	// StrictProjection fails formatting if a projection path is not present in the message.
+	StrictProjection bool
+}
+
+type payloadOptionsKeyType struct{}
+
+var payloadOptionsKey payloadOptionsKeyType
+
+// NewContextWithPayloadOptions returns a derived context with the given payload options.
+func NewContextWithPayloadOptions(ctx context.Context, opts PayloadOptions) context.Context {
+	return context.WithValue(ctx, payloadOptionsKey, opts)
+}
+
+// PayloadOptionsFromContext returns the payload options from the context.
+// If the context has no payload options, all payload sections are included.
+func PayloadOptionsFromContext(ctx context.Context) PayloadOptions {
+	opts, _ := ctx.Value(payloadOptionsKey).(PayloadOptions)
+	return opts
+}
+
+// FromUp formats the message with the given formatter, honoring the payload options in the context.
+// The message is not modified.
+func FromUp(ctx context.Context, f Formatter, msg *ttnpb.ApplicationUp) ([]byte, error) {
+	opts := PayloadOptionsFromContext(ctx)
+	if up := msg.GetUplinkMessage(); up != nil && (opts.ExcludeRawPayload || opts.ExcludeDecodedPayload) {
+		upCopy := *up
+		if opts.ExcludeRawPayload {
+			upCopy.FRMPayload = nil
+		}
+		if opts.ExcludeDecodedPayload {
+			upCopy.DecodedPayload = nil
+		}
+		msgCopy := *msg
+		msgCopy.Up = &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &upCopy}
+		msg = &msgCopy
+	}
+	buf, err := f.FromUp(msg)
+	if err != nil {
+		return nil, err
+	}
+	if p, ok := f.(ProjectingFormatter); ok && len(opts.ProjectionPaths) > 0 {
+		return p.Project(buf, opts.ProjectionPaths, opts.StrictProjection)
+	}
+	return buf, nil
+}
@@ -0,0 +1,100 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters_test
+
+import (
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/formatters"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestFromUpPayloadOptions(t *testing.T) {
+	msg := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: "foo-app",
+			},
+			DeviceID: "foo-device",
+		},
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11, 0x22, 0x33, 0x44},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+				DecodedPayload: &pbtypes.Struct{
+					Fields: map[string]*pbtypes.Value{
+						"test_key": {
+							Kind: &pbtypes.Value_NumberValue{
+								NumberValue: 42,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		Name    string
+		Options formatters.PayloadOptions
+		Result  string
+	}{
+		{
+			Name:   "All",
+			Result: `{"end_device_ids":{"device_id":"foo-device","application_ids":{"application_id":"foo-app"}},"uplink_message":{"session_key_id":"ESIzRA==","f_port":42,"f_cnt":42,"frm_payload":"AQID","decoded_payload":{"test_key":42},"settings":{"data_rate":{}}}}`,
+		},
+		{
+			Name: "NoRawPayload",
+			Options: formatters.PayloadOptions{
+				ExcludeRawPayload: true,
+			},
+			Result: `{"end_device_ids":{"device_id":"foo-device","application_ids":{"application_id":"foo-app"}},"uplink_message":{"session_key_id":"ESIzRA==","f_port":42,"f_cnt":42,"decoded_payload":{"test_key":42},"settings":{"data_rate":{}}}}`,
+		},
+		{
+			Name: "NoDecodedPayload",
+			Options: formatters.PayloadOptions{
+				ExcludeDecodedPayload: true,
+			},
+			Result: `{"end_device_ids":{"device_id":"foo-device","application_ids":{"application_id":"foo-app"}},"uplink_message":{"session_key_id":"ESIzRA==","f_port":42,"f_cnt":42,"frm_payload":"AQID","settings":{"data_rate":{}}}}`,
+		},
+		{
+			Name: "NoPayload",
+			Options: formatters.PayloadOptions{
+				ExcludeRawPayload:     true,
+				ExcludeDecodedPayload: true,
+			},
+			Result: `{"end_device_ids":{"device_id":"foo-device","application_ids":{"application_id":"foo-app"}},"uplink_message":{"session_key_id":"ESIzRA==","f_port":42,"f_cnt":42,"settings":{"data_rate":{}}}}`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := formatters.NewContextWithPayloadOptions(test.Context(), tc.Options)
+			buf, err := formatters.FromUp(ctx, formatters.JSON, msg)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(string(buf), should.Equal, tc.Result)
+			// The original message is not modified.
+			a.So(msg.GetUplinkMessage().FRMPayload, should.Resemble, []byte{0x1, 0x2, 0x3})
+			a.So(msg.GetUplinkMessage().DecodedPayload, should.NotBeNil)
+		})
+	}
+}
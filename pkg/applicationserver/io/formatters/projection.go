@@ -0,0 +1,67 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters
+
+import (
+	"bytes"
+	stdjson "encoding/json"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+var errProjectionPath = errors.DefineInvalidArgument("projection_path", "projection path `{path}` not found")
+
+func (json) Project(data []byte, paths []string, strict bool) ([]byte, error) {
+	dec := stdjson.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var src map[string]interface{}
+	if err := dec.Decode(&src); err != nil {
+		return nil, err
+	}
+	dst := make(map[string]interface{})
+	for _, path := range paths {
+		if !projectJSON(dst, src, strings.Split(path, ".")) && strict {
+			return nil, errProjectionPath.WithAttributes("path", path)
+		}
+	}
+	return stdjson.Marshal(dst)
+}
+
+// projectJSON copies the value at the path in src to the same path in dst, and returns whether the path is present in
+// src. Objects on the path are created in dst as needed.
+func projectJSON(dst, src map[string]interface{}, path []string) bool {
+	v, ok := src[path[0]]
+	if !ok {
+		return false
+	}
+	if len(path) == 1 {
+		dst[path[0]] = v
+		return true
+	}
+	srcChild, ok := v.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	dstChild, ok := dst[path[0]].(map[string]interface{})
+	if !ok {
+		dstChild = make(map[string]interface{})
+	}
+	if !projectJSON(dstChild, srcChild, path[1:]) {
+		return false
+	}
+	dst[path[0]] = dstChild
+	return true
+}
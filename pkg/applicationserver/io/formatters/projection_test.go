@@ -0,0 +1,140 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters_test
+
+import (
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/formatters"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestFromUpProjection(t *testing.T) {
+	msg := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: "foo-app",
+			},
+			DeviceID: "foo-device",
+		},
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11, 0x22, 0x33, 0x44},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+				DecodedPayload: &pbtypes.Struct{
+					Fields: map[string]*pbtypes.Value{
+						"temperature": {
+							Kind: &pbtypes.Value_NumberValue{
+								NumberValue: 21.5,
+							},
+						},
+						"humidity": {
+							Kind: &pbtypes.Value_NumberValue{
+								NumberValue: 42,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		Name    string
+		Options formatters.PayloadOptions
+		Result  string
+		Error   bool
+	}{
+		{
+			Name: "Fields",
+			Options: formatters.PayloadOptions{
+				ProjectionPaths: []string{
+					"end_device_ids.device_id",
+					"uplink_message.f_port",
+					"uplink_message.decoded_payload.temperature",
+				},
+			},
+			Result: `{"end_device_ids":{"device_id":"foo-device"},"uplink_message":{"decoded_payload":{"temperature":21.5},"f_port":42}}`,
+		},
+		{
+			Name: "Object",
+			Options: formatters.PayloadOptions{
+				ProjectionPaths: []string{
+					"end_device_ids.application_ids",
+				},
+			},
+			Result: `{"end_device_ids":{"application_ids":{"application_id":"foo-app"}}}`,
+		},
+		{
+			Name: "IgnoreInvalidPaths",
+			Options: formatters.PayloadOptions{
+				ProjectionPaths: []string{
+					"end_device_ids.device_id",
+					"uplink_message.unknown",
+					"uplink_message.f_port.unknown",
+				},
+			},
+			Result: `{"end_device_ids":{"device_id":"foo-device"}}`,
+		},
+		{
+			Name: "StrictInvalidPath",
+			Options: formatters.PayloadOptions{
+				ProjectionPaths: []string{
+					"end_device_ids.device_id",
+					"uplink_message.unknown",
+				},
+				StrictProjection: true,
+			},
+			Error: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := formatters.NewContextWithPayloadOptions(test.Context(), tc.Options)
+			buf, err := formatters.FromUp(ctx, formatters.JSON, msg)
+			if tc.Error {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(string(buf), should.Equal, tc.Result)
+		})
+	}
+
+	t.Run("Unsupported", func(t *testing.T) {
+		a := assertions.New(t)
+		ctx := formatters.NewContextWithPayloadOptions(test.Context(), formatters.PayloadOptions{
+			ProjectionPaths: []string{"end_device_ids.device_id"},
+		})
+		buf, err := formatters.FromUp(ctx, formatters.Protobuf, msg)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		expected, err := formatters.Protobuf.FromUp(msg)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(buf, should.Resemble, expected)
+	})
+}
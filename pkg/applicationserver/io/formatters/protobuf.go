@@ -18,6 +18,13 @@ import "go.thethings.network/lorawan-stack/pkg/ttnpb"
 
 type protobuf struct{}
 
+// protobufPayloadVersion is the version of the schema of protobuf payloads.
+const protobufPayloadVersion = "1"
+
+func (protobuf) PayloadVersion() string {
+	return protobufPayloadVersion
+}
+
 func (protobuf) FromUp(msg *ttnpb.ApplicationUp) ([]byte, error) {
 	return msg.Marshal()
 }
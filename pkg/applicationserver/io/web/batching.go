@@ -0,0 +1,123 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// defaultBatchLinger is the time that a partial batch is held if the webhook has no maximum linger.
+const defaultBatchLinger = time.Second
+
+type upBatch struct {
+	hook  *ttnpb.ApplicationWebhook
+	msgs  []*ttnpb.ApplicationUp
+	timer *time.Timer
+}
+
+type upBatcher struct {
+	mu      sync.Mutex
+	batches map[string]*upBatch
+}
+
+func newUpBatcher() *upBatcher {
+	return &upBatcher{
+		batches: make(map[string]*upBatch),
+	}
+}
+
+// add adds the message to the batch with the given key. If there is no batch, a new batch is started with the given
+// webhook and onLinger is called after linger. If the batch is full, it is removed and returned.
+func (b *upBatcher) add(key string, hook *ttnpb.ApplicationWebhook, msg *ttnpb.ApplicationUp, maxSize int, linger time.Duration, onLinger func(*upBatch)) *upBatch {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	batch, ok := b.batches[key]
+	if !ok {
+		batch = &upBatch{
+			hook: hook,
+			msgs: make([]*ttnpb.ApplicationUp, 0, maxSize),
+		}
+		batch.timer = time.AfterFunc(linger, func() { onLinger(batch) })
+		b.batches[key] = batch
+	}
+	batch.msgs = append(batch.msgs, msg)
+	if len(batch.msgs) < maxSize {
+		return nil
+	}
+	batch.timer.Stop()
+	delete(b.batches, key)
+	return batch
+}
+
+// take removes the batch with the given key and returns whether the batch was still pending.
+func (b *upBatcher) take(key string, batch *upBatch) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.batches[key] != batch {
+		return false
+	}
+	delete(b.batches, key)
+	return true
+}
+
+// takeAll removes and returns all pending batches.
+func (b *upBatcher) takeAll() []*upBatch {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	batches := make([]*upBatch, 0, len(b.batches))
+	for key, batch := range b.batches {
+		batch.timer.Stop()
+		batches = append(batches, batch)
+		delete(b.batches, key)
+	}
+	return batches
+}
+
+// batchUp adds the message to the batch of the webhook for the message type. The batch is delivered when it is full,
+// or when the maximum linger of the webhook has passed since the batch was started.
+func (w *webhooks) batchUp(ctx context.Context, msg *ttnpb.ApplicationUp, hook *ttnpb.ApplicationWebhook) {
+	key := fmt.Sprintf("%s:%s:%s", unique.ID(ctx, hook.ApplicationIdentifiers), hook.WebhookID, messageField(msg))
+	linger := hook.MaxBatchLinger
+	if linger <= 0 {
+		linger = defaultBatchLinger
+	}
+	full := w.batches.add(key, hook, msg, int(hook.MaxBatchSize), linger, func(batch *upBatch) {
+		// If the webhooks are closing, Close delivers the pending batches.
+		if !w.startDelivery() {
+			return
+		}
+		defer w.inFlight.Done()
+		if w.batches.take(key, batch) {
+			w.deliverBatch(w.ctx, batch)
+		}
+	})
+	if full != nil {
+		w.deliverBatch(ctx, full)
+	}
+}
+
+// deliverBatch delivers the messages of the batch in a single request.
+func (w *webhooks) deliverBatch(ctx context.Context, batch *upBatch) error {
+	return w.deliverHook(ctx, batch.hook, func(ctx context.Context) (*http.Request, error) {
+		return w.newBatchRequest(ctx, batch.msgs, batch.hook)
+	})
+}
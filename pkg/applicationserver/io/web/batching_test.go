@@ -0,0 +1,156 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestWebhooksBatching(t *testing.T) {
+	uplink := func(fCnt uint32) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: registeredDeviceID,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					SessionKeyID: []byte{0x11},
+					FPort:        42,
+					FCnt:         fCnt,
+					FRMPayload:   []byte{0x1, 0x2, 0x3},
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name         string
+		MaxBatchSize uint32
+		Linger       time.Duration
+		Messages     int
+		Close        bool
+		Batches      []int
+	}{
+		{
+			Name:         "Full",
+			MaxBatchSize: 5,
+			Linger:       time.Hour,
+			Messages:     10,
+			Batches:      []int{5, 5},
+		},
+		{
+			Name:         "Linger",
+			MaxBatchSize: 10,
+			Linger:       test.Delay,
+			Messages:     3,
+			Batches:      []int{3},
+		},
+		{
+			Name:         "FullAndLinger",
+			MaxBatchSize: 4,
+			Linger:       test.Delay,
+			Messages:     6,
+			Batches:      []int{4, 2},
+		},
+		{
+			Name:         "Close",
+			MaxBatchSize: 10,
+			Linger:       time.Hour,
+			Messages:     2,
+			Close:        true,
+			Batches:      []int{2},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+			defer cancel()
+
+			reqCh := make(chan *http.Request, len(tc.Batches)+1)
+			w := web.NewWebhooks(ctx, nil, &countingRegistry{
+				hook: &ttnpb.ApplicationWebhook{
+					ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+						ApplicationIdentifiers: registeredApplicationID,
+						WebhookID:              registeredWebhookID,
+					},
+					BaseURL: "https://myapp.com/api/ttn/v3",
+					Format:  "json",
+					UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+						Path: "up",
+					},
+					MaxBatchSize:   tc.MaxBatchSize,
+					MaxBatchLinger: tc.Linger,
+				},
+			}, sinkFunc(func(req *http.Request) error {
+				reqCh <- req
+				return nil
+			}))
+			sub := w.NewSubscription()
+			for i := 0; i < tc.Messages; i++ {
+				if err := sub.SendUp(uplink(uint32(i))); !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+			}
+			if tc.Close {
+				time.Sleep(test.Delay)
+				if err := w.Close(ctx); !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+			}
+
+			var fCnt uint32
+			for _, n := range tc.Batches {
+				select {
+				case req := <-reqCh:
+					a.So(req.URL.String(), should.Equal, "https://myapp.com/api/ttn/v3/up")
+					body, err := ioutil.ReadAll(req.Body)
+					if !a.So(err, should.BeNil) {
+						t.FailNow()
+					}
+					var batch []struct {
+						UplinkMessage struct {
+							FCnt uint32 `json:"f_cnt"`
+						} `json:"uplink_message"`
+					}
+					if !a.So(json.Unmarshal(body, &batch), should.BeNil) || !a.So(batch, should.HaveLength, n) {
+						t.FailNow()
+					}
+					// The messages are delivered in order.
+					for _, msg := range batch {
+						a.So(msg.UplinkMessage.FCnt, should.Equal, fCnt)
+						fCnt++
+					}
+				case <-time.After(timeout):
+					t.Fatal("Expected batch but nothing received")
+				}
+			}
+			select {
+			case <-reqCh:
+				t.Fatal("Expected no more batches")
+			case <-time.After(test.Delay):
+			}
+		})
+	}
+}
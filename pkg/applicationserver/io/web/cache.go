@@ -0,0 +1,153 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+type cachedList struct {
+	hooks   []*ttnpb.ApplicationWebhook
+	expires time.Time
+}
+
+// cachedApplication holds the cached lists of an application by field mask.
+type cachedApplication struct {
+	uid   string
+	lists map[string]cachedList
+}
+
+// cachedWebhookRegistry is a WebhookRegistry that caches the results of List.
+type cachedWebhookRegistry struct {
+	WebhookRegistry
+	ttl  time.Duration
+	size int
+
+	mu sync.Mutex
+	// apps are the elements of recent by application UID.
+	apps map[string]*list.Element
+	// recent holds the cached applications, where the front is the most recently used.
+	recent *list.List
+}
+
+// NewCachedWebhookRegistry returns a WebhookRegistry that caches the webhooks returned by List of the given registry
+// per application and field mask for the given TTL.
+// The webhooks of at most size applications are cached; the least recently used application is evicted first.
+// If size is 0, the number of applications is unlimited.
+// The cached webhooks of an application are invalidated when a webhook of that application is set through the
+// returned registry.
+// The returned webhooks are shared between callers and must not be modified.
+func NewCachedWebhookRegistry(registry WebhookRegistry, ttl time.Duration, size int) WebhookRegistry {
+	return &cachedWebhookRegistry{
+		WebhookRegistry: registry,
+		ttl:             ttl,
+		size:            size,
+		apps:            make(map[string]*list.Element),
+		recent:          list.New(),
+	}
+}
+
+// List implements WebhookRegistry.
+func (r *cachedWebhookRegistry) List(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) ([]*ttnpb.ApplicationWebhook, error) {
+	uid := unique.ID(ctx, ids)
+	key := strings.Join(paths, ",")
+	now := time.Now()
+
+	if hooks, ok := r.get(uid, key, now); ok {
+		return hooks, nil
+	}
+
+	hooks, err := r.WebhookRegistry.List(ctx, ids, paths)
+	if err != nil {
+		return nil, err
+	}
+	r.add(uid, key, cachedList{
+		hooks:   hooks,
+		expires: now.Add(r.ttl),
+	})
+	return hooks, nil
+}
+
+// get returns the cached list of the application with the field mask key, if it has not expired.
+// Expired lists are evicted.
+func (r *cachedWebhookRegistry) get(uid, key string, now time.Time) ([]*ttnpb.ApplicationWebhook, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.apps[uid]
+	if !ok {
+		return nil, false
+	}
+	app := e.Value.(*cachedApplication)
+	entry, ok := app.lists[key]
+	if !ok {
+		return nil, false
+	}
+	if !now.Before(entry.expires) {
+		delete(app.lists, key)
+		if len(app.lists) == 0 {
+			r.remove(e)
+		}
+		return nil, false
+	}
+	r.recent.MoveToFront(e)
+	return entry.hooks, true
+}
+
+// add caches the list of the application with the field mask key, and evicts the least recently used application if
+// the cache is full.
+func (r *cachedWebhookRegistry) add(uid, key string, entry cachedList) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.apps[uid]
+	if ok {
+		r.recent.MoveToFront(e)
+	} else {
+		e = r.recent.PushFront(&cachedApplication{
+			uid:   uid,
+			lists: make(map[string]cachedList),
+		})
+		r.apps[uid] = e
+	}
+	e.Value.(*cachedApplication).lists[key] = entry
+	if r.size > 0 && r.recent.Len() > r.size {
+		r.remove(r.recent.Back())
+	}
+}
+
+func (r *cachedWebhookRegistry) remove(e *list.Element) {
+	r.recent.Remove(e)
+	delete(r.apps, e.Value.(*cachedApplication).uid)
+}
+
+// Set implements WebhookRegistry.
+func (r *cachedWebhookRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, paths []string, f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error)) (*ttnpb.ApplicationWebhook, error) {
+	defer r.invalidate(unique.ID(ctx, ids.ApplicationIdentifiers))
+	return r.WebhookRegistry.Set(ctx, ids, paths, f)
+}
+
+func (r *cachedWebhookRegistry) invalidate(uid string) {
+	r.mu.Lock()
+	if e, ok := r.apps[uid]; ok {
+		r.remove(e)
+	}
+	r.mu.Unlock()
+}
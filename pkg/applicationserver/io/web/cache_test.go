@@ -0,0 +1,210 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+// countingRegistry is a WebhookRegistry that returns a single webhook and counts the calls to List.
+// If hook is nil, a webhook with only the uplink message configured is returned.
+type countingRegistry struct {
+	hook *ttnpb.ApplicationWebhook
+
+	mu        sync.Mutex
+	lists     int
+	lastPaths []string
+}
+
+func (r *countingRegistry) Get(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, paths []string) (*ttnpb.ApplicationWebhook, error) {
+	return nil, nil
+}
+
+func (r *countingRegistry) List(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) ([]*ttnpb.ApplicationWebhook, error) {
+	r.mu.Lock()
+	r.lists++
+	r.lastPaths = paths
+	r.mu.Unlock()
+	if r.hook != nil {
+		return []*ttnpb.ApplicationWebhook{r.hook}, nil
+	}
+	hook := &ttnpb.ApplicationWebhook{
+		ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+			ApplicationIdentifiers: ids,
+			WebhookID:              registeredWebhookID,
+		},
+		BaseURL: "https://myapp.com/api/ttn/v3",
+		Format:  "json",
+		UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+			Path: "up",
+		},
+	}
+	return []*ttnpb.ApplicationWebhook{hook}, nil
+}
+
+func (r *countingRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, paths []string, f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error)) (*ttnpb.ApplicationWebhook, error) {
+	pb, _, err := f(nil)
+	return pb, err
+}
+
+func (r *countingRegistry) Lists() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.lists
+}
+
+func TestCachedWebhookRegistry(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	store := &countingRegistry{}
+	registry := web.NewCachedWebhookRegistry(store, timeout, 2)
+	paths := []string{"base_url", "uplink_message"}
+
+	hooks, err := registry.List(ctx, registeredApplicationID, paths)
+	a.So(err, should.BeNil)
+	a.So(hooks, should.HaveLength, 1)
+	a.So(store.Lists(), should.Equal, 1)
+
+	// Hit the cache.
+	cached, err := registry.List(ctx, registeredApplicationID, paths)
+	a.So(err, should.BeNil)
+	a.So(cached, should.Resemble, hooks)
+	a.So(store.Lists(), should.Equal, 1)
+
+	// Other field masks and applications are cached separately.
+	_, err = registry.List(ctx, registeredApplicationID, []string{"base_url", "join_accept"})
+	a.So(err, should.BeNil)
+	a.So(store.Lists(), should.Equal, 2)
+	_, err = registry.List(ctx, unregisteredDeviceID.ApplicationIdentifiers, paths)
+	a.So(err, should.BeNil)
+	a.So(store.Lists(), should.Equal, 3)
+
+	// Setting a webhook invalidates the cache of the application.
+	_, err = registry.Set(ctx, ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}, nil, func(_ *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		return nil, nil, nil
+	})
+	a.So(err, should.BeNil)
+	_, err = registry.List(ctx, registeredApplicationID, paths)
+	a.So(err, should.BeNil)
+	a.So(store.Lists(), should.Equal, 4)
+	_, err = registry.List(ctx, unregisteredDeviceID.ApplicationIdentifiers, paths)
+	a.So(err, should.BeNil)
+	a.So(store.Lists(), should.Equal, 4)
+
+	// The cache expires after the TTL.
+	time.Sleep(timeout)
+	_, err = registry.List(ctx, registeredApplicationID, paths)
+	a.So(err, should.BeNil)
+	a.So(store.Lists(), should.Equal, 5)
+
+	// The least recently used application is evicted when the cache is full.
+	otherApplicationID := ttnpb.ApplicationIdentifiers{ApplicationID: "other-app"}
+	_, err = registry.List(ctx, otherApplicationID, paths)
+	a.So(err, should.BeNil)
+	a.So(store.Lists(), should.Equal, 6)
+	_, err = registry.List(ctx, registeredApplicationID, paths)
+	a.So(err, should.BeNil)
+	a.So(store.Lists(), should.Equal, 6)
+	_, err = registry.List(ctx, unregisteredDeviceID.ApplicationIdentifiers, paths)
+	a.So(err, should.BeNil)
+	a.So(store.Lists(), should.Equal, 7)
+	_, err = registry.List(ctx, otherApplicationID, paths)
+	a.So(err, should.BeNil)
+	a.So(store.Lists(), should.Equal, 8)
+}
+
+func TestWebhooksFieldMask(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	store := &countingRegistry{}
+	sink := &mockSink{
+		ch: make(chan *http.Request, 1),
+	}
+	w := web.NewWebhooks(ctx, nil, store, sink)
+	sub := w.NewSubscription()
+	err := sub.SendUp(&ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort:      42,
+				FRMPayload: []byte{0x1, 0x2, 0x3},
+			},
+		},
+	})
+	a.So(err, should.BeNil)
+	select {
+	case req := <-sink.ch:
+		a.So(req.URL.String(), should.Equal, "https://myapp.com/api/ttn/v3/up")
+	case <-time.After(timeout):
+		t.Fatal("Expected message but nothing received")
+	}
+	store.mu.Lock()
+	a.So(store.lists, should.Equal, 1)
+	a.So(store.lastPaths, should.Resemble, []string{"base_url", "headers", "format", "compression", "exclude_raw_payload", "exclude_decoded_payload", "method", "uplink_message"})
+	store.mu.Unlock()
+}
+
+func BenchmarkWebhooksRegistryList(b *testing.B) {
+	ctx := test.Context()
+	paths := []string{"base_url", "headers", "format", "uplink_message"}
+	for _, tc := range []struct {
+		Name     string
+		Registry func(web.WebhookRegistry) web.WebhookRegistry
+	}{
+		{
+			Name:     "Uncached",
+			Registry: func(r web.WebhookRegistry) web.WebhookRegistry { return r },
+		},
+		{
+			Name: "Cached",
+			Registry: func(r web.WebhookRegistry) web.WebhookRegistry {
+				return web.NewCachedWebhookRegistry(r, time.Minute, 0)
+			},
+		},
+	} {
+		b.Run(tc.Name, func(b *testing.B) {
+			store := &countingRegistry{}
+			registry := tc.Registry(store)
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					if _, err := registry.List(ctx, registeredApplicationID, paths); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+			b.StopTimer()
+			b.Logf("%d store calls for %d uplinks", store.Lists(), b.N)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// CapturedRequest is a request that is sent to a webhook, captured for debugging.
+type CapturedRequest struct {
+	// Time is the time at which the request is sent.
+	Time time.Time
+	// Method is the HTTP method of the request.
+	Method string
+	// URL is the URL of the request, with the user information and the query values redacted.
+	URL string
+	// Header is the header of the request, with the values of the authorization headers and the headers configured
+	// in the webhook redacted.
+	Header http.Header
+	// Body is the body of the request, as it is sent. If the webhook uses compression, the body is compressed.
+	Body []byte
+}
+
+// Capturer provides the requests that are captured for webhooks.
+type Capturer interface {
+	// Captures returns the last requests that are sent to the webhook with the given identifiers, oldest first.
+	Captures(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) ([]CapturedRequest, error)
+}
+
+// redactedHeaders are the headers of which the values are redacted in captured requests.
+var redactedHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+}
+
+// captureRing is a ring buffer of the captured requests of a webhook.
+type captureRing struct {
+	requests []CapturedRequest
+	next     int
+}
+
+// captureBuffer captures the last requests that are sent to each webhook.
+type captureBuffer struct {
+	size int
+
+	mu    sync.Mutex
+	rings map[string]*captureRing
+}
+
+func newCaptureBuffer(size int) *captureBuffer {
+	return &captureBuffer{
+		size:  size,
+		rings: make(map[string]*captureRing),
+	}
+}
+
+func captureKey(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) string {
+	return fmt.Sprintf("%s:%s", unique.ID(ctx, ids.ApplicationIdentifiers), ids.WebhookID)
+}
+
+// add captures the request to the webhook. The body of the request is read from the GetBody function of the request,
+// so that the body of the request itself is not consumed.
+func (b *captureBuffer) add(ctx context.Context, hook *ttnpb.ApplicationWebhook, req *http.Request, now time.Time) error {
+	captured := CapturedRequest{
+		Time:   now,
+		Method: req.Method,
+		URL:    redactURL(req.URL),
+		Header: make(http.Header, len(req.Header)),
+	}
+	for key, values := range req.Header {
+		captured.Header[key] = append([]string(nil), values...)
+	}
+	for _, key := range redactedHeaders {
+		if captured.Header.Get(key) != "" {
+			captured.Header.Set(key, redactedValue)
+		}
+	}
+	for key := range hook.Headers {
+		captured.Header.Set(key, redactedValue)
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return err
+		}
+		defer body.Close()
+		if captured.Body, err = ioutil.ReadAll(body); err != nil {
+			return err
+		}
+	}
+
+	key := captureKey(ctx, hook.ApplicationWebhookIdentifiers)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ring, ok := b.rings[key]
+	if !ok {
+		ring = &captureRing{}
+		b.rings[key] = ring
+	}
+	if len(ring.requests) < b.size {
+		ring.requests = append(ring.requests, captured)
+		return nil
+	}
+	ring.requests[ring.next] = captured
+	ring.next = (ring.next + 1) % b.size
+	return nil
+}
+
+// get returns the captured requests of the webhook, oldest first.
+func (b *captureBuffer) get(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) []CapturedRequest {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ring, ok := b.rings[captureKey(ctx, ids)]
+	if !ok {
+		return nil
+	}
+	res := make([]CapturedRequest, 0, len(ring.requests))
+	res = append(res, ring.requests[ring.next:]...)
+	return append(res, ring.requests[:ring.next]...)
+}
+
+// WithCapture returns an Option that captures the last size requests that are sent to each webhook, for debugging.
+// Secrets in the URL and the header of the captured requests are redacted. See Webhooks.Captures.
+func WithCapture(size int) Option {
+	return func(w *webhooks) {
+		if size < 1 {
+			return
+		}
+		w.captures = newCaptureBuffer(size)
+	}
+}
+
+var errNoCapture = errors.DefineFailedPrecondition("no_capture", "request capture is not enabled")
+
+// toPB returns the captured request as protobuf message. Multiple values of a header are joined with commas.
+func (r CapturedRequest) toPB() *ttnpb.ApplicationWebhookCapture {
+	t := r.Time
+	res := &ttnpb.ApplicationWebhookCapture{
+		Time:    &t,
+		Method:  r.Method,
+		URL:     r.URL,
+		Headers: make(map[string]string, len(r.Header)),
+		Body:    r.Body,
+	}
+	for key, values := range r.Header {
+		res.Headers[key] = strings.Join(values, ", ")
+	}
+	return res
+}
+
+// Captures implements Webhooks.
+func (w *webhooks) Captures(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) ([]CapturedRequest, error) {
+	if w.captures == nil {
+		return nil, errNoCapture
+	}
+	return w.captures.get(ctx, ids), nil
+}
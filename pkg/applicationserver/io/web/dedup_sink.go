@@ -0,0 +1,111 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+)
+
+// DeduplicatingSink is a ControllableSink that suppresses duplicate requests to the same webhook.
+// Requests are duplicates if they have the same idempotency key and are processed within TTL of each other.
+// A request that fails to be processed by the target is not considered seen, so that it can be retried.
+// Requests without idempotency key or webhook identifiers are always processed.
+// Note that redeliveries of messages that were delivered within TTL are also suppressed.
+type DeduplicatingSink struct {
+	Target Sink
+	TTL    time.Duration
+
+	seenMu    sync.Mutex
+	seen      map[string]time.Time
+	nextSweep time.Time
+}
+
+// Run runs the target if it is a ControllableSink.
+// This method blocks until the target (if controllable) is done.
+func (s *DeduplicatingSink) Run(ctx context.Context) error {
+	if controllable, ok := s.Target.(ControllableSink); ok {
+		if err := controllable.Run(ctx); err != nil && !errors.IsCanceled(err) {
+			log.FromContext(ctx).WithError(err).Error("Target sink failed")
+		}
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// Process processes the request by the target, unless a request with the same idempotency key to the same webhook
+// is seen within TTL. Duplicate requests are dropped without error.
+func (s *DeduplicatingSink) Process(req *http.Request) error {
+	key := s.key(req)
+	if key == "" {
+		return s.Target.Process(req)
+	}
+	if !s.reserve(key, time.Now()) {
+		log.FromContext(req.Context()).Debug("Drop duplicate request")
+		return nil
+	}
+	if err := s.Target.Process(req); err != nil {
+		s.release(key)
+		return err
+	}
+	return nil
+}
+
+func (s *DeduplicatingSink) key(req *http.Request) string {
+	idempotencyKey := req.Header.Get(idempotencyKeyHeader)
+	if idempotencyKey == "" {
+		return ""
+	}
+	ids, ok := webhookIdentifiersFromContext(req.Context())
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%s:%s:%s", ids.ApplicationID, ids.WebhookID, idempotencyKey)
+}
+
+// reserve marks the key as seen at now and returns true, unless the key is seen within TTL.
+func (s *DeduplicatingSink) reserve(key string, now time.Time) bool {
+	s.seenMu.Lock()
+	defer s.seenMu.Unlock()
+	if s.seen == nil {
+		s.seen = make(map[string]time.Time)
+	}
+	if now.After(s.nextSweep) {
+		for k, t := range s.seen {
+			if now.Sub(t) >= s.TTL {
+				delete(s.seen, k)
+			}
+		}
+		s.nextSweep = now.Add(s.TTL)
+	}
+	if t, ok := s.seen[key]; ok && now.Sub(t) < s.TTL {
+		return false
+	}
+	s.seen[key] = now
+	return true
+}
+
+// release removes the key from the seen keys.
+func (s *DeduplicatingSink) release(key string) {
+	s.seenMu.Lock()
+	delete(s.seen, key)
+	s.seenMu.Unlock()
+}
@@ -0,0 +1,122 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestDeduplicatingSink(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+	defer cancel()
+
+	registry := &countingRegistry{
+		hook: &ttnpb.ApplicationWebhook{
+			ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+				ApplicationIdentifiers: registeredApplicationID,
+				WebhookID:              registeredWebhookID,
+			},
+			BaseURL: "https://myapp.com/api/ttn/v3",
+			Format:  "json",
+			UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+				Path: "up",
+			},
+		},
+	}
+	var fail int32
+	reqCh := make(chan *http.Request, 4)
+	errTarget := errors.New("target failed")
+	sink := &web.DeduplicatingSink{
+		Target: sinkFunc(func(req *http.Request) error {
+			reqCh <- req
+			if atomic.CompareAndSwapInt32(&fail, 1, 0) {
+				return errTarget
+			}
+			return nil
+		}),
+		TTL: 4 * timeout,
+	}
+	w := web.NewWebhooks(ctx, nil, registry, sink)
+	sub := w.NewSubscription()
+
+	send := func(fCnt uint32) {
+		err := sub.SendUp(&ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: registeredDeviceID,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					SessionKeyID: []byte{0x11},
+					FPort:        42,
+					FCnt:         fCnt,
+					FRMPayload:   []byte{0x1, 0x2, 0x3},
+				},
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+	expectRequest := func() {
+		select {
+		case <-reqCh:
+		case <-time.After(timeout):
+			t.Fatal("Expected request but nothing received")
+		}
+	}
+	expectNoRequest := func() {
+		select {
+		case <-reqCh:
+			t.Fatal("Expected no request but received one")
+		case <-time.After(timeout / 2):
+		}
+	}
+
+	// The same message is delivered only once within the TTL.
+	send(42)
+	expectRequest()
+	send(42)
+	expectNoRequest()
+
+	// Other messages are delivered.
+	send(43)
+	expectRequest()
+
+	// A message that fails to be delivered can be delivered again.
+	atomic.StoreInt32(&fail, 1)
+	send(44)
+	expectRequest()
+	time.Sleep(test.Delay) // Wait for the failure to be processed.
+	send(44)
+	expectRequest()
+	send(44)
+	expectNoRequest()
+
+	// The same message is delivered again after the TTL.
+	time.Sleep(sink.TTL)
+	send(42)
+	expectRequest()
+}
@@ -0,0 +1,56 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// DeviceWebhookRegistry is a store for webhooks of end devices.
+type DeviceWebhookRegistry interface {
+	// List returns the webhooks of the end device by its identifiers.
+	List(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationWebhook, error)
+}
+
+// WithDeviceWebhooks returns an Option that delivers uplink messages to the webhooks of the end device in addition
+// to the webhooks of the application. End device webhooks override application webhooks with the same ID.
+func WithDeviceWebhooks(registry DeviceWebhookRegistry) Option {
+	return func(w *webhooks) {
+		w.deviceRegistry = registry
+	}
+}
+
+// mergeWebhooks merges the application webhooks with the end device webhooks. The result contains each webhook ID
+// once, where end device webhooks take precedence over application webhooks with the same ID.
+func mergeWebhooks(appHooks, devHooks []*ttnpb.ApplicationWebhook) []*ttnpb.ApplicationWebhook {
+	if len(devHooks) == 0 {
+		return appHooks
+	}
+	hooks := make([]*ttnpb.ApplicationWebhook, 0, len(appHooks)+len(devHooks))
+	index := make(map[string]int, len(appHooks)+len(devHooks))
+	for _, list := range [][]*ttnpb.ApplicationWebhook{appHooks, devHooks} {
+		for _, hook := range list {
+			if i, ok := index[hook.WebhookID]; ok {
+				hooks[i] = hook
+				continue
+			}
+			index[hook.WebhookID] = len(hooks)
+			hooks = append(hooks, hook)
+		}
+	}
+	return hooks
+}
@@ -31,3 +31,22 @@ var (
 
 	errFormatNotFound = errors.DefineNotFound("format_not_found", "format `{format}` not found")
 )
+
+// WithFormat returns an Option that overrides the format with the given name for the webhooks.
+func WithFormat(name string, format Format) Option {
+	return func(w *webhooks) {
+		if w.formats == nil {
+			w.formats = make(map[string]Format)
+		}
+		w.formats[name] = format
+	}
+}
+
+// format returns the format with the given name, preferring the formats configured for the webhooks.
+func (w *webhooks) format(name string) (Format, bool) {
+	if format, ok := w.formats[name]; ok {
+		return format, true
+	}
+	format, ok := formats[name]
+	return format, ok
+}
@@ -24,12 +24,15 @@ import (
 
 type webhookRegistryRPC struct {
 	webhooks WebhookRegistry
+	captures Capturer
 }
 
 // NewWebhookRegistryRPC returns a new webhook registry gRPC server.
-func NewWebhookRegistryRPC(webhooks WebhookRegistry) ttnpb.ApplicationWebhookRegistryServer {
+// The captured requests are provided by captures, which may be nil if request capture is not supported.
+func NewWebhookRegistryRPC(webhooks WebhookRegistry, captures Capturer) ttnpb.ApplicationWebhookRegistryServer {
 	return &webhookRegistryRPC{
 		webhooks: webhooks,
+		captures: captures,
 	}
 }
 
@@ -88,3 +91,23 @@ func (s webhookRegistryRPC) Delete(ctx context.Context, req *ttnpb.ApplicationWe
 	}
 	return ttnpb.Empty, nil
 }
+
+func (s webhookRegistryRPC) GetCaptures(ctx context.Context, req *ttnpb.ApplicationWebhookIdentifiers) (*ttnpb.ApplicationWebhookCaptures, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	if s.captures == nil {
+		return nil, errNoCapture
+	}
+	captures, err := s.captures.Captures(ctx, *req)
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.ApplicationWebhookCaptures{
+		Captures: make([]*ttnpb.ApplicationWebhookCapture, len(captures)),
+	}
+	for i, captured := range captures {
+		res.Captures[i] = captured.toPB()
+	}
+	return res, nil
+}
@@ -34,7 +34,7 @@ func TestWebhookRegistryRPC(t *testing.T) {
 	defer flush()
 	defer redisClient.Close()
 	webhookReg := &redis.WebhookRegistry{Redis: redisClient}
-	srv := web.NewWebhookRegistryRPC(webhookReg)
+	srv := web.NewWebhookRegistryRPC(webhookReg, nil)
 	authorizedCtx := contextWithKey(ctx, registeredApplicationKey)
 
 	// Formats.
@@ -42,6 +42,7 @@ func TestWebhookRegistryRPC(t *testing.T) {
 		res, err := srv.GetFormats(authorizedCtx, ttnpb.Empty)
 		a.So(err, should.BeNil)
 		a.So(res.Formats, should.HaveSameElementsDeep, map[string]string{
+			"csv":      "CSV",
 			"json":     "JSON",
 			"protobuf": "Protocol Buffers",
 		})
@@ -0,0 +1,125 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+// ApplicationLimits limits the webhook deliveries of each application, so that a single application cannot
+// monopolize the target. Messages that exceed the limits are dropped.
+type ApplicationLimits struct {
+	// MaxConcurrent is the maximum number of messages of an application that are delivered concurrently.
+	// Zero is unlimited.
+	MaxConcurrent int
+	// Rate is the maximum number of messages per second of an application. Zero is unlimited.
+	Rate float64
+	// Burst is the number of messages of an application that can be delivered at once when the application stays
+	// within the rate. The minimum is 1.
+	Burst int
+}
+
+type applicationUsage struct {
+	active int
+	tokens float64
+	last   time.Time
+}
+
+type applicationLimiter struct {
+	limits ApplicationLimits
+
+	mu        sync.Mutex
+	usage     map[string]*applicationUsage
+	lastSweep time.Time
+}
+
+func newApplicationLimiter(limits ApplicationLimits) *applicationLimiter {
+	if limits.Burst < 1 {
+		limits.Burst = 1
+	}
+	return &applicationLimiter{
+		limits: limits,
+		usage:  make(map[string]*applicationUsage),
+	}
+}
+
+var (
+	errApplicationConcurrency = errors.DefineResourceExhausted(
+		"application_concurrency",
+		"too many concurrent deliveries for application `{application_uid}`",
+	)
+	errApplicationRate = errors.DefineResourceExhausted(
+		"application_rate",
+		"delivery rate exceeded for application `{application_uid}`",
+	)
+)
+
+// acquire reserves a delivery for the application. The returned function releases the delivery.
+func (l *applicationLimiter) acquire(uid string, now time.Time) (func(), error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.limits.Rate > 0 {
+		l.sweep(now)
+	}
+	u, ok := l.usage[uid]
+	if !ok {
+		u = &applicationUsage{
+			tokens: float64(l.limits.Burst),
+			last:   now,
+		}
+		l.usage[uid] = u
+	}
+	if l.limits.MaxConcurrent > 0 && u.active >= l.limits.MaxConcurrent {
+		return nil, errApplicationConcurrency.WithAttributes("application_uid", uid)
+	}
+	if l.limits.Rate > 0 {
+		u.tokens = math.Min(float64(l.limits.Burst), u.tokens+now.Sub(u.last).Seconds()*l.limits.Rate)
+		u.last = now
+		if u.tokens < 1 {
+			return nil, errApplicationRate.WithAttributes("application_uid", uid)
+		}
+		u.tokens--
+	}
+	u.active++
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		u.active--
+		// Without rate limiting, there is no state to keep for applications without active deliveries.
+		if u.active == 0 && l.limits.Rate == 0 {
+			delete(l.usage, uid)
+		}
+	}, nil
+}
+
+// sweep removes the usage of applications without active deliveries that have been idle long enough to refill all
+// tokens, since their usage is the same as the usage of an application that is not seen yet. The usage is swept at
+// most once per refill period. The caller must hold the lock.
+func (l *applicationLimiter) sweep(now time.Time) {
+	refill := time.Duration(float64(l.limits.Burst) / l.limits.Rate * float64(time.Second))
+	if now.Sub(l.lastSweep) < refill {
+		return
+	}
+	l.lastSweep = now
+	for uid, u := range l.usage {
+		if u.active == 0 && now.Sub(u.last) >= refill {
+			delete(l.usage, uid)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestApplicationLimiterSweep(t *testing.T) {
+	a := assertions.New(t)
+	l := newApplicationLimiter(ApplicationLimits{
+		Rate:  1,
+		Burst: 2,
+	})
+	now := time.Now()
+
+	release, err := l.acquire("idle", now)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	release()
+	_, err = l.acquire("active", now)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(l.usage, should.HaveLength, 2)
+
+	// Applications are not evicted before their tokens are refilled.
+	_, err = l.acquire("other", now.Add(time.Second))
+	a.So(err, should.BeNil)
+	a.So(l.usage, should.ContainKey, "idle")
+
+	// Idle applications are evicted once their tokens are refilled, applications with active deliveries are kept.
+	_, err = l.acquire("other", now.Add(3*time.Second))
+	a.So(err, should.BeNil)
+	a.So(l.usage, should.NotContainKey, "idle")
+	a.So(l.usage, should.ContainKey, "active")
+	a.So(l.usage, should.ContainKey, "other")
+}
@@ -0,0 +1,125 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestWebhooksApplicationLimits(t *testing.T) {
+	noisyAppID := ttnpb.ApplicationIdentifiers{ApplicationID: "noisy-app"}
+	quietAppID := ttnpb.ApplicationIdentifiers{ApplicationID: "quiet-app"}
+
+	uplink := func(appID ttnpb.ApplicationIdentifiers) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: appID,
+				DeviceID:               "foo-device",
+			},
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					SessionKeyID: []byte{0x11},
+					FPort:        42,
+					FCnt:         42,
+					FRMPayload:   []byte{0x1, 0x2, 0x3},
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Limits         web.ApplicationLimits
+		BlockNoisy     bool
+		NoisyDelivered int32
+	}{
+		{
+			Name: "Concurrency",
+			Limits: web.ApplicationLimits{
+				MaxConcurrent: 1,
+			},
+			BlockNoisy:     true,
+			NoisyDelivered: 1,
+		},
+		{
+			Name: "Rate",
+			Limits: web.ApplicationLimits{
+				Rate:  0.01,
+				Burst: 2,
+			},
+			NoisyDelivered: 2,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+			defer cancel()
+
+			release := make(chan struct{})
+			defer close(release)
+			var noisyDelivered int32
+			quietCh := make(chan struct{}, 1)
+			sink := sinkFunc(func(req *http.Request) error {
+				body, err := ioutil.ReadAll(req.Body)
+				if err != nil {
+					return err
+				}
+				if bytes.Contains(body, []byte(quietAppID.ApplicationID)) {
+					quietCh <- struct{}{}
+					return nil
+				}
+				atomic.AddInt32(&noisyDelivered, 1)
+				if tc.BlockNoisy {
+					<-release
+				}
+				return nil
+			})
+			w := web.NewWebhooks(ctx, nil, &countingRegistry{}, sink, web.WithApplicationLimits(tc.Limits))
+			sub := w.NewSubscription()
+
+			// Saturate the noisy application.
+			for i := 0; i < 5; i++ {
+				if err := sub.SendUp(uplink(noisyAppID)); !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+			}
+			time.Sleep(timeout / 2)
+
+			// Deliveries of the quiet application proceed.
+			if err := sub.SendUp(uplink(quietAppID)); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			select {
+			case <-quietCh:
+			case <-time.After(timeout):
+				t.Fatal("Expected delivery of quiet application")
+			}
+			a.So(atomic.LoadInt32(&noisyDelivered), should.Equal, tc.NoisyDelivered)
+		})
+	}
+}
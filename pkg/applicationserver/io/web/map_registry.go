@@ -0,0 +1,132 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mohae/deepcopy"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+func applyWebhookFieldMask(dst, src *ttnpb.ApplicationWebhook, paths ...string) (*ttnpb.ApplicationWebhook, error) {
+	if dst == nil {
+		dst = &ttnpb.ApplicationWebhook{}
+	}
+	return dst, dst.SetFields(src, append(paths, "ids")...)
+}
+
+// copyWebhook returns a deep copy of the webhook with the field mask applied, so that callers do not share state with
+// the registry.
+func copyWebhook(pb *ttnpb.ApplicationWebhook, paths ...string) (*ttnpb.ApplicationWebhook, error) {
+	pb, err := applyWebhookFieldMask(nil, pb, paths...)
+	if err != nil {
+		return nil, err
+	}
+	return deepcopy.Copy(pb).(*ttnpb.ApplicationWebhook), nil
+}
+
+// MapRegistry is an in-memory WebhookRegistry.
+// The zero value is ready to use.
+type MapRegistry struct {
+	mu       sync.RWMutex
+	webhooks map[string]map[string]*ttnpb.ApplicationWebhook
+}
+
+// Get implements WebhookRegistry.
+func (r *MapRegistry) Get(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, paths []string) (*ttnpb.ApplicationWebhook, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	pb, ok := r.webhooks[unique.ID(ctx, ids.ApplicationIdentifiers)][ids.WebhookID]
+	if !ok {
+		return nil, errWebhookNotFound
+	}
+	return copyWebhook(pb, paths...)
+}
+
+// List implements WebhookRegistry.
+func (r *MapRegistry) List(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) ([]*ttnpb.ApplicationWebhook, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	stored := r.webhooks[unique.ID(ctx, ids)]
+	pbs := make([]*ttnpb.ApplicationWebhook, 0, len(stored))
+	for _, pb := range stored {
+		pb, err := copyWebhook(pb, paths...)
+		if err != nil {
+			return nil, err
+		}
+		pbs = append(pbs, pb)
+	}
+	sort.Slice(pbs, func(i, j int) bool { return pbs[i].WebhookID < pbs[j].WebhookID })
+	return pbs, nil
+}
+
+// Set implements WebhookRegistry.
+func (r *MapRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, gets []string, f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error)) (*ttnpb.ApplicationWebhook, error) {
+	uid := unique.ID(ctx, ids.ApplicationIdentifiers)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, create := r.webhooks[uid][ids.WebhookID], false
+	var pb *ttnpb.ApplicationWebhook
+	if stored == nil {
+		create = true
+	} else {
+		var err error
+		pb, err = copyWebhook(stored, gets...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pb, sets, err := f(pb)
+	if err != nil {
+		return nil, err
+	}
+	if pb == nil {
+		delete(r.webhooks[uid], ids.WebhookID)
+		if len(r.webhooks[uid]) == 0 {
+			delete(r.webhooks, uid)
+		}
+		return nil, nil
+	}
+
+	pb.ApplicationWebhookIdentifiers = ids
+	pb.UpdatedAt = time.Now().UTC()
+	sets = append(sets, "updated_at")
+	if create {
+		pb.CreatedAt = pb.UpdatedAt
+		sets = append(sets, "created_at")
+	}
+	updated := &ttnpb.ApplicationWebhook{}
+	if stored != nil {
+		updated = deepcopy.Copy(stored).(*ttnpb.ApplicationWebhook)
+	}
+	if _, err := applyWebhookFieldMask(updated, deepcopy.Copy(pb).(*ttnpb.ApplicationWebhook), sets...); err != nil {
+		return nil, err
+	}
+	if r.webhooks == nil {
+		r.webhooks = make(map[string]map[string]*ttnpb.ApplicationWebhook)
+	}
+	if r.webhooks[uid] == nil {
+		r.webhooks[uid] = make(map[string]*ttnpb.ApplicationWebhook)
+	}
+	r.webhooks[uid][ids.WebhookID] = updated
+	return copyWebhook(updated, gets...)
+}
@@ -0,0 +1,146 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestMapRegistry(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	registry := &web.MapRegistry{}
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+
+	_, err := registry.Get(ctx, ids, nil)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	hooks, err := registry.List(ctx, registeredApplicationID, nil)
+	a.So(err, should.BeNil)
+	a.So(hooks, should.BeEmpty)
+
+	created, err := registry.Set(ctx, ids, []string{"base_url", "format"}, func(pb *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		a.So(pb, should.BeNil)
+		return &ttnpb.ApplicationWebhook{
+			BaseURL: "https://myapp.com/api/ttn/v3",
+			Format:  "json",
+			UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+				Path: "up",
+			},
+		}, []string{"base_url", "format", "uplink_message"}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(created.ApplicationWebhookIdentifiers, should.Resemble, ids)
+	a.So(created.BaseURL, should.Equal, "https://myapp.com/api/ttn/v3")
+	a.So(created.Format, should.Equal, "json")
+	a.So(created.UplinkMessage, should.BeNil)
+
+	updated, err := registry.Set(ctx, ids, []string{"base_url", "format", "uplink_message"}, func(pb *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		a.So(pb.UplinkMessage, should.Resemble, &ttnpb.ApplicationWebhook_Message{Path: "up"})
+		pb.Format = "protobuf"
+		pb.UplinkMessage.Path = "uplink"
+		return pb, []string{"format"}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(updated.Format, should.Equal, "protobuf")
+	a.So(updated.UplinkMessage, should.Resemble, &ttnpb.ApplicationWebhook_Message{Path: "up"})
+
+	hooks, err = registry.List(ctx, registeredApplicationID, []string{"format"})
+	a.So(err, should.BeNil)
+	if a.So(hooks, should.HaveLength, 1) {
+		a.So(hooks[0].ApplicationWebhookIdentifiers, should.Resemble, ids)
+		a.So(hooks[0].Format, should.Equal, "protobuf")
+		a.So(hooks[0].BaseURL, should.BeEmpty)
+		a.So(hooks[0].UplinkMessage, should.BeNil)
+		a.So(hooks[0].CreatedAt.IsZero(), should.BeFalse)
+	}
+
+	// Modifying the returned webhooks does not modify the stored webhook.
+	hook, err := registry.Get(ctx, ids, []string{"uplink_message"})
+	a.So(err, should.BeNil)
+	hook.UplinkMessage.Path = "modified"
+	hook, err = registry.Get(ctx, ids, []string{"uplink_message"})
+	a.So(err, should.BeNil)
+	a.So(hook.UplinkMessage.Path, should.Equal, "up")
+
+	hooks, err = registry.List(ctx, unregisteredDeviceID.ApplicationIdentifiers, nil)
+	a.So(err, should.BeNil)
+	a.So(hooks, should.BeEmpty)
+
+	deleted, err := registry.Set(ctx, ids, nil, func(pb *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		return nil, nil, nil
+	})
+	a.So(err, should.BeNil)
+	a.So(deleted, should.BeNil)
+	_, err = registry.Get(ctx, ids, nil)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestMapRegistryConcurrent(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	registry := &web.MapRegistry{}
+
+	const n = 32
+	wg := sync.WaitGroup{}
+	for i := 0; i < n; i++ {
+		wg.Add(2)
+		ids := ttnpb.ApplicationWebhookIdentifiers{
+			ApplicationIdentifiers: registeredApplicationID,
+			WebhookID:              fmt.Sprintf("hook-%02d", i),
+		}
+		go func() {
+			defer wg.Done()
+			_, err := registry.Set(ctx, ids, nil, func(pb *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+				return &ttnpb.ApplicationWebhook{
+					Format: "json",
+				}, []string{"format"}, nil
+			})
+			a.So(err, should.BeNil)
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := registry.List(ctx, registeredApplicationID, []string{"format"})
+			a.So(err, should.BeNil)
+		}()
+	}
+	wg.Wait()
+
+	hooks, err := registry.List(ctx, registeredApplicationID, []string{"format"})
+	a.So(err, should.BeNil)
+	if a.So(hooks, should.HaveLength, n) {
+		for i, hook := range hooks {
+			a.So(hook.WebhookID, should.Equal, fmt.Sprintf("hook-%02d", i))
+			a.So(hook.Format, should.Equal, "json")
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+)
+
+// MultiSink is a ControllableSink that fans out requests to multiple sinks.
+// The first sink is the primary sink, the other sinks are secondary sinks, for example for mirroring requests.
+type MultiSink struct {
+	Sinks []Sink
+	// PrimaryOnly indicates that only the result of the primary sink is returned by Process.
+	// Errors of secondary sinks are logged.
+	PrimaryOnly bool
+}
+
+// Run runs the sinks that are a ControllableSink.
+// This method blocks until all controllable sinks are done.
+func (s *MultiSink) Run(ctx context.Context) error {
+	wg := sync.WaitGroup{}
+	for _, sink := range s.Sinks {
+		controllable, ok := sink.(ControllableSink)
+		if !ok {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			if err := controllable.Run(ctx); err != nil && !errors.IsCanceled(err) {
+				log.FromContext(ctx).WithError(err).Error("Target sink failed")
+			}
+			wg.Done()
+		}()
+	}
+	<-ctx.Done()
+	wg.Wait()
+	return ctx.Err()
+}
+
+// Process sends a copy of the request to each sink concurrently and waits for all sinks to process it.
+// If PrimaryOnly is set, the error of the primary sink is returned. Otherwise, the first error of any sink is returned.
+func (s *MultiSink) Process(req *http.Request) error {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return err
+		}
+	}
+	errs := make([]error, len(s.Sinks))
+	wg := sync.WaitGroup{}
+	for i, sink := range s.Sinks {
+		i, sink := i, sink
+		sinkReq := req.WithContext(req.Context())
+		sinkReq.Header = make(http.Header, len(req.Header))
+		for key, values := range req.Header {
+			sinkReq.Header[key] = append([]string(nil), values...)
+		}
+		sinkReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+		wg.Add(1)
+		go func() {
+			errs[i] = sink.Process(sinkReq)
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		if i > 0 && s.PrimaryOnly {
+			log.FromContext(req.Context()).WithError(err).Warn("Failed to process message in secondary sink")
+			continue
+		}
+		return err
+	}
+	return nil
+}
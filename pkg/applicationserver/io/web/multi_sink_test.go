@@ -0,0 +1,103 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+type sinkFunc func(*http.Request) error
+
+func (f sinkFunc) Process(req *http.Request) error { return f(req) }
+
+func TestMultiSink(t *testing.T) {
+	errPrimary := errors.New("primary failed")
+	errSecondary := errors.New("secondary failed")
+
+	bodySink := func(body *[]byte, err error) web.Sink {
+		return sinkFunc(func(req *http.Request) error {
+			b, readErr := ioutil.ReadAll(req.Body)
+			if readErr != nil {
+				return readErr
+			}
+			*body = b
+			return err
+		})
+	}
+
+	for _, tc := range []struct {
+		Name         string
+		PrimaryOnly  bool
+		PrimaryErr   error
+		SecondaryErr error
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success",
+		},
+		{
+			Name:         "FailingSecondary",
+			SecondaryErr: errSecondary,
+			ExpectedErr:  errSecondary,
+		},
+		{
+			Name:         "FailingSecondary/PrimaryOnly",
+			PrimaryOnly:  true,
+			SecondaryErr: errSecondary,
+		},
+		{
+			Name:         "FailingPrimary/PrimaryOnly",
+			PrimaryOnly:  true,
+			PrimaryErr:   errPrimary,
+			SecondaryErr: errSecondary,
+			ExpectedErr:  errPrimary,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			var primaryBody, secondaryBody []byte
+			sink := &web.MultiSink{
+				Sinks: []web.Sink{
+					bodySink(&primaryBody, tc.PrimaryErr),
+					bodySink(&secondaryBody, tc.SecondaryErr),
+				},
+				PrimaryOnly: tc.PrimaryOnly,
+			}
+			req, err := http.NewRequest(http.MethodPost, "https://myapp.com/api/ttn/v3/up", bytes.NewReader([]byte("payload")))
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			err = sink.Process(req)
+			if tc.ExpectedErr != nil {
+				if a.So(err, should.NotBeNil) {
+					a.So(err.Error(), should.Equal, tc.ExpectedErr.Error())
+				}
+			} else {
+				a.So(err, should.BeNil)
+			}
+			// Every sink gets the full body.
+			a.So(primaryBody, should.Resemble, []byte("payload"))
+			a.So(secondaryBody, should.Resemble, []byte("payload"))
+		})
+	}
+}
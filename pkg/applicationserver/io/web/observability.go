@@ -0,0 +1,141 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/metrics"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	evtDeliverySuccess = events.Define("as.webhook.delivery.success", "deliver webhook message success")
+	evtDeliveryFail    = events.Define("as.webhook.delivery.fail", "deliver webhook message fail")
+)
+
+const (
+	subsystem = "as_webhooks"
+	unknown   = "unknown"
+	namespace = "applicationserver/io/web"
+)
+
+var webhookMetrics = &messageMetrics{
+	queueDropped: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "queue_dropped_total",
+			Help:      "Total number of requests dropped because the queue is full",
+		},
+		[]string{"application_id"},
+	),
+	subscriptions: metrics.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: subsystem,
+			Name:      "subscriptions_active",
+			Help:      "Number of active webhook subscriptions",
+		},
+		[]string{"namespace"},
+	),
+}
+
+func init() {
+	metrics.MustRegister(webhookMetrics)
+}
+
+type messageMetrics struct {
+	queueDropped  *metrics.ContextualCounterVec
+	subscriptions *prometheus.GaugeVec
+}
+
+func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.queueDropped.Describe(ch)
+	m.subscriptions.Describe(ch)
+}
+
+func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.queueDropped.Collect(ch)
+	m.subscriptions.Collect(ch)
+}
+
+func registerDropQueueFull(ctx context.Context) {
+	appID := unknown
+	if ids, ok := webhookIdentifiersFromContext(ctx); ok {
+		appID = ids.ApplicationID
+	}
+	webhookMetrics.queueDropped.WithLabelValues(ctx, appID).Inc()
+}
+
+func registerSubscribe() {
+	webhookMetrics.subscriptions.WithLabelValues(namespace).Inc()
+}
+
+func registerUnsubscribe() {
+	webhookMetrics.subscriptions.WithLabelValues(namespace).Dec()
+}
+
+// deliveryResult is the result of the delivery of a webhook request.
+// It is updated by the HTTPClientSink that performs the request.
+type deliveryResult struct {
+	mu         sync.Mutex
+	statusCode int
+	attempts   int
+}
+
+type deliveryResultKeyType struct{}
+
+var deliveryResultKey deliveryResultKeyType
+
+// newContextWithDeliveryResult returns a derived context with a new delivery result, and the result itself.
+func newContextWithDeliveryResult(ctx context.Context) (context.Context, *deliveryResult) {
+	res := &deliveryResult{}
+	return context.WithValue(ctx, deliveryResultKey, res), res
+}
+
+// registerDeliveryAttempt registers an attempt to perform the webhook request. The status code is 0 if no response
+// was received.
+func registerDeliveryAttempt(ctx context.Context, statusCode int) {
+	res, ok := ctx.Value(deliveryResultKey).(*deliveryResult)
+	if !ok {
+		return
+	}
+	res.mu.Lock()
+	res.attempts++
+	res.statusCode = statusCode
+	res.mu.Unlock()
+}
+
+// publishDeliveryResult publishes the delivery success or failure event of the webhook.
+// The event data contains the webhook ID, the last response status code, the number of attempts and the latency.
+func publishDeliveryResult(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, res *deliveryResult, latency time.Duration, err error) {
+	res.mu.Lock()
+	data := map[string]interface{}{
+		"webhook_id":  ids.WebhookID,
+		"status_code": res.statusCode,
+		"attempts":    res.attempts,
+		"latency":     latency,
+	}
+	res.mu.Unlock()
+	if err != nil {
+		data["error"] = redactError(err).Error()
+		events.Publish(evtDeliveryFail(ctx, ids.ApplicationIdentifiers, data))
+		return
+	}
+	events.Publish(evtDeliverySuccess(ctx, ids.ApplicationIdentifiers, data))
+}
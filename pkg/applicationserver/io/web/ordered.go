@@ -0,0 +1,57 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import "sync"
+
+// orderedQueues runs functions in order per key. Functions of different keys run concurrently.
+type orderedQueues struct {
+	mu     sync.Mutex
+	queues map[string][]func()
+}
+
+func newOrderedQueues() *orderedQueues {
+	return &orderedQueues{
+		queues: make(map[string][]func()),
+	}
+}
+
+// push queues the function to run after the functions that are queued for the key.
+func (q *orderedQueues) push(key string, f func()) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if queue, ok := q.queues[key]; ok {
+		q.queues[key] = append(queue, f)
+		return
+	}
+	q.queues[key] = nil
+	go q.run(key, f)
+}
+
+// run runs the function and then the functions that are queued for the key, until the queue is empty.
+func (q *orderedQueues) run(key string, f func()) {
+	for {
+		f()
+		q.mu.Lock()
+		queue := q.queues[key]
+		if len(queue) == 0 {
+			delete(q.queues, key)
+			q.mu.Unlock()
+			return
+		}
+		f, q.queues[key] = queue[0], queue[1:]
+		q.mu.Unlock()
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestWebhooksOrderedDelivery(t *testing.T) {
+	const messages = 20
+
+	for _, tc := range []struct {
+		Name       string
+		WrapTarget func(context.Context, web.Sink) web.Sink
+	}{
+		{
+			Name: "Direct",
+			WrapTarget: func(_ context.Context, sink web.Sink) web.Sink {
+				return sink
+			},
+		},
+		{
+			Name: "Queued",
+			WrapTarget: func(ctx context.Context, sink web.Sink) web.Sink {
+				queued := &web.QueuedSink{
+					Target:  sink,
+					Queue:   make(chan *http.Request, messages),
+					Workers: 4,
+					Ordered: true,
+				}
+				go queued.Run(ctx)
+				return queued
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+			defer cancel()
+
+			fCntCh := make(chan uint32, messages)
+			sink := sinkFunc(func(req *http.Request) error {
+				body, err := ioutil.ReadAll(req.Body)
+				if err != nil {
+					return err
+				}
+				var up struct {
+					UplinkMessage struct {
+						FCnt uint32 `json:"f_cnt"`
+					} `json:"uplink_message"`
+				}
+				if err := json.Unmarshal(body, &up); err != nil {
+					return err
+				}
+				// Take a random time to process the request, so that concurrent deliveries get reordered.
+				time.Sleep(time.Duration(rand.Int63n(int64(test.Delay))))
+				fCntCh <- up.UplinkMessage.FCnt
+				return nil
+			})
+			// Application limits make deliveries asynchronous.
+			w := web.NewWebhooks(ctx, nil, &countingRegistry{}, tc.WrapTarget(ctx, sink),
+				web.WithApplicationLimits(web.ApplicationLimits{}),
+				web.WithOrderedDelivery(),
+			)
+			sub := w.NewSubscription()
+
+			for fCnt := uint32(1); fCnt <= messages; fCnt++ {
+				err := sub.SendUp(&ttnpb.ApplicationUp{
+					EndDeviceIdentifiers: registeredDeviceID,
+					Up: &ttnpb.ApplicationUp_UplinkMessage{
+						UplinkMessage: &ttnpb.ApplicationUplink{
+							SessionKeyID: []byte{0x11},
+							FPort:        42,
+							FCnt:         fCnt,
+							FRMPayload:   []byte{0x1, 0x2, 0x3},
+						},
+					},
+				})
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+			}
+
+			for fCnt := uint32(1); fCnt <= messages; fCnt++ {
+				select {
+				case received := <-fCntCh:
+					if !a.So(received, should.Equal, fCnt) {
+						t.FailNow()
+					}
+				case <-time.After(timeout * 2):
+					t.Fatalf("Expected message with FCnt %d but nothing received", fCnt)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// Publisher publishes messages to a subject of a message queue, for example a NATS subject or a Kafka topic.
+// Implementations wrap the client of the message queue, which is responsible for authentication, TLS and reconnects.
+// Publish must respect the deadline and cancellation of the context.
+type Publisher interface {
+	Publish(ctx context.Context, subject string, data []byte) error
+}
+
+// QueueSink is a Sink that publishes the body of requests to a message queue instead of performing HTTP requests.
+// The body is the message encoded by the formatter of the webhook, so the webhook formats apply as well.
+// The subject is derived from the application and webhook IDs: `{prefix}.{application_id}.{webhook_id}`.
+type QueueSink struct {
+	Publisher     Publisher
+	SubjectPrefix string
+}
+
+var errNoWebhookIdentifiers = errors.DefineInvalidArgument("no_webhook_identifiers", "no webhook identifiers in request")
+
+// Subject returns the subject that messages of the given webhook are published to.
+func (s *QueueSink) Subject(ids ttnpb.ApplicationWebhookIdentifiers) string {
+	subject := fmt.Sprintf("%s.%s", ids.ApplicationID, ids.WebhookID)
+	if s.SubjectPrefix == "" {
+		return subject
+	}
+	return fmt.Sprintf("%s.%s", s.SubjectPrefix, subject)
+}
+
+// Process publishes the body of the request to the subject of the webhook.
+func (s *QueueSink) Process(req *http.Request) error {
+	ids, ok := webhookIdentifiersFromContext(req.Context())
+	if !ok {
+		return errNoWebhookIdentifiers
+	}
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return s.Publisher.Publish(req.Context(), s.Subject(ids), body)
+}
@@ -0,0 +1,98 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/formatters"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+type publishedMessage struct {
+	subject string
+	data    []byte
+}
+
+type mockPublisher struct {
+	ch chan publishedMessage
+}
+
+func (p *mockPublisher) Publish(ctx context.Context, subject string, data []byte) error {
+	p.ch <- publishedMessage{subject: subject, data: data}
+	return nil
+}
+
+func TestQueueSink(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+	defer cancel()
+
+	registry := &countingRegistry{
+		hook: &ttnpb.ApplicationWebhook{
+			ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+				ApplicationIdentifiers: registeredApplicationID,
+				WebhookID:              registeredWebhookID,
+			},
+			BaseURL: "https://myapp.com/api/ttn/v3",
+			Format:  "json",
+			UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+				Path: "up",
+			},
+		},
+	}
+	publisher := &mockPublisher{
+		ch: make(chan publishedMessage, 1),
+	}
+	sink := &web.QueueSink{
+		Publisher:     publisher,
+		SubjectPrefix: "ttn.webhooks",
+	}
+	w := web.NewWebhooks(ctx, nil, registry, sink)
+	sub := w.NewSubscription()
+
+	msg := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+			},
+		},
+	}
+	if err := sub.SendUp(msg); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	expected, err := formatters.JSON.FromUp(msg)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	select {
+	case published := <-publisher.ch:
+		a.So(published.subject, should.Equal, "ttn.webhooks.foo-app.foo-hook")
+		a.So(published.data, should.Resemble, expected)
+	case <-time.After(timeout):
+		t.Fatal("Expected message to be published")
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+var evtQuotaExceeded = events.Define("as.webhook.quota.exceeded", "webhook delivery quota exceeded")
+
+// Quota limits the number of webhook deliveries of each application per period, for example for billing or fair use.
+// Each delivery of a message to a webhook counts towards the quota. Deliveries that exceed the quota are suppressed.
+type Quota struct {
+	// Budget is the maximum number of deliveries of an application per period.
+	Budget uint64
+	// Period is the duration of the periods. Periods are aligned to the Unix epoch.
+	// Zero means calendar months in UTC.
+	Period time.Duration
+}
+
+// periodStart returns the start of the period that contains t.
+func (q Quota) periodStart(t time.Time) time.Time {
+	t = t.UTC()
+	if q.Period <= 0 {
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return t.Truncate(q.Period)
+}
+
+// QuotaStore stores the number of webhook deliveries of applications per period.
+type QuotaStore interface {
+	// Increment increments the number of deliveries of the application in the period that starts at the given time,
+	// and returns the number of deliveries in that period, including this one.
+	// The number of deliveries starts at zero in each period.
+	Increment(ctx context.Context, ids ttnpb.ApplicationIdentifiers, period time.Time) (uint64, error)
+}
+
+type quotaUsage struct {
+	period time.Time
+	count  uint64
+}
+
+type memoryQuotaStore struct {
+	mu    sync.Mutex
+	usage map[string]*quotaUsage
+}
+
+// NewMemoryQuotaStore returns a QuotaStore that stores the number of deliveries in memory.
+// Only the number of deliveries in the current period of each application is kept.
+func NewMemoryQuotaStore() QuotaStore {
+	return &memoryQuotaStore{
+		usage: make(map[string]*quotaUsage),
+	}
+}
+
+// Increment implements QuotaStore.
+func (s *memoryQuotaStore) Increment(ctx context.Context, ids ttnpb.ApplicationIdentifiers, period time.Time) (uint64, error) {
+	uid := unique.ID(ctx, ids)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, ok := s.usage[uid]
+	if !ok || !u.period.Equal(period) {
+		u = &quotaUsage{period: period}
+		s.usage[uid] = u
+	}
+	u.count++
+	return u.count, nil
+}
+
+type quotaEnforcer struct {
+	quota Quota
+	store QuotaStore
+}
+
+// allow consumes a delivery of the application from the quota and returns whether the delivery is allowed.
+// The quota exceeded event is published once per period, when the first delivery exceeds the budget.
+// If the store fails, the delivery is allowed.
+func (e *quotaEnforcer) allow(ctx context.Context, ids ttnpb.ApplicationIdentifiers, now time.Time) bool {
+	period := e.quota.periodStart(now)
+	count, err := e.store.Increment(ctx, ids, period)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to increment webhook delivery quota")
+		return true
+	}
+	if count <= e.quota.Budget {
+		return true
+	}
+	if count == e.quota.Budget+1 {
+		events.Publish(evtQuotaExceeded(ctx, ids, map[string]interface{}{
+			"budget":       e.quota.Budget,
+			"period_start": period,
+		}))
+	}
+	return false
+}
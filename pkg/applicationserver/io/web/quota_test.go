@@ -0,0 +1,113 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestWebhooksQuota(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+	defer cancel()
+
+	evtCh := make(events.Channel, 4)
+	events.Subscribe("as.webhook.quota.exceeded", evtCh)
+	defer events.Unsubscribe("as.webhook.quota.exceeded", evtCh)
+
+	var delivered int32
+	sink := sinkFunc(func(req *http.Request) error {
+		atomic.AddInt32(&delivered, 1)
+		return nil
+	})
+	w := web.NewWebhooks(ctx, nil, &countingRegistry{}, sink, web.WithQuota(web.Quota{
+		Budget: 2,
+		Period: time.Hour,
+	}, web.NewMemoryQuotaStore()))
+	sub := w.NewSubscription()
+
+	for i := 0; i < 5; i++ {
+		if err := sub.SendUp(&ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: registeredDeviceID,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					SessionKeyID: []byte{0x11},
+					FPort:        42,
+					FCnt:         uint32(i),
+					FRMPayload:   []byte{0x1, 0x2, 0x3},
+				},
+			},
+		}); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+	time.Sleep(timeout)
+
+	// Deliveries that exceed the budget are suppressed.
+	a.So(atomic.LoadInt32(&delivered), should.Equal, 2)
+
+	// The quota exceeded event is published once per period.
+	select {
+	case evt := <-evtCh:
+		a.So(evt.Name(), should.Equal, "as.webhook.quota.exceeded")
+		a.So(evt.Identifiers().GetEntityIdentifiers(), should.HaveLength, 1)
+	case <-time.After(timeout):
+		t.Fatal("Expected quota exceeded event")
+	}
+	select {
+	case <-evtCh:
+		t.Fatal("Expected quota exceeded event to be published once")
+	case <-time.After(test.Delay):
+	}
+}
+
+func TestMemoryQuotaStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	store := web.NewMemoryQuotaStore()
+
+	appID := ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"}
+	otherAppID := ttnpb.ApplicationIdentifiers{ApplicationID: "bar-app"}
+	period := time.Date(2019, time.March, 1, 0, 0, 0, 0, time.UTC)
+	nextPeriod := time.Date(2019, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := uint64(1); i <= 3; i++ {
+		count, err := store.Increment(ctx, appID, period)
+		a.So(err, should.BeNil)
+		a.So(count, should.Equal, i)
+	}
+
+	// Applications have separate counters.
+	count, err := store.Increment(ctx, otherAppID, period)
+	a.So(err, should.BeNil)
+	a.So(count, should.Equal, 1)
+
+	// The counter resets in the next period.
+	count, err = store.Increment(ctx, appID, nextPeriod)
+	a.So(err, should.BeNil)
+	a.So(count, should.Equal, 1)
+}
@@ -0,0 +1,97 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// Retention bounds the messages of each application that are retained for redelivery.
+type Retention struct {
+	// MaxCount is the maximum number of messages retained per application. Zero is unlimited.
+	MaxCount int
+	// MaxAge is the maximum age of retained messages. Zero is unlimited.
+	MaxAge time.Duration
+}
+
+type retainedMessage struct {
+	receivedAt time.Time
+	msg        *ttnpb.ApplicationUp
+}
+
+type retentionBuffer struct {
+	retention Retention
+
+	mu       sync.Mutex
+	messages map[string][]retainedMessage
+}
+
+func newRetentionBuffer(retention Retention) *retentionBuffer {
+	return &retentionBuffer{
+		retention: retention,
+		messages:  make(map[string][]retainedMessage),
+	}
+}
+
+// prune removes the messages of the application that exceed the retention. This method must be called with the mutex
+// held.
+func (b *retentionBuffer) prune(uid string, now time.Time) {
+	msgs := b.messages[uid]
+	if b.retention.MaxCount > 0 && len(msgs) > b.retention.MaxCount {
+		msgs = msgs[len(msgs)-b.retention.MaxCount:]
+	}
+	if b.retention.MaxAge > 0 {
+		i := 0
+		for i < len(msgs) && now.Sub(msgs[i].receivedAt) > b.retention.MaxAge {
+			i++
+		}
+		msgs = msgs[i:]
+	}
+	if len(msgs) == 0 {
+		delete(b.messages, uid)
+		return
+	}
+	b.messages[uid] = msgs
+}
+
+// add retains the message of the application.
+func (b *retentionBuffer) add(uid string, msg *ttnpb.ApplicationUp, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.messages[uid] = append(b.messages[uid], retainedMessage{
+		receivedAt: now,
+		msg:        msg,
+	})
+	b.prune(uid, now)
+}
+
+// since returns the retained messages of the application that are received at or after the given time, in the order
+// in which they are received.
+func (b *retentionBuffer) since(uid string, since time.Time, now time.Time) []*ttnpb.ApplicationUp {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.prune(uid, now)
+	var res []*ttnpb.ApplicationUp
+	for _, retained := range b.messages[uid] {
+		if retained.receivedAt.Before(since) {
+			continue
+		}
+		res = append(res, retained.msg)
+	}
+	return res
+}
@@ -0,0 +1,179 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestWebhooksRedeliver(t *testing.T) {
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	downIDs := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              "down-hook",
+	}
+	upIDs := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              "up-hook",
+	}
+	newRegistry := func(t *testing.T) web.WebhookRegistry {
+		registry := &web.MapRegistry{}
+		for ids, baseURL := range map[ttnpb.ApplicationWebhookIdentifiers]string{
+			downIDs: "https://down.com/api",
+			upIDs:   "https://up.com/api",
+		} {
+			baseURL := baseURL
+			_, err := registry.Set(ctx, ids, nil, func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+				return &ttnpb.ApplicationWebhook{
+					BaseURL: baseURL,
+					Format:  "json",
+					UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+						Path: "up",
+					},
+				}, []string{
+					"base_url",
+					"format",
+					"uplink_message",
+				}, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		return registry
+	}
+	newUplink := func(fCnt uint32) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: registeredDeviceID,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					SessionKeyID: []byte{0x11},
+					FPort:        42,
+					FCnt:         fCnt,
+					FRMPayload:   []byte{0x1, 0x2, 0x3},
+				},
+			},
+		}
+	}
+	errDown := errors.DefineUnavailable("down", "receiver is down")
+
+	for _, tc := range []struct {
+		Name      string
+		Retention web.Retention
+		FCnts     []uint32
+	}{
+		{
+			Name:      "All",
+			Retention: web.Retention{MaxCount: 10, MaxAge: time.Hour},
+			FCnts:     []uint32{1, 2, 3},
+		},
+		{
+			Name:      "MaxCount",
+			Retention: web.Retention{MaxCount: 2},
+			FCnts:     []uint32{2, 3},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			var receiverUp int32
+			reqCh := make(chan *http.Request, 10)
+			sink := sinkFunc(func(req *http.Request) error {
+				reqCh <- req
+				if req.URL.Host == "down.com" && atomic.LoadInt32(&receiverUp) == 0 {
+					return errDown
+				}
+				return nil
+			})
+			w := web.NewWebhooks(ctx, nil, newRegistry(t), sink, web.WithRetention(tc.Retention))
+			sub := w.NewSubscription()
+
+			since := time.Now()
+			for fCnt := uint32(1); fCnt <= 3; fCnt++ {
+				if err := sub.SendUp(newUplink(fCnt)); !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				// Both webhooks get the message, but the receiver of one of them is down.
+				for i := 0; i < 2; i++ {
+					select {
+					case <-reqCh:
+					case <-time.After(timeout):
+						t.Fatal("Expected message but nothing received")
+					}
+				}
+			}
+
+			atomic.StoreInt32(&receiverUp, 1)
+			if err := w.Redeliver(ctx, downIDs, since); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			for _, fCnt := range tc.FCnts {
+				select {
+				case req := <-reqCh:
+					a.So(req.URL.String(), should.Equal, "https://down.com/api/up")
+					body, err := ioutil.ReadAll(req.Body)
+					if !a.So(err, should.BeNil) {
+						t.FailNow()
+					}
+					a.So(string(body), should.ContainSubstring, fmt.Sprintf(`"f_cnt":%d`, fCnt))
+				default:
+					t.Fatalf("Expected redelivery of message with FCnt %d", fCnt)
+				}
+			}
+			// The other webhook does not get the messages again.
+			select {
+			case req := <-reqCh:
+				t.Fatalf("Expected no more requests, but got request to %s", req.URL)
+			default:
+			}
+
+			// Messages received before the given time are not redelivered.
+			if err := w.Redeliver(ctx, downIDs, time.Now()); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			select {
+			case req := <-reqCh:
+				t.Fatalf("Expected no requests, but got request to %s", req.URL)
+			default:
+			}
+		})
+	}
+
+	t.Run("NoRetention", func(t *testing.T) {
+		a := assertions.New(t)
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		w := web.NewWebhooks(ctx, nil, newRegistry(t), sinkFunc(func(*http.Request) error { return nil }))
+		err := w.Redeliver(ctx, downIDs, time.Time{})
+		a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+	})
+}
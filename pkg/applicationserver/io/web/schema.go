@@ -0,0 +1,132 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+// PayloadValidator validates the encoded body of a webhook request.
+type PayloadValidator interface {
+	ValidatePayload(body []byte) error
+}
+
+// JSONSchema is a JSON schema that supports a subset of the JSON Schema specification: the types, the required
+// properties and the schemas of properties and array items.
+type JSONSchema struct {
+	// Type is the JSON type of the value: object, array, string, number, integer, boolean or null.
+	// An empty Type allows any type.
+	Type       string                 `json:"type,omitempty"`
+	Required   []string               `json:"required,omitempty"`
+	Properties map[string]*JSONSchema `json:"properties,omitempty"`
+	Items      *JSONSchema            `json:"items,omitempty"`
+}
+
+var (
+	errSchemaType     = errors.DefineInvalidArgument("schema_type", "value of `{path}` is not of type `{type}`")
+	errSchemaRequired = errors.DefineInvalidArgument("schema_required", "value of `{path}` is required")
+	errSchemaJSON     = errors.DefineInvalidArgument("schema_json", "invalid JSON")
+)
+
+// ValidatePayload implements PayloadValidator.
+func (s *JSONSchema) ValidatePayload(body []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return errSchemaJSON.WithCause(err)
+	}
+	return s.validate("$", v)
+}
+
+func (s *JSONSchema) validate(path string, v interface{}) error {
+	if s == nil {
+		return nil
+	}
+	if s.Type != "" && !isJSONType(v, s.Type) {
+		return errSchemaType.WithAttributes("path", path, "type", s.Type)
+	}
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for _, name := range s.Required {
+			if _, ok := val[name]; !ok {
+				return errSchemaRequired.WithAttributes("path", path+"."+name)
+			}
+		}
+		for name, schema := range s.Properties {
+			if prop, ok := val[name]; ok {
+				if err := schema.validate(path+"."+name, prop); err != nil {
+					return err
+				}
+			}
+		}
+	case []interface{}:
+		for i, item := range val {
+			if err := s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func isJSONType(v interface{}, typ string) bool {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return typ == "object"
+	case []interface{}:
+		return typ == "array"
+	case string:
+		return typ == "string"
+	case float64:
+		return typ == "number" || typ == "integer" && val == float64(int64(val))
+	case bool:
+		return typ == "boolean"
+	case nil:
+		return typ == "null"
+	}
+	return false
+}
+
+// JSONUpSchema is the schema of upstream messages in the JSON format.
+var JSONUpSchema = &JSONSchema{
+	Type:     "object",
+	Required: []string{"end_device_ids"},
+	Properties: map[string]*JSONSchema{
+		"end_device_ids": {
+			Type:     "object",
+			Required: []string{"device_id", "application_ids"},
+			Properties: map[string]*JSONSchema{
+				"device_id": {Type: "string"},
+				"application_ids": {
+					Type:     "object",
+					Required: []string{"application_id"},
+					Properties: map[string]*JSONSchema{
+						"application_id": {Type: "string"},
+					},
+				},
+			},
+		},
+		"uplink_message":  {Type: "object"},
+		"join_accept":     {Type: "object"},
+		"downlink_ack":    {Type: "object"},
+		"downlink_nack":   {Type: "object"},
+		"downlink_sent":   {Type: "object"},
+		"downlink_failed": {Type: "object"},
+		"downlink_queued": {Type: "object"},
+		"location_solved": {Type: "object"},
+	},
+}
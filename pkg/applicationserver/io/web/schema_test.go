@@ -0,0 +1,150 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/formatters"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestJSONUpSchema(t *testing.T) {
+	a := assertions.New(t)
+
+	body, err := formatters.JSON.FromUp(&ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(web.JSONUpSchema.ValidatePayload(body), should.BeNil)
+
+	for _, tc := range []struct {
+		Name string
+		Body string
+	}{
+		{
+			Name: "InvalidJSON",
+			Body: `{"end_device_ids":`,
+		},
+		{
+			Name: "NotAnObject",
+			Body: `["end_device_ids"]`,
+		},
+		{
+			Name: "MissingIdentifiers",
+			Body: `{"uplink_message":{"f_port":42}}`,
+		},
+		{
+			Name: "InvalidDeviceID",
+			Body: `{"end_device_ids":{"device_id":42,"application_ids":{"application_id":"foo-app"}}}`,
+		},
+		{
+			Name: "InvalidMessage",
+			Body: `{"end_device_ids":{"device_id":"foo-device","application_ids":{"application_id":"foo-app"}},"uplink_message":"foo"}`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			err := web.JSONUpSchema.ValidatePayload([]byte(tc.Body))
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		})
+	}
+}
+
+func TestWebhooksPayloadValidation(t *testing.T) {
+	msg := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		Name      string
+		Validator web.PayloadValidator
+		Delivered bool
+	}{
+		{
+			Name:      "Conforming",
+			Validator: web.JSONUpSchema,
+			Delivered: true,
+		},
+		{
+			Name: "NonConforming",
+			Validator: &web.JSONSchema{
+				Type:     "object",
+				Required: []string{"unknown_field"},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+			defer cancel()
+			registry := &countingRegistry{
+				hook: &ttnpb.ApplicationWebhook{
+					ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+						ApplicationIdentifiers: registeredApplicationID,
+						WebhookID:              registeredWebhookID,
+					},
+					BaseURL: "https://myapp.com/api/ttn/v3",
+					Format:  "json",
+					UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+						Path: "up",
+					},
+				},
+			}
+			sink := &mockSink{
+				ch: make(chan *http.Request, 1),
+			}
+			w := web.NewWebhooks(ctx, nil, registry, sink, web.WithPayloadValidator("json", tc.Validator))
+			sub := w.NewSubscription()
+			if err := sub.SendUp(msg); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			select {
+			case req := <-sink.ch:
+				a.So(tc.Delivered, should.BeTrue)
+				a.So(req.Header.Get("Content-Type"), should.Equal, "application/json")
+			case <-time.After(timeout):
+				a.So(tc.Delivered, should.BeFalse)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"net/url"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// encryptedBaseURLPrefix is the prefix of encrypted base URLs.
+// An encrypted base URL is formatted as encrypted:<wrapped key>:<nonce and ciphertext>:<KEK label>, where the
+// wrapped key and the nonce and ciphertext are base64 encoded.
+const encryptedBaseURLPrefix = "encrypted:"
+
+var (
+	errNoKeyVault        = errors.DefineFailedPrecondition("no_key_vault", "no key vault to decrypt base URL")
+	errEncryptedBaseURL  = errors.DefineCorruption("encrypted_base_url", "invalid encrypted base URL")
+	errDecryptingBaseURL = errors.DefineCorruption("decrypt_base_url", "decrypt base URL")
+	errBaseURLPrefix     = errors.DefineInvalidArgument("base_url_prefix", "base URL must not start with `{prefix}`")
+)
+
+// encryptBaseURL encrypts the base URL with a random key that is wrapped with the KEK with the given label.
+func encryptBaseURL(keyVault crypto.KeyVault, kekLabel, baseURL string) (string, error) {
+	key := make([]byte, 16)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	wrappedKey, err := keyVault.Wrap(key, kekLabel)
+	if err != nil {
+		return "", err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(baseURL), nil)
+	return encryptedBaseURLPrefix + strings.Join([]string{
+		base64.RawURLEncoding.EncodeToString(wrappedKey),
+		base64.RawURLEncoding.EncodeToString(sealed),
+		kekLabel,
+	}, ":"), nil
+}
+
+// decryptBaseURL decrypts the base URL if it is encrypted. Base URLs that are not encrypted are returned as is.
+func decryptBaseURL(keyVault crypto.KeyVault, baseURL string) (string, error) {
+	if !strings.HasPrefix(baseURL, encryptedBaseURLPrefix) {
+		return baseURL, nil
+	}
+	if keyVault == nil {
+		return "", errNoKeyVault
+	}
+	parts := strings.SplitN(strings.TrimPrefix(baseURL, encryptedBaseURLPrefix), ":", 3)
+	if len(parts) != 3 {
+		return "", errEncryptedBaseURL
+	}
+	wrappedKey, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", errEncryptedBaseURL.WithCause(err)
+	}
+	sealed, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", errEncryptedBaseURL.WithCause(err)
+	}
+	key, err := keyVault.Unwrap(wrappedKey, parts[2])
+	if err != nil {
+		return "", err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", errEncryptedBaseURL
+	}
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", errDecryptingBaseURL.WithCause(err)
+	}
+	return string(plaintext), nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// redactedValue replaces secrets in redacted URLs.
+const redactedValue = "REDACTED"
+
+// redactURL returns the URL with the user information and the query values redacted, so that it can be logged.
+func redactURL(u *url.URL) string {
+	redacted := *u
+	if redacted.User != nil {
+		redacted.User = url.User(redactedValue)
+	}
+	if redacted.RawQuery != "" {
+		query := redacted.Query()
+		for key, values := range query {
+			for i := range values {
+				values[i] = redactedValue
+			}
+			query[key] = values
+		}
+		redacted.RawQuery = query.Encode()
+	}
+	return redacted.String()
+}
+
+// redactError returns err with the URL redacted if err is a *url.Error, since the URL of the request may contain
+// secrets. If the URL cannot be parsed, it is replaced entirely. Other errors are returned as is.
+func redactError(err error) error {
+	urlErr, ok := err.(*url.Error)
+	if !ok {
+		return err
+	}
+	redacted := redactedValue
+	if u, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+		redacted = redactURL(u)
+	}
+	return &url.Error{
+		Op:  urlErr.Op,
+		URL: redacted,
+		Err: urlErr.Err,
+	}
+}
+
+// secretWebhookRegistry is a WebhookRegistry that encrypts the base URLs of webhooks that are marked secret.
+type secretWebhookRegistry struct {
+	WebhookRegistry
+	keyVault crypto.KeyVault
+	kekLabel string
+}
+
+// NewSecretWebhookRegistry returns a WebhookRegistry that encrypts the base URL of webhooks that are marked secret
+// before they are stored in the given registry. The base URLs are encrypted with keys that are wrapped with the KEK
+// with the given label.
+// The base URLs of secret webhooks are returned encrypted; Webhooks decrypts them when it creates requests, if it
+// has the key vault (see WithKeyVault).
+func NewSecretWebhookRegistry(registry WebhookRegistry, keyVault crypto.KeyVault, kekLabel string) WebhookRegistry {
+	return &secretWebhookRegistry{
+		WebhookRegistry: registry,
+		keyVault:        keyVault,
+		kekLabel:        kekLabel,
+	}
+}
+
+func hasPath(paths []string, path string) bool {
+	for _, p := range paths {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
+// Set implements WebhookRegistry.
+// When a webhook is marked secret, its base URL is encrypted. When a webhook is no longer marked secret, its base URL
+// is decrypted.
+func (r *secretWebhookRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, gets []string, f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error)) (*ttnpb.ApplicationWebhook, error) {
+	storedGets := append(append(make([]string, 0, len(gets)+2), gets...), "base_url", "base_url_secret")
+	hook, err := r.WebhookRegistry.Set(ctx, ids, storedGets, func(stored *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		var secret bool
+		var baseURL string
+		if stored != nil {
+			secret, baseURL = stored.BaseURLSecret, stored.BaseURL
+		}
+		hook, sets, err := f(stored)
+		if err != nil || hook == nil {
+			return hook, sets, err
+		}
+		if hasPath(sets, "base_url_secret") {
+			secret = hook.BaseURLSecret
+		}
+		if hasPath(sets, "base_url") {
+			// Only the stored encrypted base URL can be set again, so that other values are not taken to be encrypted.
+			if strings.HasPrefix(hook.BaseURL, encryptedBaseURLPrefix) && hook.BaseURL != baseURL {
+				return nil, nil, errBaseURLPrefix.WithAttributes("prefix", encryptedBaseURLPrefix)
+			}
+			baseURL = hook.BaseURL
+		}
+		encrypted := strings.HasPrefix(baseURL, encryptedBaseURLPrefix)
+		switch {
+		case secret && !encrypted:
+			baseURL, err = encryptBaseURL(r.keyVault, r.kekLabel, baseURL)
+		case !secret && encrypted:
+			baseURL, err = decryptBaseURL(r.keyVault, baseURL)
+		default:
+			return hook, sets, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		// Do not modify the webhook of the caller.
+		updated := *hook
+		updated.BaseURL = baseURL
+		if !hasPath(sets, "base_url") {
+			sets = append(sets, "base_url")
+		}
+		return &updated, sets, nil
+	})
+	if err != nil || hook == nil {
+		return hook, err
+	}
+	res := &ttnpb.ApplicationWebhook{}
+	if err := res.SetFields(hook, append(gets, "ids")...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,143 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+// privateTargetNetworks are the networks that are not publicly routable, including private, loopback and
+// link-local networks. Cloud metadata services, such as 169.254.169.254, are in the link-local network.
+var privateTargetNetworks = mustParseCIDRs(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"::/128",
+	"::1/128",
+	"fc00::/7",
+	"fe80::/10",
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+func networksContain(networks []*net.IPNet, ip net.IP) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	errForbiddenTarget  = errors.DefinePermissionDenied("forbidden_target", "target `{host}` resolves to forbidden address `{address}`")
+	errTooManyRedirects = errors.DefineFailedPrecondition("too_many_redirects", "stopped after `{count}` redirects")
+)
+
+// maxRedirects is the maximum number of redirects that are followed, which is the same as the default of http.Client.
+const maxRedirects = 10
+
+// checkTargetIP returns an error if ip is a private address that is not in allowed.
+func checkTargetIP(allowed []*net.IPNet, host string, ip net.IP) error {
+	if networksContain(privateTargetNetworks, ip) && !networksContain(allowed, ip) {
+		return errForbiddenTarget.WithAttributes("host", host, "address", ip.String())
+	}
+	return nil
+}
+
+// checkTarget returns an error if the host resolves to a private address that is not in allowed.
+func checkTarget(ctx context.Context, allowed []*net.IPNet, host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		return checkTargetIP(allowed, host, ip)
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		if err := checkTargetIP(allowed, host, addr.IP); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// targetControl returns a net.Dialer Control function that refuses connections to private addresses that are not in
+// allowed. The address is checked after name resolution, right before connecting, so that a host cannot resolve to
+// another address between the check and the connection.
+func targetControl(allowed []*net.IPNet) func(network, address string, c syscall.RawConn) error {
+	return func(network, address string, _ syscall.RawConn) error {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return err
+		}
+		ip := net.ParseIP(host)
+		if ip == nil {
+			return errForbiddenTarget.WithAttributes("host", host, "address", host)
+		}
+		return checkTargetIP(allowed, host, ip)
+	}
+}
+
+// TargetRedirectPolicy returns a CheckRedirect function of an http.Client that refuses redirects to hosts that resolve
+// to private addresses that are not in allowedTargets. Like the default policy, it stops after 10 redirects.
+// The connections to redirect targets are checked as well if the transport blocks private targets; this policy makes
+// redirects to forbidden targets fail before they are followed.
+func TargetRedirectPolicy(allowedTargets []*net.IPNet) func(req *http.Request, via []*http.Request) error {
+	return func(req *http.Request, via []*http.Request) error {
+		if len(via) >= maxRedirects {
+			return errTooManyRedirects.WithAttributes("count", len(via))
+		}
+		return checkTarget(req.Context(), allowedTargets, req.URL.Hostname())
+	}
+}
+
+// forbiddenTargetCause returns the forbidden target error that caused the request error err, or nil if the request
+// did not fail because of a forbidden target.
+func forbiddenTargetCause(err error) error {
+	for {
+		switch e := err.(type) {
+		case *url.Error:
+			err = e.Err
+		case *net.OpError:
+			err = e.Err
+		default:
+			if errors.Resemble(err, errForbiddenTarget) {
+				return err
+			}
+			return nil
+		}
+	}
+}
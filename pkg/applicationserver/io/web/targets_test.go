@@ -0,0 +1,157 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestHTTPClientSinkPrivateTargets(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, r.URL.Query().Get("to"), http.StatusFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+	srvURL, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newSink := func(t *testing.T, allowed ...string) *web.HTTPClientSink {
+		var allowedTargets []*net.IPNet
+		for _, cidr := range allowed {
+			_, network, err := net.ParseCIDR(cidr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			allowedTargets = append(allowedTargets, network)
+		}
+		transport, err := web.NewHTTPTransport(web.HTTPTransportConfig{
+			ProxyURL:            "http://proxy.invalid",
+			NoProxy:             "*",
+			BlockPrivateTargets: true,
+			AllowedTargets:      allowedTargets,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &web.HTTPClientSink{
+			Client: &http.Client{
+				Transport:     transport,
+				CheckRedirect: web.TargetRedirectPolicy(allowedTargets),
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name      string
+		URL       string
+		Allowed   []string
+		Forbidden bool
+	}{
+		{
+			Name:      "Metadata",
+			URL:       "http://169.254.169.254/latest/meta-data",
+			Forbidden: true,
+		},
+		{
+			Name:      "LoopbackIPv6",
+			URL:       "http://[::1]:8080/webhook",
+			Forbidden: true,
+		},
+		{
+			Name:      "Private",
+			URL:       "http://192.168.1.1/webhook",
+			Forbidden: true,
+		},
+		{
+			Name:      "NotAllowedPrivate",
+			URL:       "http://10.11.1.1/webhook",
+			Allowed:   []string{"10.10.0.0/16"},
+			Forbidden: true,
+		},
+		{
+			Name:      "Loopback",
+			URL:       srv.URL + "/webhook",
+			Forbidden: true,
+		},
+		{
+			// The host name is resolved by the dialer, so the resolved address is checked right before connecting.
+			Name:      "LoopbackName",
+			URL:       "http://localhost:" + srvURL.Port() + "/webhook",
+			Forbidden: true,
+		},
+		{
+			Name:    "AllowedLoopback",
+			URL:     srv.URL + "/webhook",
+			Allowed: []string{"127.0.0.0/8"},
+		},
+		{
+			Name:      "Redirect",
+			URL:       srv.URL + "/redirect?to=" + url.QueryEscape("http://127.0.0.2:"+srvURL.Port()+"/webhook"),
+			Allowed:   []string{"127.0.0.1/32"},
+			Forbidden: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			req, err := http.NewRequest(http.MethodPost, tc.URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			before := atomic.LoadInt32(&requests)
+			err = newSink(t, tc.Allowed...).Process(req)
+			if tc.Forbidden {
+				a.So(errors.IsPermissionDenied(err), should.BeTrue)
+			} else {
+				a.So(err, should.BeNil)
+				a.So(atomic.LoadInt32(&requests), should.Equal, before+1)
+			}
+		})
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		a := assertions.New(t)
+		sink := &web.HTTPClientSink{
+			Client: http.DefaultClient,
+		}
+		req, err := http.NewRequest(http.MethodPost, srv.URL+"/webhook", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.So(sink.Process(req), should.BeNil)
+	})
+}
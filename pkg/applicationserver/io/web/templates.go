@@ -0,0 +1,249 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mohae/deepcopy"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// WebhookTemplateField is a field of a webhook template that is provided by the user.
+type WebhookTemplateField struct {
+	ID          string
+	Name        string
+	Description string
+	// Secret indicates that the value of the field is a secret, such as a token or a password.
+	Secret bool
+	// Optional indicates that the field may be left empty.
+	Optional bool
+}
+
+// WebhookTemplate is a preset of a webhook for an integration.
+//
+// The base URL, header values and message paths of the webhook may contain placeholders in the form `{field}`, which
+// are replaced by the values of the template fields. Headers that refer to an empty optional field are omitted.
+type WebhookTemplate struct {
+	ID          string
+	Name        string
+	Description string
+	Fields      []WebhookTemplateField
+	Webhook     ttnpb.ApplicationWebhook
+}
+
+var (
+	errTemplateFieldMissing = errors.DefineInvalidArgument("template_field_missing", "field `{field}` of template `{template}` is missing")
+	errTemplateFieldUnknown = errors.DefineInvalidArgument("template_field_unknown", "template `{template}` has no field `{field}`")
+)
+
+func placeholder(fieldID string) string {
+	return "{" + fieldID + "}"
+}
+
+// Instantiate returns a new webhook with the given identifiers from the template, using the given values for the
+// template fields. The returned webhook is validated.
+func (t *WebhookTemplate) Instantiate(ids ttnpb.ApplicationWebhookIdentifiers, values map[string]string) (*ttnpb.ApplicationWebhook, error) {
+	fields := make(map[string]bool, len(t.Fields))
+	replacements := make([]string, 0, 2*len(t.Fields))
+	var empty []string
+	for _, field := range t.Fields {
+		fields[field.ID] = true
+		value := values[field.ID]
+		if value == "" {
+			if !field.Optional {
+				return nil, errTemplateFieldMissing.WithAttributes("template", t.ID, "field", field.ID)
+			}
+			empty = append(empty, field.ID)
+		}
+		replacements = append(replacements, placeholder(field.ID), value)
+	}
+	for id := range values {
+		if !fields[id] {
+			return nil, errTemplateFieldUnknown.WithAttributes("template", t.ID, "field", id)
+		}
+	}
+	replacer := strings.NewReplacer(replacements...)
+
+	hook := deepcopy.Copy(&t.Webhook).(*ttnpb.ApplicationWebhook)
+	hook.ApplicationWebhookIdentifiers = ids
+	hook.BaseURL = replacer.Replace(hook.BaseURL)
+headers:
+	for key, value := range hook.Headers {
+		for _, id := range empty {
+			if strings.Contains(value, placeholder(id)) {
+				delete(hook.Headers, key)
+				continue headers
+			}
+		}
+		hook.Headers[key] = replacer.Replace(value)
+	}
+	for _, msg := range []*ttnpb.ApplicationWebhook_Message{
+		hook.UplinkMessage,
+		hook.JoinAccept,
+		hook.DownlinkAck,
+		hook.DownlinkNack,
+		hook.DownlinkSent,
+		hook.DownlinkFailed,
+		hook.DownlinkQueued,
+		hook.LocationSolved,
+		hook.Default,
+	} {
+		if msg != nil {
+			msg.Path = replacer.Replace(msg.Path)
+		}
+	}
+	if _, ok := formats[hook.Format]; !ok {
+		return nil, errFormatNotFound.WithAttributes("format", hook.Format)
+	}
+	if err := hook.Validate(); err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+var (
+	errTemplateNotFound = errors.DefineNotFound("template_not_found", "template `{template}` not found")
+	errTemplateExists   = errors.DefineAlreadyExists("template_exists", "template `{template}` already exists")
+)
+
+// TemplateRegistry is a registry of webhook templates.
+// The zero value is ready to use.
+type TemplateRegistry struct {
+	mu        sync.RWMutex
+	templates map[string]*WebhookTemplate
+}
+
+// NewTemplateRegistry returns a new TemplateRegistry with the given templates.
+// This function panics if a template ID is used more than once.
+func NewTemplateRegistry(templates ...*WebhookTemplate) *TemplateRegistry {
+	r := &TemplateRegistry{}
+	for _, t := range templates {
+		if err := r.Register(t); err != nil {
+			panic(err)
+		}
+	}
+	return r
+}
+
+// Register registers the template.
+func (r *TemplateRegistry) Register(t *WebhookTemplate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.templates[t.ID]; ok {
+		return errTemplateExists.WithAttributes("template", t.ID)
+	}
+	if r.templates == nil {
+		r.templates = make(map[string]*WebhookTemplate)
+	}
+	r.templates[t.ID] = t
+	return nil
+}
+
+// Get returns the template by its ID.
+func (r *TemplateRegistry) Get(id string) (*WebhookTemplate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.templates[id]
+	if !ok {
+		return nil, errTemplateNotFound.WithAttributes("template", id)
+	}
+	return t, nil
+}
+
+// List returns the registered templates, sorted by ID.
+func (r *TemplateRegistry) List() []*WebhookTemplate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	templates := make([]*WebhookTemplate, 0, len(r.templates))
+	for _, t := range r.templates {
+		templates = append(templates, t)
+	}
+	sort.Slice(templates, func(i, j int) bool { return templates[i].ID < templates[j].ID })
+	return templates
+}
+
+var baseURLTemplateField = WebhookTemplateField{
+	ID:          "base_url",
+	Name:        "Base URL",
+	Description: "Base URL to which the message paths are appended",
+}
+
+// DefaultTemplates are the webhook templates that are available by default.
+var DefaultTemplates = []*WebhookTemplate{
+	{
+		ID:          "generic-json",
+		Name:        "Generic JSON",
+		Description: "Send all messages as JSON, optionally authenticated with a bearer token",
+		Fields: []WebhookTemplateField{
+			baseURLTemplateField,
+			{
+				ID:          "token",
+				Name:        "Bearer token",
+				Description: "Token that is sent in the Authorization header",
+				Secret:      true,
+				Optional:    true,
+			},
+		},
+		Webhook: ttnpb.ApplicationWebhook{
+			BaseURL: "{base_url}",
+			Headers: map[string]string{
+				"Authorization": "Bearer {token}",
+			},
+			Format:         "json",
+			UplinkMessage:  &ttnpb.ApplicationWebhook_Message{Path: "/uplink"},
+			JoinAccept:     &ttnpb.ApplicationWebhook_Message{Path: "/join"},
+			DownlinkAck:    &ttnpb.ApplicationWebhook_Message{Path: "/downlink/ack"},
+			DownlinkNack:   &ttnpb.ApplicationWebhook_Message{Path: "/downlink/nack"},
+			DownlinkSent:   &ttnpb.ApplicationWebhook_Message{Path: "/downlink/sent"},
+			DownlinkFailed: &ttnpb.ApplicationWebhook_Message{Path: "/downlink/failed"},
+			DownlinkQueued: &ttnpb.ApplicationWebhook_Message{Path: "/downlink/queued"},
+			LocationSolved: &ttnpb.ApplicationWebhook_Message{Path: "/location"},
+		},
+	},
+	{
+		ID:          "generic-protobuf",
+		Name:        "Generic Protocol Buffers",
+		Description: "Send all messages as gzip-compressed Protocol Buffers, authenticated with a shared secret",
+		Fields: []WebhookTemplateField{
+			baseURLTemplateField,
+			{
+				ID:          "secret",
+				Name:        "Secret",
+				Description: "Shared secret that is sent in the X-Webhook-Secret header",
+				Secret:      true,
+			},
+		},
+		Webhook: ttnpb.ApplicationWebhook{
+			BaseURL: "{base_url}",
+			Headers: map[string]string{
+				"X-Webhook-Secret": "{secret}",
+			},
+			Format:         "protobuf",
+			UplinkMessage:  &ttnpb.ApplicationWebhook_Message{Path: "/uplink"},
+			JoinAccept:     &ttnpb.ApplicationWebhook_Message{Path: "/join"},
+			DownlinkAck:    &ttnpb.ApplicationWebhook_Message{Path: "/downlink/ack"},
+			DownlinkNack:   &ttnpb.ApplicationWebhook_Message{Path: "/downlink/nack"},
+			DownlinkSent:   &ttnpb.ApplicationWebhook_Message{Path: "/downlink/sent"},
+			DownlinkFailed: &ttnpb.ApplicationWebhook_Message{Path: "/downlink/failed"},
+			DownlinkQueued: &ttnpb.ApplicationWebhook_Message{Path: "/downlink/queued"},
+			LocationSolved: &ttnpb.ApplicationWebhook_Message{Path: "/location"},
+			Compression:    "gzip",
+		},
+	},
+}
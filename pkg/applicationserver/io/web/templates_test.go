@@ -0,0 +1,203 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestWebhookTemplates(t *testing.T) {
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+	registry := web.NewTemplateRegistry(web.DefaultTemplates...)
+
+	t.Run("List", func(t *testing.T) {
+		a := assertions.New(t)
+		templates := registry.List()
+		if a.So(templates, should.HaveLength, 2) {
+			a.So(templates[0].ID, should.Equal, "generic-json")
+			a.So(templates[1].ID, should.Equal, "generic-protobuf")
+		}
+		_, err := registry.Get("unknown")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+		err = registry.Register(web.DefaultTemplates[0])
+		a.So(errors.IsAlreadyExists(err), should.BeTrue)
+	})
+
+	t.Run("Instantiate", func(t *testing.T) {
+		a := assertions.New(t)
+		template, err := registry.Get("generic-protobuf")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		hook, err := template.Instantiate(ids, map[string]string{
+			"base_url": "https://myapp.com/api/ttn/v3",
+			"secret":   "secret-value",
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(hook.ApplicationWebhookIdentifiers, should.Resemble, ids)
+		a.So(hook.BaseURL, should.Equal, "https://myapp.com/api/ttn/v3")
+		a.So(hook.Format, should.Equal, "protobuf")
+		a.So(hook.Compression, should.Equal, "gzip")
+		a.So(hook.Headers, should.Resemble, map[string]string{
+			"X-Webhook-Secret": "secret-value",
+		})
+		a.So(hook.UplinkMessage, should.Resemble, &ttnpb.ApplicationWebhook_Message{Path: "/uplink"})
+		a.So(hook.LocationSolved, should.Resemble, &ttnpb.ApplicationWebhook_Message{Path: "/location"})
+
+		// The template itself is not modified.
+		a.So(template.Webhook.BaseURL, should.Equal, "{base_url}")
+		a.So(template.Webhook.Headers["X-Webhook-Secret"], should.Equal, "{secret}")
+	})
+
+	t.Run("OptionalField", func(t *testing.T) {
+		a := assertions.New(t)
+		template, err := registry.Get("generic-json")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		hook, err := template.Instantiate(ids, map[string]string{
+			"base_url": "https://myapp.com/api/ttn/v3",
+			"token":    "my-token",
+		})
+		a.So(err, should.BeNil)
+		a.So(hook.Headers, should.Resemble, map[string]string{
+			"Authorization": "Bearer my-token",
+		})
+
+		hook, err = template.Instantiate(ids, map[string]string{
+			"base_url": "https://myapp.com/api/ttn/v3",
+		})
+		a.So(err, should.BeNil)
+		a.So(hook.Headers, should.BeEmpty)
+	})
+
+	t.Run("InvalidFields", func(t *testing.T) {
+		a := assertions.New(t)
+		template, err := registry.Get("generic-protobuf")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		_, err = template.Instantiate(ids, map[string]string{
+			"base_url": "https://myapp.com/api/ttn/v3",
+		})
+		if a.So(errors.IsInvalidArgument(err), should.BeTrue) {
+			a.So(errors.Attributes(err)["field"], should.Equal, "secret")
+		}
+		_, err = template.Instantiate(ids, map[string]string{
+			"base_url": "https://myapp.com/api/ttn/v3",
+			"secret":   "secret-value",
+			"unknown":  "value",
+		})
+		if a.So(errors.IsInvalidArgument(err), should.BeTrue) {
+			a.So(errors.Attributes(err)["field"], should.Equal, "unknown")
+		}
+	})
+}
+
+func TestCreateFromTemplate(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	registry := &web.MapRegistry{}
+	w := web.NewWebhooks(ctx, nil, registry, nil)
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+
+	_, err := w.CreateFromTemplate(ctx, ids, "unknown", nil)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	hook, err := w.CreateFromTemplate(ctx, ids, "generic-json", map[string]string{
+		"base_url": "https://myapp.com/api/ttn/v3",
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(hook.CreatedAt.IsZero(), should.BeFalse)
+
+	stored, err := registry.Get(ctx, ids, ttnpb.ApplicationWebhookFieldPathsTopLevel)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(stored.BaseURL, should.Equal, "https://myapp.com/api/ttn/v3")
+	a.So(stored.Format, should.Equal, "json")
+	a.So(stored.UplinkMessage, should.Resemble, &ttnpb.ApplicationWebhook_Message{Path: "/uplink"})
+	a.So(stored.JoinAccept, should.Resemble, &ttnpb.ApplicationWebhook_Message{Path: "/join"})
+
+	_, err = w.CreateFromTemplate(ctx, ids, "generic-json", map[string]string{
+		"base_url": "https://otherapp.com",
+	})
+	a.So(errors.IsAlreadyExists(err), should.BeTrue)
+
+	// All fields that the template populates are stored.
+	err = w.Templates().Register(&web.WebhookTemplate{
+		ID: "batched",
+		Fields: []web.WebhookTemplateField{
+			{ID: "base_url"},
+		},
+		Webhook: ttnpb.ApplicationWebhook{
+			BaseURL:               "{base_url}",
+			Headers:               map[string]string{"X-Source": "template"},
+			Format:                "json",
+			Method:                "PUT",
+			Default:               &ttnpb.ApplicationWebhook_Message{Path: "/messages"},
+			AcceptedStatusCodes:   []uint32{200, 202},
+			ExcludeRawPayload:     true,
+			ExcludeDecodedPayload: true,
+			MaxBatchSize:          10,
+			MaxBatchLinger:        5 * time.Second,
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	batchedIDs := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              "batched",
+	}
+	_, err = w.CreateFromTemplate(ctx, batchedIDs, "batched", map[string]string{
+		"base_url": "https://myapp.com/api/ttn/v3",
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	stored, err = registry.Get(ctx, batchedIDs, ttnpb.ApplicationWebhookFieldPathsTopLevel)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(stored.BaseURL, should.Equal, "https://myapp.com/api/ttn/v3")
+	a.So(stored.Headers, should.Resemble, map[string]string{"X-Source": "template"})
+	a.So(stored.Method, should.Equal, "PUT")
+	a.So(stored.Default, should.Resemble, &ttnpb.ApplicationWebhook_Message{Path: "/messages"})
+	a.So(stored.AcceptedStatusCodes, should.Resemble, []uint32{200, 202})
+	a.So(stored.ExcludeRawPayload, should.BeTrue)
+	a.So(stored.ExcludeDecodedPayload, should.BeTrue)
+	a.So(stored.MaxBatchSize, should.Equal, 10)
+	a.So(stored.MaxBatchLinger, should.Equal, 5*time.Second)
+}
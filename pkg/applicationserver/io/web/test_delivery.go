@@ -0,0 +1,142 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	stdio "io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// testDeliveryHeader is the header that flags test deliveries. Receivers can use it to ignore test deliveries.
+const testDeliveryHeader = "X-TTS-Test"
+
+// maxTestResponseBodySize is the maximum size of the response body that is returned by a test delivery.
+const maxTestResponseBodySize = 1 << 10
+
+// defaultTestDeliveryTimeout is the timeout of test deliveries.
+const defaultTestDeliveryTimeout = 10 * time.Second
+
+// TestResult is the result of a test delivery.
+type TestResult struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Body is the start of the response body, truncated to 1 KiB.
+	Body []byte
+}
+
+// WithTestClient returns an Option that performs test deliveries with the given HTTP client.
+func WithTestClient(client *http.Client) Option {
+	return func(w *webhooks) {
+		w.testClient = client
+	}
+}
+
+// testUplink returns a synthetic uplink message of an end device of the application.
+func testUplink(ids ttnpb.ApplicationIdentifiers) *ttnpb.ApplicationUp {
+	now := time.Now().UTC()
+	return &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ids,
+			DeviceID:               "test-device",
+		},
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x00},
+				FPort:        1,
+				FCnt:         1,
+				FRMPayload:   []byte{0x01, 0x02, 0x03},
+				RxMetadata: []*ttnpb.RxMetadata{
+					{
+						GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+							GatewayID: "test-gateway",
+						},
+						Time: &now,
+						RSSI: -42,
+						SNR:  4.2,
+					},
+				},
+				Settings: ttnpb.TxSettings{
+					DataRate: ttnpb.DataRate{
+						Modulation: &ttnpb.DataRate_LoRa{
+							LoRa: &ttnpb.LoRaDataRate{
+								Bandwidth:       125000,
+								SpreadingFactor: 7,
+							},
+						},
+					},
+					CodingRate: "4/5",
+					Frequency:  868100000,
+				},
+			},
+		},
+	}
+}
+
+var errTestDelivery = errors.DefineUnavailable("test_delivery", "test delivery failed")
+
+// Test implements Webhooks.
+// The test request is performed directly instead of through the target sink, as the target sink may queue the
+// request and does not return the response.
+func (w *webhooks) Test(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) (*TestResult, error) {
+	hook, err := w.registry.Get(ctx, ids,
+		[]string{
+			"base_url",
+			"headers",
+			"format",
+			"compression",
+			"exclude_raw_payload",
+			"exclude_decoded_payload",
+			"projection_paths",
+			"strict_projection",
+			"method",
+			"uplink_message",
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if hook == nil {
+		return nil, errWebhookNotFound
+	}
+	if hook.UplinkMessage == nil {
+		copied := *hook
+		copied.UplinkMessage = &ttnpb.ApplicationWebhook_Message{}
+		hook = &copied
+	}
+	req, err := w.newRequest(ctx, testUplink(ids.ApplicationIdentifiers), hook)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(testDeliveryHeader, "true")
+	res, err := w.testClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errTestDelivery.WithCause(redactError(err))
+	}
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(stdio.LimitReader(res.Body, maxTestResponseBodySize))
+	if err != nil {
+		return nil, errTestDelivery.WithCause(err)
+	}
+	return &TestResult{
+		StatusCode: res.StatusCode,
+		Body:       body,
+	}, nil
+}
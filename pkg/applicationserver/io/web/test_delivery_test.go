@@ -0,0 +1,119 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestWebhooksTest(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+	defer cancel()
+
+	reqCh := make(chan *http.Request, 1)
+	bodyCh := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		reqCh <- r
+		bodyCh <- body
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte("accepted"))
+	}))
+	defer server.Close()
+
+	registry := &web.MapRegistry{}
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+	_, err := registry.Set(ctx, ids, nil, func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		return &ttnpb.ApplicationWebhook{
+			ApplicationWebhookIdentifiers: ids,
+			BaseURL:                       server.URL,
+			Format:                        "json",
+			Method:                        http.MethodPut,
+			UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+				Path: "up",
+			},
+		}, []string{"base_url", "format", "method", "uplink_message"}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	w := web.NewWebhooks(ctx, nil, registry, sinkFunc(func(*http.Request) error {
+		t.Error("Test delivery must not be processed by the target sink")
+		return nil
+	}))
+
+	res, err := w.Test(ctx, ids)
+	if !a.So(err, should.BeNil) || !a.So(res, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(res.StatusCode, should.Equal, http.StatusAccepted)
+	a.So(string(res.Body), should.Equal, "accepted")
+
+	req, body := <-reqCh, <-bodyCh
+	a.So(req.Method, should.Equal, http.MethodPut)
+	a.So(req.URL.Path, should.Equal, "/up")
+	a.So(req.Header.Get("X-TTS-Test"), should.Equal, "true")
+
+	var up struct {
+		EndDeviceIDs struct {
+			DeviceID       string `json:"device_id"`
+			ApplicationIDs struct {
+				ApplicationID string `json:"application_id"`
+			} `json:"application_ids"`
+		} `json:"end_device_ids"`
+		UplinkMessage *struct {
+			FPort      int    `json:"f_port"`
+			FRMPayload string `json:"frm_payload"`
+			RxMetadata []struct {
+				RSSI float64 `json:"rssi"`
+			} `json:"rx_metadata"`
+		} `json:"uplink_message"`
+	}
+	if !a.So(json.Unmarshal(body, &up), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(up.EndDeviceIDs.DeviceID, should.Equal, "test-device")
+	a.So(up.EndDeviceIDs.ApplicationIDs.ApplicationID, should.Equal, registeredApplicationID.ApplicationID)
+	if a.So(up.UplinkMessage, should.NotBeNil) {
+		a.So(up.UplinkMessage.FPort, should.Equal, 1)
+		a.So(up.UplinkMessage.FRMPayload, should.Equal, "AQID")
+		a.So(up.UplinkMessage.RxMetadata, should.HaveLength, 1)
+	}
+
+	// Unknown webhook.
+	_, err = w.Test(ctx, ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              "unknown",
+	})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
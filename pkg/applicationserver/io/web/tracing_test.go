@@ -0,0 +1,152 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.opencensus.io/plugin/ochttp/propagation/tracecontext"
+	"go.opencensus.io/trace"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+type memoryExporter struct {
+	mu    sync.Mutex
+	spans []*trace.SpanData
+}
+
+func (e *memoryExporter) ExportSpan(s *trace.SpanData) {
+	e.mu.Lock()
+	e.spans = append(e.spans, s)
+	e.mu.Unlock()
+}
+
+func (e *memoryExporter) Spans(name string) []*trace.SpanData {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var res []*trace.SpanData
+	for _, s := range e.spans {
+		if s.Name == name {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+func TestWebhooksTracing(t *testing.T) {
+	exporter := &memoryExporter{}
+	trace.RegisterExporter(exporter)
+	defer trace.UnregisterExporter(exporter)
+	trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
+	defer trace.ApplyConfig(trace.Config{DefaultSampler: trace.ProbabilitySampler(1e-4)})
+
+	errTest := errors.DefineUnavailable("test", "test")
+
+	for _, tc := range []struct {
+		Name       string
+		ProcessErr error
+		StatusCode int32
+	}{
+		{
+			Name: "Success",
+		},
+		{
+			Name:       "Failure",
+			ProcessErr: errTest,
+			StatusCode: int32(errTest.Code()),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+			defer cancel()
+
+			registry := &countingRegistry{
+				hook: &ttnpb.ApplicationWebhook{
+					ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+						ApplicationIdentifiers: registeredApplicationID,
+						WebhookID:              registeredWebhookID + "-" + tc.Name,
+					},
+					BaseURL: "https://myapp.com/api/ttn/v3",
+					Format:  "json",
+					UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+						Path: "up",
+					},
+				},
+			}
+			reqCh := make(chan *http.Request, 1)
+			sink := sinkFunc(func(req *http.Request) error {
+				reqCh <- req
+				return tc.ProcessErr
+			})
+			w := web.NewWebhooks(ctx, nil, registry, sink)
+			sub := w.NewSubscription()
+
+			err := sub.SendUp(&ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: registeredDeviceID,
+				Up: &ttnpb.ApplicationUp_UplinkMessage{
+					UplinkMessage: &ttnpb.ApplicationUplink{
+						SessionKeyID: []byte{0x11},
+						FPort:        42,
+						FCnt:         42,
+						FRMPayload:   []byte{0x1, 0x2, 0x3},
+					},
+				},
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			var req *http.Request
+			select {
+			case req = <-reqCh:
+			case <-time.After(timeout):
+				t.Fatal("Expected request to be processed")
+			}
+			sc, ok := (&tracecontext.HTTPFormat{}).SpanContextFromRequest(req)
+			if !a.So(ok, should.BeTrue) {
+				t.FailNow()
+			}
+
+			var span *trace.SpanData
+			for start := time.Now(); span == nil && time.Since(start) < timeout; time.Sleep(test.Delay) {
+				for _, s := range exporter.Spans("webhook.deliver") {
+					if s.Attributes["webhook_id"] == registeredWebhookID+"-"+tc.Name {
+						span = s
+					}
+				}
+			}
+			if !a.So(span, should.NotBeNil) {
+				t.FailNow()
+			}
+			a.So(span.SpanKind, should.Equal, trace.SpanKindClient)
+			a.So(span.Attributes["application_id"], should.Equal, registeredApplicationID.ApplicationID)
+			a.So(span.Status.Code, should.Equal, tc.StatusCode)
+			// The propagated trace context is the one of the delivery span.
+			a.So(sc.TraceID, should.Equal, span.TraceID)
+			a.So(sc.SpanID, should.Equal, span.SpanID)
+		})
+	}
+}
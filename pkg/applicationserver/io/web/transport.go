@@ -0,0 +1,125 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"golang.org/x/net/http/httpproxy"
+	"golang.org/x/net/http2"
+)
+
+// HTTPTransportConfig configures the connections of the HTTP client of an HTTPClientSink.
+type HTTPTransportConfig struct {
+	// MaxIdleConns is the maximum number of idle connections across all hosts. Zero is unlimited.
+	MaxIdleConns int
+	// MaxIdleConnsPerHost is the maximum number of idle connections that are kept per host for reuse.
+	// Zero uses http.DefaultMaxIdleConnsPerHost, which is too low to reuse connections under high volume.
+	MaxIdleConnsPerHost int
+	// IdleConnTimeout is the time after which an idle connection is closed. Zero keeps idle connections open.
+	IdleConnTimeout time.Duration
+	// KeepAlive is the interval of TCP keep-alive probes. Zero disables keep-alive probes.
+	KeepAlive time.Duration
+	// DisableHTTP2 disables HTTP/2, so that connections to TLS hosts use HTTP/1.1.
+	DisableHTTP2 bool
+	// ProxyURL is the URL of the HTTP or HTTPS proxy through which requests are sent. HTTPS requests are tunneled with
+	// CONNECT. If empty, the proxy is taken from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+	ProxyURL string
+	// ProxyUsername and ProxyPassword are the credentials for the proxy. They override credentials in ProxyURL.
+	ProxyUsername, ProxyPassword string
+	// NoProxy is a comma-separated list of hosts, domains, IP addresses and CIDR networks that are not proxied, in
+	// the format of the NO_PROXY environment variable. It is only used with ProxyURL.
+	NoProxy string
+	// BlockPrivateTargets makes the transport refuse connections to private, loopback or link-local addresses, so that
+	// webhooks cannot be used to probe internal services. Addresses are checked after name resolution, right before
+	// connecting. Connections to the proxy are checked as well, so a private proxy must be in AllowedTargets.
+	BlockPrivateTargets bool
+	// AllowedTargets are the networks that may be connected to even if BlockPrivateTargets is set.
+	AllowedTargets []*net.IPNet
+}
+
+// DefaultHTTPTransportConfig is the transport configuration for high volumes of requests to few hosts.
+var DefaultHTTPTransportConfig = HTTPTransportConfig{
+	MaxIdleConns:        1024,
+	MaxIdleConnsPerHost: 64,
+	IdleConnTimeout:     90 * time.Second,
+	KeepAlive:           30 * time.Second,
+}
+
+var errProxyURL = errors.DefineInvalidArgument("proxy_url", "invalid proxy URL")
+
+// proxyFunc returns the function that selects the proxy of requests with the configuration.
+// Requests to localhost are never proxied.
+func proxyFunc(config HTTPTransportConfig) (func(*http.Request) (*url.URL, error), error) {
+	if config.ProxyURL == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+	proxyURL, err := url.Parse(config.ProxyURL)
+	if err != nil {
+		return nil, errProxyURL.WithCause(err)
+	}
+	if proxyURL.Host == "" {
+		return nil, errProxyURL
+	}
+	if config.ProxyUsername != "" {
+		proxyURL.User = url.UserPassword(config.ProxyUsername, config.ProxyPassword)
+	}
+	proxy := (&httpproxy.Config{
+		HTTPProxy:  proxyURL.String(),
+		HTTPSProxy: proxyURL.String(),
+		NoProxy:    config.NoProxy,
+	}).ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}, nil
+}
+
+// NewHTTPTransport returns a new HTTP transport with the configuration.
+func NewHTTPTransport(config HTTPTransportConfig) (*http.Transport, error) {
+	proxy, err := proxyFunc(config)
+	if err != nil {
+		return nil, err
+	}
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: config.KeepAlive,
+	}
+	if config.BlockPrivateTargets {
+		dialer.Control = targetControl(config.AllowedTargets)
+	}
+	transport := &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           dialer.DialContext,
+		MaxIdleConns:          config.MaxIdleConns,
+		MaxIdleConnsPerHost:   config.MaxIdleConnsPerHost,
+		IdleConnTimeout:       config.IdleConnTimeout,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	}
+	if config.DisableHTTP2 {
+		// A non-nil, empty map disables HTTP/2.
+		transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+		return transport, nil
+	}
+	if err := http2.ConfigureTransport(transport); err != nil {
+		return nil, err
+	}
+	return transport, nil
+}
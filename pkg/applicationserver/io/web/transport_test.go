@@ -0,0 +1,262 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestHTTPClientSinkConnectionReuse(t *testing.T) {
+	const (
+		workers  = 16
+		requests = 20
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	for _, tc := range []struct {
+		Name     string
+		Config   web.HTTPTransportConfig
+		MaxDials int32
+	}{
+		{
+			Name:     "Default",
+			Config:   web.DefaultHTTPTransportConfig,
+			MaxDials: workers,
+		},
+		{
+			Name: "NoHTTP2",
+			Config: web.HTTPTransportConfig{
+				MaxIdleConnsPerHost: workers,
+				DisableHTTP2:        true,
+			},
+			MaxDials: workers,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			transport, err := web.NewHTTPTransport(tc.Config)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			defer transport.CloseIdleConnections()
+			var dials int32
+			dial := transport.DialContext
+			transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+				atomic.AddInt32(&dials, 1)
+				return dial(ctx, network, addr)
+			}
+			sink := &web.HTTPClientSink{
+				Client: &http.Client{
+					Transport: transport,
+				},
+			}
+
+			var wg sync.WaitGroup
+			errs := make(chan error, workers*requests)
+			for i := 0; i < workers; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					for j := 0; j < requests; j++ {
+						req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/%d/%d", server.URL, i, j), nil)
+						if err != nil {
+							errs <- err
+							continue
+						}
+						errs <- sink.Process(req)
+					}
+				}(i)
+			}
+			wg.Wait()
+			close(errs)
+			for err := range errs {
+				a.So(err, should.BeNil)
+			}
+			a.So(atomic.LoadInt32(&dials), should.BeBetweenOrEqual, 1, tc.MaxDials)
+		})
+	}
+}
+
+func TestHTTPClientSinkProxy(t *testing.T) {
+	type proxiedRequest struct {
+		Method        string
+		RequestURI    string
+		Authorization string
+	}
+
+	var targetRequests int32
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&targetRequests, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer target.Close()
+
+	proxied := make(chan proxiedRequest, 1)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied <- proxiedRequest{
+			Method:        r.Method,
+			RequestURI:    r.RequestURI,
+			Authorization: r.Header.Get("Proxy-Authorization"),
+		}
+		if r.Method == http.MethodConnect {
+			// The fake proxy does not tunnel connections.
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	basicAuth := "Basic " + base64.StdEncoding.EncodeToString([]byte("user:secret"))
+
+	for _, tc := range []struct {
+		Name           string
+		Config         web.HTTPTransportConfig
+		URL            string
+		Proxied        *proxiedRequest
+		TargetRequests int32
+		Success        bool
+	}{
+		{
+			Name: "HTTP",
+			Config: web.HTTPTransportConfig{
+				ProxyURL:      proxy.URL,
+				ProxyUsername: "user",
+				ProxyPassword: "secret",
+			},
+			URL: "http://webhook.example.com/up",
+			Proxied: &proxiedRequest{
+				Method:        http.MethodPost,
+				RequestURI:    "http://webhook.example.com/up",
+				Authorization: basicAuth,
+			},
+			Success: true,
+		},
+		{
+			Name: "HTTPS",
+			Config: web.HTTPTransportConfig{
+				ProxyURL:      proxy.URL,
+				ProxyUsername: "user",
+				ProxyPassword: "secret",
+			},
+			URL: "https://webhook.example.com/up",
+			Proxied: &proxiedRequest{
+				Method:        http.MethodConnect,
+				RequestURI:    "webhook.example.com:443",
+				Authorization: basicAuth,
+			},
+		},
+		{
+			Name: "CredentialsInURL",
+			Config: web.HTTPTransportConfig{
+				ProxyURL: "http://user:secret@" + proxy.Listener.Addr().String(),
+			},
+			URL: "http://webhook.example.com/up",
+			Proxied: &proxiedRequest{
+				Method:        http.MethodPost,
+				RequestURI:    "http://webhook.example.com/up",
+				Authorization: basicAuth,
+			},
+			Success: true,
+		},
+		{
+			Name: "NoProxy",
+			Config: web.HTTPTransportConfig{
+				ProxyURL: proxy.URL,
+				NoProxy:  "localhost,example.com",
+			},
+			URL:            "http://webhook.example.com/up",
+			TargetRequests: 1,
+			Success:        true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			atomic.StoreInt32(&targetRequests, 0)
+
+			transport, err := web.NewHTTPTransport(tc.Config)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			defer transport.CloseIdleConnections()
+			// Direct connections to webhook.example.com go to the target server.
+			dial := transport.DialContext
+			transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+				if addr == "webhook.example.com:80" {
+					addr = target.Listener.Addr().String()
+				}
+				return dial(ctx, network, addr)
+			}
+			sink := &web.HTTPClientSink{
+				Client: &http.Client{
+					Transport: transport,
+				},
+			}
+
+			req, err := http.NewRequest(http.MethodPost, tc.URL, nil)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			err = sink.Process(req)
+			if tc.Success {
+				a.So(err, should.BeNil)
+			} else {
+				a.So(err, should.NotBeNil)
+			}
+
+			select {
+			case req := <-proxied:
+				if a.So(tc.Proxied, should.NotBeNil) {
+					a.So(req, should.Resemble, *tc.Proxied)
+				}
+			default:
+				a.So(tc.Proxied, should.BeNil)
+			}
+			a.So(atomic.LoadInt32(&targetRequests), should.Equal, tc.TargetRequests)
+		})
+	}
+}
+
+func TestNewHTTPTransportInvalidProxyURL(t *testing.T) {
+	a := assertions.New(t)
+
+	for _, proxyURL := range []string{
+		"://proxy",
+		"proxy.example.com:3128",
+	} {
+		_, err := web.NewHTTPTransport(web.HTTPTransportConfig{
+			ProxyURL: proxyURL,
+		})
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+}
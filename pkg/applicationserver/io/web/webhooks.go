@@ -16,22 +16,37 @@ package web
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash/fnv"
 	stdio "io"
 	"io/ioutil"
+	"math"
 	"net/http"
 	"net/url"
 	"path"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/labstack/echo"
+	"github.com/oklog/ulid"
+	"go.opencensus.io/plugin/ochttp/propagation/tracecontext"
+	"go.opencensus.io/trace"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/formatters"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	web_errors "go.thethings.network/lorawan-stack/pkg/errors/web"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
 	"go.thethings.network/lorawan-stack/pkg/version"
 	ttnweb "go.thethings.network/lorawan-stack/pkg/web"
 	"google.golang.org/grpc/metadata"
@@ -39,6 +54,18 @@ import (
 
 var userAgent = "ttn-lw-application-server/" + version.TTN
 
+// idempotencyKeyHeader is the header that contains the idempotency key of the message.
+// Receivers can use the key to deduplicate messages that are delivered more than once.
+const idempotencyKeyHeader = "X-TTS-Idempotency-Key"
+
+// requestIDHeader is the header that contains the ID of the request.
+// Receivers can use the ID to correlate their logs with the logs of the Application Server.
+const requestIDHeader = "X-Request-ID"
+
+// payloadVersionHeader is the header that contains the schema version of the payload, as declared by the formatter.
+// Receivers can use the version to handle changes in the shape of the payload.
+const payloadVersionHeader = "X-TTS-Payload-Version"
+
 // Sink processes HTTP requests.
 type Sink interface {
 	Process(*http.Request) error
@@ -50,27 +77,213 @@ type ControllableSink interface {
 	Run(context.Context) error
 }
 
+// TrySink is a Sink that can signal that it is full.
+type TrySink interface {
+	Sink
+	// TryProcess processes the request if the sink has capacity, without blocking.
+	// If the sink is full, the request is not processed and this method returns false.
+	TryProcess(*http.Request) bool
+}
+
 // HTTPClientSink contains an HTTP client to make outgoing requests.
 type HTTPClientSink struct {
 	*http.Client
+	// BreakerThreshold is the number of consecutive failures after which the circuit to a host opens.
+	// While the circuit is open, requests to the host are not performed. Zero disables the circuit breaker.
+	BreakerThreshold int
+	// BreakerCooldown is the time after which an open circuit becomes half-open, so that a single request is
+	// performed to the host. If that request succeeds, the circuit closes. Otherwise, the circuit opens again.
+	BreakerCooldown time.Duration
+	// MaxRetries is the number of times a request is retried if the receiver responds with 429 Too Many Requests or
+	// 503 Service Unavailable. Zero disables retries.
+	MaxRetries int
+	// MaxBackoff is the maximum delay before a retry, also if the receiver indicates a longer delay with the
+	// Retry-After header. Zero means DefaultMaxBackoff.
+	MaxBackoff time.Duration
+	// MaxRequestBodySize is the maximum size of the request body in bytes. Zero is unlimited.
+	MaxRequestBodySize int64
+	// MaxResponseBodySize is the maximum size of the response body in bytes that is read. Zero is unlimited.
+	MaxResponseBodySize int64
+
+	circuitsMu sync.Mutex
+	circuits   map[string]*circuit
 }
 
-var errRequest = errors.DefineUnavailable("request", "request failed with status `{code}`")
+var (
+	errRequest          = errors.DefineUnavailable("request", "request failed with status `{code}`")
+	errCircuitOpen      = errors.DefineUnavailable("circuit_open", "circuit to host `{host}` is open")
+	errRequestTooLarge  = errors.DefineInvalidArgument("request_too_large", "request body of `{size}` bytes exceeds the maximum of `{max}` bytes")
+	errResponseTooLarge = errors.DefineResourceExhausted("response_too_large", "response body exceeds the maximum of `{max}` bytes")
+)
 
 // Process uses the HTTP client to perform the request.
+// Transport errors and server errors count as failures for the circuit breaker of the host.
+// Responses with a 2xx status code, or with one of the accepted status codes of the webhook, indicate success.
+// Requests with a body larger than MaxRequestBodySize are not performed. Response bodies are read up to
+// MaxResponseBodySize; larger response bodies are truncated and result in an error.
+// Requests that fail because the transport refuses the target are not counted as failures of the host.
+// Requests that are rejected with 429 Too Many Requests or 503 Service Unavailable are retried up to MaxRetries
+// times, after the delay indicated by the Retry-After header of the response.
 func (s *HTTPClientSink) Process(req *http.Request) error {
+	if s.MaxRequestBodySize > 0 && req.ContentLength > s.MaxRequestBodySize {
+		return errRequestTooLarge.WithAttributes("size", req.ContentLength, "max", s.MaxRequestBodySize)
+	}
+	host := req.URL.Host
+	backoff := minRetryBackoff
+	for attempt := 0; ; attempt++ {
+		if !s.allow(host) {
+			return errCircuitOpen.WithAttributes("host", host)
+		}
+		retryAfter, err := s.do(req, host)
+		if err == nil || retryAfter < 0 || attempt >= s.MaxRetries {
+			return err
+		}
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return err
+			}
+			req.Body = body
+		}
+		delay := retryAfter
+		if delay == 0 {
+			delay = backoff
+			backoff *= 2
+		}
+		if maxBackoff := s.maxBackoff(); delay > maxBackoff {
+			delay = maxBackoff
+		}
+		select {
+		case <-req.Context().Done():
+			return req.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// do performs the request once. If the request should be retried, this method returns the delay indicated by the
+// Retry-After header of the response, or zero if the response does not indicate a delay. If the request should not
+// be retried, the returned delay is negative.
+func (s *HTTPClientSink) do(req *http.Request, host string) (time.Duration, error) {
 	res, err := s.Do(req)
 	if err != nil {
-		return err
+		registerDeliveryAttempt(req.Context(), 0)
+		if targetErr := forbiddenTargetCause(err); targetErr != nil {
+			return -1, targetErr
+		}
+		s.report(host, false)
+		return -1, redactError(err)
 	}
-	defer func() {
-		stdio.Copy(ioutil.Discard, res.Body)
-		res.Body.Close()
-	}()
-	if res.StatusCode >= 200 && res.StatusCode <= 299 {
-		return nil
+	defer res.Body.Close()
+	registerDeliveryAttempt(req.Context(), res.StatusCode)
+	s.report(host, res.StatusCode < 500)
+	var body stdio.Reader = res.Body
+	if s.MaxResponseBodySize > 0 {
+		body = stdio.LimitReader(res.Body, s.MaxResponseBodySize+1)
+	}
+	n, _ := stdio.Copy(ioutil.Discard, body)
+	if s.MaxResponseBodySize > 0 && n > s.MaxResponseBodySize {
+		return -1, errResponseTooLarge.WithAttributes("max", s.MaxResponseBodySize)
+	}
+	if statusCodeAccepted(req.Context(), res.StatusCode) {
+		return -1, nil
+	}
+	err = errRequest.WithAttributes("code", res.StatusCode)
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return parseRetryAfter(res.Header.Get("Retry-After"), time.Now()), err
+	default:
+		return -1, err
+	}
+}
+
+const (
+	// minRetryBackoff is the delay before the first retry if the response does not indicate a delay.
+	// The delay doubles with every retry.
+	minRetryBackoff = 100 * time.Millisecond
+	// DefaultMaxBackoff is the default maximum delay before a retry.
+	DefaultMaxBackoff = 30 * time.Second
+)
+
+func (s *HTTPClientSink) maxBackoff() time.Duration {
+	if s.MaxBackoff > 0 {
+		return s.MaxBackoff
+	}
+	return DefaultMaxBackoff
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP-date.
+// This function returns zero if the value is empty or invalid, or if the date is not after now.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		switch {
+		case seconds <= 0:
+			return 0
+		case seconds > math.MaxInt64/int64(time.Second):
+			return math.MaxInt64
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+type circuit struct {
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+// allow returns whether a request to the host may be performed.
+func (s *HTTPClientSink) allow(host string) bool {
+	if s.BreakerThreshold <= 0 {
+		return true
+	}
+	s.circuitsMu.Lock()
+	defer s.circuitsMu.Unlock()
+	c, ok := s.circuits[host]
+	if !ok || c.failures < s.BreakerThreshold {
+		return true
+	}
+	if c.trial || time.Since(c.openedAt) < s.BreakerCooldown {
+		return false
+	}
+	c.trial = true
+	return true
+}
+
+// report reports the result of a request to the host.
+func (s *HTTPClientSink) report(host string, success bool) {
+	if s.BreakerThreshold <= 0 {
+		return
+	}
+	s.circuitsMu.Lock()
+	defer s.circuitsMu.Unlock()
+	if success {
+		delete(s.circuits, host)
+		return
+	}
+	if s.circuits == nil {
+		s.circuits = make(map[string]*circuit)
+	}
+	c, ok := s.circuits[host]
+	if !ok {
+		c = &circuit{}
+		s.circuits[host] = c
+	}
+	c.trial = false
+	c.failures++
+	if c.failures >= s.BreakerThreshold {
+		c.openedAt = time.Now()
 	}
-	return errRequest.WithAttributes("code", res.StatusCode)
 }
 
 // QueuedSink is a ControllableSink with queue.
@@ -78,6 +291,9 @@ type QueuedSink struct {
 	Target  Sink
 	Queue   chan *http.Request
 	Workers int
+	// Ordered makes the requests of each webhook and end device be processed by the same worker, so that they are
+	// processed in the order in which they are queued. This trades throughput for order.
+	Ordered bool
 }
 
 // Run starts concurrent workers to process messages from the queue.
@@ -97,7 +313,35 @@ func (s *QueuedSink) Run(ctx context.Context) error {
 			wg.Done()
 		}()
 	}
+	queues := make([]chan *http.Request, s.Workers)
+	for i := range queues {
+		queues[i] = s.Queue
+	}
+	if s.Ordered && s.Workers > 1 {
+		for i := range queues {
+			queues[i] = make(chan *http.Request, cap(s.Queue)/s.Workers+1)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case req := <-s.Queue:
+					h := fnv.New32a()
+					h.Write([]byte(deliveryKeyFromContext(req.Context())))
+					select {
+					case <-ctx.Done():
+						return
+					case queues[h.Sum32()%uint32(s.Workers)] <- req:
+					}
+				}
+			}
+		}()
+	}
 	for i := 0; i < s.Workers; i++ {
+		queue := queues[i]
 		wg.Add(1)
 		go func() {
 			for {
@@ -105,7 +349,7 @@ func (s *QueuedSink) Run(ctx context.Context) error {
 				case <-ctx.Done():
 					wg.Done()
 					return
-				case req := <-s.Queue:
+				case req := <-queue:
 					if err := s.Target.Process(req); err != nil {
 						log.FromContext(ctx).WithError(err).Warn("Failed to process message")
 					}
@@ -120,15 +364,25 @@ func (s *QueuedSink) Run(ctx context.Context) error {
 
 var errQueueFull = errors.DefineResourceExhausted("queue_full", "the queue is full")
 
-// Process sends the request to the queue.
-// This method returns immediately. An error is returned when the queue is full.
-func (s *QueuedSink) Process(req *http.Request) error {
+// TryProcess sends the request to the queue if the queue is not full.
+// This method returns immediately.
+func (s *QueuedSink) TryProcess(req *http.Request) bool {
 	select {
 	case s.Queue <- req:
-		return nil
+		return true
 	default:
+		return false
+	}
+}
+
+// Process sends the request to the queue.
+// This method returns immediately. An error is returned when the queue is full; the request is dropped then.
+func (s *QueuedSink) Process(req *http.Request) error {
+	if !s.TryProcess(req) {
+		registerDropQueueFull(req.Context())
 		return errQueueFull
 	}
+	return nil
 }
 
 // Webhooks is an interface for registering incoming webhooks for downlink and creating a subscription to outgoing
@@ -136,30 +390,185 @@ func (s *QueuedSink) Process(req *http.Request) error {
 type Webhooks interface {
 	ttnweb.Registerer
 	Registry() WebhookRegistry
+	// Templates returns the registry of webhook templates.
+	Templates() *TemplateRegistry
+	// CreateFromTemplate creates a webhook from the template with the given ID, using the given template field values.
+	CreateFromTemplate(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, templateID string, values map[string]string) (*ttnpb.ApplicationWebhook, error)
 	// NewSubscription returns a new webhooks integration subscription.
 	NewSubscription() *io.Subscription
+	// Redeliver delivers the retained messages of the application that are received since the given time to the
+	// webhook with the given identifiers only. The messages are delivered in the order in which they are received.
+	Redeliver(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, since time.Time) error
+	// Test delivers a synthetic uplink message to the webhook with the given identifiers and returns the response.
+	// Test deliveries have the X-TTS-Test header set, so that receivers can ignore them.
+	Test(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) (*TestResult, error)
+	// Captures returns the last requests that are sent to the webhook with the given identifiers, oldest first.
+	// Captures returns an error if request capture is not enabled. See WithCapture.
+	Captures(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) ([]CapturedRequest, error)
+	// Close stops accepting new messages, delivers pending batches and waits for in-flight deliveries to finish.
+	// If the context is done before the deliveries are finished, the context error is returned.
+	Close(ctx context.Context) error
 }
 
 type webhooks struct {
-	ctx      context.Context
-	server   io.Server
-	registry WebhookRegistry
-	target   Sink
+	ctx            context.Context
+	server         io.Server
+	registry       WebhookRegistry
+	deviceRegistry DeviceWebhookRegistry
+	templates      *TemplateRegistry
+	target         Sink
+	validators     map[string]PayloadValidator
+	formats        map[string]Format
+	limiter        *applicationLimiter
+	quota          *quotaEnforcer
+	retention      *retentionBuffer
+	captures       *captureBuffer
+	ordered        *orderedQueues
+	batches        *upBatcher
+	keyVault       crypto.KeyVault
+	testClient     *http.Client
+	slots          chan struct{}
+
+	closeMu  sync.Mutex
+	closing  chan struct{}
+	inFlight sync.WaitGroup
+}
+
+// Option configures Webhooks.
+type Option func(*webhooks)
+
+// WithPayloadValidator returns an Option that validates the encoded body of requests in the given format before
+// they are sent. Requests with an invalid body are not sent.
+func WithPayloadValidator(format string, validator PayloadValidator) Option {
+	return func(w *webhooks) {
+		if w.validators == nil {
+			w.validators = make(map[string]PayloadValidator)
+		}
+		w.validators[format] = validator
+	}
+}
+
+// WithApplicationLimits returns an Option that limits the deliveries of each application.
+// With limits, messages are delivered asynchronously, so that deliveries of one application do not hold up
+// deliveries of other applications.
+func WithApplicationLimits(limits ApplicationLimits) Option {
+	return func(w *webhooks) {
+		w.limiter = newApplicationLimiter(limits)
+	}
+}
+
+// WithQuota returns an Option that limits the number of deliveries of each application per period to the quota.
+// The number of deliveries is stored in the given store. Deliveries that exceed the quota are suppressed.
+func WithQuota(quota Quota, store QuotaStore) Option {
+	return func(w *webhooks) {
+		w.quota = &quotaEnforcer{
+			quota: quota,
+			store: store,
+		}
+	}
+}
+
+// WithRetention returns an Option that retains the messages of each application within the given retention, so that
+// they can be redelivered.
+func WithRetention(retention Retention) Option {
+	return func(w *webhooks) {
+		w.retention = newRetentionBuffer(retention)
+	}
+}
+
+// WithOrderedDelivery returns an Option that delivers the messages of each end device in the order in which they are
+// received. Messages of different end devices are delivered concurrently.
+// Use a QueuedSink with Ordered set as target to keep the order when requests are queued.
+func WithOrderedDelivery() Option {
+	return func(w *webhooks) {
+		w.ordered = newOrderedQueues()
+	}
+}
+
+// backpressureInterval is the interval at which a full TrySink is retried when backpressure is applied.
+const backpressureInterval = 10 * time.Millisecond
+
+// WithBackpressure returns an Option that applies backpressure to the subscription when the webhooks are busy.
+// At most maxInFlight messages are delivered concurrently; further messages are not consumed from the subscription
+// until a delivery is done. If the target is a TrySink, deliveries wait until the target has capacity, instead of
+// dropping the request when the target is full.
+func WithBackpressure(maxInFlight int) Option {
+	return func(w *webhooks) {
+		if maxInFlight < 1 {
+			maxInFlight = 1
+		}
+		w.slots = make(chan struct{}, maxInFlight)
+	}
+}
+
+// WithKeyVault returns an Option that decrypts the base URLs of secret webhooks with the given key vault.
+// See NewSecretWebhookRegistry.
+func WithKeyVault(keyVault crypto.KeyVault) Option {
+	return func(w *webhooks) {
+		w.keyVault = keyVault
+	}
 }
 
 // NewWebhooks returns a new Webhooks.
-func NewWebhooks(ctx context.Context, server io.Server, registry WebhookRegistry, target Sink) Webhooks {
-	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/web")
-	return &webhooks{
-		ctx:      ctx,
-		server:   server,
-		registry: registry,
-		target:   target,
+func NewWebhooks(ctx context.Context, server io.Server, registry WebhookRegistry, target Sink, opts ...Option) Webhooks {
+	ctx = log.NewContextWithField(ctx, "namespace", namespace)
+	w := &webhooks{
+		ctx:       ctx,
+		server:    server,
+		registry:  registry,
+		templates: NewTemplateRegistry(DefaultTemplates...),
+		target:    target,
+		batches:   newUpBatcher(),
+		testClient: &http.Client{
+			Timeout: defaultTestDeliveryTimeout,
+		},
+		closing: make(chan struct{}),
 	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
 }
 
 func (w *webhooks) Registry() WebhookRegistry { return w.registry }
 
+func (w *webhooks) Templates() *TemplateRegistry { return w.templates }
+
+// templateWebhookPaths are the paths of the webhooks that are created from templates. These are all fields, except the
+// fields that are managed by the registry, so that all fields that the template populates are stored.
+var templateWebhookPaths = func() []string {
+	paths := make([]string, 0, len(ttnpb.ApplicationWebhookFieldPathsTopLevel))
+	for _, path := range ttnpb.ApplicationWebhookFieldPathsTopLevel {
+		switch path {
+		case "ids", "created_at", "updated_at":
+		default:
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}()
+
+var errWebhookExists = errors.DefineAlreadyExists("webhook_exists", "webhook `{webhook_id}` already exists")
+
+func (w *webhooks) CreateFromTemplate(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, templateID string, values map[string]string) (*ttnpb.ApplicationWebhook, error) {
+	template, err := w.templates.Get(templateID)
+	if err != nil {
+		return nil, err
+	}
+	hook, err := template.Instantiate(ids, values)
+	if err != nil {
+		return nil, err
+	}
+	return w.registry.Set(ctx, ids, ttnpb.ApplicationWebhookFieldPathsTopLevel,
+		func(stored *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+			if stored != nil {
+				return nil, nil, errWebhookExists.WithAttributes("webhook_id", ids.WebhookID)
+			}
+			return hook, append([]string(nil), templateWebhookPaths...), nil
+		},
+	)
+}
+
 // RegisterRoutes registers the webhooks to the web server to handle downlink requests.
 func (w *webhooks) RegisterRoutes(server *ttnweb.Server) {
 	middleware := []echo.MiddlewareFunc{
@@ -258,14 +667,49 @@ func (w *webhooks) requireApplicationRights(required ...ttnpb.Right) echo.Middle
 
 func (w *webhooks) NewSubscription() *io.Subscription {
 	sub := io.NewSubscription(w.ctx, "webhook", nil)
+	registerSubscribe()
 	go func() {
+		defer registerUnsubscribe()
 		for {
 			select {
 			case <-w.ctx.Done():
 				return
+			case <-sub.Context().Done():
+				return
+			case <-w.closing:
+				return
 			case msg := <-sub.Up():
-				if err := w.handleUp(w.ctx, msg); err != nil {
-					log.FromContext(w.ctx).WithError(err).Warn("Failed to handle message")
+				if !w.acquireSlot() {
+					return
+				}
+				if !w.startDelivery() {
+					w.releaseSlot()
+					return
+				}
+				if w.retention != nil {
+					w.retention.add(unique.ID(w.ctx, msg.ApplicationIdentifiers), msg, time.Now())
+				}
+				var release func()
+				if w.limiter != nil {
+					var err error
+					release, err = w.limiter.acquire(unique.ID(w.ctx, msg.ApplicationIdentifiers), time.Now())
+					if err != nil {
+						log.FromContext(w.ctx).WithError(err).Warn("Failed to handle message")
+						w.releaseSlot()
+						w.inFlight.Done()
+						continue
+					}
+				}
+				switch {
+				case w.ordered != nil:
+					msg := msg
+					w.ordered.push(unique.ID(w.ctx, msg.EndDeviceIdentifiers), func() {
+						w.deliver(msg, release)
+					})
+				case w.limiter != nil:
+					go w.deliver(msg, release)
+				default:
+					w.deliver(msg, nil)
 				}
 			}
 		}
@@ -273,51 +717,365 @@ func (w *webhooks) NewSubscription() *io.Subscription {
 	return sub
 }
 
+// deliver handles the message and marks the in-flight delivery as done. If release is not nil, it is called when the
+// message is handled.
+func (w *webhooks) deliver(msg *ttnpb.ApplicationUp, release func()) {
+	if err := w.handleUp(w.ctx, msg); err != nil {
+		log.FromContext(w.ctx).WithError(err).Warn("Failed to handle message")
+	}
+	if release != nil {
+		release()
+	}
+	w.releaseSlot()
+	w.inFlight.Done()
+}
+
+// acquireSlot acquires a delivery slot if backpressure is enabled. It blocks until a slot is available, so that no
+// further messages are consumed from the subscription. It returns false if the webhooks are closing.
+func (w *webhooks) acquireSlot() bool {
+	if w.slots == nil {
+		return true
+	}
+	select {
+	case w.slots <- struct{}{}:
+		return true
+	case <-w.ctx.Done():
+		return false
+	case <-w.closing:
+		return false
+	}
+}
+
+// releaseSlot releases a delivery slot if backpressure is enabled.
+func (w *webhooks) releaseSlot() {
+	if w.slots == nil {
+		return
+	}
+	<-w.slots
+}
+
+// startDelivery registers an in-flight delivery. It returns false if the webhooks are closing.
+func (w *webhooks) startDelivery() bool {
+	w.closeMu.Lock()
+	defer w.closeMu.Unlock()
+	select {
+	case <-w.closing:
+		return false
+	default:
+	}
+	w.inFlight.Add(1)
+	return true
+}
+
+func (w *webhooks) Close(ctx context.Context) error {
+	w.closeMu.Lock()
+	select {
+	case <-w.closing:
+	default:
+		close(w.closing)
+	}
+	w.closeMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		w.inFlight.Wait()
+		// No messages are added to batches anymore, so the pending batches can be delivered.
+		for _, batch := range w.batches.takeAll() {
+			w.deliverBatch(w.ctx, batch)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// messageField returns the webhook field path of the message configuration for the given message.
+func messageField(msg *ttnpb.ApplicationUp) string {
+	switch msg.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		return "uplink_message"
+	case *ttnpb.ApplicationUp_JoinAccept:
+		return "join_accept"
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		return "downlink_ack"
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		return "downlink_nack"
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		return "downlink_sent"
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		return "downlink_failed"
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		return "downlink_queued"
+	case *ttnpb.ApplicationUp_LocationSolved:
+		return "location_solved"
+	}
+	return ""
+}
+
+// idempotencyKey returns a key that uniquely identifies the message.
+// The key is derived from the device identifiers, the message type and the session and frame counter of the message,
+// so that it is the same for every delivery of the message.
+func idempotencyKey(ctx context.Context, msg *ttnpb.ApplicationUp) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s:", unique.ID(ctx, msg.EndDeviceIdentifiers))
+	if msg.DevEUI != nil {
+		fmt.Fprintf(h, "%s:", msg.DevEUI)
+	}
+	fmt.Fprintf(h, "%s:", messageField(msg))
+	switch up := msg.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		fmt.Fprintf(h, "%X:%d", up.UplinkMessage.SessionKeyID, up.UplinkMessage.FCnt)
+	case *ttnpb.ApplicationUp_JoinAccept:
+		fmt.Fprintf(h, "%X", up.JoinAccept.SessionKeyID)
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		fmt.Fprintf(h, "%X:%d:%d", up.DownlinkAck.SessionKeyID, up.DownlinkAck.FCnt, up.DownlinkAck.FPort)
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		fmt.Fprintf(h, "%X:%d:%d", up.DownlinkNack.SessionKeyID, up.DownlinkNack.FCnt, up.DownlinkNack.FPort)
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		fmt.Fprintf(h, "%X:%d:%d", up.DownlinkSent.SessionKeyID, up.DownlinkSent.FCnt, up.DownlinkSent.FPort)
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		fmt.Fprintf(h, "%X:%d:%d", up.DownlinkFailed.SessionKeyID, up.DownlinkFailed.FCnt, up.DownlinkFailed.FPort)
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		fmt.Fprintf(h, "%X:%d:%d", up.DownlinkQueued.SessionKeyID, up.DownlinkQueued.FCnt, up.DownlinkQueued.FPort)
+	case *ttnpb.ApplicationUp_LocationSolved:
+		loc := up.LocationSolved
+		fmt.Fprintf(h, "%s:%f:%f:%d:%d", loc.Service, loc.Latitude, loc.Longitude, loc.Altitude, loc.Accuracy)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// traceFormat propagates the trace context in the W3C Trace Context headers.
+var traceFormat = &tracecontext.HTTPFormat{}
+
+// setSpanError sets the status of the span to the code and message of the error.
+func setSpanError(span *trace.Span, err error) {
+	span.SetStatus(trace.Status{
+		Code:    int32(errors.Code(err)),
+		Message: err.Error(),
+	})
+}
+
 func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error {
+	field := messageField(msg)
+	if field == "" {
+		return nil
+	}
 	hooks, err := w.registry.List(ctx, msg.ApplicationIdentifiers,
 		[]string{
 			"base_url",
 			"headers",
 			"format",
-			"uplink_message",
-			"join_accept",
-			"downlink_ack",
-			"downlink_nack",
-			"downlink_sent",
-			"downlink_failed",
-			"downlink_queued",
-			"location_solved",
+			"compression",
+			"exclude_raw_payload",
+			"exclude_decoded_payload",
+			"projection_paths",
+			"strict_projection",
+			"method",
+			"default",
+			"accepted_status_codes",
+			"max_batch_size",
+			"max_batch_linger",
+			field,
 		},
 	)
 	if err != nil {
 		return err
 	}
+	if w.deviceRegistry != nil {
+		devHooks, err := w.deviceRegistry.List(ctx, msg.EndDeviceIdentifiers)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to list end device webhooks")
+		} else {
+			hooks = mergeWebhooks(hooks, devHooks)
+		}
+	}
 	wg := sync.WaitGroup{}
 	for i := range hooks {
 		hook := hooks[i]
-		logger := log.FromContext(ctx).WithField("hook", hook.WebhookID)
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			req, err := w.newRequest(ctx, msg, hook)
-			if err != nil {
-				logger.WithError(err).Warn("Failed to create request")
-				return
-			}
-			if req == nil {
+			if hook.MaxBatchSize > 1 {
+				w.batchUp(ctx, msg, hook)
 				return
 			}
-			logger.WithField("url", req.URL).Debug("Processing message")
-			if err := w.target.Process(req); err != nil {
-				logger.WithError(err).Warn("Failed to process message")
-			}
+			w.handleUpHook(ctx, msg, hook)
 		}()
 	}
 	wg.Wait()
 	return nil
 }
 
+// handleUpHook delivers the message to the webhook.
+func (w *webhooks) handleUpHook(ctx context.Context, msg *ttnpb.ApplicationUp, hook *ttnpb.ApplicationWebhook) error {
+	return w.deliverHook(ctx, hook, func(ctx context.Context) (*http.Request, error) {
+		return w.newRequest(ctx, msg, hook)
+	})
+}
+
+// deliverHook delivers the request created by newRequest to the webhook.
+func (w *webhooks) deliverHook(ctx context.Context, hook *ttnpb.ApplicationWebhook, newRequest func(context.Context) (*http.Request, error)) error {
+	ctx, requestID := newContextWithRequestID(ctx)
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"hook", hook.WebhookID,
+		"request_id", requestID,
+	))
+	ctx, span := trace.StartSpan(ctx, "webhook.deliver", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+	span.AddAttributes(
+		trace.StringAttribute("application_id", hook.ApplicationID),
+		trace.StringAttribute("webhook_id", hook.WebhookID),
+	)
+	ctx, result := newContextWithDeliveryResult(ctx)
+	req, err := newRequest(ctx)
+	if err != nil {
+		logger.WithError(redactError(err)).Warn("Failed to create request")
+		setSpanError(span, err)
+		return err
+	}
+	if req == nil {
+		return nil
+	}
+	start := time.Now()
+	if w.quota != nil && !w.quota.allow(ctx, hook.ApplicationIdentifiers, start) {
+		logger.Debug("Delivery quota exceeded")
+		return nil
+	}
+	logger.WithField("url", redactURL(req.URL)).Debug("Processing message")
+	if w.captures != nil {
+		if err := w.captures.add(ctx, hook, req, start); err != nil {
+			logger.WithError(err).Warn("Failed to capture request")
+		}
+	}
+	err = w.process(ctx, req)
+	publishDeliveryResult(ctx, hook.ApplicationWebhookIdentifiers, result, time.Since(start), err)
+	if err != nil {
+		logger.WithError(redactError(err)).Warn("Failed to process message")
+		setSpanError(span, err)
+		return err
+	}
+	return nil
+}
+
+// process processes the request with the target. If backpressure is enabled and the target is a TrySink, this
+// method waits until the target has capacity.
+func (w *webhooks) process(ctx context.Context, req *http.Request) error {
+	trySink, ok := w.target.(TrySink)
+	if w.slots == nil || !ok {
+		return w.target.Process(req)
+	}
+	if trySink.TryProcess(req) {
+		return nil
+	}
+	ticker := time.NewTicker(backpressureInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-w.closing:
+			registerDropQueueFull(req.Context())
+			return errQueueFull
+		case <-ticker.C:
+			if trySink.TryProcess(req) {
+				return nil
+			}
+		}
+	}
+}
+
+var errNoRetention = errors.DefineFailedPrecondition("no_retention", "message retention is not enabled")
+
+// Redeliver implements Webhooks.
+// Redelivery stops at the first message that fails to be delivered, so that it can be retried from that message.
+func (w *webhooks) Redeliver(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, since time.Time) error {
+	if w.retention == nil {
+		return errNoRetention
+	}
+	hook, err := w.registry.Get(ctx, ids,
+		[]string{
+			"base_url",
+			"headers",
+			"format",
+			"compression",
+			"exclude_raw_payload",
+			"exclude_decoded_payload",
+			"projection_paths",
+			"strict_projection",
+			"method",
+			"uplink_message",
+			"join_accept",
+			"downlink_ack",
+			"downlink_nack",
+			"downlink_sent",
+			"downlink_failed",
+			"downlink_queued",
+			"location_solved",
+			"default",
+			"accepted_status_codes",
+		},
+	)
+	if err != nil {
+		return err
+	}
+	for _, msg := range w.retention.since(unique.ID(ctx, ids.ApplicationIdentifiers), since, time.Now()) {
+		if err := w.handleUpHook(ctx, msg, hook); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newRequest returns the request that delivers the message to the webhook.
 func (w *webhooks) newRequest(ctx context.Context, msg *ttnpb.ApplicationUp, hook *ttnpb.ApplicationWebhook) (*http.Request, error) {
+	return w.newRequestWithBody(ctx, msg, hook, func(ctx context.Context, formatName string, format Format) ([]byte, error) {
+		return w.formatUp(ctx, formatName, format, msg)
+	})
+}
+
+var errBatchFormat = errors.DefineInvalidArgument("batch_format", "format `{format}` does not support batches")
+
+// newBatchRequest returns the request that delivers the messages to the webhook in a single body.
+// The messages must be of the same type.
+func (w *webhooks) newBatchRequest(ctx context.Context, msgs []*ttnpb.ApplicationUp, hook *ttnpb.ApplicationWebhook) (*http.Request, error) {
+	return w.newRequestWithBody(ctx, msgs[0], hook, func(ctx context.Context, formatName string, format Format) ([]byte, error) {
+		batchFormatter, ok := format.Formatter.(formatters.BatchFormatter)
+		if !ok {
+			return nil, errBatchFormat.WithAttributes("format", formatName)
+		}
+		items := make([][]byte, 0, len(msgs))
+		for _, msg := range msgs {
+			item, err := w.formatUp(ctx, formatName, format, msg)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return batchFormatter.Batch(items)
+	})
+}
+
+// formatUp formats the message and validates the result.
+func (w *webhooks) formatUp(ctx context.Context, formatName string, format Format, msg *ttnpb.ApplicationUp) ([]byte, error) {
+	buf, err := formatters.FromUp(ctx, format.Formatter, msg)
+	if err != nil {
+		return nil, err
+	}
+	if validator, ok := w.validators[formatName]; ok {
+		if err := validator.ValidatePayload(buf); err != nil {
+			return nil, errInvalidPayload.WithAttributes("format", formatName).WithCause(err)
+		}
+	}
+	return buf, nil
+}
+
+// newRequestWithBody returns the request to the webhook with the body returned by body.
+// The message determines the message configuration of the webhook that is used.
+func (w *webhooks) newRequestWithBody(ctx context.Context, msg *ttnpb.ApplicationUp, hook *ttnpb.ApplicationWebhook, body func(context.Context, string, Format) ([]byte, error)) (*http.Request, error) {
 	var cfg *ttnpb.ApplicationWebhook_Message
 	switch msg.Up.(type) {
 	case *ttnpb.ApplicationUp_UplinkMessage:
@@ -337,23 +1095,48 @@ func (w *webhooks) newRequest(ctx context.Context, msg *ttnpb.ApplicationUp, hoo
 	case *ttnpb.ApplicationUp_LocationSolved:
 		cfg = hook.LocationSolved
 	}
+	if cfg == nil {
+		cfg = hook.Default
+	}
 	if cfg == nil {
 		return nil, nil
 	}
-	url, err := url.Parse(hook.BaseURL)
+	baseURL, err := decryptBaseURL(w.keyVault, hook.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	url, err := url.Parse(baseURL)
 	if err != nil {
 		return nil, err
 	}
 	url.Path = path.Join(url.Path, cfg.Path)
-	format, ok := formats[hook.Format]
+	formatName := hook.Format
+	if cfg.Format != "" {
+		formatName = cfg.Format
+	}
+	format, ok := w.format(formatName)
 	if !ok {
-		return nil, errFormatNotFound.WithAttributes("format", hook.Format)
+		return nil, errFormatNotFound.WithAttributes("format", formatName)
 	}
-	buf, err := format.FromUp(msg)
+	ctx = formatters.NewContextWithPayloadOptions(ctx, formatters.PayloadOptions{
+		ExcludeRawPayload:     hook.ExcludeRawPayload,
+		ExcludeDecodedPayload: hook.ExcludeDecodedPayload,
+		ProjectionPaths:       hook.ProjectionPaths,
+		StrictProjection:      hook.StrictProjection,
+	})
+	buf, err := body(ctx, formatName, format)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest(http.MethodPost, url.String(), bytes.NewReader(buf))
+	buf, err = compress(buf, hook.Compression)
+	if err != nil {
+		return nil, err
+	}
+	method, err := webhookMethod(hook)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(method, url.String(), bytes.NewReader(buf))
 	if err != nil {
 		return nil, err
 	}
@@ -361,8 +1144,140 @@ func (w *webhooks) newRequest(ctx context.Context, msg *ttnpb.ApplicationUp, hoo
 		req.Header.Set(key, value)
 	}
 	req.Header.Set("Content-Type", format.ContentType)
+	if version := formatters.PayloadVersion(format.Formatter); version != "" {
+		req.Header.Set(payloadVersionHeader, version)
+	}
+	if hook.Compression != "" {
+		req.Header.Set("Content-Encoding", hook.Compression)
+	}
 	req.Header.Set("User-Agent", userAgent)
-	return req, nil
+	req.Header.Set(idempotencyKeyHeader, idempotencyKey(ctx, msg))
+	_, requestID := newContextWithRequestID(ctx)
+	req.Header.Set(requestIDHeader, requestID)
+	if span := trace.FromContext(ctx); span != nil {
+		traceFormat.SpanContextToRequest(span.SpanContext(), req)
+	}
+	reqCtx := newContextWithWebhookIdentifiers(ctx, hook.ApplicationWebhookIdentifiers)
+	reqCtx = newContextWithDeliveryKey(reqCtx, fmt.Sprintf("%s:%s", unique.ID(ctx, msg.EndDeviceIdentifiers), hook.WebhookID))
+	if len(hook.AcceptedStatusCodes) > 0 {
+		reqCtx = newContextWithAcceptedStatusCodes(reqCtx, hook.AcceptedStatusCodes)
+	}
+	return req.WithContext(reqCtx), nil
+}
+
+type webhookIDsKeyType struct{}
+
+var webhookIDsKey webhookIDsKeyType
+
+// newContextWithWebhookIdentifiers returns a derived context with the identifiers of the webhook of the request.
+func newContextWithWebhookIdentifiers(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) context.Context {
+	return context.WithValue(ctx, webhookIDsKey, ids)
+}
+
+func webhookIdentifiersFromContext(ctx context.Context) (ttnpb.ApplicationWebhookIdentifiers, bool) {
+	ids, ok := ctx.Value(webhookIDsKey).(ttnpb.ApplicationWebhookIdentifiers)
+	return ids, ok
+}
+
+type requestIDKeyType struct{}
+
+var requestIDKey requestIDKeyType
+
+// newContextWithRequestID returns a derived context with the ID of the webhook request, and the ID itself.
+// If the context already has a request ID, either of the webhook request or of the incoming gRPC request, it is
+// reused. Otherwise, a new request ID is generated.
+func newContextWithRequestID(ctx context.Context) (context.Context, string) {
+	if id, ok := ctx.Value(requestIDKey).(string); ok {
+		return ctx, id
+	}
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get("request-id"); len(ids) > 0 {
+			id = ids[0]
+		}
+	}
+	if id == "" {
+		id = ulid.MustNew(ulid.Now(), rand.Reader).String()
+	}
+	return context.WithValue(ctx, requestIDKey, id), id
+}
+
+type deliveryKeyKeyType struct{}
+
+var deliveryKeyKey deliveryKeyKeyType
+
+// newContextWithDeliveryKey returns a derived context with the key that identifies the webhook and end device of the
+// request. Requests with the same key are delivered in order by an ordered QueuedSink.
+func newContextWithDeliveryKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, deliveryKeyKey, key)
+}
+
+func deliveryKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(deliveryKeyKey).(string)
+	return key
+}
+
+type acceptedStatusCodesKeyType struct{}
+
+var acceptedStatusCodesKey acceptedStatusCodesKeyType
+
+// newContextWithAcceptedStatusCodes returns a derived context with the response status codes that indicate that the
+// webhook request succeeded.
+func newContextWithAcceptedStatusCodes(ctx context.Context, codes []uint32) context.Context {
+	return context.WithValue(ctx, acceptedStatusCodesKey, codes)
+}
+
+// statusCodeAccepted returns whether the response status code indicates that the request succeeded.
+// If the context has no accepted status codes, all 2xx status codes are accepted.
+func statusCodeAccepted(ctx context.Context, code int) bool {
+	codes, ok := ctx.Value(acceptedStatusCodesKey).([]uint32)
+	if !ok {
+		return code >= 200 && code <= 299
+	}
+	for _, accepted := range codes {
+		if int(accepted) == code {
+			return true
+		}
+	}
+	return false
+}
+
+var errMethod = errors.DefineInvalidArgument("method", "HTTP method `{method}` is not allowed")
+
+// webhookMethod returns the HTTP method of the webhook. The default method is POST.
+func webhookMethod(hook *ttnpb.ApplicationWebhook) (string, error) {
+	switch hook.Method {
+	case "":
+		return http.MethodPost, nil
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		return hook.Method, nil
+	default:
+		return "", errMethod.WithAttributes("method", hook.Method)
+	}
+}
+
+var errInvalidPayload = errors.DefineInvalidArgument("invalid_payload", "payload does not conform to format `{format}`")
+
+var errCompressionNotFound = errors.DefineInvalidArgument("compression_not_found", "compression `{compression}` not found")
+
+// compress compresses the body with the given compression. An empty compression returns the body as is.
+func compress(body []byte, compression string) ([]byte, error) {
+	switch compression {
+	case "":
+		return body, nil
+	case "gzip":
+		buf := &bytes.Buffer{}
+		w := gzip.NewWriter(buf)
+		if _, err := w.Write(body); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, errCompressionNotFound.WithAttributes("compression", compression)
+	}
 }
 
 var errWebhookNotFound = errors.DefineNotFound("webhook_not_found", "webhook not found")
@@ -383,7 +1298,7 @@ func (w *webhooks) handleDown(c echo.Context, op func(io.Server, context.Context
 	if hook == nil {
 		return errWebhookNotFound
 	}
-	format, ok := formats[hook.Format]
+	format, ok := w.format(hook.Format)
 	if !ok {
 		return errFormatNotFound.WithAttributes("format", hook.Format)
 	}
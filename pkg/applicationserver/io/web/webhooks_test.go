@@ -16,13 +16,20 @@ package web_test
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/formatters"
@@ -30,10 +37,15 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web/redis"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/log/handler/memory"
+	"go.thethings.network/lorawan-stack/pkg/metrics"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestWebhooks(t *testing.T) {
@@ -381,6 +393,1010 @@ func TestWebhooks(t *testing.T) {
 	})
 }
 
+func TestWebhooksCompression(t *testing.T) {
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	msg := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+			},
+		},
+	}
+	expectedBody, err := formatters.JSON.FromUp(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		Name        string
+		Compression string
+		Decode      func([]byte) ([]byte, error)
+	}{
+		{
+			Name: "None",
+			Decode: func(b []byte) ([]byte, error) {
+				return b, nil
+			},
+		},
+		{
+			Name:        "Gzip",
+			Compression: "gzip",
+			Decode: func(b []byte) ([]byte, error) {
+				r, err := gzip.NewReader(bytes.NewReader(b))
+				if err != nil {
+					return nil, err
+				}
+				defer r.Close()
+				return ioutil.ReadAll(r)
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			registry := &countingRegistry{
+				hook: &ttnpb.ApplicationWebhook{
+					ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+						ApplicationIdentifiers: registeredApplicationID,
+						WebhookID:              registeredWebhookID,
+					},
+					BaseURL:     "https://myapp.com/api/ttn/v3",
+					Format:      "json",
+					Compression: tc.Compression,
+					UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+						Path: "up",
+					},
+				},
+			}
+			sink := &mockSink{
+				ch: make(chan *http.Request, 1),
+			}
+			w := web.NewWebhooks(ctx, nil, registry, sink)
+			sub := w.NewSubscription()
+			if err := sub.SendUp(msg); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			var req *http.Request
+			select {
+			case req = <-sink.ch:
+			case <-time.After(timeout):
+				t.Fatal("Expected message but nothing received")
+			}
+			a.So(req.Header.Get("Content-Type"), should.Equal, "application/json")
+			a.So(req.Header.Get("Content-Encoding"), should.Equal, tc.Compression)
+			body, err := ioutil.ReadAll(req.Body)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			if tc.Compression != "" {
+				a.So(body, should.NotResemble, expectedBody)
+			}
+			actualBody, err := tc.Decode(body)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(actualBody, should.Resemble, expectedBody)
+		})
+	}
+}
+
+func TestWebhooksPayloadOptions(t *testing.T) {
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	msg := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+				DecodedPayload: &pbtypes.Struct{
+					Fields: map[string]*pbtypes.Value{
+						"test_key": {
+							Kind: &pbtypes.Value_NumberValue{
+								NumberValue: 42,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		Name                  string
+		ExcludeRawPayload     bool
+		ExcludeDecodedPayload bool
+	}{
+		{
+			Name: "All",
+		},
+		{
+			Name:              "DecodedOnly",
+			ExcludeRawPayload: true,
+		},
+		{
+			Name:                  "RawOnly",
+			ExcludeDecodedPayload: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			registry := &countingRegistry{
+				hook: &ttnpb.ApplicationWebhook{
+					ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+						ApplicationIdentifiers: registeredApplicationID,
+						WebhookID:              registeredWebhookID,
+					},
+					BaseURL:               "https://myapp.com/api/ttn/v3",
+					Format:                "json",
+					ExcludeRawPayload:     tc.ExcludeRawPayload,
+					ExcludeDecodedPayload: tc.ExcludeDecodedPayload,
+					UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+						Path: "up",
+					},
+				},
+			}
+			sink := &mockSink{
+				ch: make(chan *http.Request, 1),
+			}
+			w := web.NewWebhooks(ctx, nil, registry, sink)
+			sub := w.NewSubscription()
+			if err := sub.SendUp(msg); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			var req *http.Request
+			select {
+			case req = <-sink.ch:
+			case <-time.After(timeout):
+				t.Fatal("Expected message but nothing received")
+			}
+			body, err := ioutil.ReadAll(req.Body)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			if tc.ExcludeRawPayload {
+				a.So(string(body), should.NotContainSubstring, `"frm_payload"`)
+			} else {
+				a.So(string(body), should.ContainSubstring, `"frm_payload":"AQID"`)
+			}
+			if tc.ExcludeDecodedPayload {
+				a.So(string(body), should.NotContainSubstring, `"decoded_payload"`)
+			} else {
+				a.So(string(body), should.ContainSubstring, `"decoded_payload":{"test_key":42}`)
+			}
+			// The message is not modified for other subscribers.
+			a.So(msg.GetUplinkMessage().FRMPayload, should.Resemble, []byte{0x1, 0x2, 0x3})
+			a.So(msg.GetUplinkMessage().DecodedPayload, should.NotBeNil)
+		})
+	}
+}
+
+func TestWebhooksIdempotencyKey(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+	defer cancel()
+
+	registry := &countingRegistry{
+		hook: &ttnpb.ApplicationWebhook{
+			ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+				ApplicationIdentifiers: registeredApplicationID,
+				WebhookID:              registeredWebhookID,
+			},
+			BaseURL: "https://myapp.com/api/ttn/v3",
+			Format:  "json",
+			UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+				Path: "up",
+			},
+			DownlinkSent: &ttnpb.ApplicationWebhook_Message{
+				Path: "down/sent",
+			},
+		},
+	}
+	sink := &mockSink{
+		ch: make(chan *http.Request, 1),
+	}
+	w := web.NewWebhooks(ctx, nil, registry, sink)
+	sub := w.NewSubscription()
+
+	newUplink := func(fCnt uint32) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: registeredDeviceID,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					SessionKeyID: []byte{0x11},
+					FPort:        42,
+					FCnt:         fCnt,
+					FRMPayload:   []byte{0x1, 0x2, 0x3},
+				},
+			},
+		}
+	}
+	keyOf := func(msg *ttnpb.ApplicationUp) string {
+		if err := sub.SendUp(msg); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		select {
+		case req := <-sink.ch:
+			return req.Header.Get("X-TTS-Idempotency-Key")
+		case <-time.After(timeout):
+			t.Fatal("Expected message but nothing received")
+		}
+		return ""
+	}
+
+	key := keyOf(newUplink(42))
+	a.So(key, should.NotBeEmpty)
+
+	// The key is the same for every delivery of the same message.
+	a.So(keyOf(newUplink(42)), should.Equal, key)
+
+	// The key is different for different messages.
+	a.So(keyOf(newUplink(43)), should.NotEqual, key)
+	a.So(keyOf(&ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_DownlinkSent{
+			DownlinkSent: &ttnpb.ApplicationDownlink{
+				SessionKeyID: []byte{0x11},
+				FPort:        42,
+				FCnt:         42,
+			},
+		},
+	}), should.NotEqual, key)
+}
+
+func TestWebhooksMethod(t *testing.T) {
+	for _, tc := range []struct {
+		Method   string
+		Expected string
+	}{
+		{
+			Expected: http.MethodPost,
+		},
+		{
+			Method:   http.MethodPost,
+			Expected: http.MethodPost,
+		},
+		{
+			Method:   http.MethodPut,
+			Expected: http.MethodPut,
+		},
+		{
+			Method:   http.MethodPatch,
+			Expected: http.MethodPatch,
+		},
+		{
+			Method: http.MethodDelete,
+		},
+		{
+			Method: "put",
+		},
+	} {
+		t.Run(fmt.Sprintf("Method=%q", tc.Method), func(t *testing.T) {
+			a := assertions.New(t)
+			ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+			defer cancel()
+
+			registry := &countingRegistry{
+				hook: &ttnpb.ApplicationWebhook{
+					ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+						ApplicationIdentifiers: registeredApplicationID,
+						WebhookID:              registeredWebhookID,
+					},
+					BaseURL: "https://myapp.com/api/ttn/v3",
+					Format:  "json",
+					Method:  tc.Method,
+					UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+						Path: "up",
+					},
+				},
+			}
+			sink := &mockSink{
+				ch: make(chan *http.Request, 1),
+			}
+			w := web.NewWebhooks(ctx, nil, registry, sink)
+			sub := w.NewSubscription()
+			err := sub.SendUp(&ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: registeredDeviceID,
+				Up: &ttnpb.ApplicationUp_UplinkMessage{
+					UplinkMessage: &ttnpb.ApplicationUplink{
+						SessionKeyID: []byte{0x11},
+						FPort:        42,
+						FCnt:         42,
+						FRMPayload:   []byte{0x1, 0x2, 0x3},
+					},
+				},
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			if tc.Expected == "" {
+				a.So(registry.hook.Validate(), should.NotBeNil)
+				select {
+				case <-sink.ch:
+					t.Fatal("Expected no request but received one")
+				case <-time.After(timeout):
+				}
+				return
+			}
+			a.So(registry.hook.Validate(), should.BeNil)
+			select {
+			case req := <-sink.ch:
+				a.So(req.Method, should.Equal, tc.Expected)
+			case <-time.After(timeout):
+				t.Fatal("Expected request but nothing received")
+			}
+		})
+	}
+}
+
+func TestHTTPClientSinkCircuitBreaker(t *testing.T) {
+	a := assertions.New(t)
+
+	var (
+		requests int32
+		status   int32 = http.StatusInternalServerError
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(int(atomic.LoadInt32(&status)))
+	}))
+	defer server.Close()
+
+	sink := &web.HTTPClientSink{
+		Client:           http.DefaultClient,
+		BreakerThreshold: 2,
+		BreakerCooldown:  test.Delay,
+	}
+	process := func() error {
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/up", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sink.Process(req)
+	}
+	isCircuitOpen := func(err error) bool {
+		ttnErr, ok := errors.From(err)
+		return ok && ttnErr.Name() == "circuit_open"
+	}
+
+	// Trip the breaker.
+	for i := 0; i < 2; i++ {
+		err := process()
+		a.So(err, should.NotBeNil)
+		a.So(isCircuitOpen(err), should.BeFalse)
+	}
+	a.So(atomic.LoadInt32(&requests), should.Equal, 2)
+
+	// Requests are short-circuited while the circuit is open.
+	err := process()
+	a.So(isCircuitOpen(err), should.BeTrue)
+	a.So(atomic.LoadInt32(&requests), should.Equal, 2)
+
+	// After the cooldown, a failing request opens the circuit again.
+	time.Sleep(2 * test.Delay)
+	err = process()
+	a.So(isCircuitOpen(err), should.BeFalse)
+	a.So(atomic.LoadInt32(&requests), should.Equal, 3)
+	a.So(isCircuitOpen(process()), should.BeTrue)
+
+	// After the cooldown, a successful request closes the circuit.
+	atomic.StoreInt32(&status, http.StatusOK)
+	time.Sleep(2 * test.Delay)
+	a.So(process(), should.BeNil)
+	a.So(process(), should.BeNil)
+	a.So(atomic.LoadInt32(&requests), should.Equal, 5)
+
+	// Client errors do not open the circuit.
+	atomic.StoreInt32(&status, http.StatusBadRequest)
+	for i := 0; i < 3; i++ {
+		err := process()
+		a.So(err, should.NotBeNil)
+		a.So(isCircuitOpen(err), should.BeFalse)
+	}
+	a.So(atomic.LoadInt32(&requests), should.Equal, 8)
+}
+
+func TestHTTPClientSinkBodySizeLimits(t *testing.T) {
+	a := assertions.New(t)
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		switch r.URL.Path {
+		case "/small":
+			w.Write(bytes.Repeat([]byte{0x42}, 16))
+		case "/large":
+			w.Write(bytes.Repeat([]byte{0x42}, 1<<16))
+		}
+	}))
+	defer server.Close()
+
+	sink := &web.HTTPClientSink{
+		Client:              http.DefaultClient,
+		MaxRequestBodySize:  32,
+		MaxResponseBodySize: 32,
+	}
+	process := func(path string, body []byte) error {
+		req, err := http.NewRequest(http.MethodPost, server.URL+path, bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sink.Process(req)
+	}
+	errorName := func(err error) string {
+		if ttnErr, ok := errors.From(err); ok {
+			return ttnErr.Name()
+		}
+		return ""
+	}
+
+	a.So(process("/small", []byte("payload")), should.BeNil)
+
+	err := process("/large", []byte("payload"))
+	a.So(errors.IsResourceExhausted(err), should.BeTrue)
+	a.So(errorName(err), should.Equal, "response_too_large")
+
+	err = process("/small", bytes.Repeat([]byte{0x42}, 64))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	a.So(errorName(err), should.Equal, "request_too_large")
+	a.So(atomic.LoadInt32(&requests), should.Equal, 2)
+}
+
+func TestHTTPClientSinkRetryAfter(t *testing.T) {
+	for _, tc := range []struct {
+		Name       string
+		StatusCode int
+		RetryAfter func() string
+		Failures   int32
+		MaxRetries int
+		MaxBackoff time.Duration
+		MinDelay   time.Duration
+		MaxDelay   time.Duration
+		Requests   int32
+		Success    bool
+	}{
+		{
+			Name:       "Seconds",
+			StatusCode: http.StatusTooManyRequests,
+			RetryAfter: func() string { return "2" },
+			Failures:   1,
+			MaxRetries: 1,
+			MinDelay:   2 * time.Second,
+			MaxDelay:   3 * time.Second,
+			Requests:   2,
+			Success:    true,
+		},
+		{
+			Name:       "HTTPDate",
+			StatusCode: http.StatusServiceUnavailable,
+			RetryAfter: func() string { return time.Now().Add(3 * time.Second).UTC().Format(http.TimeFormat) },
+			Failures:   1,
+			MaxRetries: 1,
+			MinDelay:   time.Second,
+			MaxDelay:   4 * time.Second,
+			Requests:   2,
+			Success:    true,
+		},
+		{
+			Name:       "CappedByMaxBackoff",
+			StatusCode: http.StatusTooManyRequests,
+			RetryAfter: func() string { return "2" },
+			Failures:   1,
+			MaxRetries: 1,
+			MaxBackoff: 100 * time.Millisecond,
+			MinDelay:   100 * time.Millisecond,
+			MaxDelay:   time.Second,
+			Requests:   2,
+			Success:    true,
+		},
+		{
+			Name:       "RetriesExhausted",
+			StatusCode: http.StatusTooManyRequests,
+			RetryAfter: func() string { return "" },
+			Failures:   3,
+			MaxRetries: 2,
+			MaxBackoff: 100 * time.Millisecond,
+			MaxDelay:   time.Second,
+			Requests:   3,
+		},
+		{
+			Name:       "NoRetries",
+			StatusCode: http.StatusTooManyRequests,
+			RetryAfter: func() string { return "2" },
+			Failures:   1,
+			MaxDelay:   time.Second,
+			Requests:   1,
+		},
+		{
+			Name:       "NotRetryable",
+			StatusCode: http.StatusInternalServerError,
+			RetryAfter: func() string { return "2" },
+			Failures:   1,
+			MaxRetries: 1,
+			MaxDelay:   time.Second,
+			Requests:   1,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			var requests int32
+			bodies := make(chan []byte, 4)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				bodies <- body
+				if atomic.AddInt32(&requests, 1) <= tc.Failures {
+					if retryAfter := tc.RetryAfter(); retryAfter != "" {
+						w.Header().Set("Retry-After", retryAfter)
+					}
+					w.WriteHeader(tc.StatusCode)
+				}
+			}))
+			defer server.Close()
+
+			sink := &web.HTTPClientSink{
+				Client:     http.DefaultClient,
+				MaxRetries: tc.MaxRetries,
+				MaxBackoff: tc.MaxBackoff,
+			}
+			req, err := http.NewRequest(http.MethodPost, server.URL, bytes.NewReader([]byte("payload")))
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			start := time.Now()
+			err = sink.Process(req)
+			elapsed := time.Since(start)
+			if tc.Success {
+				a.So(err, should.BeNil)
+			} else {
+				a.So(errors.IsUnavailable(err), should.BeTrue)
+			}
+			a.So(atomic.LoadInt32(&requests), should.Equal, tc.Requests)
+			a.So(elapsed, should.BeGreaterThanOrEqualTo, tc.MinDelay)
+			a.So(elapsed, should.BeLessThan, tc.MaxDelay)
+			close(bodies)
+			for body := range bodies {
+				a.So(string(body), should.Equal, "payload")
+			}
+		})
+	}
+}
+
+func TestHTTPClientSinkAcceptedStatusCodes(t *testing.T) {
+	msg := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		Name                string
+		AcceptedStatusCodes []uint32
+		StatusCode          int
+		Accepted            bool
+	}{
+		{
+			Name:       "Default/200",
+			StatusCode: http.StatusOK,
+			Accepted:   true,
+		},
+		{
+			Name:       "Default/202",
+			StatusCode: http.StatusAccepted,
+			Accepted:   true,
+		},
+		{
+			Name:       "Default/302",
+			StatusCode: http.StatusFound,
+		},
+		{
+			Name:                "Only202/200",
+			AcceptedStatusCodes: []uint32{http.StatusAccepted},
+			StatusCode:          http.StatusOK,
+		},
+		{
+			Name:                "Only202/202",
+			AcceptedStatusCodes: []uint32{http.StatusAccepted},
+			StatusCode:          http.StatusAccepted,
+			Accepted:            true,
+		},
+		{
+			Name:                "Custom/302",
+			AcceptedStatusCodes: []uint32{http.StatusOK, http.StatusFound},
+			StatusCode:          http.StatusFound,
+			Accepted:            true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+			defer cancel()
+
+			sink := &web.HTTPClientSink{
+				Client: &http.Client{
+					Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: tc.StatusCode,
+							Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+							Request:    req,
+						}, nil
+					}),
+					CheckRedirect: func(*http.Request, []*http.Request) error {
+						return http.ErrUseLastResponse
+					},
+				},
+			}
+			errCh := make(chan error, 1)
+			w := web.NewWebhooks(ctx, nil, &countingRegistry{
+				hook: &ttnpb.ApplicationWebhook{
+					ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+						ApplicationIdentifiers: registeredApplicationID,
+						WebhookID:              registeredWebhookID,
+					},
+					BaseURL: "https://myapp.com/api/ttn/v3",
+					Format:  "json",
+					UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+						Path: "up",
+					},
+					AcceptedStatusCodes: tc.AcceptedStatusCodes,
+				},
+			}, sinkFunc(func(req *http.Request) error {
+				err := sink.Process(req)
+				select {
+				case errCh <- err:
+				default:
+				}
+				return err
+			}))
+			sub := w.NewSubscription()
+
+			if err := sub.SendUp(msg); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			select {
+			case err := <-errCh:
+				if tc.Accepted {
+					a.So(err, should.BeNil)
+				} else if a.So(err, should.NotBeNil) {
+					a.So(errors.IsUnavailable(err), should.BeTrue)
+				}
+			case <-time.After(timeout):
+				t.Fatal("Expected request but nothing received")
+			}
+		})
+	}
+}
+
+func TestWebhooksDeliveryEvents(t *testing.T) {
+	msg := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		Name       string
+		StatusCode int
+		Event      string
+	}{
+		{
+			Name:       "Success",
+			StatusCode: http.StatusOK,
+			Event:      "as.webhook.delivery.success",
+		},
+		{
+			Name:       "InternalServerError",
+			StatusCode: http.StatusInternalServerError,
+			Event:      "as.webhook.delivery.fail",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+			defer cancel()
+
+			ch := make(events.Channel, 10)
+			events.Subscribe("as.webhook.delivery.*", ch)
+			defer events.Unsubscribe("as.webhook.delivery.*", ch)
+
+			sink := &web.HTTPClientSink{
+				Client: &http.Client{
+					Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: tc.StatusCode,
+							Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+							Request:    req,
+						}, nil
+					}),
+				},
+			}
+			w := web.NewWebhooks(ctx, nil, &countingRegistry{
+				hook: &ttnpb.ApplicationWebhook{
+					ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+						ApplicationIdentifiers: registeredApplicationID,
+						WebhookID:              "delivery-events",
+					},
+					BaseURL: "https://myapp.com/api/ttn/v3",
+					Format:  "json",
+					UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+						Path: "up",
+					},
+				},
+			}, sink)
+			sub := w.NewSubscription()
+
+			if err := sub.SendUp(msg); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			for {
+				evt := ch.ReceiveTimeout(timeout)
+				if evt == nil {
+					t.Fatal("Expected delivery event but nothing received")
+				}
+				data, ok := evt.Data().(map[string]interface{})
+				if !ok || data["webhook_id"] != "delivery-events" {
+					continue
+				}
+				a.So(evt.Name(), should.Equal, tc.Event)
+				a.So(data["status_code"], should.Equal, tc.StatusCode)
+				a.So(data["attempts"], should.Equal, 1)
+				a.So(data["latency"], should.HaveSameTypeAs, time.Duration(0))
+				break
+			}
+		})
+	}
+}
+
+type deviceWebhookRegistryFunc func(context.Context, ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationWebhook, error)
+
+func (f deviceWebhookRegistryFunc) List(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationWebhook, error) {
+	return f(ctx, ids)
+}
+
+func TestWebhooksDeviceWebhooks(t *testing.T) {
+	msg := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+			},
+		},
+	}
+	deviceHook := func(webhookID string) *ttnpb.ApplicationWebhook {
+		return &ttnpb.ApplicationWebhook{
+			ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+				ApplicationIdentifiers: registeredApplicationID,
+				WebhookID:              webhookID,
+			},
+			BaseURL: "https://premium.myapp.com/api/ttn/v3",
+			Format:  "json",
+			UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+				Path: "up",
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name        string
+		DeviceHooks []*ttnpb.ApplicationWebhook
+		DeviceErr   error
+		URLs        []string
+	}{
+		{
+			Name: "NoDeviceWebhooks",
+			URLs: []string{
+				"https://myapp.com/api/ttn/v3/up",
+			},
+		},
+		{
+			Name:        "ExtraTarget",
+			DeviceHooks: []*ttnpb.ApplicationWebhook{deviceHook("premium")},
+			URLs: []string{
+				"https://myapp.com/api/ttn/v3/up",
+				"https://premium.myapp.com/api/ttn/v3/up",
+			},
+		},
+		{
+			Name:        "Override",
+			DeviceHooks: []*ttnpb.ApplicationWebhook{deviceHook(registeredWebhookID)},
+			URLs: []string{
+				"https://premium.myapp.com/api/ttn/v3/up",
+			},
+		},
+		{
+			Name:        "Duplicate",
+			DeviceHooks: []*ttnpb.ApplicationWebhook{deviceHook("premium"), deviceHook("premium")},
+			URLs: []string{
+				"https://myapp.com/api/ttn/v3/up",
+				"https://premium.myapp.com/api/ttn/v3/up",
+			},
+		},
+		{
+			Name:      "DeviceRegistryError",
+			DeviceErr: errors.New("device registry unavailable"),
+			URLs: []string{
+				"https://myapp.com/api/ttn/v3/up",
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+			defer cancel()
+
+			reqCh := make(chan *http.Request, 4)
+			w := web.NewWebhooks(ctx, nil, &countingRegistry{}, sinkFunc(func(req *http.Request) error {
+				reqCh <- req
+				return nil
+			}), web.WithDeviceWebhooks(deviceWebhookRegistryFunc(func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationWebhook, error) {
+				a.So(ids, should.Resemble, registeredDeviceID)
+				return tc.DeviceHooks, tc.DeviceErr
+			})))
+			sub := w.NewSubscription()
+			if err := sub.SendUp(msg); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			urls := make([]string, 0, len(tc.URLs))
+			for range tc.URLs {
+				select {
+				case req := <-reqCh:
+					urls = append(urls, req.URL.String())
+				case <-time.After(timeout):
+					t.Fatal("Expected request but nothing received")
+				}
+			}
+			select {
+			case req := <-reqCh:
+				t.Fatalf("Expected no more requests but received request to %s", req.URL)
+			case <-time.After(test.Delay):
+			}
+			sort.Strings(urls)
+			a.So(urls, should.Resemble, tc.URLs)
+		})
+	}
+}
+
+func TestWebhooksClose(t *testing.T) {
+	msg := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+			},
+		},
+	}
+	newWebhooks := func(ctx context.Context, sink web.Sink) web.Webhooks {
+		return web.NewWebhooks(ctx, nil, &countingRegistry{
+			hook: &ttnpb.ApplicationWebhook{
+				ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+					ApplicationIdentifiers: registeredApplicationID,
+					WebhookID:              registeredWebhookID,
+				},
+				BaseURL: "https://myapp.com/api/ttn/v3",
+				Format:  "json",
+				UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+					Path: "up",
+				},
+			},
+		}, sink)
+	}
+
+	t.Run("Drain", func(t *testing.T) {
+		a := assertions.New(t)
+		ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+		defer cancel()
+		sink := &blockingSink{
+			received: make(chan *http.Request, 1),
+			release:  make(chan struct{}),
+		}
+		w := newWebhooks(ctx, sink)
+		sub := w.NewSubscription()
+		if err := sub.SendUp(msg); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		select {
+		case <-sink.received:
+		case <-time.After(timeout):
+			t.Fatal("Expected message but nothing received")
+		}
+
+		closed := make(chan error, 1)
+		go func() {
+			closed <- w.Close(ctx)
+		}()
+		select {
+		case <-closed:
+			t.Fatal("Expected Close to wait for the in-flight delivery")
+		case <-time.After(test.Delay):
+		}
+		close(sink.release)
+		select {
+		case err := <-closed:
+			a.So(err, should.BeNil)
+		case <-time.After(timeout):
+			t.Fatal("Expected Close to return after the in-flight delivery")
+		}
+		a.So(atomic.LoadInt32(&sink.processed), should.Equal, 1)
+
+		// New messages are not accepted after closing.
+		sub.SendUp(msg)
+		select {
+		case <-sink.received:
+			t.Fatal("Expected no message after closing")
+		case <-time.After(test.Delay):
+		}
+	})
+
+	t.Run("Deadline", func(t *testing.T) {
+		a := assertions.New(t)
+		ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+		defer cancel()
+		sink := &blockingSink{
+			received: make(chan *http.Request, 1),
+			release:  make(chan struct{}),
+		}
+		defer close(sink.release)
+		w := newWebhooks(ctx, sink)
+		sub := w.NewSubscription()
+		if err := sub.SendUp(msg); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		select {
+		case <-sink.received:
+		case <-time.After(timeout):
+			t.Fatal("Expected message but nothing received")
+		}
+
+		closeCtx, closeCancel := context.WithTimeout(ctx, test.Delay)
+		defer closeCancel()
+		err := w.Close(closeCtx)
+		a.So(err, should.Equal, context.DeadlineExceeded)
+		a.So(atomic.LoadInt32(&sink.processed), should.Equal, 0)
+	})
+}
+
+// blockingSink is a Sink that blocks processing until release is closed.
+type blockingSink struct {
+	received  chan *http.Request
+	release   chan struct{}
+	processed int32
+}
+
+func (s *blockingSink) Process(req *http.Request) error {
+	s.received <- req
+	<-s.release
+	atomic.AddInt32(&s.processed, 1)
+	return nil
+}
+
 type mockSink struct {
 	io.Server
 	ch chan *http.Request
@@ -398,3 +1414,599 @@ func (s *mockSink) DownlinkQueuePush(ctx context.Context, ids ttnpb.EndDeviceIde
 func (s *mockSink) DownlinkQueueReplace(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
 	return nil
 }
+
+func TestWebhooksBackpressure(t *testing.T) {
+	msg := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+			},
+		},
+	}
+
+	t.Run("MaxInFlight", func(t *testing.T) {
+		a := assertions.New(t)
+		ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+		defer cancel()
+		sink := &blockingSink{
+			received: make(chan *http.Request, 10),
+			release:  make(chan struct{}),
+		}
+		// The application limits make deliveries concurrent, so that only the backpressure bounds them.
+		w := web.NewWebhooks(ctx, nil, &countingRegistry{}, sink,
+			web.WithApplicationLimits(web.ApplicationLimits{MaxConcurrent: 10}),
+			web.WithBackpressure(2),
+		)
+		sub := w.NewSubscription()
+		for i := 0; i < 10; i++ {
+			if err := sub.SendUp(msg); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+		}
+
+		for i := 0; i < 2; i++ {
+			select {
+			case <-sink.received:
+			case <-time.After(timeout):
+				t.Fatal("Expected message but nothing received")
+			}
+		}
+		select {
+		case <-sink.received:
+			t.Fatal("Expected at most 2 messages in flight")
+		case <-time.After(test.Delay):
+		}
+		// The remaining messages stay in the subscription, except the one waiting for a delivery slot.
+		a.So(len(sub.Up()), should.Equal, 7)
+
+		close(sink.release)
+		for i := 0; i < 8; i++ {
+			select {
+			case <-sink.received:
+			case <-time.After(timeout):
+				t.Fatal("Expected message but nothing received")
+			}
+		}
+		a.So(w.Close(ctx), should.BeNil)
+		a.So(atomic.LoadInt32(&sink.processed), should.Equal, 10)
+	})
+
+	for _, tc := range []struct {
+		Name         string
+		Options      []web.Option
+		Backpressure bool
+	}{
+		{
+			Name: "QueueFull/Drop",
+		},
+		{
+			Name:         "QueueFull/Wait",
+			Options:      []web.Option{web.WithBackpressure(1)},
+			Backpressure: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+			defer cancel()
+			// The queue is not run, so that it stays full until it is drained by the test.
+			sink := &web.QueuedSink{
+				Target: sinkFunc(func(*http.Request) error { return nil }),
+				Queue:  make(chan *http.Request, 1),
+			}
+			w := web.NewWebhooks(ctx, nil, &countingRegistry{}, sink, tc.Options...)
+			sub := w.NewSubscription()
+			for i := 0; i < 2; i++ {
+				if err := sub.SendUp(msg); !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+			}
+			time.Sleep(test.Delay)
+			a.So(len(sink.Queue), should.Equal, 1)
+			a.So(len(sub.Up()), should.Equal, 0)
+
+			<-sink.Queue
+			select {
+			case <-sink.Queue:
+				if !tc.Backpressure {
+					t.Fatal("Expected the second message to be dropped")
+				}
+			case <-time.After(timeout):
+				if tc.Backpressure {
+					t.Fatal("Expected the second message to be queued when the queue has capacity")
+				}
+			}
+		})
+	}
+}
+
+func TestWebhooksRequestID(t *testing.T) {
+	msg := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		Name      string
+		RequestID string
+	}{
+		{
+			Name: "Generated",
+		},
+		{
+			Name:      "Propagated",
+			RequestID: "test-request-id",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			mem := memory.New()
+			logger, err := log.NewLogger(log.WithHandler(mem), log.WithLevel(log.DebugLevel))
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			ctx := log.NewContext(test.Context(), logger)
+			if tc.RequestID != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("request-id", tc.RequestID))
+			}
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			reqCh := make(chan *http.Request, 1)
+			w := web.NewWebhooks(ctx, nil, &countingRegistry{}, sinkFunc(func(req *http.Request) error {
+				reqCh <- req
+				return nil
+			}))
+			sub := w.NewSubscription()
+			if err := sub.SendUp(msg); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			var requestID string
+			select {
+			case req := <-reqCh:
+				requestID = req.Header.Get("X-Request-ID")
+			case <-time.After(timeout):
+				t.Fatal("Expected request but nothing received")
+			}
+			a.So(requestID, should.NotBeEmpty)
+			if tc.RequestID != "" {
+				a.So(requestID, should.Equal, tc.RequestID)
+			}
+
+			var logged bool
+			for _, entry := range mem.Entries {
+				if entry.Message() == "Processing message" {
+					logged = true
+					a.So(entry.Fields().Fields()["request_id"], should.Equal, requestID)
+				}
+			}
+			a.So(logged, should.BeTrue)
+		})
+	}
+}
+
+func TestWebhooksMessageFormat(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+	defer cancel()
+
+	reqCh := make(chan *http.Request, 1)
+	w := web.NewWebhooks(ctx, nil, &countingRegistry{
+		hook: &ttnpb.ApplicationWebhook{
+			ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+				ApplicationIdentifiers: registeredApplicationID,
+				WebhookID:              registeredWebhookID,
+			},
+			BaseURL: "https://myapp.com/api/ttn/v3",
+			Format:  "json",
+			UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+				Path: "up",
+			},
+			DownlinkSent: &ttnpb.ApplicationWebhook_Message{
+				Path:   "down/sent",
+				Format: "protobuf",
+			},
+		},
+	}, sinkFunc(func(req *http.Request) error {
+		reqCh <- req
+		return nil
+	}))
+	sub := w.NewSubscription()
+
+	for _, tc := range []struct {
+		Name        string
+		Message     *ttnpb.ApplicationUp
+		Path        string
+		ContentType string
+		Formatter   formatters.Formatter
+	}{
+		{
+			Name: "Default",
+			Message: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: registeredDeviceID,
+				Up: &ttnpb.ApplicationUp_UplinkMessage{
+					UplinkMessage: &ttnpb.ApplicationUplink{
+						SessionKeyID: []byte{0x11},
+						FPort:        42,
+						FCnt:         42,
+						FRMPayload:   []byte{0x1, 0x2, 0x3},
+					},
+				},
+			},
+			Path:        "/api/ttn/v3/up",
+			ContentType: "application/json",
+			Formatter:   formatters.JSON,
+		},
+		{
+			Name: "Override",
+			Message: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: registeredDeviceID,
+				Up: &ttnpb.ApplicationUp_DownlinkSent{
+					DownlinkSent: &ttnpb.ApplicationDownlink{
+						SessionKeyID: []byte{0x22},
+						FPort:        42,
+						FCnt:         42,
+						FRMPayload:   []byte{0x1, 0x2, 0x3},
+					},
+				},
+			},
+			Path:        "/api/ttn/v3/down/sent",
+			ContentType: "application/octet-stream",
+			Formatter:   formatters.Protobuf,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			if err := sub.SendUp(tc.Message); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			select {
+			case req := <-reqCh:
+				a.So(req.URL.Path, should.Equal, tc.Path)
+				a.So(req.Header.Get("Content-Type"), should.Equal, tc.ContentType)
+				body, err := ioutil.ReadAll(req.Body)
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				expected, err := tc.Formatter.FromUp(tc.Message)
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(body, should.Resemble, expected)
+			case <-time.After(timeout):
+				t.Fatal("Expected request but nothing received")
+			}
+		})
+	}
+}
+
+type versionedFormatter struct {
+	formatters.Formatter
+	version string
+}
+
+func (f versionedFormatter) PayloadVersion() string { return f.version }
+
+type unversionedFormatter struct {
+	formatters.Formatter
+}
+
+func TestWebhooksPayloadVersion(t *testing.T) {
+	msg := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		Name    string
+		Format  string
+		Version string
+	}{
+		{
+			Name:    "JSON",
+			Format:  "json",
+			Version: formatters.PayloadVersion(formatters.JSON),
+		},
+		{
+			Name:    "Protobuf",
+			Format:  "protobuf",
+			Version: formatters.PayloadVersion(formatters.Protobuf),
+		},
+		{
+			Name:    "CSV",
+			Format:  "csv",
+			Version: formatters.PayloadVersion(formatters.CSV),
+		},
+		{
+			Name:    "Versioned",
+			Format:  "versioned",
+			Version: "2",
+		},
+		{
+			Name:   "Unversioned",
+			Format: "unversioned",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+			defer cancel()
+
+			reqCh := make(chan *http.Request, 1)
+			w := web.NewWebhooks(ctx, nil, &countingRegistry{
+				hook: &ttnpb.ApplicationWebhook{
+					ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+						ApplicationIdentifiers: registeredApplicationID,
+						WebhookID:              registeredWebhookID,
+					},
+					BaseURL: "https://myapp.com/api/ttn/v3",
+					Format:  tc.Format,
+					UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+						Path: "up",
+					},
+				},
+			}, sinkFunc(func(req *http.Request) error {
+				reqCh <- req
+				return nil
+			}),
+				web.WithFormat("versioned", web.Format{
+					Formatter:   versionedFormatter{Formatter: formatters.JSON, version: "2"},
+					Name:        "Versioned JSON",
+					ContentType: "application/json",
+				}),
+				web.WithFormat("unversioned", web.Format{
+					Formatter:   unversionedFormatter{Formatter: formatters.JSON},
+					Name:        "Unversioned JSON",
+					ContentType: "application/json",
+				}),
+			)
+			sub := w.NewSubscription()
+			if err := sub.SendUp(msg); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			select {
+			case req := <-reqCh:
+				a.So(req.Header.Get("X-TTS-Payload-Version"), should.Equal, tc.Version)
+			case <-time.After(timeout):
+				t.Fatal("Expected request but nothing received")
+			}
+		})
+	}
+}
+
+func TestWebhooksDefaultMessage(t *testing.T) {
+	downlinkSent := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_DownlinkSent{
+			DownlinkSent: &ttnpb.ApplicationDownlink{
+				SessionKeyID: []byte{0x22},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+			},
+		},
+	}
+	joinAccept := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_JoinAccept{
+			JoinAccept: &ttnpb.ApplicationJoinAccept{
+				SessionKeyID: []byte{0x33},
+			},
+		},
+	}
+	uplink := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		Name    string
+		Default *ttnpb.ApplicationWebhook_Message
+		Message *ttnpb.ApplicationUp
+		Path    string
+	}{
+		{
+			Name:    "Configured",
+			Default: &ttnpb.ApplicationWebhook_Message{Path: "other"},
+			Message: uplink,
+			Path:    "/api/ttn/v3/up",
+		},
+		{
+			Name:    "DefaultDownlinkSent",
+			Default: &ttnpb.ApplicationWebhook_Message{Path: "other"},
+			Message: downlinkSent,
+			Path:    "/api/ttn/v3/other",
+		},
+		{
+			Name:    "DefaultJoinAccept",
+			Default: &ttnpb.ApplicationWebhook_Message{Path: "other"},
+			Message: joinAccept,
+			Path:    "/api/ttn/v3/other",
+		},
+		{
+			Name:    "NoDefault",
+			Message: downlinkSent,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+			defer cancel()
+
+			reqCh := make(chan *http.Request, 1)
+			w := web.NewWebhooks(ctx, nil, &countingRegistry{
+				hook: &ttnpb.ApplicationWebhook{
+					ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+						ApplicationIdentifiers: registeredApplicationID,
+						WebhookID:              registeredWebhookID,
+					},
+					BaseURL: "https://myapp.com/api/ttn/v3",
+					Format:  "json",
+					UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+						Path: "up",
+					},
+					Default: tc.Default,
+				},
+			}, sinkFunc(func(req *http.Request) error {
+				reqCh <- req
+				return nil
+			}))
+			sub := w.NewSubscription()
+
+			if err := sub.SendUp(tc.Message); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			if tc.Path == "" {
+				select {
+				case req := <-reqCh:
+					t.Fatalf("Expected no request but received request to %s", req.URL.Path)
+				case <-time.After(test.Delay):
+				}
+				return
+			}
+			select {
+			case req := <-reqCh:
+				a.So(req.URL.Path, should.Equal, tc.Path)
+				body, err := ioutil.ReadAll(req.Body)
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				expected, err := formatters.JSON.FromUp(tc.Message)
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(body, should.Resemble, expected)
+			case <-time.After(timeout):
+				t.Fatal("Expected request but nothing received")
+			}
+		})
+	}
+}
+
+func activeWebhookSubscriptions(t *testing.T) float64 {
+	rec := httptest.NewRecorder()
+	metrics.Exporter.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	prefix := `ttn_as_webhooks_subscriptions_active{namespace="applicationserver/io/web"} `
+	for _, line := range strings.Split(rec.Body.String(), "\n") {
+		if !strings.HasPrefix(line, prefix) {
+			continue
+		}
+		v, err := strconv.ParseFloat(strings.TrimPrefix(line, prefix), 64)
+		if err != nil {
+			t.Fatalf("Invalid metric value in %q: %v", line, err)
+		}
+		return v
+	}
+	return 0
+}
+
+func TestWebhooksSubscriptionsGauge(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	sink := sinkFunc(func(*http.Request) error { return nil })
+	w := web.NewWebhooks(ctx, nil, &countingRegistry{}, sink)
+
+	// Subscriptions of other tests may still be winding down.
+	time.Sleep(test.Delay)
+	baseline := activeWebhookSubscriptions(t)
+
+	subs := make([]*io.Subscription, 3)
+	for i := range subs {
+		subs[i] = w.NewSubscription()
+	}
+	a.So(activeWebhookSubscriptions(t), should.Equal, baseline+3)
+
+	for _, sub := range subs {
+		sub.Disconnect(context.Canceled)
+	}
+	time.Sleep(test.Delay)
+	a.So(activeWebhookSubscriptions(t), should.Equal, baseline)
+
+	sub := w.NewSubscription()
+	a.So(activeWebhookSubscriptions(t), should.Equal, baseline+1)
+	cancel()
+	<-sub.Context().Done()
+	time.Sleep(test.Delay)
+	a.So(activeWebhookSubscriptions(t), should.Equal, baseline)
+}
+
+func TestWebhooksProjection(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+	defer cancel()
+
+	reqCh := make(chan *http.Request, 1)
+	w := web.NewWebhooks(ctx, nil, &countingRegistry{
+		hook: &ttnpb.ApplicationWebhook{
+			ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+				ApplicationIdentifiers: registeredApplicationID,
+				WebhookID:              registeredWebhookID,
+			},
+			BaseURL: "https://myapp.com/api/ttn/v3",
+			Format:  "json",
+			UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+				Path: "up",
+			},
+			ProjectionPaths: []string{
+				"end_device_ids.device_id",
+				"uplink_message.f_cnt",
+			},
+		},
+	}, sinkFunc(func(req *http.Request) error {
+		reqCh <- req
+		return nil
+	}))
+	sub := w.NewSubscription()
+	if err := sub.SendUp(&ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+			},
+		},
+	}); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	select {
+	case req := <-reqCh:
+		body, err := ioutil.ReadAll(req.Body)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(string(body), should.Equal, `{"end_device_ids":{"device_id":"foo-device"},"uplink_message":{"f_cnt":42}}`)
+	case <-time.After(timeout):
+		t.Fatal("Expected request but nothing received")
+	}
+}
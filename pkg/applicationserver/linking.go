@@ -204,6 +204,12 @@ func (as *ApplicationServer) link(ctx context.Context, ids ttnpb.ApplicationIden
 		case *ttnpb.ApplicationUp_JoinAccept:
 			p.JoinAccept.AppSKey = nil
 			p.JoinAccept.InvalidatedDownlinks = nil
+		case *ttnpb.ApplicationUp_UplinkMessage:
+			if as.uplinks != nil {
+				if err := as.uplinks.Store(ctx, up); err != nil {
+					logger.WithError(err).Warn("Failed to store uplink message")
+				}
+			}
 		case *ttnpb.ApplicationUp_DownlinkQueueInvalidated:
 			continue
 		}
@@ -0,0 +1,96 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+const upKey = "up"
+
+// MessageStore is a Redis store of application uplink messages.
+// The messages of each application are stored in a capped stream.
+type MessageStore struct {
+	Redis *ttnredis.Client
+	// MaxLen is the maximum number of messages that are stored per application. Zero is unlimited.
+	MaxLen int64
+	// TTL is the time that messages are stored. Zero is unlimited.
+	TTL time.Duration
+}
+
+// Store stores the uplink message in the stream of the application.
+// The stream expires TTL after the last stored message.
+func (s *MessageStore) Store(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	k := s.Redis.Key(unique.ID(ctx, up.ApplicationIdentifiers))
+	v, err := ttnredis.MarshalProto(up)
+	if err != nil {
+		return err
+	}
+	_, err = s.Redis.Pipelined(func(p redis.Pipeliner) error {
+		p.XAdd(&redis.XAddArgs{
+			Stream: k,
+			MaxLen: s.MaxLen,
+			Values: map[string]interface{}{
+				upKey: v,
+			},
+		})
+		if s.TTL > 0 {
+			p.PExpire(k, s.TTL)
+		}
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Range ranges the uplink messages of the application that are stored at or after since and that are not expired.
+func (s *MessageStore) Range(ctx context.Context, ids ttnpb.ApplicationIdentifiers, since time.Time, f func(*ttnpb.ApplicationUp) bool) error {
+	if s.TTL > 0 {
+		if expired := time.Now().Add(-s.TTL); since.Before(expired) {
+			since = expired
+		}
+	}
+	start := "-"
+	if !since.IsZero() {
+		start = strconv.FormatInt(since.UnixNano()/int64(time.Millisecond), 10)
+	}
+	msgs, err := s.Redis.XRange(s.Redis.Key(unique.ID(ctx, ids)), start, "+").Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	for _, msg := range msgs {
+		v, ok := msg.Values[upKey].(string)
+		if !ok {
+			continue
+		}
+		up := &ttnpb.ApplicationUp{}
+		if err := ttnredis.UnmarshalProto(v, up); err != nil {
+			return err
+		}
+		if !f(up) {
+			return nil
+		}
+	}
+	return nil
+}
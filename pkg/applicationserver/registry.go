@@ -16,7 +16,9 @@ package applicationserver
 
 import (
 	"context"
+	"time"
 
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
@@ -33,6 +35,25 @@ type DeviceRegistry interface {
 	Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error)
 }
 
+// deviceWebhookRegistry is a web.DeviceWebhookRegistry that lists the webhooks stored in the end devices.
+type deviceWebhookRegistry struct {
+	DeviceRegistry
+}
+
+var _ web.DeviceWebhookRegistry = deviceWebhookRegistry{}
+
+// List implements web.DeviceWebhookRegistry.
+func (r deviceWebhookRegistry) List(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationWebhook, error) {
+	dev, err := r.Get(ctx, ids, []string{"webhooks"})
+	if err != nil {
+		return nil, err
+	}
+	for _, hook := range dev.Webhooks {
+		hook.ApplicationIdentifiers = ids.ApplicationIdentifiers
+	}
+	return dev.Webhooks, nil
+}
+
 // LinkRegistry is a store for application links.
 type LinkRegistry interface {
 	// Get returns the link by the application identifiers.
@@ -42,3 +63,12 @@ type LinkRegistry interface {
 	// Set creates, updates or deletes the link by the application identifiers.
 	Set(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string, f func(*ttnpb.ApplicationLink) (*ttnpb.ApplicationLink, []string, error)) (*ttnpb.ApplicationLink, error)
 }
+
+// MessageStore is a store for application uplink messages.
+type MessageStore interface {
+	// Store stores the uplink message.
+	Store(ctx context.Context, up *ttnpb.ApplicationUp) error
+	// Range ranges the uplink messages of the application that are stored at or after since, in the order in which
+	// they are stored, and calls the callback function, until false is returned.
+	Range(ctx context.Context, ids ttnpb.ApplicationIdentifiers, since time.Time, f func(*ttnpb.ApplicationUp) bool) error
+}
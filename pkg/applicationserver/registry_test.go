@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/redis"
@@ -138,3 +139,82 @@ func TestLinkRegistry(t *testing.T) {
 		}
 	}
 }
+
+func TestMessageStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "applicationserver_test", "uplinks")
+	defer func() {
+		flush()
+		cl.Close()
+	}()
+	store := &redis.MessageStore{
+		Redis:  cl,
+		MaxLen: 3,
+		TTL:    (1 << 8) * test.Delay,
+	}
+
+	app1IDs := ttnpb.ApplicationIdentifiers{ApplicationID: "app-1"}
+	app2IDs := ttnpb.ApplicationIdentifiers{ApplicationID: "app-2"}
+	storeUp := func(ids ttnpb.ApplicationIdentifiers, fCnt uint32) {
+		err := store.Store(ctx, &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ids,
+				DeviceID:               "dev",
+			},
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FCnt:       fCnt,
+					FRMPayload: []byte{0x1, 0x2, 0x3},
+				},
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+	fCnts := func(ids ttnpb.ApplicationIdentifiers, since time.Time) []uint32 {
+		var res []uint32
+		err := store.Range(ctx, ids, since, func(up *ttnpb.ApplicationUp) bool {
+			a.So(up.ApplicationIdentifiers, should.Resemble, ids)
+			res = append(res, up.GetUplinkMessage().FCnt)
+			return true
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		return res
+	}
+
+	storeUp(app1IDs, 1)
+	storeUp(app1IDs, 2)
+	storeUp(app2IDs, 1)
+	a.So(fCnts(app1IDs, time.Time{}), should.Resemble, []uint32{1, 2})
+	a.So(fCnts(app2IDs, time.Time{}), should.Resemble, []uint32{1})
+
+	// Range since.
+	time.Sleep(2 * test.Delay)
+	since := time.Now()
+	time.Sleep(2 * test.Delay)
+	storeUp(app1IDs, 3)
+	storeUp(app1IDs, 4)
+	a.So(fCnts(app1IDs, since), should.Resemble, []uint32{3, 4})
+
+	// The stream is capped.
+	a.So(fCnts(app1IDs, time.Time{}), should.Resemble, []uint32{2, 3, 4})
+
+	// Range stops when false is returned.
+	var n int
+	err := store.Range(ctx, app1IDs, time.Time{}, func(*ttnpb.ApplicationUp) bool {
+		n++
+		return false
+	})
+	a.So(err, should.BeNil)
+	a.So(n, should.Equal, 1)
+
+	// Messages expire.
+	time.Sleep(store.TTL)
+	a.So(fCnts(app1IDs, time.Time{}), should.BeEmpty)
+	a.So(fCnts(app2IDs, time.Time{}), should.BeEmpty)
+}
@@ -0,0 +1,167 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rights
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// BatchFetcher is a Fetcher that can fetch the rights for multiple entities at once.
+type BatchFetcher interface {
+	Fetcher
+	// EntityRights returns the rights for the given entities, in the same order as the given identifiers.
+	EntityRights(ctx context.Context, ids ...*ttnpb.EntityIdentifiers) ([]*ttnpb.Rights, error)
+}
+
+// Check is a check of the required rights for an entity.
+type Check struct {
+	IDs      *ttnpb.EntityIdentifiers
+	Required []ttnpb.Right
+}
+
+var errUnsupportedEntity = errors.DefineInvalidArgument("unsupported_entity", "unsupported entity `{entity_type}`")
+
+func entityKeyOf(ctx context.Context, ids *ttnpb.EntityIdentifiers) (entityKey, error) {
+	var entityType string
+	switch ids.Ids.(type) {
+	case *ttnpb.EntityIdentifiers_ApplicationIDs:
+		entityType = "application"
+	case *ttnpb.EntityIdentifiers_ClientIDs:
+		entityType = "client"
+	case *ttnpb.EntityIdentifiers_GatewayIDs:
+		entityType = "gateway"
+	case *ttnpb.EntityIdentifiers_OrganizationIDs:
+		entityType = "organization"
+	case *ttnpb.EntityIdentifiers_UserIDs:
+		entityType = "user"
+	default:
+		return entityKey{}, errUnsupportedEntity.WithAttributes("entity_type", ids.Ids)
+	}
+	return entityKey{entityType: entityType, uid: unique.ID(ctx, ids.Identifiers())}, nil
+}
+
+func (r Rights) entityRights(key entityKey) *ttnpb.Rights {
+	switch key.entityType {
+	case "application":
+		return r.ApplicationRights[key.uid]
+	case "client":
+		return r.ClientRights[key.uid]
+	case "gateway":
+		return r.GatewayRights[key.uid]
+	case "organization":
+		return r.OrganizationRights[key.uid]
+	case "user":
+		return r.UserRights[key.uid]
+	}
+	return nil
+}
+
+func fetchEntityRights(ctx context.Context, fetcher Fetcher, ids *ttnpb.EntityIdentifiers) (*ttnpb.Rights, error) {
+	switch ids := ids.Identifiers().(type) {
+	case *ttnpb.ApplicationIdentifiers:
+		return fetcher.ApplicationRights(ctx, *ids)
+	case *ttnpb.ClientIdentifiers:
+		return fetcher.ClientRights(ctx, *ids)
+	case *ttnpb.GatewayIdentifiers:
+		return fetcher.GatewayRights(ctx, *ids)
+	case *ttnpb.OrganizationIdentifiers:
+		return fetcher.OrganizationRights(ctx, *ids)
+	case *ttnpb.UserIdentifiers:
+		return fetcher.UserRights(ctx, *ids)
+	}
+	panic("unreachable")
+}
+
+func requireEntityRights(key entityKey, rights *ttnpb.Rights, required []ttnpb.Right) error {
+	var errNoRights, errInsufficientRights errors.Definition
+	switch key.entityType {
+	case "application":
+		errNoRights, errInsufficientRights = errNoApplicationRights, errInsufficientApplicationRights
+	case "client":
+		errNoRights, errInsufficientRights = errNoClientRights, errInsufficientClientRights
+	case "gateway":
+		errNoRights, errInsufficientRights = errNoGatewayRights, errInsufficientGatewayRights
+	case "organization":
+		errNoRights, errInsufficientRights = errNoOrganizationRights, errInsufficientOrganizationRights
+	case "user":
+		errNoRights, errInsufficientRights = errNoUserRights, errInsufficientUserRights
+	}
+	if len(rights.GetRights()) == 0 {
+		return errNoRights.WithAttributes("uid", key.uid)
+	}
+	missing := ttnpb.RightsFrom(required...).Sub(rights).GetRights()
+	if len(missing) > 0 {
+		return errInsufficientRights.WithAttributes("uid", key.uid, "missing", missing)
+	}
+	return nil
+}
+
+// RequireAny checks that context contains the required rights of all given checks.
+// The checks may be for any type of entity. If the fetcher in the context is a BatchFetcher,
+// the rights of all entities that are not in the request cache are fetched at once.
+func RequireAny(ctx context.Context, checks ...Check) error {
+	keys := make([]entityKey, len(checks))
+	for i, check := range checks {
+		key, err := entityKeyOf(ctx, check.IDs)
+		if err != nil {
+			return err
+		}
+		keys[i] = key
+	}
+	resolved := make([]*ttnpb.Rights, len(checks))
+	if inCtx, ok := FromContext(ctx); ok {
+		for i, key := range keys {
+			resolved[i] = inCtx.entityRights(key)
+		}
+	} else {
+		fetcher, ok := fetcherFromContext(ctx)
+		if !ok {
+			panic(errNoFetcher)
+		}
+		if batch, ok := fetcher.(BatchFetcher); ok {
+			var err error
+			resolved, err = cachedBatchFetch(ctx, keys, func(missing []int) ([]*ttnpb.Rights, error) {
+				ids := make([]*ttnpb.EntityIdentifiers, len(missing))
+				for i, idx := range missing {
+					ids[i] = checks[idx].IDs
+				}
+				return batch.EntityRights(ctx, ids...)
+			})
+			if err != nil {
+				return err
+			}
+		} else {
+			for i, check := range checks {
+				rights, err := cachedFetch(ctx, keys[i].entityType, keys[i].uid, func() (*ttnpb.Rights, error) {
+					return fetchEntityRights(ctx, fetcher, check.IDs)
+				})
+				if err != nil && !errors.IsPermissionDenied(err) {
+					return err
+				}
+				resolved[i] = rights
+			}
+		}
+	}
+	for i, check := range checks {
+		if err := requireEntityRights(keys[i], resolved[i], check.Required); err != nil {
+			return err
+		}
+	}
+	return nil
+}
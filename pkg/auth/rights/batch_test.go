@@ -0,0 +1,124 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rights
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+type mockBatchFetcher struct {
+	FetcherFunc
+	batchCalls int
+	batchIDs   []*ttnpb.EntityIdentifiers
+}
+
+func (f *mockBatchFetcher) EntityRights(ctx context.Context, ids ...*ttnpb.EntityIdentifiers) ([]*ttnpb.Rights, error) {
+	f.batchCalls++
+	f.batchIDs = append(f.batchIDs, ids...)
+	res := make([]*ttnpb.Rights, len(ids))
+	for i, ids := range ids {
+		rights, err := f.FetcherFunc(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = rights
+	}
+	return res, nil
+}
+
+func TestRequireAny(t *testing.T) {
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"}
+	gtwIDs := ttnpb.GatewayIdentifiers{GatewayID: "foo-gtw"}
+	usrIDs := ttnpb.UserIdentifiers{UserID: "foo-usr"}
+
+	var singleCalls int
+	fetcherFunc := FetcherFunc(func(ctx context.Context, ids ttnpb.Identifiers) (*ttnpb.Rights, error) {
+		singleCalls++
+		switch ids.IDString() {
+		case "foo-app":
+			return ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_INFO), nil
+		case "foo-gtw":
+			return ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_INFO), nil
+		case "foo-usr":
+			return ttnpb.RightsFrom(ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_USER_SETTINGS_API_KEYS), nil
+		}
+		return nil, nil
+	})
+
+	allPass := []Check{
+		{IDs: appIDs.EntityIdentifiers(), Required: []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO}},
+		{IDs: gtwIDs.EntityIdentifiers(), Required: []ttnpb.Right{ttnpb.RIGHT_GATEWAY_INFO}},
+		{IDs: usrIDs.EntityIdentifiers(), Required: []ttnpb.Right{ttnpb.RIGHT_USER_INFO}},
+		{IDs: usrIDs.EntityIdentifiers(), Required: []ttnpb.Right{ttnpb.RIGHT_USER_SETTINGS_API_KEYS}},
+	}
+	oneFail := []Check{
+		{IDs: appIDs.EntityIdentifiers(), Required: []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO}},
+		{IDs: gtwIDs.EntityIdentifiers(), Required: []ttnpb.Right{ttnpb.RIGHT_GATEWAY_DELETE}},
+		{IDs: usrIDs.EntityIdentifiers(), Required: []ttnpb.Right{ttnpb.RIGHT_USER_INFO}},
+	}
+
+	t.Run("Context", func(t *testing.T) {
+		a := assertions.New(t)
+		ctx := NewContext(test.Context(), Rights{
+			ApplicationRights: map[string]*ttnpb.Rights{"foo-app": ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_INFO)},
+			GatewayRights:     map[string]*ttnpb.Rights{"foo-gtw": ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_INFO)},
+			UserRights:        map[string]*ttnpb.Rights{"foo-usr": ttnpb.RightsFrom(ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_USER_SETTINGS_API_KEYS)},
+		})
+		a.So(RequireAny(ctx, allPass...), should.BeNil)
+		err := RequireAny(ctx, oneFail...)
+		a.So(errors.Resemble(err, errInsufficientGatewayRights), should.BeTrue)
+	})
+
+	t.Run("Fetcher", func(t *testing.T) {
+		a := assertions.New(t)
+		singleCalls = 0
+		ctx := NewContextWithFetcher(test.Context(), fetcherFunc)
+		a.So(RequireAny(ctx, allPass...), should.BeNil)
+		a.So(singleCalls, should.Equal, 4)
+		err := RequireAny(ctx, oneFail...)
+		a.So(errors.Resemble(err, errInsufficientGatewayRights), should.BeTrue)
+	})
+
+	t.Run("BatchFetcher", func(t *testing.T) {
+		a := assertions.New(t)
+		singleCalls = 0
+		fetcher := &mockBatchFetcher{FetcherFunc: fetcherFunc}
+		ctx := NewContextWithCache(NewContextWithFetcher(test.Context(), fetcher))
+
+		a.So(RequireAny(ctx, allPass...), should.BeNil)
+		a.So(fetcher.batchCalls, should.Equal, 1)
+		// The user is checked twice, but fetched once.
+		a.So(fetcher.batchIDs, should.HaveLength, 3)
+
+		err := RequireAny(ctx, oneFail...)
+		a.So(errors.Resemble(err, errInsufficientGatewayRights), should.BeTrue)
+		// All rights are in the request cache.
+		a.So(fetcher.batchCalls, should.Equal, 1)
+
+		a.So(RequireAny(ctx, Check{
+			IDs:      ttnpb.OrganizationIdentifiers{OrganizationID: "foo-org"}.EntityIdentifiers(),
+			Required: []ttnpb.Right{ttnpb.RIGHT_ORGANIZATION_INFO},
+		}), should.NotBeNil)
+		a.So(fetcher.batchCalls, should.Equal, 2)
+		a.So(singleCalls, should.Equal, 4)
+	})
+}
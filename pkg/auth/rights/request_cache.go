@@ -0,0 +1,112 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rights
+
+import (
+	"context"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+type requestCacheKeyType struct{}
+
+var requestCacheKey requestCacheKeyType
+
+type entityKey struct {
+	entityType string
+	uid        string
+}
+
+type requestCache struct {
+	mu     sync.Mutex
+	rights map[entityKey]*ttnpb.Rights
+}
+
+// NewContextWithCache returns a derived context with a cache for the rights of the request.
+// Within the request, rights that are not in the context are fetched at most once per entity.
+// Successful fetches and fetches that result in permission denied errors are cached.
+func NewContextWithCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, requestCacheKey, &requestCache{
+		rights: make(map[entityKey]*ttnpb.Rights),
+	})
+}
+
+// cachedFetch calls fetch, unless the result for the entity is in the request cache.
+func cachedFetch(ctx context.Context, entityType, uid string, fetch func() (*ttnpb.Rights, error)) (*ttnpb.Rights, error) {
+	cache, ok := ctx.Value(requestCacheKey).(*requestCache)
+	if !ok {
+		return fetch()
+	}
+	key := entityKey{entityType: entityType, uid: uid}
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if rights, ok := cache.rights[key]; ok {
+		return rights, nil
+	}
+	rights, err := fetch()
+	if err != nil && !errors.IsPermissionDenied(err) {
+		return nil, err
+	}
+	cache.rights[key] = rights
+	return rights, err
+}
+
+// cachedBatchFetch returns the rights of the entities identified by keys, in the same order as keys.
+// It calls fetch once with the indexes of the distinct keys that are not in the request cache.
+func cachedBatchFetch(ctx context.Context, keys []entityKey, fetch func(missing []int) ([]*ttnpb.Rights, error)) ([]*ttnpb.Rights, error) {
+	cache, cacheOK := ctx.Value(requestCacheKey).(*requestCache)
+	if cacheOK {
+		cache.mu.Lock()
+		defer cache.mu.Unlock()
+	}
+	res := make([]*ttnpb.Rights, len(keys))
+	resolved := make(map[entityKey]*ttnpb.Rights, len(keys))
+	var missing []int
+	for i, key := range keys {
+		if cacheOK {
+			if rights, ok := cache.rights[key]; ok {
+				resolved[key] = rights
+				continue
+			}
+		}
+		if _, ok := resolved[key]; ok {
+			continue
+		}
+		resolved[key] = nil
+		missing = append(missing, i)
+	}
+	if len(missing) > 0 {
+		fetched, err := fetch(missing)
+		if err != nil && !errors.IsPermissionDenied(err) {
+			return nil, err
+		}
+		for i, idx := range missing {
+			var rights *ttnpb.Rights
+			if i < len(fetched) {
+				rights = fetched[i]
+			}
+			resolved[keys[idx]] = rights
+			if cacheOK {
+				cache.rights[keys[idx]] = rights
+			}
+		}
+	}
+	for i, key := range keys {
+		res[i] = resolved[key]
+	}
+	return res, nil
+}
@@ -0,0 +1,94 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rights
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+func TestRequestCache(t *testing.T) {
+	a := assertions.New(t)
+
+	var (
+		mu      sync.Mutex
+		fetches = make(map[string]int)
+	)
+	fetcher := FetcherFunc(func(ctx context.Context, ids ttnpb.Identifiers) (*ttnpb.Rights, error) {
+		mu.Lock()
+		fetches[ids.IDString()]++
+		mu.Unlock()
+		switch ids.IDString() {
+		case "foo":
+			return ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_INFO, ttnpb.RIGHT_GATEWAY_INFO, ttnpb.RIGHT_USER_INFO), nil
+		case "denied":
+			return nil, errInsufficientUserRights
+		default:
+			return nil, errors.New("unavailable")
+		}
+	})
+	fetchCount := func(id string) int {
+		mu.Lock()
+		defer mu.Unlock()
+		return fetches[id]
+	}
+
+	ctx := NewContextWithCache(NewContextWithFetcher(test.Context(), fetcher))
+
+	for i := 0; i < 3; i++ {
+		a.So(RequireUser(ctx, ttnpb.UserIdentifiers{UserID: "foo"}, ttnpb.RIGHT_USER_INFO), should.BeNil)
+	}
+	a.So(fetchCount("foo"), should.Equal, 1)
+
+	// Rights are cached per entity type.
+	a.So(RequireApplication(ctx, ttnpb.ApplicationIdentifiers{ApplicationID: "foo"}, ttnpb.RIGHT_APPLICATION_INFO), should.BeNil)
+	a.So(RequireGateway(ctx, ttnpb.GatewayIdentifiers{GatewayID: "foo"}, ttnpb.RIGHT_GATEWAY_INFO), should.BeNil)
+	a.So(fetchCount("foo"), should.Equal, 3)
+	a.So(RequireApplication(ctx, ttnpb.ApplicationIdentifiers{ApplicationID: "foo"}, ttnpb.RIGHT_APPLICATION_INFO), should.BeNil)
+	a.So(fetchCount("foo"), should.Equal, 3)
+
+	// Insufficient rights are checked against the cached rights.
+	err := RequireUser(ctx, ttnpb.UserIdentifiers{UserID: "foo"}, ttnpb.RIGHT_USER_DELETE)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	a.So(fetchCount("foo"), should.Equal, 3)
+
+	// Permission denied is cached.
+	for i := 0; i < 2; i++ {
+		err := RequireUser(ctx, ttnpb.UserIdentifiers{UserID: "denied"}, ttnpb.RIGHT_USER_INFO)
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	}
+	a.So(fetchCount("denied"), should.Equal, 1)
+
+	// Other errors are not cached.
+	for i := 0; i < 2; i++ {
+		err := RequireUser(ctx, ttnpb.UserIdentifiers{UserID: "other"}, ttnpb.RIGHT_USER_INFO)
+		a.So(err, should.NotBeNil)
+	}
+	a.So(fetchCount("other"), should.Equal, 2)
+
+	// Without cache in the context, every check fetches.
+	uncachedCtx := NewContextWithFetcher(test.Context(), fetcher)
+	for i := 0; i < 2; i++ {
+		a.So(RequireUser(uncachedCtx, ttnpb.UserIdentifiers{UserID: "foo"}, ttnpb.RIGHT_USER_INFO), should.BeNil)
+	}
+	a.So(fetchCount("foo"), should.Equal, 5)
+}
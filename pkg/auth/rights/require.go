@@ -84,7 +84,9 @@ func RequireApplication(ctx context.Context, id ttnpb.ApplicationIdentifiers, re
 		if !ok {
 			panic(errNoFetcher)
 		}
-		rights, err = fetcher.ApplicationRights(ctx, id)
+		rights, err = cachedFetch(ctx, "application", uid, func() (*ttnpb.Rights, error) {
+			return fetcher.ApplicationRights(ctx, id)
+		})
 		if err != nil && !errors.IsPermissionDenied(err) {
 			return err
 		}
@@ -111,7 +113,9 @@ func RequireClient(ctx context.Context, id ttnpb.ClientIdentifiers, required ...
 		if !ok {
 			panic(errNoFetcher)
 		}
-		rights, err = fetcher.ClientRights(ctx, id)
+		rights, err = cachedFetch(ctx, "client", uid, func() (*ttnpb.Rights, error) {
+			return fetcher.ClientRights(ctx, id)
+		})
 		if err != nil && !errors.IsPermissionDenied(err) {
 			return err
 		}
@@ -138,7 +142,9 @@ func RequireGateway(ctx context.Context, id ttnpb.GatewayIdentifiers, required .
 		if !ok {
 			panic(errNoFetcher)
 		}
-		rights, err = fetcher.GatewayRights(ctx, id)
+		rights, err = cachedFetch(ctx, "gateway", uid, func() (*ttnpb.Rights, error) {
+			return fetcher.GatewayRights(ctx, id)
+		})
 		if err != nil && !errors.IsPermissionDenied(err) {
 			return err
 		}
@@ -165,7 +171,9 @@ func RequireOrganization(ctx context.Context, id ttnpb.OrganizationIdentifiers,
 		if !ok {
 			panic(errNoFetcher)
 		}
-		rights, err = fetcher.OrganizationRights(ctx, id)
+		rights, err = cachedFetch(ctx, "organization", uid, func() (*ttnpb.Rights, error) {
+			return fetcher.OrganizationRights(ctx, id)
+		})
 		if err != nil && !errors.IsPermissionDenied(err) {
 			return err
 		}
@@ -192,7 +200,9 @@ func RequireUser(ctx context.Context, id ttnpb.UserIdentifiers, required ...ttnp
 		if !ok {
 			panic(errNoFetcher)
 		}
-		rights, err = fetcher.UserRights(ctx, id)
+		rights, err = cachedFetch(ctx, "user", uid, func() (*ttnpb.Rights, error) {
+			return fetcher.UserRights(ctx, id)
+		})
 		if err != nil && !errors.IsPermissionDenied(err) {
 			return err
 		}
@@ -25,6 +25,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"go.thethings.network/lorawan-stack/pkg/config"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/fetch"
 	"gocloud.dev/blob"
 	"gocloud.dev/blob/fileblob"
 	"gocloud.dev/blob/gcsblob"
@@ -121,6 +122,15 @@ func (c Config) getGCP(ctx context.Context, bucket string) (*blob.Bucket, error)
 	return gcsblob.OpenBucket(ctx, cli, bucket, nil)
 }
 
+// GetFetcher returns a fetcher for the files under the given path prefix in the requested blob bucket.
+func (c Config) GetFetcher(ctx context.Context, bucket, prefix string) (fetch.Interface, error) {
+	b, err := c.GetBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return fetch.FromBucket(b, prefix), nil
+}
+
 // WriterOptions returns WriterOptions with the given content type and metadata
 // from the given key-value pairs.
 func WriterOptions(contentType string, kv ...string) *blob.WriterOptions {
@@ -48,6 +48,12 @@ func testBucket(t *testing.T, config blob.Config) {
 	res, err := bucket.ReadAll(ctx, "path/to/file")
 	a.So(err, should.BeNil)
 	a.So(res, should.Resemble, contents)
+
+	fetcher, err := config.GetFetcher(ctx, bucketName, "path/to")
+	a.So(err, should.BeNil)
+	res, err = fetcher.File("file")
+	a.So(err, should.BeNil)
+	a.So(res, should.Resemble, contents)
 }
 
 func TestLocal(t *testing.T) {
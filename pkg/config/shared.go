@@ -106,6 +106,7 @@ type Redis struct {
 	Password  string   `name:"password" description:"Password of the Redis server"`
 	Database  int      `name:"database" description:"Redis database to use"`
 	Namespace []string `name:"namespace" description:"Namespace for Redis keys"`
+	HashTags  bool     `name:"hash-tags" description:"Use hash tags in keys, so that related keys share a Redis Cluster hash slot (changes the keys)"`
 }
 
 // IsZero returns whether the Redis configuration is empty.
@@ -168,6 +169,9 @@ type Blob struct {
 	GCP      BlobConfigGCP   `name:"gcp"`
 }
 
+// httpFetchRetry is the retry policy for fetching from a web server, so that transient errors do not fail startup.
+var httpFetchRetry = fetch.WithRetry(3, time.Second)
+
 // FrequencyPlansConfig contains the source of the frequency plans.
 type FrequencyPlansConfig struct {
 	Static    map[string][]byte `name:"-"`
@@ -182,11 +186,11 @@ func (c FrequencyPlansConfig) Store() *frequencyplans.Store {
 	var fetcher fetch.Interface
 	switch {
 	case c.Static != nil:
-		fetcher = fetch.NewMemFetcher(c.Static)
+		fetcher = fetch.WithMetrics(fetch.NewMemFetcher(c.Static), "mem")
 	case c.Directory != "":
-		fetcher = fetch.FromFilesystem(c.Directory)
+		fetcher = fetch.WithMetrics(fetch.FromFilesystem(c.Directory), "fs")
 	case c.URL != "":
-		fetcher = fetch.FromHTTP(c.URL, true)
+		fetcher = fetch.WithMetrics(fetch.FromHTTP(c.URL, true, httpFetchRetry), "http")
 	default:
 		return nil
 	}
@@ -207,11 +211,11 @@ func (c DeviceRepositoryConfig) Client() *devicerepository.Client {
 	var fetcher fetch.Interface
 	switch {
 	case c.Static != nil:
-		fetcher = fetch.NewMemFetcher(c.Static)
+		fetcher = fetch.WithMetrics(fetch.NewMemFetcher(c.Static), "mem")
 	case c.Directory != "":
-		fetcher = fetch.FromFilesystem(c.Directory)
+		fetcher = fetch.WithMetrics(fetch.FromFilesystem(c.Directory), "fs")
 	case c.URL != "":
-		fetcher = fetch.FromHTTP(c.URL, true)
+		fetcher = fetch.WithMetrics(fetch.FromHTTP(c.URL, true, httpFetchRetry), "http")
 	default:
 		return nil
 	}
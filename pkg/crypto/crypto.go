@@ -15,6 +15,14 @@
 // Package crypto implements LoRaWAN crypto.
 package crypto
 
+import "crypto/subtle"
+
+// SecureCompare returns whether a and b are equal, in constant time with respect to the contents.
+// Use SecureCompare to compare MICs and other secrets.
+func SecureCompare(a, b []byte) bool {
+	return subtle.ConstantTimeCompare(a, b) == 1
+}
+
 func reverse(in []byte) []byte {
 	l := len(in)
 	out := make([]byte, l)
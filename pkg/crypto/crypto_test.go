@@ -0,0 +1,34 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestSecureCompare(t *testing.T) {
+	a := assertions.New(t)
+
+	a.So(SecureCompare(nil, nil), should.BeTrue)
+	a.So(SecureCompare([]byte{}, nil), should.BeTrue)
+	a.So(SecureCompare([]byte{0x55, 0x17, 0x54, 0x8E}, []byte{0x55, 0x17, 0x54, 0x8E}), should.BeTrue)
+	a.So(SecureCompare([]byte{0x55, 0x17, 0x54, 0x8E}, []byte{0x55, 0x17, 0x54, 0x8F}), should.BeFalse)
+	a.So(SecureCompare([]byte{0x55, 0x17, 0x54, 0x8E}, []byte{0x55, 0x17, 0x54}), should.BeFalse)
+	a.So(SecureCompare([]byte{0x55, 0x17, 0x54}, []byte{0x55, 0x17, 0x54, 0x8E}), should.BeFalse)
+	a.So(SecureCompare([]byte{0x55, 0x17, 0x54, 0x8E}, nil), should.BeFalse)
+}
@@ -123,11 +123,9 @@ func (d *mem) DeriveNwkSKeys(ctx context.Context, dev *ttnpb.EndDevice, version
 		if d.nwkKey == nil {
 			return NwkSKeys{}, errNoNwkKey
 		}
-		return NwkSKeys{
-			FNwkSIntKey: crypto.DeriveFNwkSIntKey(*d.nwkKey, jn, *dev.JoinEUI, dn),
-			SNwkSIntKey: crypto.DeriveSNwkSIntKey(*d.nwkKey, jn, *dev.JoinEUI, dn),
-			NwkSEncKey:  crypto.DeriveNwkSEncKey(*d.nwkKey, jn, *dev.JoinEUI, dn),
-		}, nil
+		var keys NwkSKeys
+		keys.FNwkSIntKey, keys.SNwkSIntKey, keys.NwkSEncKey = crypto.DeriveNwkSKeys11(*d.nwkKey, jn, *dev.JoinEUI, dn)
+		return keys, nil
 
 	default:
 		if d.appKey == nil {
@@ -49,7 +49,7 @@ func EncryptJoinAccept(key types.AES128Key, payload []byte) (encrypted []byte, e
 // - In LoRaWAN 1.1, the NwkKey or JSEncKey is used
 func DecryptJoinAccept(key types.AES128Key, encrypted []byte) (payload []byte, err error) {
 	if len(encrypted) != 16 && len(encrypted) != 32 {
-		return nil, errInvalidJoinAcceptMessageSize.WithAttributes("size", len(payload))
+		return nil, errInvalidJoinAcceptMessageSize.WithAttributes("size", len(encrypted))
 	}
 	cipher, err := aes.NewCipher(key[:])
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
 )
@@ -50,6 +51,44 @@ func TestJoinAcceptEncryption(t *testing.T) {
 	dec, err = DecryptJoinAccept(key, enc)
 	a.So(err, should.BeNil)
 	a.So(dec, should.Resemble, bin)
+
+	// With CFList, the payload spans two AES blocks.
+	bin = []byte{
+		0x03, 0x02, 0x01, // AppNonce
+		0x03, 0x02, 0x01, // NetID
+		0x04, 0x03, 0x02, 0x01, // DevAddr
+		0x00,             // DLSettings
+		0x01,             // RxDelay
+		0x18, 0x4F, 0x84, // CFList frequency 1
+		0xE8, 0x56, 0x84, // CFList frequency 2
+		0xB8, 0x5E, 0x84, // CFList frequency 3
+		0x88, 0x66, 0x84, // CFList frequency 4
+		0x58, 0x6E, 0x84, // CFList frequency 5
+		0x00,                   // CFListType
+		0x32, 0xF5, 0x4A, 0xB3, // MIC
+	}
+
+	enc, err = EncryptJoinAccept(key, bin)
+	a.So(err, should.BeNil)
+	a.So(enc, should.Resemble, []byte{
+		0xAD, 0x16, 0x83, 0xE0, 0xE4, 0xB1, 0xAD, 0x68, 0xE1, 0xD4, 0xCC, 0xD3, 0x4C, 0x5E, 0x97, 0x10,
+		0x02, 0xBE, 0xC4, 0x89, 0xC3, 0x7A, 0x7E, 0x70, 0xAF, 0xCD, 0x29, 0x4B, 0x37, 0x0F, 0x4F, 0x99,
+	})
+
+	dec, err = DecryptJoinAccept(key, enc)
+	a.So(err, should.BeNil)
+	a.So(dec, should.Resemble, bin)
+
+	// Other sizes are invalid.
+	for _, n := range []int{1, 15, 17, 31, 33, 48} {
+		_, err = EncryptJoinAccept(key, make([]byte, n))
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		_, err = DecryptJoinAccept(key, make([]byte, n))
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		if a.So(err, should.HaveSameErrorDefinitionAs, errInvalidJoinAcceptMessageSize) {
+			a.So(errors.Attributes(err)["size"], should.Equal, n)
+		}
+	}
 }
 
 func TestJoinRequestMIC(t *testing.T) {
@@ -72,6 +111,18 @@ func TestJoinRequestMIC(t *testing.T) {
 	mic, err = ComputeJoinRequestMIC(key, bin)
 	a.So(err, should.BeNil)
 	a.So(mic, should.Equal, [4]byte{0xE6, 0xE1, 0x0C, 0x55})
+
+	// LoRaWAN 1.1 join-request of the Join Server tests.
+	nwkKey := types.AES128Key{0x42, 0x42, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+	bin = []byte{
+		0x00,                                           // JoinRequest
+		0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x42, // JoinEUI
+		0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x42, 0x42, // DevEUI
+		0x00, 0x00, // DevNonce
+	}
+	mic, err = ComputeJoinRequestMIC(nwkKey, bin)
+	a.So(err, should.BeNil)
+	a.So(mic, should.Equal, [4]byte{0x55, 0x17, 0x54, 0x8E})
 }
 
 func TestRejoinRequestMIC(t *testing.T) {
@@ -101,5 +152,26 @@ func TestJoinAcceptMIC(t *testing.T) {
 	a.So(err, should.BeNil)
 	a.So(mic, should.Equal, [4]byte{0x32, 0xF5, 0x4A, 0xB3})
 
-	t.Skip("TODO: Test LoRaWAN 1.1 ComputeJoinAcceptMIC")
+	// LoRaWAN 1.1 join-accept of the Join Server tests.
+	nwkKey := types.AES128Key{0x42, 0x42, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+	devEUI := types.EUI64{0x42, 0x42, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+	joinEUI := types.EUI64{0x42, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+	bin = []byte{
+		0x20,             // JoinAccept
+		0x01, 0x00, 0x00, // JoinNonce
+		0xFF, 0xFF, 0x42, // NetID
+		0xFF, 0xFF, 0xFF, 0x42, // DevAddr
+		0xFF, // DLSettings
+		0x42, // RxDelay
+	}
+
+	jsIntKey := DeriveJSIntKey(nwkKey, devEUI)
+	a.So(jsIntKey, should.Equal, types.AES128Key{0x6E, 0x16, 0x12, 0x19, 0x50, 0xCB, 0xAD, 0x2F, 0x9F, 0x3D, 0x54, 0x2E, 0xA8, 0x3E, 0x49, 0x98})
+
+	_, err = ComputeJoinAcceptMIC(jsIntKey, 0xFF, joinEUI, types.DevNonce{0x00, 0x00}, nil)
+	a.So(err, should.NotBeNil)
+
+	mic, err = ComputeJoinAcceptMIC(jsIntKey, 0xFF, joinEUI, types.DevNonce{0x00, 0x00}, bin)
+	a.So(err, should.BeNil)
+	a.So(mic, should.Equal, [4]byte{0xEB, 0xCD, 0x74, 0x59})
 }
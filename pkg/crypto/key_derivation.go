@@ -16,30 +16,46 @@ package crypto
 
 import (
 	"crypto/aes"
+	"crypto/cipher"
 
 	"go.thethings.network/lorawan-stack/pkg/types"
 )
 
+// newBlock returns the AES block cipher for the key.
+// The key schedule is computed here, so the block should be reused when deriving multiple keys from the same root key.
+func newBlock(key types.AES128Key) cipher.Block {
+	block, _ := aes.NewCipher(key[:])
+	return block
+}
+
 // deriveSKey derives a session key
-func deriveSKey(key types.AES128Key, t byte, jn types.JoinNonce, joinEUI types.EUI64, dn types.DevNonce) (derived types.AES128Key) {
+func deriveSKey(key types.AES128Key, t byte, jn types.JoinNonce, joinEUI types.EUI64, dn types.DevNonce) types.AES128Key {
+	return deriveSKeyWithBlock(newBlock(key), t, jn, joinEUI, dn)
+}
+
+// deriveSKeyWithBlock derives a session key using the block cipher of the root key
+func deriveSKeyWithBlock(block cipher.Block, t byte, jn types.JoinNonce, joinEUI types.EUI64, dn types.DevNonce) (derived types.AES128Key) {
 	buf := make([]byte, 16)
 	buf[0] = t
 	copy(buf[1:4], reverse(jn[:]))
 	copy(buf[4:12], reverse(joinEUI[:]))
 	copy(buf[12:14], reverse(dn[:]))
-	block, _ := aes.NewCipher(key[:])
 	block.Encrypt(derived[:], buf)
 	return
 }
 
 // deriveLegacySKey derives a session key
-func deriveLegacySKey(key types.AES128Key, t byte, jn types.JoinNonce, nid types.NetID, dn types.DevNonce) (derived types.AES128Key) {
+func deriveLegacySKey(key types.AES128Key, t byte, jn types.JoinNonce, nid types.NetID, dn types.DevNonce) types.AES128Key {
+	return deriveLegacySKeyWithBlock(newBlock(key), t, jn, nid, dn)
+}
+
+// deriveLegacySKeyWithBlock derives a session key using the block cipher of the root key
+func deriveLegacySKeyWithBlock(block cipher.Block, t byte, jn types.JoinNonce, nid types.NetID, dn types.DevNonce) (derived types.AES128Key) {
 	buf := make([]byte, 16)
 	buf[0] = t
 	copy(buf[1:4], reverse(jn[:]))
 	copy(buf[4:7], reverse(nid[:]))
 	copy(buf[7:9], reverse(dn[:]))
-	block, _ := aes.NewCipher(key[:])
 	block.Encrypt(derived[:], buf)
 	return
 }
@@ -79,6 +95,46 @@ func DeriveLegacyNwkSKey(appKey types.AES128Key, jn types.JoinNonce, nid types.N
 	return deriveLegacySKey(appKey, 0x01, jn, nid, dn)
 }
 
+// SessionKeys contains the session keys derived in a join.
+// For LoRaWAN 1.0, FNwkSIntKey, SNwkSIntKey and NwkSEncKey are all set to the NwkSKey.
+type SessionKeys struct {
+	FNwkSIntKey,
+	SNwkSIntKey,
+	NwkSEncKey,
+	AppSKey types.AES128Key
+}
+
+// DeriveNwkSKeys11 derives the LoRaWAN 1.1 network session keys.
+// The key schedule of the NwkKey is computed once for the three keys.
+func DeriveNwkSKeys11(nwkKey types.AES128Key, jn types.JoinNonce, joinEUI types.EUI64, dn types.DevNonce) (fNwkSIntKey, sNwkSIntKey, nwkSEncKey types.AES128Key) {
+	block := newBlock(nwkKey)
+	return deriveSKeyWithBlock(block, 0x01, jn, joinEUI, dn),
+		deriveSKeyWithBlock(block, 0x03, jn, joinEUI, dn),
+		deriveSKeyWithBlock(block, 0x04, jn, joinEUI, dn)
+}
+
+// DeriveSessionKeys11 derives the LoRaWAN 1.1 session keys.
+func DeriveSessionKeys11(nwkKey, appKey types.AES128Key, jn types.JoinNonce, joinEUI types.EUI64, dn types.DevNonce) SessionKeys {
+	var keys SessionKeys
+	keys.FNwkSIntKey, keys.SNwkSIntKey, keys.NwkSEncKey = DeriveNwkSKeys11(nwkKey, jn, joinEUI, dn)
+	keys.AppSKey = DeriveAppSKey(appKey, jn, joinEUI, dn)
+	return keys
+}
+
+// DeriveSessionKeys10 derives the LoRaWAN 1.0 session keys.
+// - If a LoRaWAN 1.0 device joins a LoRaWAN 1.0/1.1 network, the AppKey is used as "key"
+// - If a LoRaWAN 1.1 device joins a LoRaWAN 1.0 network, the NwkKey is used as "key"
+func DeriveSessionKeys10(key types.AES128Key, jn types.JoinNonce, nid types.NetID, dn types.DevNonce) SessionKeys {
+	block := newBlock(key)
+	nwkSKey := deriveLegacySKeyWithBlock(block, 0x01, jn, nid, dn)
+	return SessionKeys{
+		FNwkSIntKey: nwkSKey,
+		SNwkSIntKey: nwkSKey,
+		NwkSEncKey:  nwkSKey,
+		AppSKey:     deriveLegacySKeyWithBlock(block, 0x02, jn, nid, dn),
+	}
+}
+
 // deriveKey derives a device key
 func deriveDeviceKey(key types.AES128Key, t byte, devEUI types.EUI64) (derived types.AES128Key) {
 	buf := make([]byte, 16)
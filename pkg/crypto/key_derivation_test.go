@@ -15,6 +15,7 @@
 package crypto
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/smartystreets/assertions"
@@ -58,3 +59,114 @@ func TestKeyDerivation(t *testing.T) {
 	jsEncKey := DeriveJSEncKey(key, devEUI)
 	a.So(jsEncKey, should.Equal, types.AES128Key{0xBB, 0x71, 0x1E, 0xEF, 0xB9, 0x82, 0x9B, 0x4A, 0x75, 0x86, 0x6F, 0x86, 0x16, 0xBA, 0xCD, 0x6D})
 }
+
+func TestDeriveSessionKeys(t *testing.T) {
+	a := assertions.New(t)
+
+	nwkKey := types.AES128Key{0xBE, 0xC4, 0x99, 0xC6, 0x9E, 0x9C, 0x93, 0x9E, 0x41, 0x3B, 0x66, 0x39, 0x61, 0x63, 0x6C, 0x61}
+	appKey := types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	dn := types.DevNonce{0x73, 0x69}
+	nid := types.NetID{0x02, 0x01, 0x01}
+	jn := types.JoinNonce{0xAE, 0x3B, 0x1C}
+	joinEUI := types.EUI64{0x00, 0x00, 0x00, 0x12, 0x23, 0x22, 0x42, 0x42}
+
+	keys := DeriveSessionKeys11(nwkKey, appKey, jn, joinEUI, dn)
+	a.So(keys, should.Resemble, SessionKeys{
+		FNwkSIntKey: types.AES128Key{0x37, 0x90, 0x84, 0xE7, 0xCE, 0x22, 0xFF, 0x19, 0x1B, 0xFF, 0x4B, 0x77, 0x53, 0x6F, 0x2A, 0xA3},
+		SNwkSIntKey: types.AES128Key{0x63, 0xC5, 0x93, 0x09, 0xD5, 0x34, 0x85, 0xBC, 0x51, 0x64, 0xDB, 0xF7, 0x16, 0x27, 0xAE, 0xB9},
+		NwkSEncKey:  types.AES128Key{0xCE, 0x07, 0xA0, 0x09, 0xA3, 0x97, 0x0A, 0xC0, 0x51, 0x9A, 0x09, 0x9E, 0xD5, 0x3E, 0x55, 0x0B},
+		AppSKey:     DeriveAppSKey(appKey, jn, joinEUI, dn),
+	})
+	a.So(keys.AppSKey, should.NotResemble, DeriveAppSKey(nwkKey, jn, joinEUI, dn))
+
+	keys = DeriveSessionKeys10(nwkKey, jn, nid, dn)
+	nwkSKey := types.AES128Key{0x0D, 0xB9, 0x24, 0xEE, 0x6A, 0xF9, 0x06, 0x98, 0xE0, 0x5F, 0xC7, 0xCE, 0x48, 0x30, 0x3C, 0x01}
+	a.So(keys, should.Resemble, SessionKeys{
+		FNwkSIntKey: nwkSKey,
+		SNwkSIntKey: nwkSKey,
+		NwkSEncKey:  nwkSKey,
+		AppSKey:     types.AES128Key{0x8C, 0x1E, 0x05, 0x43, 0xA2, 0x29, 0x08, 0x8D, 0xE6, 0xF8, 0x4E, 0x74, 0xBB, 0x46, 0xBD, 0x62},
+	})
+}
+
+func TestDeriveJSKeys(t *testing.T) {
+	a := assertions.New(t)
+
+	nwkKey := types.AES128Key{0x42, 0x42, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+	devEUI := types.EUI64{0x42, 0x42, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+
+	// JSIntKey = aes128_encrypt(NwkKey, 0x06 | DevEUI | pad16)
+	a.So(DeriveJSIntKey(nwkKey, devEUI), should.Equal, types.AES128Key{0x6E, 0x16, 0x12, 0x19, 0x50, 0xCB, 0xAD, 0x2F, 0x9F, 0x3D, 0x54, 0x2E, 0xA8, 0x3E, 0x49, 0x98})
+
+	// JSEncKey = aes128_encrypt(NwkKey, 0x05 | DevEUI | pad16)
+	a.So(DeriveJSEncKey(nwkKey, devEUI), should.Equal, types.AES128Key{0xFE, 0xB0, 0xE4, 0x83, 0x57, 0x74, 0xFF, 0xB2, 0x71, 0xBF, 0x50, 0xE5, 0x5F, 0x95, 0xBE, 0xE8})
+
+	// The keys are specific to the device.
+	a.So(DeriveJSIntKey(nwkKey, types.EUI64{0x42, 0x42, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE}), should.NotEqual, DeriveJSIntKey(nwkKey, devEUI))
+	a.So(DeriveJSEncKey(nwkKey, devEUI), should.NotEqual, DeriveJSIntKey(nwkKey, devEUI))
+}
+
+func TestDeriveSessionKeysPerKey(t *testing.T) {
+	a := assertions.New(t)
+
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 100; i++ {
+		var (
+			nwkKey, appKey types.AES128Key
+			jn             types.JoinNonce
+			joinEUI        types.EUI64
+			nid            types.NetID
+			dn             types.DevNonce
+		)
+		r.Read(nwkKey[:])
+		r.Read(appKey[:])
+		r.Read(jn[:])
+		r.Read(joinEUI[:])
+		r.Read(nid[:])
+		r.Read(dn[:])
+
+		a.So(DeriveSessionKeys11(nwkKey, appKey, jn, joinEUI, dn), should.Resemble, SessionKeys{
+			FNwkSIntKey: DeriveFNwkSIntKey(nwkKey, jn, joinEUI, dn),
+			SNwkSIntKey: DeriveSNwkSIntKey(nwkKey, jn, joinEUI, dn),
+			NwkSEncKey:  DeriveNwkSEncKey(nwkKey, jn, joinEUI, dn),
+			AppSKey:     DeriveAppSKey(appKey, jn, joinEUI, dn),
+		})
+
+		nwkSKey := DeriveLegacyNwkSKey(nwkKey, jn, nid, dn)
+		a.So(DeriveSessionKeys10(nwkKey, jn, nid, dn), should.Resemble, SessionKeys{
+			FNwkSIntKey: nwkSKey,
+			SNwkSIntKey: nwkSKey,
+			NwkSEncKey:  nwkSKey,
+			AppSKey:     DeriveLegacyAppSKey(nwkKey, jn, nid, dn),
+		})
+	}
+}
+
+var benchmarkSessionKeys SessionKeys
+
+func BenchmarkDeriveSessionKeys11(b *testing.B) {
+	nwkKey := types.AES128Key{0xBE, 0xC4, 0x99, 0xC6, 0x9E, 0x9C, 0x93, 0x9E, 0x41, 0x3B, 0x66, 0x39, 0x61, 0x63, 0x6C, 0x61}
+	appKey := types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	dn := types.DevNonce{0x73, 0x69}
+	jn := types.JoinNonce{0xAE, 0x3B, 0x1C}
+	joinEUI := types.EUI64{0x00, 0x00, 0x00, 0x12, 0x23, 0x22, 0x42, 0x42}
+
+	b.Run("PerKey", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchmarkSessionKeys = SessionKeys{
+				FNwkSIntKey: DeriveFNwkSIntKey(nwkKey, jn, joinEUI, dn),
+				SNwkSIntKey: DeriveSNwkSIntKey(nwkKey, jn, joinEUI, dn),
+				NwkSEncKey:  DeriveNwkSEncKey(nwkKey, jn, joinEUI, dn),
+				AppSKey:     DeriveAppSKey(appKey, jn, joinEUI, dn),
+			}
+		}
+	})
+
+	b.Run("Batch", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchmarkSessionKeys = DeriveSessionKeys11(nwkKey, appKey, jn, joinEUI, dn)
+		}
+	})
+}
@@ -15,10 +15,12 @@
 package crypto
 
 import (
+	"crypto/rand"
 	"encoding/hex"
 	"testing"
 
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
 )
 
@@ -82,3 +84,28 @@ func TestKEK(t *testing.T) {
 	a.So(err, should.NotBeNil)
 
 }
+
+func TestKEKRoundTrip(t *testing.T) {
+	a := assertions.New(t)
+
+	var kek, key types.AES128Key
+	for i := 0; i < 16; i++ {
+		_, err := rand.Read(kek[:])
+		a.So(err, should.BeNil)
+		_, err = rand.Read(key[:])
+		a.So(err, should.BeNil)
+
+		wrapped, err := WrapKey(key[:], kek[:])
+		a.So(err, should.BeNil)
+		a.So(wrapped, should.HaveLength, 24)
+
+		unwrapped, err := UnwrapKey(wrapped, kek[:])
+		a.So(err, should.BeNil)
+		a.So(unwrapped, should.Resemble, key[:])
+
+		// Any modification of the wrapped key is detected.
+		wrapped[i] ^= 0x01
+		_, err = UnwrapKey(wrapped, kek[:])
+		a.So(err, should.NotBeNil)
+	}
+}
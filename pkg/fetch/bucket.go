@@ -16,38 +16,111 @@ package fetch
 
 import (
 	"context"
-	"path/filepath"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
 	"time"
 
 	"gocloud.dev/blob"
 )
 
+// ObjectStore is a client of an object store, such as S3 or GCS.
+type ObjectStore interface {
+	// NewReader returns a reader for the object with the given key.
+	NewReader(ctx context.Context, key string) (io.ReadCloser, error)
+	// List returns the keys of the objects with the given key prefix.
+	List(ctx context.Context, prefix string) ([]string, error)
+	// IsNotExist returns whether the error returned by NewReader indicates that the object does not exist.
+	IsNotExist(err error) bool
+}
+
+type bucketStore struct {
+	bucket *blob.Bucket
+}
+
+func (s bucketStore) NewReader(ctx context.Context, key string) (io.ReadCloser, error) {
+	return s.bucket.NewReader(ctx, key, nil)
+}
+
+func (s bucketStore) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	iter := s.bucket.List(&blob.ListOptions{Prefix: prefix})
+	for {
+		obj, err := iter.Next(ctx)
+		if err == io.EOF {
+			return keys, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, obj.Key)
+	}
+}
+
+func (s bucketStore) IsNotExist(err error) bool { return blob.IsNotExist(err) }
+
 type bucketFetcher struct {
 	baseFetcher
-	bucket *blob.Bucket
+	store ObjectStore
 }
 
 // FromBucket returns an interface that fetches files from the given blob bucket.
+// Use the blob package to open buckets on S3 or GCS with the configured credentials.
 func FromBucket(bucket *blob.Bucket, basePath string) Interface {
+	return FromObjectStore(bucketStore{bucket: bucket}, basePath)
+}
+
+// FromObjectStore returns an interface that fetches files from the given object store.
+// The returned fetcher implements Lister.
+// The object keys are prefixed with the given path prefix.
+func FromObjectStore(store ObjectStore, prefix string) Interface {
 	return &bucketFetcher{
 		baseFetcher: baseFetcher{
-			base:    basePath,
-			latency: fetchLatency.WithLabelValues("bucket", basePath),
+			base:    prefix,
+			latency: fetchLatency.WithLabelValues("bucket", prefix),
 		},
-		bucket: bucket,
+		store: store,
 	}
 }
 
 func (f *bucketFetcher) File(pathElements ...string) ([]byte, error) {
 	start := time.Now()
-	content, err := f.bucket.ReadAll(context.TODO(), filepath.Join(append([]string{f.base}, pathElements...)...))
-	if err == nil {
-		f.observeLatency(time.Since(start))
-		return content, nil
+	filename := path.Join(pathElements...)
+	r, err := f.store.NewReader(context.TODO(), path.Join(f.base, filename))
+	if err != nil {
+		if f.store.IsNotExist(err) {
+			return nil, errFileNotFound.WithAttributes("filename", filename)
+		}
+		return nil, errCouldNotFetchFile.WithCause(err).WithAttributes("filename", filename)
+	}
+	defer r.Close()
+
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errCouldNotReadFile.WithCause(err).WithAttributes("filename", filename)
 	}
+	f.observeLatency(time.Since(start))
+	return content, nil
+}
 
-	if blob.IsNotExist(err) {
-		return nil, errFileNotFound.WithAttributes("filename", filepath.Join(pathElements...))
+func (f *bucketFetcher) List(prefix string) ([]string, error) {
+	keyPrefix := path.Join(f.base, prefix)
+	if keyPrefix != "" {
+		keyPrefix += "/"
+	}
+	keys, err := f.store.List(context.TODO(), keyPrefix)
+	if err != nil {
+		return nil, errCouldNotFetchFile.WithCause(err).WithAttributes("filename", prefix)
+	}
+	if len(keys) == 0 {
+		return nil, errFileNotFound.WithAttributes("filename", prefix)
+	}
+	files := make([]string, 0, len(keys))
+	for _, key := range keys {
+		files = append(files, strings.TrimPrefix(key, keyPrefix))
 	}
-	return nil, errCouldNotReadFile.WithCause(err).WithAttributes("filename", filepath.Join(pathElements...))
+	sort.Strings(files)
+	return files, nil
 }
@@ -15,14 +15,19 @@
 package fetch_test
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/pkg/blob"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/fetch"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
@@ -62,6 +67,63 @@ func TestBucket(t *testing.T) {
 	// Reading non-working file
 	{
 		_, err = fetcher.File("non-existing file")
-		a.So(err, should.NotBeNil)
+		a.So(errors.IsNotFound(err), should.BeTrue)
 	}
 }
+
+type mockObjectStore struct {
+	objects map[string]io.ReadCloser
+}
+
+func (s *mockObjectStore) NewReader(ctx context.Context, key string) (io.ReadCloser, error) {
+	r, ok := s.objects[key]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return r, nil
+}
+
+func (s *mockObjectStore) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	for key := range s.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (s *mockObjectStore) IsNotExist(err error) bool { return os.IsNotExist(err) }
+
+type errorReader struct{}
+
+func (errorReader) Read([]byte) (int, error) { return 0, io.ErrUnexpectedEOF }
+
+func TestObjectStore(t *testing.T) {
+	a := assertions.New(t)
+
+	fetcher := fetch.FromObjectStore(&mockObjectStore{
+		objects: map[string]io.ReadCloser{
+			"prefix/present":    ioutil.NopCloser(strings.NewReader("content")),
+			"prefix/read-error": ioutil.NopCloser(errorReader{}),
+			"absent":            ioutil.NopCloser(strings.NewReader("outside prefix")),
+		},
+	}, "prefix")
+
+	content, err := fetcher.File("present")
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "content")
+
+	_, err = fetcher.File("absent")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	_, err = fetcher.File("read-error")
+	a.So(errors.IsDataLoss(err), should.BeTrue)
+
+	files, err := fetcher.(fetch.Lister).List("")
+	a.So(err, should.BeNil)
+	a.So(files, should.Resemble, []string{"present", "read-error"})
+
+	_, err = fetcher.(fetch.Lister).List("absent")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
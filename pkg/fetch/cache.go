@@ -0,0 +1,99 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetch
+
+import (
+	"container/list"
+	"path"
+	"sync"
+	"time"
+)
+
+type cacheEntry struct {
+	key     string
+	content []byte
+	expires time.Time
+}
+
+type cacheFetcher struct {
+	Interface
+	maxBytes int
+	ttl      time.Duration
+
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+// WithCache returns a fetcher that caches the files retrieved by the given fetcher in memory.
+// Files are cached for the given TTL. When the total size of the cached files exceeds maxBytes, the least recently
+// used files are evicted. Files larger than maxBytes are not cached.
+func WithCache(f Interface, maxBytes int, ttl time.Duration) Interface {
+	return &cacheFetcher{
+		Interface: f,
+		maxBytes:  maxBytes,
+		ttl:       ttl,
+		entries:   make(map[string]*list.Element),
+		lru:       list.New(),
+	}
+}
+
+func (f *cacheFetcher) remove(el *list.Element) {
+	entry := f.lru.Remove(el).(*cacheEntry)
+	delete(f.entries, entry.key)
+	f.size -= len(entry.content)
+}
+
+func (f *cacheFetcher) File(pathElements ...string) ([]byte, error) {
+	key := path.Join(pathElements...)
+	now := time.Now()
+
+	f.mu.Lock()
+	if el, ok := f.entries[key]; ok {
+		entry := el.Value.(*cacheEntry)
+		if now.Before(entry.expires) {
+			f.lru.MoveToFront(el)
+			f.mu.Unlock()
+			return entry.content, nil
+		}
+		f.remove(el)
+	}
+	f.mu.Unlock()
+
+	content, err := f.Interface.File(pathElements...)
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > f.maxBytes {
+		return content, nil
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if el, ok := f.entries[key]; ok {
+		f.remove(el)
+	}
+	for f.size+len(content) > f.maxBytes {
+		f.remove(f.lru.Back())
+	}
+	f.entries[key] = f.lru.PushFront(&cacheEntry{
+		key:     key,
+		content: content,
+		expires: now.Add(f.ttl),
+	})
+	f.size += len(content)
+	return content, nil
+}
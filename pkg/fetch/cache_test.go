@@ -0,0 +1,95 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetch_test
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/fetch"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+type countingFetcher struct {
+	fetch.Interface
+	calls map[string]int
+}
+
+func (f *countingFetcher) File(pathElements ...string) ([]byte, error) {
+	f.calls[path.Join(pathElements...)]++
+	return f.Interface.File(pathElements...)
+}
+
+func TestCache(t *testing.T) {
+	a := assertions.New(t)
+
+	backend := &countingFetcher{
+		Interface: fetch.NewMemFetcher(map[string][]byte{
+			"a.txt":     []byte("aaaa"),
+			"b.txt":     []byte("bbbb"),
+			"c.txt":     []byte("cccc"),
+			"large.txt": []byte("0123456789"),
+		}),
+		calls: make(map[string]int),
+	}
+	ttl := (1 << 4) * test.Delay
+	fetcher := fetch.WithCache(backend, 8, ttl)
+
+	// Cache hits.
+	for i := 0; i < 3; i++ {
+		content, err := fetcher.File("a.txt")
+		a.So(err, should.BeNil)
+		a.So(string(content), should.Equal, "aaaa")
+	}
+	a.So(backend.calls["a.txt"], should.Equal, 1)
+
+	// Not found errors are not cached.
+	for i := 0; i < 2; i++ {
+		_, err := fetcher.File("missing.txt")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+	a.So(backend.calls["missing.txt"], should.Equal, 2)
+
+	// Files larger than the cache are not cached.
+	for i := 0; i < 2; i++ {
+		_, err := fetcher.File("large.txt")
+		a.So(err, should.BeNil)
+	}
+	a.So(backend.calls["large.txt"], should.Equal, 2)
+
+	// Size eviction evicts the least recently used file.
+	_, err := fetcher.File("b.txt")
+	a.So(err, should.BeNil)
+	_, err = fetcher.File("a.txt")
+	a.So(err, should.BeNil)
+	_, err = fetcher.File("c.txt")
+	a.So(err, should.BeNil)
+	_, err = fetcher.File("a.txt")
+	a.So(err, should.BeNil)
+	a.So(backend.calls["a.txt"], should.Equal, 1)
+	_, err = fetcher.File("b.txt")
+	a.So(err, should.BeNil)
+	a.So(backend.calls["b.txt"], should.Equal, 2)
+
+	// TTL expiry.
+	time.Sleep(ttl)
+	_, err = fetcher.File("a.txt")
+	a.So(err, should.BeNil)
+	a.So(backend.calls["a.txt"], should.Equal, 2)
+}
@@ -0,0 +1,72 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetch
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"path"
+)
+
+// ChecksumSuffix is the suffix of the sidecar files that contain the SHA-256 checksum of a file.
+const ChecksumSuffix = ".sha256"
+
+type checksumFetcher struct {
+	Interface
+	expected map[string][]byte
+}
+
+// WithChecksum returns a fetcher that verifies the SHA-256 checksum of the files retrieved by the given fetcher.
+// The expected checksums are looked up by file name in the given map. If a file has no expected checksum, the
+// checksum is read from the sidecar file with ChecksumSuffix, in the format of sha256sum.
+func WithChecksum(f Interface, expected map[string][]byte) Interface {
+	return &checksumFetcher{
+		Interface: f,
+		expected:  expected,
+	}
+}
+
+func (f *checksumFetcher) File(pathElements ...string) ([]byte, error) {
+	filename := path.Join(pathElements...)
+	content, err := f.Interface.File(pathElements...)
+	if err != nil {
+		return nil, err
+	}
+	expected, ok := f.expected[filename]
+	if !ok {
+		if len(pathElements) == 0 {
+			return nil, errFileNotFound.WithAttributes("filename", ChecksumSuffix)
+		}
+		sidecarElements := append([]string(nil), pathElements...)
+		sidecarElements[len(sidecarElements)-1] += ChecksumSuffix
+		sidecar, err := f.Interface.File(sidecarElements...)
+		if err != nil {
+			return nil, err
+		}
+		fields := bytes.Fields(sidecar)
+		if len(fields) == 0 {
+			return nil, errCouldNotReadFile.WithAttributes("filename", filename+ChecksumSuffix)
+		}
+		expected = make([]byte, hex.DecodedLen(len(fields[0])))
+		if _, err := hex.Decode(expected, fields[0]); err != nil {
+			return nil, errCouldNotReadFile.WithCause(err).WithAttributes("filename", filename+ChecksumSuffix)
+		}
+	}
+	if actual := sha256.Sum256(content); !bytes.Equal(actual[:], expected) {
+		return nil, errChecksumMismatch.WithAttributes("filename", filename)
+	}
+	return content, nil
+}
@@ -0,0 +1,78 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetch_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/fetch"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestChecksum(t *testing.T) {
+	a := assertions.New(t)
+
+	// echo -n content | sha256sum
+	const checksum = "ed7002b439e9ac845f22357d822bac1444730fbdb6016d3ec9432297b9ec9f73"
+	sum := sha256.Sum256([]byte("content"))
+
+	fetcher := fetch.WithChecksum(fetch.NewMemFetcher(map[string][]byte{
+		"dir/file.txt":        []byte("content"),
+		"dir/file.txt.sha256": []byte(checksum + "  file.txt\n"),
+		"bad.txt":             []byte("content"),
+		"bad.txt.sha256":      []byte("0000000000000000000000000000000000000000000000000000000000000000  bad.txt\n"),
+		"nosidecar.txt":       []byte("content"),
+		"expected.txt":        []byte("content"),
+		"unexpected.txt":      []byte("tampered"),
+	}), map[string][]byte{
+		"expected.txt":   sum[:],
+		"unexpected.txt": sum[:],
+	})
+
+	// Matching checksum from sidecar file.
+	{
+		content, err := fetcher.File("dir", "file.txt")
+		a.So(err, should.BeNil)
+		a.So(string(content), should.Equal, "content")
+	}
+
+	// Mismatching checksum from sidecar file.
+	{
+		_, err := fetcher.File("bad.txt")
+		a.So(errors.IsDataLoss(err), should.BeTrue)
+	}
+
+	// Missing sidecar file.
+	{
+		_, err := fetcher.File("nosidecar.txt")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+
+	// Matching expected checksum.
+	{
+		content, err := fetcher.File("expected.txt")
+		a.So(err, should.BeNil)
+		a.So(string(content), should.Equal, "content")
+	}
+
+	// Mismatching expected checksum.
+	{
+		_, err := fetcher.File("unexpected.txt")
+		a.So(errors.IsDataLoss(err), should.BeTrue)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetch
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"path"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zipMagic  = []byte{'P', 'K', 0x03, 0x04}
+)
+
+type decompressFetcher struct {
+	Interface
+	zipEntry string
+}
+
+// WithDecompression returns a fetcher that decompresses the files retrieved by the given fetcher.
+// Gzip and zip files are detected by their magic bytes; other files are returned as is. Of zip files, the entry with
+// the given name is returned. If the name is empty, the zip file must contain exactly one file.
+func WithDecompression(f Interface, zipEntry string) Interface {
+	return &decompressFetcher{
+		Interface: f,
+		zipEntry:  zipEntry,
+	}
+}
+
+func (f *decompressFetcher) File(pathElements ...string) ([]byte, error) {
+	content, err := f.Interface.File(pathElements...)
+	if err != nil {
+		return nil, err
+	}
+	filename := path.Join(pathElements...)
+	switch {
+	case bytes.HasPrefix(content, gzipMagic):
+		r, err := gzip.NewReader(bytes.NewReader(content))
+		if err != nil {
+			return nil, errCouldNotReadFile.WithCause(err).WithAttributes("filename", filename)
+		}
+		defer r.Close()
+		content, err = ioutil.ReadAll(r)
+		if err != nil {
+			return nil, errCouldNotReadFile.WithCause(err).WithAttributes("filename", filename)
+		}
+		return content, nil
+
+	case bytes.HasPrefix(content, zipMagic):
+		r, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+		if err != nil {
+			return nil, errCouldNotReadFile.WithCause(err).WithAttributes("filename", filename)
+		}
+		var entry *zip.File
+		for _, file := range r.File {
+			if file.FileInfo().IsDir() {
+				continue
+			}
+			if f.zipEntry == "" && entry != nil {
+				return nil, errCouldNotReadFile.WithAttributes("filename", filename)
+			}
+			if f.zipEntry == "" || file.Name == f.zipEntry {
+				entry = file
+			}
+		}
+		if entry == nil {
+			return nil, errFileNotFound.WithAttributes("filename", path.Join(filename, f.zipEntry))
+		}
+		rc, err := entry.Open()
+		if err != nil {
+			return nil, errCouldNotReadFile.WithCause(err).WithAttributes("filename", filename)
+		}
+		defer rc.Close()
+		content, err = ioutil.ReadAll(rc)
+		if err != nil {
+			return nil, errCouldNotReadFile.WithCause(err).WithAttributes("filename", filename)
+		}
+		return content, nil
+
+	default:
+		return content, nil
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetch_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/fetch"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestDecompression(t *testing.T) {
+	a := assertions.New(t)
+
+	gzipped := &bytes.Buffer{}
+	gw := gzip.NewWriter(gzipped)
+	_, err := gw.Write([]byte("gzip content"))
+	a.So(err, should.BeNil)
+	a.So(gw.Close(), should.BeNil)
+
+	zipped := &bytes.Buffer{}
+	zw := zip.NewWriter(zipped)
+	for name, content := range map[string]string{
+		"vendor/index.yaml":  "index",
+		"vendor/device.yaml": "device",
+	} {
+		w, err := zw.Create(name)
+		a.So(err, should.BeNil)
+		_, err = w.Write([]byte(content))
+		a.So(err, should.BeNil)
+	}
+	a.So(zw.Close(), should.BeNil)
+
+	backend := fetch.NewMemFetcher(map[string][]byte{
+		"file.txt.gz": gzipped.Bytes(),
+		"repo.zip":    zipped.Bytes(),
+		"corrupt.gz":  {0x1f, 0x8b, 0x00},
+		"file.txt":    []byte("plain content"),
+	})
+
+	fetcher := fetch.WithDecompression(backend, "vendor/index.yaml")
+
+	content, err := fetcher.File("file.txt.gz")
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "gzip content")
+
+	content, err = fetcher.File("repo.zip")
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "index")
+
+	content, err = fetcher.File("file.txt")
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "plain content")
+
+	_, err = fetcher.File("corrupt.gz")
+	a.So(errors.IsDataLoss(err), should.BeTrue)
+
+	_, err = fetch.WithDecompression(backend, "vendor/missing.yaml").File("repo.zip")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	_, err = fetch.WithDecompression(backend, "").File("repo.zip")
+	a.So(errors.IsDataLoss(err), should.BeTrue)
+}
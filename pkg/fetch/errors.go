@@ -14,10 +14,31 @@
 
 package fetch
 
-import "go.thethings.network/lorawan-stack/pkg/errors"
+import (
+	"os"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+// ErrNotModified is returned by ConditionalInterface when the file has not been modified since the last fetch.
+var ErrNotModified = errors.DefineFailedPrecondition("not_modified", "file `{filename}` not modified")
 
 var (
 	errFileNotFound      = errors.DefineNotFound("file_not_found", "file `{filename}` not found")
 	errCouldNotFetchFile = errors.Define("fetch_file", "could not fetch file `{filename}`")
 	errCouldNotReadFile  = errors.DefineCorruption("read_file", "could not read file `{filename}`")
+	errChecksumMismatch  = errors.DefineCorruption("checksum_mismatch", "checksum of file `{filename}` does not match")
 )
+
+// osError returns the message of the underlying system error of err, for example "permission denied".
+func osError(err error) string {
+	switch err := err.(type) {
+	case *os.PathError:
+		return err.Err.Error()
+	case *os.LinkError:
+		return err.Err.Error()
+	case *os.SyscallError:
+		return err.Err.Error()
+	}
+	return err.Error()
+}
@@ -0,0 +1,38 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetch
+
+import "go.thethings.network/lorawan-stack/pkg/errors"
+
+type fallbackFetcher struct {
+	primary, secondary Interface
+}
+
+// Fallback returns a fetcher that retrieves files from the primary fetcher, and from the secondary fetcher if the
+// file is not found by the primary fetcher. Other errors of the primary fetcher are returned as is.
+func Fallback(primary, secondary Interface) Interface {
+	return &fallbackFetcher{
+		primary:   primary,
+		secondary: secondary,
+	}
+}
+
+func (f *fallbackFetcher) File(pathElements ...string) ([]byte, error) {
+	content, err := f.primary.File(pathElements...)
+	if err != nil && errors.IsNotFound(err) {
+		return f.secondary.File(pathElements...)
+	}
+	return content, err
+}
@@ -0,0 +1,63 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetch_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/fetch"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+type fetcherFunc func(pathElements ...string) ([]byte, error)
+
+func (f fetcherFunc) File(pathElements ...string) ([]byte, error) { return f(pathElements...) }
+
+func TestFallback(t *testing.T) {
+	a := assertions.New(t)
+
+	primary := fetch.NewMemFetcher(map[string][]byte{
+		"override.yml": []byte("primary"),
+	})
+	secondary := fetch.NewMemFetcher(map[string][]byte{
+		"override.yml": []byte("secondary"),
+		"default.yml":  []byte("secondary"),
+	})
+	fetcher := fetch.Fallback(primary, secondary)
+
+	// Primary hit.
+	content, err := fetcher.File("override.yml")
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "primary")
+
+	// Fallback hit.
+	content, err = fetcher.File("default.yml")
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "secondary")
+
+	// Not found in both.
+	_, err = fetcher.File("unknown.yml")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// Primary error.
+	errTest := errors.DefineUnavailable("test_unavailable", "unavailable")
+	fetcher = fetch.Fallback(fetcherFunc(func(...string) ([]byte, error) {
+		return nil, errTest
+	}), secondary)
+	_, err = fetcher.File("default.yml")
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+}
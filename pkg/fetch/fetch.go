@@ -17,6 +17,7 @@
 package fetch
 
 import (
+	"io"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -27,6 +28,23 @@ type Interface interface {
 	File(pathElements ...string) ([]byte, error)
 }
 
+// ConditionalInterface is an Interface that can skip retrieval of files that have not been modified since they were
+// last fetched.
+type ConditionalInterface interface {
+	Interface
+	// FileIfModified returns the file if it has been modified since it was last fetched.
+	// If the file has not been modified, an error that resembles ErrNotModified is returned.
+	FileIfModified(pathElements ...string) ([]byte, error)
+}
+
+// Lister is an Interface that can list the available files.
+type Lister interface {
+	Interface
+	// List returns the paths of the files under the given prefix, relative to the prefix and sorted.
+	// If the prefix does not exist, an error that resembles the not found error is returned.
+	List(prefix string) ([]string, error)
+}
+
 type baseFetcher struct {
 	base    string
 	latency prometheus.Observer
@@ -37,3 +55,43 @@ func (f baseFetcher) observeLatency(d time.Duration) {
 		f.latency.Observe(d.Seconds())
 	}
 }
+
+// Peeker is an Interface that can retrieve the first bytes of a file without retrieving the whole file.
+type Peeker interface {
+	Interface
+	// Peek returns up to the first n bytes of the file with the given name.
+	Peek(name string, n int) ([]byte, error)
+}
+
+// Peek returns up to the first n bytes of the file with the given name, for example to detect the format of the file.
+// If f is a Peeker, only the first bytes of the file are retrieved. Otherwise, the whole file is retrieved and
+// truncated.
+func Peek(f Interface, name string, n int) ([]byte, error) {
+	if p, ok := f.(Peeker); ok {
+		return p.Peek(name, n)
+	}
+	content, err := f.File(name)
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 {
+		n = 0
+	}
+	if len(content) > n {
+		content = content[:n]
+	}
+	return content, nil
+}
+
+// readPrefix reads up to the first n bytes of r.
+func readPrefix(r io.Reader, n int) ([]byte, error) {
+	if n < 0 {
+		n = 0
+	}
+	buf := make([]byte, n)
+	read, err := io.ReadFull(r, buf)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = nil
+	}
+	return buf[:read], err
+}
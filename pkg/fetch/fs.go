@@ -18,6 +18,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 )
 
@@ -25,7 +26,8 @@ type fsFetcher struct {
 	baseFetcher
 }
 
-// FromFilesystem returns an interface that fetches files from the local filesystem
+// FromFilesystem returns an interface that fetches files from the local filesystem.
+// The returned fetcher implements Lister and Peeker.
 func FromFilesystem(basePath string) Interface {
 	basePath = filepath.Clean(basePath)
 	return fsFetcher{
@@ -47,5 +49,50 @@ func (f fsFetcher) File(pathElements ...string) ([]byte, error) {
 	if os.IsNotExist(err) {
 		return nil, errFileNotFound.WithAttributes("filename", filepath.Join(pathElements...))
 	}
-	return nil, errCouldNotReadFile.WithCause(err).WithAttributes("filename", filepath.Join(pathElements...))
+	return nil, errCouldNotReadFile.WithCause(err).WithAttributes("filename", filepath.Join(pathElements...), "os_error", osError(err))
+}
+
+func (f fsFetcher) Peek(name string, n int) ([]byte, error) {
+	start := time.Now()
+	file, err := os.Open(filepath.Join(f.base, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errFileNotFound.WithAttributes("filename", name)
+		}
+		return nil, errCouldNotReadFile.WithCause(err).WithAttributes("filename", name, "os_error", osError(err))
+	}
+	defer file.Close()
+	content, err := readPrefix(file, n)
+	if err != nil {
+		return nil, errCouldNotReadFile.WithCause(err).WithAttributes("filename", name, "os_error", osError(err))
+	}
+	f.observeLatency(time.Since(start))
+	return content, nil
+}
+
+func (f fsFetcher) List(prefix string) ([]string, error) {
+	root := filepath.Join(f.base, filepath.FromSlash(prefix))
+	var files []string
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errFileNotFound.WithAttributes("filename", prefix)
+		}
+		return nil, errCouldNotReadFile.WithCause(err).WithAttributes("filename", prefix, "os_error", osError(err))
+	}
+	sort.Strings(files)
+	return files, nil
 }
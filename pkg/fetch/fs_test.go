@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/fetch"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
 )
@@ -79,3 +80,63 @@ func TestFilesystem(t *testing.T) {
 		a.So(err, should.NotBeNil)
 	}
 }
+
+func TestFilesystemList(t *testing.T) {
+	a := assertions.New(t)
+
+	fs, err := createMockFileSystem()
+	a.So(err, should.BeNil)
+	defer fs.Destroy()
+
+	for _, filename := range []string{"EU_863_870.yml", "US_902_928.yml", "gateways/indoor.yml"} {
+		err := os.MkdirAll(filepath.Dir(filepath.Join(fs.Dir(), filename)), 0755)
+		a.So(err, should.BeNil)
+		err = ioutil.WriteFile(filepath.Join(fs.Dir(), filename), []byte("content"), 0644)
+		a.So(err, should.BeNil)
+	}
+
+	fetcher := fetch.FromFilesystem(fs.Dir()).(fetch.Lister)
+
+	files, err := fetcher.List("")
+	a.So(err, should.BeNil)
+	a.So(files, should.Resemble, []string{"EU_863_870.yml", "US_902_928.yml", "gateways/indoor.yml"})
+
+	files, err = fetcher.List("gateways")
+	a.So(err, should.BeNil)
+	a.So(files, should.Resemble, []string{"indoor.yml"})
+
+	_, err = fetcher.List("missing")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestFilesystemPeek(t *testing.T) {
+	a := assertions.New(t)
+
+	fs, err := createMockFileSystem()
+	a.So(err, should.BeNil)
+	defer fs.Destroy()
+
+	err = ioutil.WriteFile(filepath.Join(fs.Dir(), "file.yml"), []byte("band-id: EU_863_870\n"), 0644)
+	a.So(err, should.BeNil)
+	err = ioutil.WriteFile(filepath.Join(fs.Dir(), "empty.yml"), nil, 0644)
+	a.So(err, should.BeNil)
+
+	fetcher := fetch.FromFilesystem(fs.Dir())
+	_, ok := fetcher.(fetch.Peeker)
+	a.So(ok, should.BeTrue)
+
+	content, err := fetch.Peek(fetcher, "file.yml", 7)
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "band-id")
+
+	content, err = fetch.Peek(fetcher, "file.yml", 1024)
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "band-id: EU_863_870\n")
+
+	content, err = fetch.Peek(fetcher, "empty.yml", 7)
+	a.So(err, should.BeNil)
+	a.So(content, should.BeEmpty)
+
+	_, err = fetch.Peek(fetcher, "missing.yml", 7)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
@@ -0,0 +1,210 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetch
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+var errGitFetch = errors.Define("git_fetch", "could not fetch Git ref `{ref}`")
+
+type gitFetcher struct {
+	baseFetcher
+	url, ref        string
+	dir             string
+	shallow         bool
+	refreshInterval time.Duration
+
+	mu          sync.RWMutex
+	initialized bool
+	fetchedAt   time.Time
+}
+
+// GitOption configures the Git fetcher.
+type GitOption func(*gitFetcher)
+
+// WithGitCacheDir sets the directory that the repository is cloned to.
+// If the directory is not set, a temporary directory is created.
+func WithGitCacheDir(dir string) GitOption {
+	return func(f *gitFetcher) {
+		f.dir = dir
+	}
+}
+
+// WithGitShallowClone only fetches the commit of the ref, without its history.
+func WithGitShallowClone() GitOption {
+	return func(f *gitFetcher) {
+		f.shallow = true
+	}
+}
+
+// WithGitRefreshInterval sets the interval after which the ref is fetched again, so that changes to the ref are picked
+// up. The ref is fetched again on the first file retrieval after the interval. By default, the ref is only fetched once.
+func WithGitRefreshInterval(d time.Duration) GitOption {
+	return func(f *gitFetcher) {
+		f.refreshInterval = d
+	}
+}
+
+// FromGit returns an interface that fetches files from a checkout of the given ref (branch, tag or commit) of the Git
+// repository at the given URL. The repository is cloned to the cache directory on the first file retrieval.
+// The git command must be available.
+func FromGit(repositoryURL, ref string, opts ...GitOption) Interface {
+	f := &gitFetcher{
+		baseFetcher: baseFetcher{
+			latency: fetchLatency.WithLabelValues("git", redactGitURL(repositoryURL)),
+		},
+		url: repositoryURL,
+		ref: ref,
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// redactGitURL removes the credentials from the repository URL.
+func redactGitURL(repositoryURL string) string {
+	u, err := url.Parse(repositoryURL)
+	if err != nil || u.User == nil {
+		return repositoryURL
+	}
+	u.User = nil
+	return u.String()
+}
+
+func (f *gitFetcher) git(args ...string) error {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = f.dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := bytes.TrimSpace(stderr.Bytes()); len(msg) > 0 {
+			return fmt.Errorf("%s: %s", err, msg)
+		}
+		return err
+	}
+	return nil
+}
+
+// update clones the repository if needed and checks out the ref. The caller must hold the write lock.
+func (f *gitFetcher) update() error {
+	if !f.initialized {
+		if f.dir == "" {
+			dir, err := ioutil.TempDir("", "fetch-git")
+			if err != nil {
+				return err
+			}
+			f.dir = dir
+		}
+		if err := os.MkdirAll(f.dir, 0755); err != nil {
+			return err
+		}
+		if _, err := os.Stat(filepath.Join(f.dir, ".git")); os.IsNotExist(err) {
+			if err := f.git("init", "--quiet"); err != nil {
+				return err
+			}
+			if err := f.git("remote", "add", "--", "origin", f.url); err != nil {
+				return err
+			}
+		} else if err := f.git("remote", "set-url", "--", "origin", f.url); err != nil {
+			return err
+		}
+		f.initialized = true
+	}
+	args := []string{"fetch", "--quiet", "--force"}
+	if f.shallow {
+		args = append(args, "--depth", "1")
+	}
+	// The URL and the ref are not trusted: separate them from the options, so that they are not parsed as options.
+	if err := f.git(append(args, "--", "origin", f.ref)...); err != nil {
+		return err
+	}
+	if err := f.git("checkout", "--quiet", "--force", "FETCH_HEAD"); err != nil {
+		return err
+	}
+	return f.git("clean", "--quiet", "--force", "-d", "-x")
+}
+
+// checkout makes sure that the checkout of the ref is available and up to date.
+func (f *gitFetcher) checkout() error {
+	f.mu.RLock()
+	fresh := !f.fetchedAt.IsZero() && (f.refreshInterval == 0 || time.Since(f.fetchedAt) < f.refreshInterval)
+	f.mu.RUnlock()
+	if fresh {
+		return nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.fetchedAt.IsZero() && (f.refreshInterval == 0 || time.Since(f.fetchedAt) < f.refreshInterval) {
+		return nil
+	}
+	if err := f.update(); err != nil {
+		// Keep serving the previous checkout if the ref cannot be refreshed.
+		if !f.fetchedAt.IsZero() {
+			f.fetchedAt = time.Now()
+			return nil
+		}
+		return errGitFetch.WithCause(err).WithAttributes("ref", f.ref)
+	}
+	f.fetchedAt = time.Now()
+	return nil
+}
+
+// isCheckoutFile returns whether the file is in the checkout. Files outside of the checkout directory and the Git
+// metadata, which contains the repository URL, are not served.
+func isCheckoutFile(filename string) bool {
+	rel := filepath.Clean(filename)
+	for _, dir := range []string{"..", ".git"} {
+		if rel == dir || strings.HasPrefix(rel, dir+string(filepath.Separator)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *gitFetcher) File(pathElements ...string) ([]byte, error) {
+	start := time.Now()
+	filename := filepath.Join(pathElements...)
+	if !isCheckoutFile(filename) {
+		return nil, errFileNotFound.WithAttributes("filename", filename)
+	}
+	if err := f.checkout(); err != nil {
+		return nil, errCouldNotFetchFile.WithCause(err).WithAttributes("filename", filename)
+	}
+	f.mu.RLock()
+	content, err := ioutil.ReadFile(filepath.Join(f.dir, filename))
+	f.mu.RUnlock()
+	if err == nil {
+		f.observeLatency(time.Since(start))
+		return content, nil
+	}
+
+	if os.IsNotExist(err) {
+		return nil, errFileNotFound.WithAttributes("filename", filename)
+	}
+	return nil, errCouldNotReadFile.WithCause(err).WithAttributes("filename", filename, "os_error", osError(err))
+}
@@ -0,0 +1,181 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetch_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/fetch"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+// gitRepository is a bare Git repository with a working copy to commit to it.
+type gitRepository struct {
+	t    *testing.T
+	dir  string
+	bare string
+	work string
+}
+
+func newGitRepository(t *testing.T) *gitRepository {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &gitRepository{
+		t:    t,
+		dir:  dir,
+		bare: filepath.Join(dir, "remote.git"),
+		work: filepath.Join(dir, "work"),
+	}
+	r.git(dir, "init", "--quiet", "--bare", r.bare)
+	r.git(dir, "init", "--quiet", r.work)
+	r.git(r.work, "config", "user.name", "Test")
+	r.git(r.work, "config", "user.email", "test@example.com")
+	r.git(r.work, "remote", "add", "origin", r.bare)
+	return r
+}
+
+func (r *gitRepository) git(dir string, args ...string) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		r.t.Fatalf("git %v: %v: %s", args, err, out)
+	}
+}
+
+// commit writes the files, commits them and pushes the commit to the master branch.
+func (r *gitRepository) commit(files map[string]string) {
+	for name, content := range files {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(r.work, name)), 0755); err != nil {
+			r.t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(r.work, name), []byte(content), 0644); err != nil {
+			r.t.Fatal(err)
+		}
+	}
+	r.git(r.work, "add", "--all")
+	r.git(r.work, "commit", "--quiet", "--message", "Update")
+	r.git(r.work, "push", "--quiet", "origin", "HEAD:refs/heads/master")
+}
+
+func (r *gitRepository) tag(name string) {
+	r.git(r.work, "tag", name)
+	r.git(r.work, "push", "--quiet", "origin", name)
+}
+
+func (r *gitRepository) Destroy() error {
+	return os.RemoveAll(r.dir)
+}
+
+func TestGit(t *testing.T) {
+	a := assertions.New(t)
+
+	repo := newGitRepository(t)
+	defer repo.Destroy()
+
+	repo.commit(map[string]string{
+		"file":               "v1",
+		"vendor/device.yaml": "device",
+	})
+	repo.tag("v1")
+
+	cacheDir := filepath.Join(repo.dir, "cache")
+	fetcher := fetch.FromGit(repo.bare, "master",
+		fetch.WithGitCacheDir(cacheDir),
+		fetch.WithGitRefreshInterval(test.Delay),
+	)
+
+	content, err := fetcher.File("file")
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "v1")
+
+	content, err = fetcher.File("vendor", "device.yaml")
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "device")
+
+	_, err = fetcher.File("missing")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	_, err = fetcher.File(".git", "config")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// Files outside of the checkout are not served.
+	if err := ioutil.WriteFile(filepath.Join(repo.dir, "outside"), []byte("outside"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, err = fetcher.File("..", "outside")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	_, err = fetcher.File("vendor", "..", "..", "outside")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// Changes to the ref are picked up after the refresh interval.
+	repo.commit(map[string]string{
+		"file":  "v2",
+		"added": "added",
+	})
+
+	content, err = fetcher.File("file")
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "v1")
+
+	time.Sleep(test.Delay)
+
+	content, err = fetcher.File("file")
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "v2")
+
+	content, err = fetcher.File("added")
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "added")
+
+	// Other refs serve the files of their own checkout.
+	tagged := fetch.FromGit(repo.bare, "v1",
+		fetch.WithGitCacheDir(filepath.Join(repo.dir, "tagged")),
+		fetch.WithGitShallowClone(),
+	)
+
+	content, err = tagged.File("file")
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "v1")
+
+	_, err = tagged.File("added")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// Refs are not parsed as options.
+	_, err = fetch.FromGit(repo.bare, "--upload-pack=touch "+filepath.Join(repo.dir, "injected"),
+		fetch.WithGitCacheDir(filepath.Join(repo.dir, "injection")),
+	).File("file")
+	a.So(err, should.NotBeNil)
+	_, err = os.Stat(filepath.Join(repo.dir, "injected"))
+	a.So(os.IsNotExist(err), should.BeTrue)
+
+	// A ref that does not exist cannot be fetched.
+	_, err = fetch.FromGit(repo.bare, "missing", fetch.WithGitCacheDir(filepath.Join(repo.dir, "missing"))).File("file")
+	a.So(err, should.NotBeNil)
+	a.So(errors.IsNotFound(err), should.BeFalse)
+}
@@ -19,7 +19,9 @@ import (
 	"io/ioutil"
 	"net/http"
 	"path"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gregjones/httpcache"
@@ -28,44 +30,231 @@ import (
 
 const timeout = 10 * time.Second
 
+// HTTPIndexFile is the name of the index file that lists the files under a path on a web server.
+// The index file contains the paths of the files relative to the path, one per line.
+const HTTPIndexFile = "index.txt"
+
+// maxHTTPValidators is the maximum number of files of which the validators are remembered.
+const maxHTTPValidators = 1024
+
+// httpValidators are the validators of a fetched file, that are used to make conditional requests. The content of the
+// file is not kept; caching the content is left to the caching transport.
+type httpValidators struct {
+	etag         string
+	lastModified string
+}
+
 type httpFetcher struct {
 	baseFetcher
 	httpClient *http.Client
+	attempts   int
+	backoff    time.Duration
+
+	mu         sync.Mutex
+	validators map[string]httpValidators
+}
+
+func (f *httpFetcher) List(prefix string) ([]string, error) {
+	index, err := f.File(prefix, HTTPIndexFile)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, line := range strings.Split(string(index), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			files = append(files, line)
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// HTTPOption configures the HTTP fetcher.
+type HTTPOption func(*httpFetcher)
+
+// WithRetry configures the HTTP fetcher to make up to the given number of attempts when the request fails with a
+// network error or a server error. The delay between attempts starts at backoff and doubles after every attempt.
+func WithRetry(attempts int, backoff time.Duration) HTTPOption {
+	return func(f *httpFetcher) {
+		f.attempts = attempts
+		f.backoff = backoff
+	}
 }
 
-func (f httpFetcher) File(pathElements ...string) ([]byte, error) {
+// WithAttemptTimeout configures the timeout of each HTTP request attempt.
+func WithAttemptTimeout(d time.Duration) HTTPOption {
+	return func(f *httpFetcher) {
+		f.httpClient.Timeout = d
+	}
+}
+
+// do performs the request, retrying on network errors and server errors.
+// The response of the last attempt is returned.
+func (f *httpFetcher) do(req *http.Request) (*http.Response, error) {
+	backoff := f.backoff
+	for attempt := 1; ; attempt++ {
+		resp, err := f.httpClient.Do(req)
+		if (err == nil && resp.StatusCode < http.StatusInternalServerError) || attempt >= f.attempts {
+			return resp, err
+		}
+		if err == nil {
+			resp.Body.Close()
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// fetch retrieves the file. If conditional is true, the validators of a previous fetch of the same file are used to
+// make a conditional request. If the server responds that the file has not been modified, no content is returned and
+// modified is false.
+func (f *httpFetcher) fetch(conditional bool, pathElements ...string) (content []byte, modified bool, err error) {
 	start := time.Now()
 	filename := strings.TrimLeft(path.Join(pathElements...), "/")
 	url := fmt.Sprintf("%s/%s", f.base, filename)
 
-	resp, err := f.httpClient.Get(url)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
-		return nil, errCouldNotFetchFile.WithCause(err).WithAttributes("filename", filename)
+		return nil, false, errCouldNotFetchFile.WithCause(err).WithAttributes("filename", filename)
+	}
+	var (
+		cached    httpValidators
+		hasCached bool
+	)
+	if conditional {
+		f.mu.Lock()
+		cached, hasCached = f.validators[filename]
+		f.mu.Unlock()
+	}
+	if hasCached {
+		if cached.etag != "" {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
+		if cached.lastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.lastModified)
+		}
+	}
+
+	resp, err := f.do(req)
+	if err != nil {
+		return nil, false, errCouldNotFetchFile.WithCause(err).WithAttributes("filename", filename)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, false, errFileNotFound.WithAttributes("filename", filename, "status_code", resp.StatusCode)
+	}
+
+	if resp.StatusCode == http.StatusNotModified && hasCached {
+		resp.Body.Close()
+		f.observeLatency(time.Since(start))
+		return nil, false, nil
 	}
 
 	if err = errors.FromHTTP(resp); err != nil {
-		return nil, errCouldNotFetchFile.WithCause(err).WithAttributes("filename", filename)
+		return nil, false, errCouldNotFetchFile.WithCause(err).WithAttributes("filename", filename, "status_code", resp.StatusCode)
 	}
 
 	result, err := ioutil.ReadAll(resp.Body)
 	resp.Body.Close()
 
 	if err != nil {
-		return nil, errCouldNotReadFile.WithCause(err).WithAttributes("filename", filename)
+		return nil, false, errCouldNotReadFile.WithCause(err).WithAttributes("filename", filename, "status_code", resp.StatusCode)
 	}
 
+	etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	if hasCached && etag != "" && etag == cached.etag {
+		// The caching transport may have served a 304 Not Modified as 200 OK.
+		f.observeLatency(time.Since(start))
+		return nil, false, nil
+	}
+	if etag != "" || lastModified != "" {
+		f.mu.Lock()
+		if _, ok := f.validators[filename]; !ok && len(f.validators) >= maxHTTPValidators {
+			// Forget the validators of an arbitrary file. That file is then fetched unconditionally once.
+			for name := range f.validators {
+				delete(f.validators, name)
+				break
+			}
+		}
+		f.validators[filename] = httpValidators{
+			etag:         etag,
+			lastModified: lastModified,
+		}
+		f.mu.Unlock()
+	}
+
+	f.observeLatency(time.Since(start))
+	return result, true, nil
+}
+
+func (f *httpFetcher) File(pathElements ...string) ([]byte, error) {
+	content, _, err := f.fetch(false, pathElements...)
+	return content, err
+}
+
+func (f *httpFetcher) FileIfModified(pathElements ...string) ([]byte, error) {
+	content, modified, err := f.fetch(true, pathElements...)
+	if err != nil {
+		return nil, err
+	}
+	if !modified {
+		return nil, ErrNotModified.WithAttributes("filename", strings.TrimLeft(path.Join(pathElements...), "/"))
+	}
+	return content, nil
+}
+
+// Peek requests the first n bytes of the file with a Range header. If the server does not support ranges, only the
+// first n bytes of the response body are read.
+func (f *httpFetcher) Peek(name string, n int) ([]byte, error) {
+	start := time.Now()
+	filename := strings.TrimLeft(path.Clean("/"+name), "/")
+	if n <= 0 {
+		return []byte{}, nil
+	}
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s", f.base, filename), nil)
+	if err != nil {
+		return nil, errCouldNotFetchFile.WithCause(err).WithAttributes("filename", filename)
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=0-%d", n-1))
+
+	resp, err := f.do(req)
+	if err != nil {
+		return nil, errCouldNotFetchFile.WithCause(err).WithAttributes("filename", filename)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return nil, errFileNotFound.WithAttributes("filename", filename, "status_code", resp.StatusCode)
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The file is empty.
+		f.observeLatency(time.Since(start))
+		return []byte{}, nil
+	}
+	if err = errors.FromHTTP(resp); err != nil {
+		return nil, errCouldNotFetchFile.WithCause(err).WithAttributes("filename", filename, "status_code", resp.StatusCode)
+	}
+
+	content, err := readPrefix(resp.Body, n)
+	if err != nil {
+		return nil, errCouldNotReadFile.WithCause(err).WithAttributes("filename", filename, "status_code", resp.StatusCode)
+	}
 	f.observeLatency(time.Since(start))
-	return result, nil
+	return content, nil
 }
 
 // FromHTTP returns an object to fetch files from a webserver.
-func FromHTTP(baseURL string, cache bool) Interface {
+// The returned fetcher implements ConditionalInterface, Lister and Peeker; it remembers the ETag and Last-Modified headers of fetched
+// files and sends conditional requests on subsequent calls to FileIfModified.
+// By default, failed requests are not retried.
+func FromHTTP(baseURL string, cache bool, opts ...HTTPOption) Interface {
 	baseURL = strings.TrimRight(baseURL, "/")
 	transport := http.DefaultTransport
 	if cache {
 		transport = httpcache.NewMemoryCacheTransport()
 	}
-	f := httpFetcher{
+	f := &httpFetcher{
 		baseFetcher: baseFetcher{
 			base:    baseURL,
 			latency: fetchLatency.WithLabelValues("http", baseURL),
@@ -74,6 +263,11 @@ func FromHTTP(baseURL string, cache bool) Interface {
 			Transport: transport,
 			Timeout:   timeout,
 		},
+		attempts:   1,
+		validators: make(map[string]httpValidators),
+	}
+	for _, opt := range opts {
+		opt(f)
 	}
 	return f
 }
@@ -15,13 +15,18 @@
 package fetch_test
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/fetch"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
 	httpmock "gopkg.in/jarcoal/httpmock.v1"
 )
@@ -102,3 +107,224 @@ func TestHTTPCache(t *testing.T) {
 	a.So(err, should.BeNil)
 	a.So(string(receivedContent), should.Equal, nonCachedContent)
 }
+
+func TestHTTPConditional(t *testing.T) {
+	a := assertions.New(t)
+
+	const etag = `"v1"`
+	var requests, notModified int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == etag {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Write([]byte("content"))
+	}))
+	defer s.Close()
+
+	fetcher := fetch.FromHTTP(s.URL, false).(fetch.ConditionalInterface)
+
+	content, err := fetcher.FileIfModified("file")
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "content")
+	a.So(notModified, should.Equal, 0)
+
+	// Files are not conditionally requested with File.
+	content, err = fetcher.File("file")
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "content")
+	a.So(notModified, should.Equal, 0)
+
+	// The server returns 304 Not Modified.
+	_, err = fetcher.FileIfModified("file")
+	a.So(err, should.HaveSameErrorDefinitionAs, fetch.ErrNotModified)
+	a.So(notModified, should.Equal, 1)
+
+	// Other files are not conditionally requested.
+	content, err = fetcher.FileIfModified("other")
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "content")
+	a.So(requests, should.Equal, 4)
+	a.So(notModified, should.Equal, 1)
+}
+
+func TestHTTPRetry(t *testing.T) {
+	a := assertions.New(t)
+
+	var requests int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch {
+		case r.URL.Path == "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case requests < 3:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.Write([]byte("content"))
+		}
+	}))
+	defer s.Close()
+
+	// Server errors are retried.
+	fetcher := fetch.FromHTTP(s.URL, false, fetch.WithRetry(3, test.Delay), fetch.WithAttemptTimeout(time.Second))
+	content, err := fetcher.File("file")
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "content")
+	a.So(requests, should.Equal, 3)
+
+	// Not found is not retried.
+	requests = 0
+	_, err = fetcher.File("missing")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	a.So(requests, should.Equal, 1)
+
+	// The final failure is returned after the last attempt.
+	requests = 0
+	fetcher = fetch.FromHTTP(s.URL, false, fetch.WithRetry(2, test.Delay))
+	_, err = fetcher.File("file")
+	a.So(err, should.NotBeNil)
+	a.So(errors.IsNotFound(err), should.BeFalse)
+	a.So(requests, should.Equal, 2)
+}
+
+func TestHTTPErrorAttributes(t *testing.T) {
+	a := assertions.New(t)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case "/forbidden":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer s.Close()
+
+	fetcher := fetch.FromHTTP(s.URL, false)
+	for path, code := range map[string]int{
+		"file":      http.StatusServiceUnavailable,
+		"forbidden": http.StatusForbidden,
+		"missing":   http.StatusNotFound,
+	} {
+		_, err := fetcher.File(path)
+		if !a.So(err, should.NotBeNil) {
+			continue
+		}
+		attributes := errors.Attributes(err)
+		a.So(attributes["filename"], should.Equal, path)
+		a.So(attributes["status_code"], should.Equal, code)
+	}
+}
+
+func TestHTTPList(t *testing.T) {
+	a := assertions.New(t)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/index.txt":
+			w.Write([]byte("US_902_928.yml\nEU_863_870.yml\n\n"))
+		case "/gateways/index.txt":
+			w.Write([]byte("indoor.yml\n"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer s.Close()
+
+	fetcher := fetch.FromHTTP(s.URL, false).(fetch.Lister)
+
+	files, err := fetcher.List("")
+	a.So(err, should.BeNil)
+	a.So(files, should.Resemble, []string{"EU_863_870.yml", "US_902_928.yml"})
+
+	files, err = fetcher.List("gateways")
+	a.So(err, should.BeNil)
+	a.So(files, should.Resemble, []string{"indoor.yml"})
+
+	_, err = fetcher.List("missing")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestHTTPPeek(t *testing.T) {
+	content := []byte(`{"band_id":"EU_863_870"}`)
+	var (
+		rangesMu sync.Mutex
+		ranges   []string
+	)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rangesMu.Lock()
+		ranges = append(ranges, r.Header.Get("Range"))
+		rangesMu.Unlock()
+		switch r.URL.Path {
+		case "/ranged.json":
+			http.ServeContent(w, r, "ranged.json", time.Time{}, bytes.NewReader(content))
+		case "/unranged.json":
+			w.Write(content)
+		case "/empty.json":
+			http.ServeContent(w, r, "empty.json", time.Time{}, bytes.NewReader(nil))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer s.Close()
+
+	fetcher := fetch.FromHTTP(s.URL, false)
+	_, ok := fetcher.(fetch.Peeker)
+
+	a := assertions.New(t)
+	a.So(ok, should.BeTrue)
+
+	for _, tc := range []struct {
+		Name     string
+		Filename string
+		N        int
+		Expected string
+	}{
+		{
+			Name:     "Ranged",
+			Filename: "ranged.json",
+			N:        1,
+			Expected: "{",
+		},
+		{
+			Name:     "RangedLongerThanFile",
+			Filename: "ranged.json",
+			N:        1024,
+			Expected: string(content),
+		},
+		{
+			Name:     "Unranged",
+			Filename: "unranged.json",
+			N:        2,
+			Expected: `{"`,
+		},
+		{
+			Name:     "Empty",
+			Filename: "empty.json",
+			N:        2,
+			Expected: "",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			rangesMu.Lock()
+			ranges = nil
+			rangesMu.Unlock()
+
+			peeked, err := fetch.Peek(fetcher, tc.Filename, tc.N)
+			a.So(err, should.BeNil)
+			a.So(string(peeked), should.Equal, tc.Expected)
+			rangesMu.Lock()
+			a.So(ranges, should.Resemble, []string{fmt.Sprintf("bytes=0-%d", tc.N-1)})
+			rangesMu.Unlock()
+		})
+	}
+
+	_, err := fetch.Peek(fetcher, "missing.json", 1)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
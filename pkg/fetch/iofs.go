@@ -0,0 +1,87 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.16
+// +build go1.16
+
+package fetch
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+type ioFSFetcher struct {
+	baseFetcher
+	fsys fs.FS
+}
+
+// FromFS returns an interface that fetches files from the given filesystem, for example an embed.FS.
+// The returned fetcher implements Lister.
+func FromFS(fsys fs.FS) Interface {
+	return ioFSFetcher{
+		baseFetcher: baseFetcher{
+			latency: fetchLatency.WithLabelValues("iofs", ""),
+		},
+		fsys: fsys,
+	}
+}
+
+func (f ioFSFetcher) File(pathElements ...string) ([]byte, error) {
+	start := time.Now()
+	filename := path.Join(pathElements...)
+	content, err := fs.ReadFile(f.fsys, filename)
+	if err == nil {
+		f.observeLatency(time.Since(start))
+		return content, nil
+	}
+
+	if os.IsNotExist(err) {
+		return nil, errFileNotFound.WithAttributes("filename", filename)
+	}
+	return nil, errCouldNotReadFile.WithCause(err).WithAttributes("filename", filename, "os_error", osError(err))
+}
+
+func (f ioFSFetcher) List(prefix string) ([]string, error) {
+	root := path.Clean("/" + prefix)[1:]
+	if root == "" {
+		root = "."
+	}
+	var files []string
+	err := fs.WalkDir(f.fsys, root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if root != "." {
+			p = strings.TrimPrefix(p, root+"/")
+		}
+		files = append(files, p)
+		return nil
+	})
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errFileNotFound.WithAttributes("filename", prefix)
+		}
+		return nil, errCouldNotReadFile.WithCause(err).WithAttributes("filename", prefix, "os_error", osError(err))
+	}
+	sort.Strings(files)
+	return files, nil
+}
@@ -0,0 +1,61 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.16
+// +build go1.16
+
+package fetch_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/fetch"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestFS(t *testing.T) {
+	a := assertions.New(t)
+
+	fetcher := fetch.FromFS(fstest.MapFS{
+		"EU_863_870.yml":      {Data: []byte("content1")},
+		"gateways/indoor.yml": {Data: []byte("content2")},
+	})
+
+	content, err := fetcher.File("EU_863_870.yml")
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "content1")
+
+	content, err = fetcher.File("gateways", "indoor.yml")
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "content2")
+
+	_, err = fetcher.File("US_902_928.yml")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	lister := fetcher.(fetch.Lister)
+
+	files, err := lister.List("")
+	a.So(err, should.BeNil)
+	a.So(files, should.Resemble, []string{"EU_863_870.yml", "gateways/indoor.yml"})
+
+	files, err = lister.List("gateways")
+	a.So(err, should.BeNil)
+	a.So(files, should.Resemble, []string{"indoor.yml"})
+
+	_, err = lister.List("unknown")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
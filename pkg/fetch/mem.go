@@ -15,6 +15,7 @@
 package fetch
 
 import (
+	"sort"
 	"strings"
 	"time"
 )
@@ -28,6 +29,7 @@ type memFetcher struct {
 }
 
 // NewMemFetcher initializes a new memory fetcher.
+// The returned fetcher implements Lister.
 func NewMemFetcher(store map[string][]byte) Interface {
 	return &memFetcher{
 		store: store,
@@ -48,6 +50,25 @@ func (f *memFetcher) File(pathElements ...string) ([]byte, error) {
 	return content, nil
 }
 
+// List lists the files in memory.
+func (f *memFetcher) List(prefix string) ([]string, error) {
+	pathPrefix := strings.Trim(prefix, memFetcherSeparator)
+	if pathPrefix != "" {
+		pathPrefix += memFetcherSeparator
+	}
+	var files []string
+	for path := range f.store {
+		if strings.HasPrefix(path, pathPrefix) {
+			files = append(files, strings.TrimPrefix(path, pathPrefix))
+		}
+	}
+	if len(files) == 0 {
+		return nil, errFileNotFound.WithAttributes("filename", prefix)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
 func memFetcherPath(pathElements ...string) string {
 	return strings.Join(pathElements, memFetcherSeparator)
 }
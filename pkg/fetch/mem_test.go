@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/fetch"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
 )
@@ -64,3 +65,22 @@ func TestMemFetcher(t *testing.T) {
 		a.So(err, should.NotBeNil)
 	}
 }
+
+func TestMemFetcherPeek(t *testing.T) {
+	a := assertions.New(t)
+
+	fetcher := fetch.NewMemFetcher(map[string][]byte{
+		"file.json": []byte(`{"hello":"world"}`),
+	})
+
+	content, err := fetch.Peek(fetcher, "file.json", 1)
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, "{")
+
+	content, err = fetch.Peek(fetcher, "file.json", 1024)
+	a.So(err, should.BeNil)
+	a.So(string(content), should.Equal, `{"hello":"world"}`)
+
+	_, err = fetch.Peek(fetcher, "missing.json", 1)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
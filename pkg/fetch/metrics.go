@@ -16,6 +16,7 @@ package fetch
 
 import (
 	"github.com/prometheus/client_golang/prometheus"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/metrics"
 )
 
@@ -30,6 +31,44 @@ var fetchLatency = metrics.NewHistogramVec(
 	[]string{"backend", "base"},
 )
 
+var fetchTotal = metrics.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: subsystem,
+		Name:      "fetches_total",
+		Help:      "Total number of file fetches",
+	},
+	[]string{"backend", "result"},
+)
+
 func init() {
-	metrics.MustRegister(fetchLatency)
+	metrics.MustRegister(fetchLatency, fetchTotal)
+}
+
+type metricsFetcher struct {
+	Interface
+	backend string
+}
+
+// WithMetrics returns a fetcher that counts the fetches of the given fetcher, labeled by the given backend
+// (e.g. fs, http or bucket) and the result.
+func WithMetrics(f Interface, backend string) Interface {
+	return &metricsFetcher{
+		Interface: f,
+		backend:   backend,
+	}
+}
+
+func (f *metricsFetcher) File(pathElements ...string) ([]byte, error) {
+	content, err := f.Interface.File(pathElements...)
+	var result string
+	switch {
+	case err == nil:
+		result = "ok"
+	case errors.IsNotFound(err):
+		result = "not_found"
+	default:
+		result = "error"
+	}
+	fetchTotal.WithLabelValues(f.backend, result).Inc()
+	return content, err
 }
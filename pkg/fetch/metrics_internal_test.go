@@ -0,0 +1,42 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetch
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestMetrics(t *testing.T) {
+	a := assertions.New(t)
+
+	fetcher := WithMetrics(NewMemFetcher(map[string][]byte{
+		"file.txt": []byte("content"),
+	}), "test")
+
+	_, err := fetcher.File("file.txt")
+	a.So(err, should.BeNil)
+	_, err = fetcher.File("file.txt")
+	a.So(err, should.BeNil)
+	_, err = fetcher.File("missing.txt")
+	a.So(err, should.NotBeNil)
+
+	a.So(testutil.ToFloat64(fetchTotal.WithLabelValues("test", "ok")), should.Equal, 2)
+	a.So(testutil.ToFloat64(fetchTotal.WithLabelValues("test", "not_found")), should.Equal, 1)
+	a.So(testutil.ToFloat64(fetchTotal.WithLabelValues("test", "error")), should.Equal, 0)
+}
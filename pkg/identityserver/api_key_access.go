@@ -0,0 +1,73 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// getAPIKeyAccess returns the entities that the API key with the requested ID can access, with the rights that the API
+// key has on each of them. These are the entity that the API key belongs to and the entities that it is a member of,
+// limited to the entity scope of the API key. Entities on which the API key has no rights are left out.
+// The caller must have the rights to manage the API keys of the entity that the API key belongs to.
+func (is *IdentityServer) getAPIKeyAccess(ctx context.Context, req *ttnpb.GetAPIKeyAccessRequest) (*ttnpb.APIKeyAccess, error) {
+	var (
+		ids    *ttnpb.EntityIdentifiers
+		apiKey *ttnpb.APIKey
+	)
+	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		ids, apiKey, err = store.GetAPIKeyStore(db).GetAPIKey(ctx, req.APIKeyID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	right, err := manageAPIKeysRight(ids)
+	if err != nil {
+		return nil, err
+	}
+	if err = rights.RequireAny(ctx, rights.Check{IDs: ids, Required: []ttnpb.Right{right}}); err != nil {
+		return nil, err
+	}
+	if apiKey.ExpiresAt != nil && apiKey.ExpiresAt.Before(time.Now()) {
+		return nil, errAPIKeyExpired
+	}
+	entityRights, err := is.resolveEntityRights(ctx, ids, ttnpb.RightsFrom(apiKey.Rights...).Implied(), apiKey.EntityScope)
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.APIKeyAccess{}
+	for ids, rights := range entityRights {
+		if len(rights.GetRights()) == 0 {
+			continue
+		}
+		res.Entities = append(res.Entities, &ttnpb.EntityRights{EntityIDs: ids, Rights: rights})
+	}
+	sort.Slice(res.Entities, func(i, j int) bool {
+		return entityKey(res.Entities[i].EntityIDs) < entityKey(res.Entities[j].EntityIDs)
+	})
+	return res, nil
+}
+
+func entityKey(ids *ttnpb.EntityIdentifiers) string {
+	return entityType(ids) + ":" + ids.IDString()
+}
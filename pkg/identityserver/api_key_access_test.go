@@ -0,0 +1,136 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+)
+
+func TestGetAPIKeyAccess(t *testing.T) {
+	a := assertions.New(t)
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+		ctx := rights.NewContext(test.Context(), rights.Rights{
+			UserRights: map[string]*ttnpb.Rights{
+				userID.UserID: ttnpb.RightsFrom(ttnpb.RIGHT_ALL).Implied(),
+			},
+		})
+
+		inScope := ttnpb.ApplicationIdentifiers{ApplicationID: "access-in-scope-app"}
+		outOfScope := ttnpb.ApplicationIdentifiers{ApplicationID: "access-out-of-scope-app"}
+		for _, ids := range []ttnpb.ApplicationIdentifiers{inScope, outOfScope} {
+			_, err := ttnpb.NewApplicationRegistryClient(cc).Create(test.Context(), &ttnpb.CreateApplicationRequest{
+				Application:  ttnpb.Application{ApplicationIdentifiers: ids},
+				Collaborator: *userID.OrganizationOrUserIdentifiers(),
+			}, creds)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+		}
+
+		reg := ttnpb.NewEntityAccessClient(cc)
+
+		accessIDs := func(access *ttnpb.APIKeyAccess) map[string]*ttnpb.Rights {
+			res := make(map[string]*ttnpb.Rights, len(access.Entities))
+			for _, entity := range access.Entities {
+				res[entityKey(entity.EntityIDs)] = entity.Rights
+			}
+			return res
+		}
+
+		t.Run("Unscoped", func(t *testing.T) {
+			a := assertions.New(t)
+
+			apiKey, err := is.createUserAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+				UserIdentifiers: userID,
+				Name:            "unscoped-access-key",
+				Rights:          []ttnpb.Right{ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_APPLICATION_INFO},
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			access, err := reg.GetAPIKeyAccess(test.Context(), &ttnpb.GetAPIKeyAccessRequest{APIKeyID: apiKey.ID}, creds)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			ids := accessIDs(access)
+			if a.So(ids, should.ContainKey, "user:"+userID.UserID) {
+				a.So(ids["user:"+userID.UserID].Rights, should.Contain, ttnpb.RIGHT_USER_INFO)
+			}
+			for _, app := range append(userApplications(&userID).Applications,
+				&ttnpb.Application{ApplicationIdentifiers: inScope},
+				&ttnpb.Application{ApplicationIdentifiers: outOfScope},
+			) {
+				if a.So(ids, should.ContainKey, "application:"+app.ApplicationID) {
+					a.So(ids["application:"+app.ApplicationID].Rights, should.Resemble, []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO})
+				}
+			}
+			for _, gtw := range userGateways(&userID).Gateways {
+				a.So(ids, should.NotContainKey, "gateway:"+gtw.GatewayID)
+			}
+		})
+
+		t.Run("Scoped", func(t *testing.T) {
+			a := assertions.New(t)
+
+			apiKey, err := is.createUserAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+				UserIdentifiers: userID,
+				Name:            "scoped-access-key",
+				Rights:          []ttnpb.Right{ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_APPLICATION_INFO},
+				EntityScope:     []*ttnpb.EntityIdentifiers{inScope.EntityIdentifiers()},
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			access, err := reg.GetAPIKeyAccess(test.Context(), &ttnpb.GetAPIKeyAccessRequest{APIKeyID: apiKey.ID}, creds)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(accessIDs(access), should.Resemble, map[string]*ttnpb.Rights{
+				"application:" + inScope.ApplicationID: ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_INFO),
+			})
+		})
+
+		t.Run("PermissionDenied", func(t *testing.T) {
+			a := assertions.New(t)
+
+			apiKey := userAPIKeys(&userID).APIKeys[0]
+			_, err := is.getAPIKeyAccess(test.Context(), &ttnpb.GetAPIKeyAccessRequest{APIKeyID: apiKey.ID})
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsPermissionDenied(err), should.BeTrue)
+			}
+		})
+
+		t.Run("NotFound", func(t *testing.T) {
+			a := assertions.New(t)
+
+			_, err := reg.GetAPIKeyAccess(test.Context(), &ttnpb.GetAPIKeyAccessRequest{APIKeyID: "NOTFOUND"}, creds)
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsNotFound(err), should.BeTrue)
+			}
+		})
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var errBatchCreateAPIKeysUser = errors.DefineInvalidArgument(
+	"batch_create_api_keys_user",
+	"request for user `{request_user_id}` in batch for user `{user_id}`",
+)
+
+// BatchCreateAPIKeys creates the API keys of the requests for the user in a single transaction. Requests without
+// user identifiers create an API key for the given user. The caller must have the rights to manage the API keys of
+// the user and at least the rights of each API key. If any API key can not be created, none of the API keys are
+// created. The returned API keys contain the tokens, which are not returned again. The DryRun field of the requests
+// is ignored.
+func (is *IdentityServer) BatchCreateAPIKeys(ctx context.Context, ids *ttnpb.UserIdentifiers, reqs []*ttnpb.CreateUserAPIKeyRequest) (keys []*ttnpb.APIKey, err error) {
+	rightsPerKey := make([][]ttnpb.Right, len(reqs))
+	for i, req := range reqs {
+		if !req.UserIdentifiers.IsZero() && req.UserID != ids.UserID {
+			return nil, errBatchCreateAPIKeysUser.WithAttributes(
+				"request_user_id", req.UserID,
+				"user_id", ids.UserID,
+			)
+		}
+		if rightsPerKey[i], err = is.expandUserAPIKeyRights(ctx, ids, req.Rights); err != nil {
+			return nil, err
+		}
+		// Require that caller has rights to manage API keys and at least the rights of the API key.
+		if err = rights.RequireAny(ctx,
+			rights.Check{IDs: ids.EntityIdentifiers(), Required: []ttnpb.Right{ttnpb.RIGHT_USER_SETTINGS_API_KEYS}},
+			rights.Check{IDs: ids.EntityIdentifiers(), Required: rightsPerKey[i]},
+		); err != nil {
+			return nil, err
+		}
+	}
+	keys = make([]*ttnpb.APIKey, len(reqs))
+	tokens := make([]string, len(reqs))
+	for i, req := range reqs {
+		keys[i], tokens[i], err = generateAPIKey(ctx, req.Name, rightsPerKey[i]...)
+		if err != nil {
+			return nil, err
+		}
+		keys[i].EntityScope = req.EntityScope
+	}
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		keyStore := store.GetAPIKeyStore(db)
+		for _, key := range keys {
+			if err := is.requireAPIKeyCapacity(ctx, db, ids.EntityIdentifiers()); err != nil {
+				return err
+			}
+			if err := keyStore.CreateAPIKey(ctx, ids.EntityIdentifiers(), key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, key := range keys {
+		key.Key = tokens[i]
+		is.logAPIKeyOperation(ctx, "Created API key", ids.EntityIdentifiers(), key.ID, nil, ttnpb.RightsFrom(key.Rights...))
+		events.Publish(evtCreateUserAPIKey(ctx, *ids, nil))
+	}
+	return keys, nil
+}
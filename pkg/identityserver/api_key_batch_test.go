@@ -0,0 +1,129 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+)
+
+func TestBatchCreateAPIKeys(t *testing.T) {
+	a := assertions.New(t)
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID := defaultUser.UserIdentifiers
+		ctx := rights.NewContext(test.Context(), rights.Rights{
+			UserRights: map[string]*ttnpb.Rights{
+				userID.UserID: ttnpb.RightsFrom(
+					ttnpb.RIGHT_USER_INFO,
+					ttnpb.RIGHT_USER_SETTINGS_API_KEYS,
+					ttnpb.RIGHT_USER_APPLICATIONS_LIST,
+				),
+			},
+		})
+
+		countKeys := func() int {
+			var keys []*ttnpb.APIKey
+			err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+				keys, err = store.GetAPIKeyStore(db).FindAPIKeys(ctx, userID.EntityIdentifiers())
+				return err
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			return len(keys)
+		}
+		before := countKeys()
+
+		keys, err := is.BatchCreateAPIKeys(ctx, &userID, []*ttnpb.CreateUserAPIKeyRequest{
+			{
+				Name:   "integration 1",
+				Rights: []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+			},
+			{
+				UserIdentifiers: userID,
+				Name:            "integration 2",
+				Rights:          []ttnpb.Right{ttnpb.RIGHT_USER_APPLICATIONS_LIST},
+			},
+		})
+		if !a.So(err, should.BeNil) || !a.So(keys, should.HaveLength, 2) {
+			t.FailNow()
+		}
+		a.So(keys[0].Name, should.Equal, "integration 1")
+		a.So(keys[0].Rights, should.Resemble, []ttnpb.Right{ttnpb.RIGHT_USER_INFO})
+		a.So(keys[1].Name, should.Equal, "integration 2")
+		a.So(keys[1].Rights, should.Resemble, []ttnpb.Right{ttnpb.RIGHT_USER_APPLICATIONS_LIST})
+		a.So(keys[0].Key, should.NotBeEmpty)
+		a.So(keys[1].Key, should.NotBeEmpty)
+		a.So(keys[0].Key, should.NotEqual, keys[1].Key)
+		a.So(countKeys(), should.Equal, before+2)
+
+		// One request exceeds the rights of the caller, so the whole batch fails.
+		_, err = is.BatchCreateAPIKeys(ctx, &userID, []*ttnpb.CreateUserAPIKeyRequest{
+			{
+				Name:   "integration 3",
+				Rights: []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+			},
+			{
+				Name:   "integration 4",
+				Rights: []ttnpb.Right{ttnpb.RIGHT_USER_DELETE},
+			},
+		})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+		a.So(countKeys(), should.Equal, before+2)
+
+		// The second API key exceeds the maximum number of API keys, so the first one is rolled back.
+		maxAPIKeys := is.config.MaxAPIKeys
+		is.config.MaxAPIKeys = before + 3
+		defer func() { is.config.MaxAPIKeys = maxAPIKeys }()
+		_, err = is.BatchCreateAPIKeys(ctx, &userID, []*ttnpb.CreateUserAPIKeyRequest{
+			{
+				Name:   "integration 5",
+				Rights: []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+			},
+			{
+				Name:   "integration 6",
+				Rights: []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+			},
+		})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsResourceExhausted(err), should.BeTrue)
+		}
+		a.So(countKeys(), should.Equal, before+2)
+
+		// Requests for other users are refused.
+		_, err = is.BatchCreateAPIKeys(ctx, &userID, []*ttnpb.CreateUserAPIKeyRequest{
+			{
+				UserIdentifiers: collaboratorUser.UserIdentifiers,
+				Name:            "integration 7",
+				Rights:          []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+			},
+		})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+	})
+}
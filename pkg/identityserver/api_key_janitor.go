@@ -0,0 +1,57 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
+)
+
+var (
+	evtPurgeExpiredAPIKeys = events.Define("api-key.purge_expired", "Purge expired API keys")
+	evtPurgeDeletedAPIKeys = events.Define("api-key.purge_deleted", "Purge deleted API keys")
+)
+
+// purgeExpiredAPIKeys deletes the API keys that are past their expiry, and the deleted API keys that are past the
+// restore window.
+// It is registered as a task of the Identity Server, which is restarted every APIKeyJanitor.Interval.
+func (is *IdentityServer) purgeExpiredAPIKeys(ctx context.Context) error {
+	var expired, deleted int
+	now := time.Now()
+	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		keyStore := store.GetAPIKeyStore(db)
+		expired, err = keyStore.DeleteExpiredAPIKeys(ctx, now)
+		if err != nil {
+			return err
+		}
+		deleted, err = keyStore.PurgeDeletedAPIKeys(ctx, now.Add(-is.config.APIKeyJanitor.RestoreWindow))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	log.FromContext(ctx).WithFields(log.Fields(
+		"expired", expired,
+		"deleted", deleted,
+	)).Debug("Purged API keys")
+	events.Publish(evtPurgeExpiredAPIKeys(ctx, nil, expired))
+	events.Publish(evtPurgeDeletedAPIKeys(ctx, nil, deleted))
+	return nil
+}
@@ -0,0 +1,172 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+)
+
+func TestPurgeExpiredAPIKeys(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID := defaultUser.UserIdentifiers
+
+		past, future := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+		expired, _, err := generateAPIKey(ctx, "expired key", ttnpb.RIGHT_USER_INFO)
+		a.So(err, should.BeNil)
+		expired.ExpiresAt = &past
+		valid, _, err := generateAPIKey(ctx, "valid key", ttnpb.RIGHT_USER_INFO)
+		a.So(err, should.BeNil)
+		valid.ExpiresAt = &future
+
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			keyStore := store.GetAPIKeyStore(db)
+			if err := keyStore.CreateAPIKey(ctx, userID.EntityIdentifiers(), expired); err != nil {
+				return err
+			}
+			return keyStore.CreateAPIKey(ctx, userID.EntityIdentifiers(), valid)
+		})
+		a.So(err, should.BeNil)
+
+		evtCh := make(events.Channel, 1)
+		events.Subscribe("api-key.purge_expired", evtCh)
+		defer events.Unsubscribe("api-key.purge_expired", evtCh)
+
+		err = is.purgeExpiredAPIKeys(ctx)
+		a.So(err, should.BeNil)
+
+		select {
+		case evt := <-evtCh:
+			a.So(evt.Data(), should.Equal, 1)
+		case <-time.After(test.Delay):
+			t.Fatal("Expected purge event but nothing received")
+		}
+
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			_, _, err := store.GetAPIKeyStore(db).GetAPIKey(ctx, expired.ID)
+			return err
+		})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			_, got, err := store.GetAPIKeyStore(db).GetAPIKey(ctx, valid.ID)
+			if err == nil {
+				a.So(got.ExpiresAt, should.NotBeNil)
+			}
+			return err
+		})
+		a.So(err, should.BeNil)
+	})
+}
+
+func TestRestoreAndPurgeDeletedAPIKeys(t *testing.T) {
+	a := assertions.New(t)
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		is.config.APIKeyJanitor.RestoreWindow = time.Hour
+
+		userID := defaultUser.UserIdentifiers
+		ctx := rights.NewContext(test.Context(), rights.Rights{
+			UserRights: map[string]*ttnpb.Rights{
+				userID.UserID: ttnpb.RightsFrom(ttnpb.RIGHT_USER_ALL).Implied(),
+			},
+		})
+
+		deleteKey := func(name string) *ttnpb.APIKey {
+			created, err := is.createUserAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+				UserIdentifiers: userID,
+				Name:            name,
+				Rights:          []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			_, err = is.updateUserAPIKey(ctx, &ttnpb.UpdateUserAPIKeyRequest{
+				UserIdentifiers: userID,
+				APIKey: ttnpb.APIKey{
+					ID: created.ID,
+				},
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			return created
+		}
+		hasKey := func(keys *ttnpb.APIKeys, id string) bool {
+			for _, key := range keys.APIKeys {
+				if key.ID == id {
+					return true
+				}
+			}
+			return false
+		}
+
+		restorable := deleteKey("restorable key")
+
+		keys, err := is.listUserAPIKeys(ctx, &userID, false)
+		a.So(err, should.BeNil)
+		a.So(hasKey(keys, restorable.ID), should.BeFalse)
+
+		keys, err = is.listUserAPIKeys(ctx, &userID, true)
+		a.So(err, should.BeNil)
+		a.So(hasKey(keys, restorable.ID), should.BeTrue)
+
+		restored, err := is.restoreUserAPIKey(ctx, &userID, restorable.ID)
+		a.So(err, should.BeNil)
+		if a.So(restored, should.NotBeNil) {
+			a.So(restored.ID, should.Equal, restorable.ID)
+			a.So(restored.Key, should.BeEmpty)
+			a.So(restored.DeletedAt, should.BeNil)
+		}
+
+		keys, err = is.listUserAPIKeys(ctx, &userID, false)
+		a.So(err, should.BeNil)
+		a.So(hasKey(keys, restorable.ID), should.BeTrue)
+
+		purgeable := deleteKey("purgeable key")
+
+		// Purge everything that was deleted before now.
+		is.config.APIKeyJanitor.RestoreWindow = 0
+		err = is.purgeExpiredAPIKeys(ctx)
+		a.So(err, should.BeNil)
+		is.config.APIKeyJanitor.RestoreWindow = time.Hour
+
+		_, err = is.restoreUserAPIKey(ctx, &userID, purgeable.ID)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		keys, err = is.listUserAPIKeys(ctx, &userID, true)
+		a.So(err, should.BeNil)
+		a.So(hasKey(keys, purgeable.ID), should.BeFalse)
+		a.So(hasKey(keys, restorable.ID), should.BeTrue)
+	})
+}
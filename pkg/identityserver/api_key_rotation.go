@@ -0,0 +1,91 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var evtRotateAPIKey = events.Define("api-key.rotate", "Rotate API key")
+
+var errNoAPIKeys = errors.DefineInvalidArgument("no_api_keys", "entity type `{entity_type}` does not have API keys")
+
+// manageAPIKeysRight returns the right that is required to manage the API keys of the entity.
+func manageAPIKeysRight(ids *ttnpb.EntityIdentifiers) (ttnpb.Right, error) {
+	switch ids.GetIds().(type) {
+	case *ttnpb.EntityIdentifiers_ApplicationIDs:
+		return ttnpb.RIGHT_APPLICATION_SETTINGS_API_KEYS, nil
+	case *ttnpb.EntityIdentifiers_GatewayIDs:
+		return ttnpb.RIGHT_GATEWAY_SETTINGS_API_KEYS, nil
+	case *ttnpb.EntityIdentifiers_OrganizationIDs:
+		return ttnpb.RIGHT_ORGANIZATION_SETTINGS_API_KEYS, nil
+	case *ttnpb.EntityIdentifiers_UserIDs:
+		return ttnpb.RIGHT_USER_SETTINGS_API_KEYS, nil
+	}
+	return 0, errNoAPIKeys.WithAttributes("entity_type", entityType(ids))
+}
+
+// rotateAPIKey generates a new token for the API key with the given ID of the entity. The API key keeps its ID, name
+// and rights, but the old token is no longer valid. The new token is only returned by this method.
+// The caller must have the rights to manage the API keys of the entity and at least the rights of the API key.
+func (is *IdentityServer) rotateAPIKey(ctx context.Context, req *ttnpb.RotateAPIKeyRequest) (*ttnpb.APIKey, error) {
+	ids, keyID := req.EntityIDs, req.APIKeyID
+	right, err := manageAPIKeysRight(ids)
+	if err != nil {
+		return nil, err
+	}
+	if err = rights.RequireAny(ctx, rights.Check{IDs: ids, Required: []ttnpb.Right{right}}); err != nil {
+		return nil, err
+	}
+	token, err := auth.APIKey.Generate(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	_, _, generatedKey, err := auth.SplitToken(token)
+	if err != nil {
+		panic(err) // Bug in either Generate or SplitToken.
+	}
+	hashedKey, err := auth.Hash(generatedKey)
+	if err != nil {
+		return nil, err
+	}
+	var key *ttnpb.APIKey
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		key, err = store.GetAPIKeyStore(db).RotateAPIKey(ctx, ids, &ttnpb.APIKey{
+			ID:  keyID,
+			Key: string(hashedKey),
+		})
+		if err != nil {
+			return err
+		}
+		// Require that caller has at least the rights of the API key.
+		return rights.RequireAny(ctx, rights.Check{IDs: ids, Required: key.Rights})
+	})
+	if err != nil {
+		return nil, err
+	}
+	key.Key = token
+	is.logAPIKeyOperation(ctx, "Rotated API key", ids, keyID, nil, nil)
+	events.Publish(evtRotateAPIKey(ctx, ids.Identifiers(), nil))
+	return key, nil
+}
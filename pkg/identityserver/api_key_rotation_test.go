@@ -0,0 +1,121 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+)
+
+func TestRotateAPIKey(t *testing.T) {
+	a := assertions.New(t)
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID := defaultUser.UserIdentifiers
+		ctx := rights.NewContext(test.Context(), rights.Rights{
+			UserRights: map[string]*ttnpb.Rights{
+				userID.UserID: ttnpb.RightsFrom(ttnpb.RIGHT_USER_ALL).Implied(),
+			},
+		})
+
+		created, err := is.createUserAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+			UserIdentifiers: userID,
+			Name:            "rotated key",
+			Rights:          []ttnpb.Right{ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_USER_APPLICATIONS_LIST},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		evtCh := make(events.Channel, 1)
+		events.Subscribe("api-key.rotate", evtCh)
+		defer events.Unsubscribe("api-key.rotate", evtCh)
+
+		cli := ttnpb.NewEntityAccessClient(cc)
+
+		rotated, err := cli.RotateAPIKey(ctx, &ttnpb.RotateAPIKeyRequest{
+			EntityIDs: userID.EntityIdentifiers(),
+			APIKeyID:  created.ID,
+		}, userCreds(defaultUserIdx))
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(rotated.ID, should.Equal, created.ID)
+		a.So(rotated.Name, should.Equal, created.Name)
+		a.So(rotated.Rights, should.Resemble, created.Rights)
+		a.So(rotated.Key, should.NotBeEmpty)
+		a.So(rotated.Key, should.NotEqual, created.Key)
+
+		select {
+		case evt := <-evtCh:
+			a.So(evt.Name(), should.Equal, "api-key.rotate")
+		case <-time.After(test.Delay):
+			t.Fatal("Expected rotate event but nothing received")
+		}
+
+		creds := func(token string) grpc.CallOption {
+			return grpc.PerRPCCredentials(rpcmetadata.MD{
+				AuthType:      "bearer",
+				AuthValue:     token,
+				AllowInsecure: true,
+			})
+		}
+		_, err = cli.AuthInfo(test.Context(), ttnpb.Empty, creds(created.Key))
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		authInfo, err := cli.AuthInfo(test.Context(), ttnpb.Empty, creds(rotated.Key))
+		if a.So(err, should.BeNil) && a.So(authInfo.GetAPIKey(), should.NotBeNil) {
+			a.So(authInfo.GetAPIKey().APIKey.ID, should.Equal, created.ID)
+			a.So(
+				ttnpb.RightsFrom(authInfo.GetAPIKey().APIKey.Rights...).Sorted(),
+				should.Resemble,
+				ttnpb.RightsFrom(created.Rights...).Implied().Sorted(),
+			)
+		}
+
+		// The caller must have the rights of the API key.
+		_, err = is.rotateAPIKey(rights.NewContext(test.Context(), rights.Rights{
+			UserRights: map[string]*ttnpb.Rights{
+				userID.UserID: ttnpb.RightsFrom(ttnpb.RIGHT_USER_SETTINGS_API_KEYS),
+			},
+		}), &ttnpb.RotateAPIKeyRequest{
+			EntityIDs: userID.EntityIdentifiers(),
+			APIKeyID:  created.ID,
+		})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = cli.RotateAPIKey(ctx, &ttnpb.RotateAPIKeyRequest{
+			EntityIDs: userID.EntityIdentifiers(),
+			APIKeyID:  "NOTFOUND",
+		}, userCreds(defaultUserIdx))
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// rightsImplying returns the rights that imply the given right, including the right itself.
+func rightsImplying(right ttnpb.Right) []ttnpb.Right {
+	var res []ttnpb.Right
+	for value := range ttnpb.Right_name {
+		if r := ttnpb.Right(value); ttnpb.RightsFrom(r).Implied().IncludesAll(right) {
+			res = append(res, r)
+		}
+	}
+	return res
+}
+
+// searchAPIKeys returns the API keys of all entities that include the requested right, directly or through a right
+// that implies it (such as RIGHT_ALL), so that keys with dangerous rights can be audited.
+// The results are paginated with the limit and page of the request. Only admins can search API keys.
+func (is *IdentityServer) searchAPIKeys(ctx context.Context, req *ttnpb.SearchAPIKeysRequest) (res *ttnpb.EntityAPIKeys, err error) {
+	authInfo, err := is.authInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !authInfo.UniversalRights.IncludesAll(ttnpb.RIGHT_ALL) {
+		return nil, errSearchAdminOnly
+	}
+	var total uint64
+	ctx = store.SetTotalCount(ctx, &total)
+	defer func() {
+		if err == nil {
+			setTotalHeader(ctx, total)
+		}
+	}()
+	res = &ttnpb.EntityAPIKeys{}
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		entityIDs, keys, err := store.GetAPIKeyStore(db).FindAPIKeysWithRights(ctx, rightsImplying(req.Right)...)
+		if err != nil {
+			return err
+		}
+		res.APIKeys = make([]*ttnpb.EntityAPIKey, len(keys))
+		for i, key := range keys {
+			key.ID = maskAPIKeyID(key.ID)
+			key.Key = ""
+			res.APIKeys[i] = &ttnpb.EntityAPIKey{
+				EntityIDs: entityIDs[i],
+				APIKey:    key,
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (rs *registrySearch) SearchAPIKeys(ctx context.Context, req *ttnpb.SearchAPIKeysRequest) (*ttnpb.EntityAPIKeys, error) {
+	return rs.searchAPIKeys(ctx, req)
+}
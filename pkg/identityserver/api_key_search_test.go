@@ -0,0 +1,99 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+)
+
+func TestRightsImplying(t *testing.T) {
+	a := assertions.New(t)
+
+	rights := ttnpb.RightsFrom(rightsImplying(ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS)...)
+	a.So(rights.IncludesAll(
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+		ttnpb.RIGHT_APPLICATION_ALL,
+		ttnpb.RIGHT_ALL,
+	), should.BeTrue)
+	a.So(rights.IncludesAll(ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS), should.BeFalse)
+	a.So(rights.IncludesAll(ttnpb.RIGHT_GATEWAY_ALL), should.BeFalse)
+}
+
+func TestSearchAPIKeys(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID := defaultUser.UserIdentifiers
+
+		withRight, _, err := generateAPIKey(ctx, "key with right", ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_USER_DELETE)
+		a.So(err, should.BeNil)
+		withoutRight, _, err := generateAPIKey(ctx, "key without right", ttnpb.RIGHT_USER_INFO)
+		a.So(err, should.BeNil)
+
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			keyStore := store.GetAPIKeyStore(db)
+			if err := keyStore.CreateAPIKey(ctx, userID.EntityIdentifiers(), withRight); err != nil {
+				return err
+			}
+			return keyStore.CreateAPIKey(ctx, userID.EntityIdentifiers(), withoutRight)
+		})
+		a.So(err, should.BeNil)
+
+		reg := ttnpb.NewEntityRegistrySearchClient(cc)
+		req := &ttnpb.SearchAPIKeysRequest{Right: ttnpb.RIGHT_USER_DELETE}
+
+		_, err = reg.SearchAPIKeys(ctx, req, userCreds(defaultUserIdx))
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		res, err := reg.SearchAPIKeys(ctx, req, userCreds(adminUserIdx))
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		var foundWithRight, foundWithoutRight bool
+		for _, result := range res.APIKeys {
+			a.So(result.APIKey.Key, should.BeEmpty)
+			a.So(ttnpb.RightsFrom(result.APIKey.Rights...).Implied().IncludesAll(ttnpb.RIGHT_USER_DELETE), should.BeTrue)
+			switch result.APIKey.Name {
+			case withRight.Name:
+				foundWithRight = true
+				a.So(result.APIKey.ID, should.Equal, maskAPIKeyID(withRight.ID))
+				a.So(result.EntityIDs, should.Resemble, userID.EntityIdentifiers())
+			case withoutRight.Name:
+				foundWithoutRight = true
+			}
+		}
+		a.So(foundWithRight, should.BeTrue)
+		a.So(foundWithoutRight, should.BeFalse)
+
+		md := rpcmetadata.MD{Limit: 1, Page: 1}
+		paginated, err := reg.SearchAPIKeys(md.ToOutgoingContext(ctx), req, userCreds(adminUserIdx))
+		if a.So(err, should.BeNil) {
+			a.So(paginated.APIKeys, should.HaveLength, 1)
+		}
+	})
+}
@@ -0,0 +1,126 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var evtTransferAPIKey = events.Define("api-key.transfer", "Transfer API key")
+
+var (
+	errTransferAPIKeyEntityType = errors.DefineInvalidArgument(
+		"transfer_api_key_entity_type",
+		"API key of entity type `{entity_type}` can not be transferred to entity type `{new_entity_type}`",
+	)
+	errTransferAPIKeyRights = errors.DefinePermissionDenied(
+		"transfer_api_key_rights",
+		"new owner does not have rights `{missing}` of the API key on {entity_type} `{entity_id}`",
+	)
+)
+
+// transferAPIKey transfers the API key with the requested ID to the new owner. The API key keeps its ID, token, name
+// and rights. The new owner must be of the same entity type as the current owner, and must have at least the rights
+// that the API key has on the entities that the current owner is a member of.
+// The caller must have the rights to manage the API keys of both owners and at least the rights of the API key.
+func (is *IdentityServer) transferAPIKey(ctx context.Context, req *ttnpb.TransferAPIKeyRequest) (*ttnpb.APIKey, error) {
+	keyID, newOwner := req.APIKeyID, req.NewOwnerIDs
+	var (
+		ids    *ttnpb.EntityIdentifiers
+		apiKey *ttnpb.APIKey
+	)
+	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		ids, apiKey, err = store.GetAPIKeyStore(db).GetAPIKey(ctx, keyID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if entityType(ids) != entityType(newOwner) {
+		return nil, errTransferAPIKeyEntityType.WithAttributes(
+			"entity_type", entityType(ids),
+			"new_entity_type", entityType(newOwner),
+		)
+	}
+	right, err := manageAPIKeysRight(ids)
+	if err != nil {
+		return nil, err
+	}
+	if err = rights.RequireAny(ctx,
+		rights.Check{IDs: ids, Required: []ttnpb.Right{right}},
+		rights.Check{IDs: newOwner, Required: append([]ttnpb.Right{right}, apiKey.Rights...)},
+	); err != nil {
+		return nil, err
+	}
+	if err = is.requireTransferAPIKeyRights(ctx, ids, newOwner, apiKey); err != nil {
+		return nil, err
+	}
+	var key *ttnpb.APIKey
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		if err = is.requireAPIKeyCapacity(ctx, db, newOwner); err != nil {
+			return err
+		}
+		key, err = store.GetAPIKeyStore(db).TransferAPIKey(ctx, ids, newOwner, keyID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	key.Key = ""
+	is.logAPIKeyOperation(ctx, "Transferred API key", newOwner, keyID, nil, nil)
+	events.Publish(evtTransferAPIKey(ctx, ttnpb.CombineIdentifiers(ids, newOwner), nil))
+	return key, nil
+}
+
+// requireTransferAPIKeyRights checks that the new owner of the API key has at least the rights that the API key has
+// on the entities that the current owner is a member of, limited to the entity scope of the API key.
+func (is *IdentityServer) requireTransferAPIKeyRights(ctx context.Context, ids, newOwner *ttnpb.EntityIdentifiers, apiKey *ttnpb.APIKey) error {
+	keyRights := ttnpb.RightsFrom(apiKey.Rights...).Implied()
+	access, err := is.resolveEntityRights(ctx, ids, keyRights, apiKey.EntityScope)
+	if err != nil {
+		return err
+	}
+	newAccess, err := is.resolveEntityRights(ctx, newOwner, keyRights, apiKey.EntityScope)
+	if err != nil {
+		return err
+	}
+	newRights := make(map[string]*ttnpb.Rights, len(newAccess))
+	for ids, rights := range newAccess {
+		newRights[entityKey(ids)] = rights
+	}
+	owner := entityKey(ids)
+	for entityIDs, rights := range access {
+		key := entityKey(entityIDs)
+		if key == owner {
+			// The rights on the owner itself are the rights of the API key.
+			continue
+		}
+		if missing := rights.Sub(newRights[key]).Sorted().GetRights(); len(missing) > 0 {
+			return errTransferAPIKeyRights.WithAttributes(
+				"entity_type", entityType(entityIDs),
+				"entity_id", entityIDs.IDString(),
+				"missing", missing,
+			)
+		}
+	}
+	return nil
+}
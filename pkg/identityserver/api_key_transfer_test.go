@@ -0,0 +1,136 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+)
+
+func TestTransferAPIKey(t *testing.T) {
+	a := assertions.New(t)
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+		newOwnerID := collaboratorUser.UserIdentifiers
+		ctx := rights.NewContext(test.Context(), rights.Rights{
+			UserRights: map[string]*ttnpb.Rights{
+				userID.UserID:     ttnpb.RightsFrom(ttnpb.RIGHT_ALL).Implied(),
+				newOwnerID.UserID: ttnpb.RightsFrom(ttnpb.RIGHT_ALL).Implied(),
+			},
+		})
+
+		appID := ttnpb.ApplicationIdentifiers{ApplicationID: "transfer-app"}
+		_, err := ttnpb.NewApplicationRegistryClient(cc).Create(test.Context(), &ttnpb.CreateApplicationRequest{
+			Application:  ttnpb.Application{ApplicationIdentifiers: appID},
+			Collaborator: *userID.OrganizationOrUserIdentifiers(),
+		}, creds)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		created, err := is.createUserAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+			UserIdentifiers: userID,
+			Name:            "transferred key",
+			Rights:          []ttnpb.Right{ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_APPLICATION_INFO},
+			EntityScope:     []*ttnpb.EntityIdentifiers{appID.EntityIdentifiers()},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		// The new owner is not a collaborator of the application.
+		_, err = is.transferAPIKey(ctx, &ttnpb.TransferAPIKeyRequest{
+			APIKeyID:    created.ID,
+			NewOwnerIDs: newOwnerID.EntityIdentifiers(),
+		})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		// API keys can only be transferred to entities of the same type.
+		_, err = is.transferAPIKey(ctx, &ttnpb.TransferAPIKeyRequest{
+			APIKeyID:    created.ID,
+			NewOwnerIDs: appID.EntityIdentifiers(),
+		})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		_, err = ttnpb.NewApplicationAccessClient(cc).SetCollaborator(test.Context(), &ttnpb.SetApplicationCollaboratorRequest{
+			ApplicationIdentifiers: appID,
+			Collaborator: ttnpb.Collaborator{
+				OrganizationOrUserIdentifiers: *newOwnerID.OrganizationOrUserIdentifiers(),
+				Rights:                        []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+			},
+		}, creds)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		evtCh := make(events.Channel, 1)
+		events.Subscribe("api-key.transfer", evtCh)
+		defer events.Unsubscribe("api-key.transfer", evtCh)
+
+		transferred, err := ttnpb.NewEntityAccessClient(cc).TransferAPIKey(test.Context(), &ttnpb.TransferAPIKeyRequest{
+			APIKeyID:    created.ID,
+			NewOwnerIDs: newOwnerID.EntityIdentifiers(),
+		}, userCreds(adminUserIdx))
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(transferred.ID, should.Equal, created.ID)
+		a.So(transferred.Name, should.Equal, created.Name)
+		a.So(transferred.Rights, should.Resemble, created.Rights)
+		a.So(transferred.Key, should.BeEmpty)
+
+		select {
+		case evt := <-evtCh:
+			a.So(evt.Name(), should.Equal, "api-key.transfer")
+			a.So(evt.Identifiers().GetEntityIdentifiers(), should.HaveLength, 2)
+		case <-time.After(test.Delay):
+			t.Fatal("Expected transfer event but nothing received")
+		}
+
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			ids, _, err := store.GetAPIKeyStore(db).GetAPIKey(ctx, created.ID)
+			if err != nil {
+				return err
+			}
+			a.So(ids.GetUserIDs().GetUserID(), should.Equal, newOwnerID.UserID)
+			return nil
+		})
+		a.So(err, should.BeNil)
+
+		_, err = is.transferAPIKey(ctx, &ttnpb.TransferAPIKeyRequest{
+			APIKeyID:    "NOTFOUND",
+			NewOwnerIDs: newOwnerID.EntityIdentifiers(),
+		})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+	})
+}
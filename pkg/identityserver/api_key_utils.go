@@ -16,9 +16,15 @@ package identityserver
 
 import (
 	"context"
+	"strings"
 
+	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
 )
 
 func generateAPIKey(ctx context.Context, name string, rights ...ttnpb.Right) (key *ttnpb.APIKey, token string, err error) {
@@ -42,3 +48,87 @@ func generateAPIKey(ctx context.Context, name string, rights ...ttnpb.Right) (ke
 	}
 	return key, token, nil
 }
+
+// ErrTooManyAPIKeys is returned when an API key is created for an entity that already has the maximum number of
+// API keys.
+var ErrTooManyAPIKeys = errors.DefineResourceExhausted(
+	"too_many_api_keys",
+	"{entity_type} `{entity_id}` already has the maximum number of `{max}` API keys",
+)
+
+// requireAPIKeyCapacity checks that the entity has less than the maximum number of API keys.
+// Deleted API keys are not counted.
+func (is *IdentityServer) requireAPIKeyCapacity(ctx context.Context, db *gorm.DB, ids *ttnpb.EntityIdentifiers) error {
+	if is.config.MaxAPIKeys <= 0 {
+		return nil
+	}
+	keys, err := store.GetAPIKeyStore(db).FindAPIKeys(ctx, ids)
+	if err != nil {
+		return err
+	}
+	if len(keys) >= is.config.MaxAPIKeys {
+		return ErrTooManyAPIKeys.WithAttributes(
+			"entity_type", entityType(ids),
+			"entity_id", ids.IDString(),
+			"max", is.config.MaxAPIKeys,
+		)
+	}
+	return nil
+}
+
+// maskAPIKeyID masks all but the first characters of the API key ID, so that it can be logged.
+func maskAPIKeyID(id string) string {
+	const visible = 4
+	if len(id) <= visible {
+		return strings.Repeat("*", len(id))
+	}
+	return id[:visible] + strings.Repeat("*", len(id)-visible)
+}
+
+func entityType(ids *ttnpb.EntityIdentifiers) string {
+	switch ids.GetIds().(type) {
+	case *ttnpb.EntityIdentifiers_ApplicationIDs:
+		return "application"
+	case *ttnpb.EntityIdentifiers_ClientIDs:
+		return "client"
+	case *ttnpb.EntityIdentifiers_DeviceIDs:
+		return "end device"
+	case *ttnpb.EntityIdentifiers_GatewayIDs:
+		return "gateway"
+	case *ttnpb.EntityIdentifiers_OrganizationIDs:
+		return "organization"
+	case *ttnpb.EntityIdentifiers_UserIDs:
+		return "user"
+	}
+	return ""
+}
+
+// logAPIKeyOperation logs an operation on the API key of the entity, with the caller of the request and the rights
+// that were added to and removed from the API key. The API key ID is masked and the API key itself is never logged.
+func (is *IdentityServer) logAPIKeyOperation(ctx context.Context, msg string, entityIDs *ttnpb.EntityIdentifiers, keyID string, oldRights, newRights *ttnpb.Rights) {
+	fields := log.Fields(
+		"entity_type", entityType(entityIDs),
+		"entity_uid", unique.ID(ctx, entityIDs),
+		"api_key_id", maskAPIKeyID(keyID),
+		"rights_added", newRights.Sub(oldRights).Sorted().GetRights(),
+		"rights_removed", oldRights.Sub(newRights).Sorted().GetRights(),
+	)
+	if info, err := is.authInfo(ctx); err == nil {
+		if apiKey := info.GetAPIKey(); apiKey != nil {
+			fields = fields.WithFields(log.Fields(
+				"caller_type", entityType(&apiKey.EntityIDs),
+				"caller_uid", unique.ID(ctx, &apiKey.EntityIDs),
+				"caller_api_key_id", maskAPIKeyID(apiKey.APIKey.ID),
+			))
+		} else if token := info.GetOAuthAccessToken(); token != nil {
+			fields = fields.WithFields(log.Fields(
+				"caller_type", "user",
+				"caller_uid", unique.ID(ctx, token.UserIDs),
+				"caller_client_uid", unique.ID(ctx, token.ClientIDs),
+			))
+		} else if info.UniversalRights != nil {
+			fields = fields.WithField("caller_type", "cluster")
+		}
+	}
+	log.FromContext(ctx).WithFields(fields).Info(msg)
+}
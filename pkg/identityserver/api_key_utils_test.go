@@ -0,0 +1,123 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/log/handler/memory"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestMaskAPIKeyID(t *testing.T) {
+	a := assertions.New(t)
+	a.So(maskAPIKeyID(""), should.Equal, "")
+	a.So(maskAPIKeyID("ABC"), should.Equal, "***")
+	a.So(maskAPIKeyID("ABCDEFGH"), should.Equal, "ABCD****")
+}
+
+func TestAPIKeyOperationLogging(t *testing.T) {
+	a := assertions.New(t)
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID := defaultUser.UserIdentifiers
+		callerKey := userAPIKeys(&userID).APIKeys[0]
+		_, callerKeyID, _, err := auth.SplitToken(callerKey.Key)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		mem := memory.New()
+		logger, err := log.NewLogger(log.WithHandler(mem), log.WithLevel(log.DebugLevel))
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		ctx := log.NewContext(test.Context(), logger)
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+callerKey.Key))
+		ctx = rights.NewContext(ctx, rights.Rights{
+			UserRights: map[string]*ttnpb.Rights{
+				userID.UserID: ttnpb.RightsFrom(ttnpb.RIGHT_USER_ALL).Implied(),
+			},
+		})
+
+		created, err := is.createUserAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+			UserIdentifiers: userID,
+			Name:            "logged key",
+			Rights:          []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		_, err = is.updateUserAPIKey(ctx, &ttnpb.UpdateUserAPIKeyRequest{
+			UserIdentifiers: userID,
+			APIKey: ttnpb.APIKey{
+				ID:     created.ID,
+				Name:   "logged key",
+				Rights: []ttnpb.Right{ttnpb.RIGHT_USER_SETTINGS_BASIC},
+			},
+		})
+		a.So(err, should.BeNil)
+		_, err = is.updateUserAPIKey(ctx, &ttnpb.UpdateUserAPIKeyRequest{
+			UserIdentifiers: userID,
+			APIKey: ttnpb.APIKey{
+				ID: created.ID,
+			},
+		})
+		a.So(err, should.BeNil)
+
+		entries := make(map[string]map[string]interface{})
+		for _, entry := range mem.Entries {
+			fields := entry.Fields().Fields()
+			if _, ok := fields["api_key_id"]; ok {
+				entries[entry.Message()] = fields
+			}
+			// Neither the API keys nor the API key IDs are logged.
+			for _, value := range fields {
+				s := fmt.Sprint(value)
+				a.So(strings.Contains(s, created.Key), should.BeFalse)
+				a.So(strings.Contains(s, callerKey.Key), should.BeFalse)
+				a.So(strings.Contains(s, created.ID), should.BeFalse)
+				a.So(strings.Contains(s, callerKeyID), should.BeFalse)
+			}
+		}
+
+		for _, msg := range []string{"Created API key", "Updated API key", "Deleted API key"} {
+			fields, ok := entries[msg]
+			if !a.So(ok, should.BeTrue) {
+				continue
+			}
+			a.So(fields["entity_type"], should.Equal, "user")
+			a.So(fields["entity_uid"], should.Equal, userID.UserID)
+			a.So(fields["api_key_id"], should.Equal, maskAPIKeyID(created.ID))
+			a.So(fields["caller_type"], should.Equal, "user")
+			a.So(fields["caller_uid"], should.Equal, userID.UserID)
+			a.So(fields["caller_api_key_id"], should.Equal, maskAPIKeyID(callerKeyID))
+		}
+		a.So(entries["Created API key"]["rights_added"], should.Resemble, []ttnpb.Right{ttnpb.RIGHT_USER_INFO})
+		a.So(entries["Updated API key"]["rights_added"], should.Resemble, []ttnpb.Right{ttnpb.RIGHT_USER_SETTINGS_BASIC})
+		a.So(entries["Updated API key"]["rights_removed"], should.Resemble, []ttnpb.Right{ttnpb.RIGHT_USER_INFO})
+		a.So(entries["Deleted API key"]["rights_removed"], should.Resemble, []ttnpb.Right{ttnpb.RIGHT_USER_SETTINGS_BASIC})
+	})
+}
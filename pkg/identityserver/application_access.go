@@ -60,12 +60,16 @@ func (is *IdentityServer) createApplicationAPIKey(ctx context.Context, req *ttnp
 		return nil, err
 	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		if err := is.requireAPIKeyCapacity(ctx, db, req.ApplicationIdentifiers.EntityIdentifiers()); err != nil {
+			return err
+		}
 		return store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.ApplicationIdentifiers.EntityIdentifiers(), key)
 	})
 	if err != nil {
 		return nil, err
 	}
 	key.Key = token
+	is.logAPIKeyOperation(ctx, "Created API key", req.ApplicationIdentifiers.EntityIdentifiers(), key.ID, nil, ttnpb.RightsFrom(key.Rights...))
 	events.Publish(evtCreateApplicationAPIKey(ctx, req.ApplicationIdentifiers, nil))
 	// TODO: Send notification email (https://github.com/TheThingsNetwork/lorawan-stack/issues/72).
 	return key, nil
@@ -98,23 +102,29 @@ func (is *IdentityServer) updateApplicationAPIKey(ctx context.Context, req *ttnp
 	if err = rights.RequireApplication(ctx, req.ApplicationIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
+	var oldRights *ttnpb.Rights
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
-		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.ApplicationIdentifiers.EntityIdentifiers(), &req.APIKey)
+		keyStore := store.GetAPIKeyStore(db)
+		_, oldKey, err := keyStore.GetAPIKey(ctx, req.APIKey.ID)
+		if err != nil {
+			return err
+		}
+		oldRights = ttnpb.RightsFrom(oldKey.Rights...)
+		key, err = keyStore.UpdateAPIKey(ctx, req.ApplicationIdentifiers.EntityIdentifiers(), &req.APIKey)
 		return err
 	})
 	if err != nil {
 		return nil, err
 	}
-	if key == nil {
+	if len(req.Rights) == 0 {
+		is.logAPIKeyOperation(ctx, "Deleted API key", req.ApplicationIdentifiers.EntityIdentifiers(), req.APIKey.ID, oldRights, nil)
+		events.Publish(evtDeleteApplicationAPIKey(ctx, req.ApplicationIdentifiers, nil))
 		return &ttnpb.APIKey{}, nil
 	}
 	key.Key = ""
-	if len(req.Rights) > 0 {
-		events.Publish(evtUpdateApplicationAPIKey(ctx, req.ApplicationIdentifiers, nil))
-		// TODO: Send notification email (https://github.com/TheThingsNetwork/lorawan-stack/issues/72).
-	} else {
-		events.Publish(evtDeleteApplicationAPIKey(ctx, req.ApplicationIdentifiers, nil))
-	}
+	is.logAPIKeyOperation(ctx, "Updated API key", req.ApplicationIdentifiers.EntityIdentifiers(), req.APIKey.ID, oldRights, ttnpb.RightsFrom(req.Rights...))
+	events.Publish(evtUpdateApplicationAPIKey(ctx, req.ApplicationIdentifiers, nil))
+	// TODO: Send notification email (https://github.com/TheThingsNetwork/lorawan-stack/issues/72).
 	return key, nil
 }
 
@@ -36,6 +36,7 @@ var (
 	errUnsupportedAuthorization = errors.DefineUnauthenticated("unsupported_authorization", "Unsupported authorization method")
 	errInvalidAuthorization     = errors.DefinePermissionDenied("invalid_authorization", "invalid authorization")
 	errTokenExpired             = errors.DefineUnauthenticated("token_expired", "access token expired")
+	errAPIKeyExpired            = errors.DefineUnauthenticated("api_key_expired", "API key expired")
 	errOAuthClientRejected      = errors.DefinePermissionDenied("oauth_client_rejected", "OAuth client was rejected")
 	errOAuthClientSuspended     = errors.DefinePermissionDenied("oauth_client_suspended", "OAuth client was suspended")
 )
@@ -108,6 +109,9 @@ func (is *IdentityServer) authInfo(ctx context.Context) (info *ttnpb.AuthInfoRes
 			if !valid {
 				return errInvalidAuthorization
 			}
+			if apiKey.ExpiresAt != nil && apiKey.ExpiresAt.Before(time.Now()) {
+				return errAPIKeyExpired
+			}
 			apiKey.Key = ""
 			apiKey.Rights = ttnpb.RightsFrom(apiKey.Rights...).Implied().GetRights()
 			res.AccessMethod = &ttnpb.AuthInfoResponse_APIKey{
@@ -180,7 +184,7 @@ func (is *IdentityServer) authInfo(ctx context.Context) (info *ttnpb.AuthInfoRes
 	}
 
 	if user != nil {
-		if user.Admin {
+		if user.Admin && !hasEntityScope(res) {
 			res.UniversalRights = ttnpb.AllRights.Implied().Intersect(userRights)
 		}
 
@@ -253,6 +257,15 @@ func restrictRights(info *ttnpb.AuthInfoResponse, rights *ttnpb.Rights) {
 	info.UniversalRights = info.UniversalRights.Intersect(rights)
 }
 
+// hasEntityScope returns whether the authentication is an API key that is restricted to an entity scope.
+// Universal rights are not granted to API keys with an entity scope.
+func hasEntityScope(authInfo *ttnpb.AuthInfoResponse) bool {
+	if apiKey := authInfo.GetAPIKey(); apiKey != nil {
+		return len(apiKey.EntityScope) > 0
+	}
+	return false
+}
+
 func entityRights(authInfo *ttnpb.AuthInfoResponse) (*ttnpb.EntityIdentifiers, *ttnpb.Rights) {
 	if apiKey := authInfo.GetAPIKey(); apiKey != nil {
 		return &apiKey.EntityIDs, ttnpb.RightsFrom(apiKey.Rights...)
@@ -278,6 +291,17 @@ func (is *IdentityServer) entityRights(ctx context.Context, authInfo *ttnpb.Auth
 	if ids == nil {
 		return nil, nil
 	}
+	var scope []*ttnpb.EntityIdentifiers
+	if hasEntityScope(authInfo) {
+		scope = authInfo.GetAPIKey().EntityScope
+	}
+	return is.resolveEntityRights(ctx, ids, rights, scope)
+}
+
+// resolveEntityRights returns the rights on the entity with the given identifiers and on the entities that it is a
+// (direct or indirect) member of, limited to the given rights. If scope is not empty, only the entities in scope
+// are returned.
+func (is *IdentityServer) resolveEntityRights(ctx context.Context, ids *ttnpb.EntityIdentifiers, rights *ttnpb.Rights, scope []*ttnpb.EntityIdentifiers) (map[*ttnpb.EntityIdentifiers]*ttnpb.Rights, error) {
 	entityRights := make(map[*ttnpb.EntityIdentifiers]*ttnpb.Rights)
 	entityRights[ids] = rights
 	memberRights, err := is.memberRights(ctx, ids)
@@ -287,9 +311,27 @@ func (is *IdentityServer) entityRights(ctx context.Context, authInfo *ttnpb.Auth
 	for ids, memberRights := range memberRights {
 		entityRights[ids] = memberRights.Implied().Intersect(rights)
 	}
+	if len(scope) > 0 {
+		entityRights = restrictEntityScope(entityRights, scope)
+	}
 	return entityRights, nil
 }
 
+// restrictEntityScope returns the entity rights of the entities in scope.
+func restrictEntityScope(entityRights map[*ttnpb.EntityIdentifiers]*ttnpb.Rights, scope []*ttnpb.EntityIdentifiers) map[*ttnpb.EntityIdentifiers]*ttnpb.Rights {
+	inScope := make(map[string]bool, len(scope))
+	for _, ids := range scope {
+		inScope[entityType(ids)+":"+ids.IDString()] = true
+	}
+	res := make(map[*ttnpb.EntityIdentifiers]*ttnpb.Rights, len(scope))
+	for ids, rights := range entityRights {
+		if inScope[entityType(ids)+":"+ids.IDString()] {
+			res[ids] = rights
+		}
+	}
+	return res
+}
+
 func (is *IdentityServer) memberRights(ctx context.Context, ids *ttnpb.EntityIdentifiers) (entityRights map[*ttnpb.EntityIdentifiers]*ttnpb.Rights, err error) {
 	var ouIDs *ttnpb.OrganizationOrUserIdentifiers
 	switch ids := ids.Identifiers().(type) {
@@ -340,3 +382,15 @@ type entityAccess struct {
 func (ea *entityAccess) AuthInfo(ctx context.Context, _ *types.Empty) (*ttnpb.AuthInfoResponse, error) {
 	return ea.authInfo(ctx)
 }
+
+func (ea *entityAccess) RotateAPIKey(ctx context.Context, req *ttnpb.RotateAPIKeyRequest) (*ttnpb.APIKey, error) {
+	return ea.rotateAPIKey(ctx, req)
+}
+
+func (ea *entityAccess) GetAPIKeyAccess(ctx context.Context, req *ttnpb.GetAPIKeyAccessRequest) (*ttnpb.APIKeyAccess, error) {
+	return ea.getAPIKeyAccess(ctx, req)
+}
+
+func (ea *entityAccess) TransferAPIKey(ctx context.Context, req *ttnpb.TransferAPIKeyRequest) (*ttnpb.APIKey, error) {
+	return ea.transferAPIKey(ctx, req)
+}
@@ -56,6 +56,11 @@ type Config struct {
 	AuthCache struct {
 		MembershipTTL time.Duration `name:"membership-ttl" description:"TTL of membership caches"`
 	} `name:"auth-cache"`
+	APIKeyJanitor struct {
+		Interval      time.Duration `name:"interval" description:"Interval between purges of expired and deleted API keys (0 disables the janitor)"`
+		RestoreWindow time.Duration `name:"restore-window" description:"Time during which deleted API keys can be restored before they are purged"`
+	} `name:"api-key-janitor"`
+	MaxAPIKeys     int          `name:"max-api-keys" description:"Maximum number of API keys per entity (0 is unlimited)"`
 	OAuth          oauth.Config `name:"oauth"`
 	ProfilePicture struct {
 		UseGravatar bool   `name:"use-gravatar" description:"Use Gravatar fallback for users without profile picture"`
@@ -129,6 +134,7 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 	c.AddContextFiller(func(ctx context.Context) context.Context {
 		ctx = is.withRequestAccessCache(ctx)
 		ctx = rights.NewContextWithFetcher(ctx, is)
+		ctx = rights.NewContextWithCache(ctx)
 		return ctx
 	})
 
@@ -153,6 +159,10 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 	}
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.EntityAccess", cluster.HookName, c.ClusterAuthUnaryHook())
 
+	if interval := is.config.APIKeyJanitor.Interval; interval > 0 {
+		c.RegisterTask("api_key_janitor", is.purgeExpiredAPIKeys, component.TaskRestartAlways, interval)
+	}
+
 	c.RegisterGRPC(is)
 	c.RegisterWeb(is.oauth)
 
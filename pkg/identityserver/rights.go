@@ -102,3 +102,25 @@ func (is *IdentityServer) UserRights(ctx context.Context, userIDs ttnpb.UserIden
 	}
 	return universal, nil
 }
+
+// EntityRights returns the rights the caller has on the given entities.
+// The rights of the caller are resolved once for all entities.
+func (is *IdentityServer) EntityRights(ctx context.Context, ids ...*ttnpb.EntityIdentifiers) ([]*ttnpb.Rights, error) {
+	entity, universal, err := is.getRights(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*ttnpb.Rights, len(entity))
+	for entityIDs, rights := range entity {
+		byID[entityType(entityIDs)+":"+entityIDs.IDString()] = rights
+	}
+	res := make([]*ttnpb.Rights, len(ids))
+	for i, ids := range ids {
+		if rights, ok := byID[entityType(ids)+":"+ids.IDString()]; ok {
+			res[i] = rights.Union(universal)
+		} else {
+			res[i] = universal
+		}
+	}
+	return res, nil
+}
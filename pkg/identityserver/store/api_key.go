@@ -14,11 +14,16 @@
 
 package store
 
-import "go.thethings.network/lorawan-stack/pkg/ttnpb"
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
 
 // APIKey model.
 type APIKey struct {
 	Model
+	SoftDelete
 
 	APIKeyID string `gorm:"type:VARCHAR;unique_index:api_key_id_index"`
 
@@ -26,6 +31,10 @@ type APIKey struct {
 	Rights Rights `gorm:"type:INT ARRAY"`
 	Name   string `gorm:"type:VARCHAR"`
 
+	ExpiresAt *time.Time `gorm:"index:api_key_expires_at_index"`
+
+	EntityScope EntityScope `gorm:"type:VARCHAR ARRAY"`
+
 	EntityID   string `gorm:"type:UUID;index:api_key_entity_index;not null"`
 	EntityType string `gorm:"type:VARCHAR(32);index:api_key_entity_index;not null"`
 }
@@ -36,9 +45,13 @@ func init() {
 
 func (k APIKey) toPB() *ttnpb.APIKey {
 	return &ttnpb.APIKey{
-		ID:     k.APIKeyID,
-		Key:    k.Key,
-		Name:   k.Name,
-		Rights: k.Rights.Rights,
+		ID:        k.APIKeyID,
+		Key:       k.Key,
+		Name:      k.Name,
+		Rights:    k.Rights.Rights,
+		ExpiresAt: cleanTimePtr(k.ExpiresAt),
+		DeletedAt: cleanTimePtr(k.DeletedAt),
+
+		EntityScope: k.EntityScope,
 	}
 }
@@ -16,6 +16,8 @@ package store
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/errors"
@@ -37,12 +39,14 @@ func (s *apiKeyStore) CreateAPIKey(ctx context.Context, entityID *ttnpb.EntityId
 		return err
 	}
 	model := APIKey{
-		APIKeyID:   key.ID,
-		Key:        key.Key,
-		Rights:     Rights{Rights: key.Rights},
-		Name:       key.Name,
-		EntityID:   entity.PrimaryKey(),
-		EntityType: entityTypeForID(entityID),
+		APIKeyID:    key.ID,
+		Key:         key.Key,
+		Rights:      Rights{Rights: key.Rights},
+		Name:        key.Name,
+		ExpiresAt:   cleanTimePtr(key.ExpiresAt),
+		EntityScope: EntityScope(key.EntityScope),
+		EntityID:    entity.PrimaryKey(),
+		EntityType:  entityTypeForID(entityID),
 	}
 	model.SetContext(ctx)
 	return s.db.Create(&model).Error
@@ -64,6 +68,26 @@ func (s *apiKeyStore) FindAPIKeys(ctx context.Context, entityID *ttnpb.EntityIde
 	return keyProtos, nil
 }
 
+func (s *apiKeyStore) FindDeletedAPIKeys(ctx context.Context, entityID *ttnpb.EntityIdentifiers) ([]*ttnpb.APIKey, error) {
+	entity, err := findEntity(ctx, s.db, entityID, "id")
+	if err != nil {
+		return nil, err
+	}
+	var keyModels []APIKey
+	err = s.db.Unscoped().Scopes(withContext(ctx)).Where(APIKey{
+		EntityID:   entity.PrimaryKey(),
+		EntityType: entityTypeForID(entityID),
+	}).Where("deleted_at IS NOT NULL").Find(&keyModels).Error
+	if err != nil {
+		return nil, err
+	}
+	keyProtos := make([]*ttnpb.APIKey, len(keyModels))
+	for i, apiKey := range keyModels {
+		keyProtos[i] = apiKey.toPB()
+	}
+	return keyProtos, nil
+}
+
 var errAPIKeyEntity = errors.DefineCorruption("api_key_entity", "API key not linked to an entity")
 
 func (s *apiKeyStore) GetAPIKey(ctx context.Context, id string) (*ttnpb.EntityIdentifiers, *ttnpb.APIKey, error) {
@@ -89,6 +113,48 @@ func (s *apiKeyStore) GetAPIKey(ctx context.Context, id string) (*ttnpb.EntityId
 	return ids, keyModel.toPB(), nil
 }
 
+func (s *apiKeyStore) FindAPIKeysWithRights(ctx context.Context, rights ...ttnpb.Right) ([]*ttnpb.EntityIdentifiers, []*ttnpb.APIKey, error) {
+	if len(rights) == 0 {
+		return nil, nil, nil
+	}
+	conditions := make([]string, len(rights))
+	values := make([]interface{}, len(rights))
+	for i, right := range rights {
+		conditions[i] = "? = ANY(rights)"
+		values[i] = int64(right)
+	}
+	query := s.db.Scopes(withContext(ctx)).Where(strings.Join(conditions, " OR "), values...).Order("created_at")
+	if limit, offset := limitAndOffsetFromContext(ctx); limit != 0 {
+		countTotal(ctx, query.Model(&APIKey{}))
+		query = query.Limit(limit).Offset(offset)
+	}
+	var keyModels []APIKey
+	query = query.Find(&keyModels)
+	setTotal(ctx, uint64(len(keyModels)))
+	if query.Error != nil {
+		return nil, nil, query.Error
+	}
+	entities := make([]polymorphicEntity, len(keyModels))
+	for i, keyModel := range keyModels {
+		entities[i] = polymorphicEntity{EntityType: keyModel.EntityType, EntityUUID: keyModel.EntityID}
+	}
+	identifiers, err := identifiers(s.db, entities...)
+	if err != nil {
+		return nil, nil, err
+	}
+	entityIDs := make([]*ttnpb.EntityIdentifiers, len(keyModels))
+	keyProtos := make([]*ttnpb.APIKey, len(keyModels))
+	for i, keyModel := range keyModels {
+		ids, ok := identifiers[entities[i]]
+		if !ok {
+			return nil, nil, errAPIKeyEntity
+		}
+		entityIDs[i] = ids
+		keyProtos[i] = keyModel.toPB()
+	}
+	return entityIDs, keyProtos, nil
+}
+
 func (s *apiKeyStore) UpdateAPIKey(ctx context.Context, entityID *ttnpb.EntityIdentifiers, key *ttnpb.APIKey) (*ttnpb.APIKey, error) {
 	entity, err := findEntity(ctx, s.db, entityID, "id")
 	if err != nil {
@@ -107,7 +173,7 @@ func (s *apiKeyStore) UpdateAPIKey(ctx context.Context, entityID *ttnpb.EntityId
 		return nil, err
 	}
 	if len(key.Rights) == 0 {
-		return nil, s.db.Delete(&APIKey{}).Error
+		return nil, s.db.Delete(&keyModel).Error
 	}
 	keyModel.Name = key.Name
 	keyModel.Rights = Rights{Rights: key.Rights}
@@ -116,3 +182,96 @@ func (s *apiKeyStore) UpdateAPIKey(ctx context.Context, entityID *ttnpb.EntityId
 	}
 	return keyModel.toPB(), nil
 }
+
+func (s *apiKeyStore) RotateAPIKey(ctx context.Context, entityID *ttnpb.EntityIdentifiers, key *ttnpb.APIKey) (*ttnpb.APIKey, error) {
+	entity, err := findEntity(ctx, s.db, entityID, "id")
+	if err != nil {
+		return nil, err
+	}
+	var keyModel APIKey
+	err = s.db.Where(APIKey{
+		APIKeyID:   key.ID,
+		EntityID:   entity.PrimaryKey(),
+		EntityType: entityTypeForID(entityID),
+	}).First(&keyModel).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errAPIKeyNotFound
+		}
+		return nil, err
+	}
+	keyModel.Key = key.Key
+	if err = s.db.Model(&keyModel).Select("key").Updates(&keyModel).Error; err != nil {
+		return nil, err
+	}
+	return keyModel.toPB(), nil
+}
+
+func (s *apiKeyStore) TransferAPIKey(ctx context.Context, entityID, newEntityID *ttnpb.EntityIdentifiers, id string) (*ttnpb.APIKey, error) {
+	entity, err := findEntity(ctx, s.db, entityID, "id")
+	if err != nil {
+		return nil, err
+	}
+	newEntity, err := findEntity(ctx, s.db, newEntityID, "id")
+	if err != nil {
+		return nil, err
+	}
+	var keyModel APIKey
+	err = s.db.Where(APIKey{
+		APIKeyID:   id,
+		EntityID:   entity.PrimaryKey(),
+		EntityType: entityTypeForID(entityID),
+	}).First(&keyModel).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errAPIKeyNotFound
+		}
+		return nil, err
+	}
+	keyModel.EntityID = newEntity.PrimaryKey()
+	keyModel.EntityType = entityTypeForID(newEntityID)
+	if err = s.db.Model(&keyModel).Select("entity_id", "entity_type").Updates(&keyModel).Error; err != nil {
+		return nil, err
+	}
+	return keyModel.toPB(), nil
+}
+
+func (s *apiKeyStore) RestoreAPIKey(ctx context.Context, entityID *ttnpb.EntityIdentifiers, id string, deletedAfter time.Time) (*ttnpb.APIKey, error) {
+	entity, err := findEntity(ctx, s.db, entityID, "id")
+	if err != nil {
+		return nil, err
+	}
+	var keyModel APIKey
+	err = s.db.Unscoped().Scopes(withContext(ctx)).Where(APIKey{
+		APIKeyID:   id,
+		EntityID:   entity.PrimaryKey(),
+		EntityType: entityTypeForID(entityID),
+	}).Where("deleted_at >= ?", cleanTime(deletedAfter)).First(&keyModel).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errAPIKeyNotFound
+		}
+		return nil, err
+	}
+	if err = s.db.Unscoped().Scopes(withContext(ctx)).Model(&keyModel).Update("deleted_at", nil).Error; err != nil {
+		return nil, err
+	}
+	keyModel.DeletedAt = nil
+	return keyModel.toPB(), nil
+}
+
+func (s *apiKeyStore) DeleteExpiredAPIKeys(ctx context.Context, before time.Time) (int, error) {
+	res := s.db.Unscoped().Scopes(withContext(ctx)).Where("expires_at < ?", cleanTime(before)).Delete(&APIKey{})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return int(res.RowsAffected), nil
+}
+
+func (s *apiKeyStore) PurgeDeletedAPIKeys(ctx context.Context, before time.Time) (int, error) {
+	res := s.db.Unscoped().Scopes(withContext(ctx)).Where("deleted_at < ?", cleanTime(before)).Delete(&APIKey{})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return int(res.RowsAffected), nil
+}
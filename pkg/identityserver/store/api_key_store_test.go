@@ -18,11 +18,13 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	"github.com/smartystreets/assertions"
 	"github.com/smartystreets/assertions/should"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 )
@@ -54,6 +56,7 @@ func TestAPIKeyStore(t *testing.T) {
 			Name        string
 			Identifiers *ttnpb.EntityIdentifiers
 			Rights      []ttnpb.Right
+			EntityScope []*ttnpb.EntityIdentifiers
 		}{
 			{
 				Name:        "Application",
@@ -75,6 +78,12 @@ func TestAPIKeyStore(t *testing.T) {
 				Identifiers: userIDs.EntityIdentifiers(),
 				Rights:      []ttnpb.Right{ttnpb.RIGHT_APPLICATION_ALL, ttnpb.RIGHT_GATEWAY_ALL},
 			},
+			{
+				Name:        "ScopedUser",
+				Identifiers: userIDs.EntityIdentifiers(),
+				Rights:      []ttnpb.Right{ttnpb.RIGHT_APPLICATION_ALL, ttnpb.RIGHT_GATEWAY_ALL},
+				EntityScope: []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers(), gtwIDs.EntityIdentifiers()},
+			},
 		} {
 			t.Run(tt.Name, func(t *testing.T) {
 				a := assertions.New(t)
@@ -84,6 +93,8 @@ func TestAPIKeyStore(t *testing.T) {
 					Key:    strings.ToUpper(fmt.Sprintf("%sKEY", tt.Name)),
 					Name:   fmt.Sprintf("%s API key", tt.Name),
 					Rights: tt.Rights,
+
+					EntityScope: tt.EntityScope,
 				}
 
 				err := store.CreateAPIKey(ctx, tt.Identifiers, key)
@@ -129,7 +140,124 @@ func TestAPIKeyStore(t *testing.T) {
 				keys, err = store.FindAPIKeys(ctx, tt.Identifiers)
 				a.So(err, should.BeNil)
 				a.So(keys, should.HaveLength, 0)
+
+				keys, err = store.FindDeletedAPIKeys(ctx, tt.Identifiers)
+				a.So(err, should.BeNil)
+				if a.So(keys, should.HaveLength, 1) {
+					a.So(keys[0].ID, should.Equal, key.ID)
+					a.So(keys[0].DeletedAt, should.NotBeNil)
+				}
+
+				_, err = store.RestoreAPIKey(ctx, tt.Identifiers, key.ID, time.Now().Add(time.Hour))
+				if a.So(err, should.NotBeNil) {
+					a.So(errors.IsNotFound(err), should.BeTrue)
+				}
+
+				restored, err := store.RestoreAPIKey(ctx, tt.Identifiers, key.ID, time.Now().Add(-time.Hour))
+				a.So(err, should.BeNil)
+				if a.So(restored, should.NotBeNil) {
+					a.So(restored.DeletedAt, should.BeNil)
+				}
+
+				_, got, err = store.GetAPIKey(ctx, key.ID)
+				a.So(err, should.BeNil)
+				a.So(got.Rights, should.Resemble, key.Rights)
+
+				_, err = store.UpdateAPIKey(ctx, tt.Identifiers, &ttnpb.APIKey{
+					ID: strings.ToUpper(fmt.Sprintf("%sKEYID", tt.Name)),
+					// Empty rights
+				})
+				a.So(err, should.BeNil)
+
+				purged, err := store.PurgeDeletedAPIKeys(ctx, time.Now().Add(time.Hour))
+				a.So(err, should.BeNil)
+				a.So(purged, should.Equal, 1)
+
+				_, err = store.RestoreAPIKey(ctx, tt.Identifiers, key.ID, time.Now().Add(-time.Hour))
+				if a.So(err, should.NotBeNil) {
+					a.So(errors.IsNotFound(err), should.BeTrue)
+				}
+
+				keys, err = store.FindDeletedAPIKeys(ctx, tt.Identifiers)
+				a.So(err, should.BeNil)
+				a.So(keys, should.HaveLength, 0)
 			})
 		}
 	})
 }
+
+func TestAPIKeyStoreFindWithRights(t *testing.T) {
+	ctx := test.Context()
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db,
+			&APIKey{},
+			&Account{}, &User{}, &Organization{},
+			&Application{}, &Client{}, &Gateway{},
+		)
+		store := GetAPIKeyStore(db)
+		a := assertions.New(t)
+
+		db.Create(&User{Account: Account{UID: "test-user"}})
+		userIDs := &ttnpb.UserIdentifiers{UserID: "test-user"}
+
+		db.Create(&Application{ApplicationID: "test-app"})
+		appIDs := &ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+
+		for _, tt := range []struct {
+			ID          string
+			Identifiers *ttnpb.EntityIdentifiers
+			Rights      []ttnpb.Right
+		}{
+			{ID: "APPALLKEYID", Identifiers: appIDs.EntityIdentifiers(), Rights: []ttnpb.Right{ttnpb.RIGHT_APPLICATION_ALL}},
+			{ID: "APPINFOKEYID", Identifiers: appIDs.EntityIdentifiers(), Rights: []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO}},
+			{ID: "USERALLKEYID", Identifiers: userIDs.EntityIdentifiers(), Rights: []ttnpb.Right{ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_APPLICATION_ALL}},
+			{ID: "USERINFOKEYID", Identifiers: userIDs.EntityIdentifiers(), Rights: []ttnpb.Right{ttnpb.RIGHT_USER_INFO}},
+			{ID: "DELETEDKEYID", Identifiers: userIDs.EntityIdentifiers(), Rights: []ttnpb.Right{ttnpb.RIGHT_APPLICATION_ALL}},
+		} {
+			err := store.CreateAPIKey(ctx, tt.Identifiers, &ttnpb.APIKey{
+				ID:     tt.ID,
+				Key:    strings.TrimSuffix(tt.ID, "ID"),
+				Name:   tt.ID,
+				Rights: tt.Rights,
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			time.Sleep(test.Delay) // Keys are returned in order of creation.
+		}
+		_, err := store.UpdateAPIKey(ctx, userIDs.EntityIdentifiers(), &ttnpb.APIKey{ID: "DELETEDKEYID"})
+		a.So(err, should.BeNil)
+
+		ids, keys, err := store.FindAPIKeysWithRights(ctx, ttnpb.RIGHT_APPLICATION_ALL)
+		a.So(err, should.BeNil)
+		a.So(ids, should.Resemble, []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers(), userIDs.EntityIdentifiers()})
+		if a.So(keys, should.HaveLength, 2) {
+			a.So(keys[0].ID, should.Equal, "APPALLKEYID")
+			a.So(keys[1].ID, should.Equal, "USERALLKEYID")
+		}
+
+		ids, keys, err = store.FindAPIKeysWithRights(ctx, ttnpb.RIGHT_APPLICATION_INFO, ttnpb.RIGHT_USER_INFO)
+		a.So(err, should.BeNil)
+		if a.So(keys, should.HaveLength, 3) {
+			a.So(keys[0].ID, should.Equal, "APPINFOKEYID")
+			a.So(keys[1].ID, should.Equal, "USERALLKEYID")
+			a.So(keys[2].ID, should.Equal, "USERINFOKEYID")
+		}
+
+		ids, keys, err = store.FindAPIKeysWithRights(ctx, ttnpb.RIGHT_GATEWAY_ALL)
+		a.So(err, should.BeNil)
+		a.So(ids, should.BeEmpty)
+		a.So(keys, should.BeEmpty)
+
+		var total uint64
+		paginatedCtx := SetTotalCount(rpcmetadata.MD{Limit: 1, Page: 2}.ToIncomingContext(ctx), &total)
+		ids, keys, err = store.FindAPIKeysWithRights(paginatedCtx, ttnpb.RIGHT_APPLICATION_ALL)
+		a.So(err, should.BeNil)
+		a.So(total, should.Equal, 2)
+		a.So(ids, should.Resemble, []*ttnpb.EntityIdentifiers{userIDs.EntityIdentifiers()})
+		if a.So(keys, should.HaveLength, 1) {
+			a.So(keys[0].ID, should.Equal, "USERALLKEYID")
+		}
+	})
+}
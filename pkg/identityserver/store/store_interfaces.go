@@ -16,6 +16,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -130,12 +131,28 @@ type MembershipStore interface {
 type APIKeyStore interface {
 	// Create a new API key for the given entity.
 	CreateAPIKey(ctx context.Context, entityID *ttnpb.EntityIdentifiers, key *ttnpb.APIKey) error
-	// Find API keys of the given entity.
+	// Find API keys of the given entity. Deleted API keys are not included.
 	FindAPIKeys(ctx context.Context, entityID *ttnpb.EntityIdentifiers) ([]*ttnpb.APIKey, error)
+	// Find deleted API keys of the given entity that are not yet purged.
+	FindDeletedAPIKeys(ctx context.Context, entityID *ttnpb.EntityIdentifiers) ([]*ttnpb.APIKey, error)
 	// Get an API key by its ID.
 	GetAPIKey(ctx context.Context, id string) (*ttnpb.EntityIdentifiers, *ttnpb.APIKey, error)
+	// Find API keys that include any of the given rights, with the identifiers of the entities they belong to (in the
+	// same order). Deleted API keys are not included. The results are paginated.
+	FindAPIKeysWithRights(ctx context.Context, rights ...ttnpb.Right) ([]*ttnpb.EntityIdentifiers, []*ttnpb.APIKey, error)
 	// Update key rights on an entity. Rights can be deleted by not passing any rights, in which case the returned API key will be nil.
+	// Deleted API keys can be restored until they are purged.
 	UpdateAPIKey(ctx context.Context, entityID *ttnpb.EntityIdentifiers, key *ttnpb.APIKey) (*ttnpb.APIKey, error)
+	// Replace the (hashed) key of an API key of an entity, keeping its ID, name and rights.
+	RotateAPIKey(ctx context.Context, entityID *ttnpb.EntityIdentifiers, key *ttnpb.APIKey) (*ttnpb.APIKey, error)
+	// Transfer an API key of an entity to another entity, keeping its ID, key and rights.
+	TransferAPIKey(ctx context.Context, entityID, newEntityID *ttnpb.EntityIdentifiers, id string) (*ttnpb.APIKey, error)
+	// Restore an API key of an entity that was deleted after the given time.
+	RestoreAPIKey(ctx context.Context, entityID *ttnpb.EntityIdentifiers, id string, deletedAfter time.Time) (*ttnpb.APIKey, error)
+	// Delete API keys that expired before the given time. Returns the number of deleted API keys.
+	DeleteExpiredAPIKeys(ctx context.Context, before time.Time) (int, error)
+	// Purge API keys that were deleted before the given time. Returns the number of purged API keys.
+	PurgeDeletedAPIKeys(ctx context.Context, before time.Time) (int, error)
 }
 
 // OAuthStore interface for the OAuth server.
@@ -17,6 +17,7 @@ package store
 import (
 	"database/sql/driver"
 	"fmt"
+	"strings"
 
 	"github.com/lib/pq"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -121,6 +122,63 @@ func (r *Rights) Scan(src interface{}) error {
 	return nil
 }
 
+// EntityScope adds methods on a []*ttnpb.EntityIdentifiers so that it can be stored in an SQL database.
+// Each entity is stored as "<entity type>:<entity ID>".
+type EntityScope []*ttnpb.EntityIdentifiers
+
+// Value returns the value to store in the database.
+func (s EntityScope) Value() (driver.Value, error) {
+	strs := make([]string, len(s))
+	for i, ids := range s {
+		strs[i] = fmt.Sprintf("%s:%s", entityTypeForID(ids), ids.IDString())
+	}
+	return pq.StringArray(strs).Value()
+}
+
+// Scan reads the value from the database into the EntityScope.
+func (s *EntityScope) Scan(src interface{}) error {
+	var strs pq.StringArray
+	if err := strs.Scan(src); err != nil {
+		return err
+	}
+	if len(strs) == 0 {
+		*s = nil
+		return nil
+	}
+	scope := make(EntityScope, len(strs))
+	for i, str := range strs {
+		parts := strings.SplitN(str, ":", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid entity in scope: %s", str)
+		}
+		switch parts[0] {
+		case "application":
+			scope[i] = ttnpb.ApplicationIdentifiers{ApplicationID: parts[1]}.EntityIdentifiers()
+		case "client":
+			scope[i] = ttnpb.ClientIdentifiers{ClientID: parts[1]}.EntityIdentifiers()
+		case "device":
+			devParts := strings.SplitN(parts[1], ":", 2)
+			if len(devParts) != 2 {
+				return fmt.Errorf("invalid end device in scope: %s", str)
+			}
+			scope[i] = ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: devParts[0]},
+				DeviceID:               devParts[1],
+			}.EntityIdentifiers()
+		case "gateway":
+			scope[i] = ttnpb.GatewayIdentifiers{GatewayID: parts[1]}.EntityIdentifiers()
+		case "organization":
+			scope[i] = ttnpb.OrganizationIdentifiers{OrganizationID: parts[1]}.EntityIdentifiers()
+		case "user":
+			scope[i] = ttnpb.UserIdentifiers{UserID: parts[1]}.EntityIdentifiers()
+		default:
+			return fmt.Errorf("invalid entity type in scope: %s", parts[0])
+		}
+	}
+	*s = scope
+	return nil
+}
+
 // Location can be embedded in other models.
 type Location struct {
 	Latitude  float64
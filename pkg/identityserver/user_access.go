@@ -16,6 +16,7 @@ package identityserver
 
 import (
 	"context"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
@@ -26,9 +27,13 @@ import (
 )
 
 var (
-	evtCreateUserAPIKey = events.Define("user.api-key.create", "Create user API key")
-	evtUpdateUserAPIKey = events.Define("user.api-key.update", "Update user API key")
-	evtDeleteUserAPIKey = events.Define("user.api-key.delete", "Delete user API key")
+	evtCreateUserAPIKey  = events.Define("user.api-key.create", "Create user API key")
+	evtUpdateUserAPIKey  = events.Define("user.api-key.update", "Update user API key")
+	evtDeleteUserAPIKey  = events.Define("user.api-key.delete", "Delete user API key")
+	evtRestoreUserAPIKey = events.Define("user.api-key.restore", "Restore user API key")
+
+	evtAddUserAPIKeyRights    = events.Define("user.api-key.rights.add", "Add rights to user API key")
+	evtRemoveUserAPIKeyRights = events.Define("user.api-key.rights.remove", "Remove rights from user API key")
 )
 
 func (is *IdentityServer) listUserRights(ctx context.Context, ids *ttnpb.UserIdentifiers) (*ttnpb.Rights, error) {
@@ -43,39 +48,82 @@ func (is *IdentityServer) listUserRights(ctx context.Context, ids *ttnpb.UserIde
 	return usrRights, nil
 }
 
+// expandUserAPIKeyRights replaces the RIGHT_ALL_READ pseudo-right in the requested rights by the concrete read rights
+// that the caller has on the user.
+func (is *IdentityServer) expandUserAPIKeyRights(ctx context.Context, ids *ttnpb.UserIdentifiers, requested []ttnpb.Right) ([]ttnpb.Right, error) {
+	requestedRights := ttnpb.RightsFrom(requested...)
+	if !requestedRights.IncludesAll(ttnpb.RIGHT_ALL_READ) {
+		return requested, nil
+	}
+	usrRights, err := is.listUserRights(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	readRights := usrRights.Implied().Intersect(ttnpb.AllReadRights)
+	if err := rights.RequireUser(ctx, *ids, readRights.GetRights()...); err != nil {
+		return nil, err
+	}
+	return requestedRights.Sub(ttnpb.RightsFrom(ttnpb.RIGHT_ALL_READ)).Union(readRights).Sorted().GetRights(), nil
+}
+
 func (is *IdentityServer) createUserAPIKey(ctx context.Context, req *ttnpb.CreateUserAPIKeyRequest) (key *ttnpb.APIKey, err error) {
-	// Require that caller has rights to manage API keys.
-	if err = rights.RequireUser(ctx, req.UserIdentifiers, ttnpb.RIGHT_USER_SETTINGS_API_KEYS); err != nil {
+	if req.Rights, err = is.expandUserAPIKeyRights(ctx, &req.UserIdentifiers, req.Rights); err != nil {
 		return nil, err
 	}
-	// Require that caller has at least the rights of the API key.
-	if err = rights.RequireUser(ctx, req.UserIdentifiers, req.Rights...); err != nil {
+	// Require that caller has rights to manage API keys and at least the rights of the API key.
+	if err = rights.RequireAny(ctx,
+		rights.Check{IDs: req.UserIdentifiers.EntityIdentifiers(), Required: []ttnpb.Right{ttnpb.RIGHT_USER_SETTINGS_API_KEYS}},
+		rights.Check{IDs: req.UserIdentifiers.EntityIdentifiers(), Required: req.Rights},
+	); err != nil {
 		return nil, err
 	}
+	if req.DryRun {
+		return &ttnpb.APIKey{
+			Name:        req.Name,
+			Rights:      req.Rights,
+			EntityScope: req.EntityScope,
+		}, nil
+	}
 	key, token, err := generateAPIKey(ctx, req.Name, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
+	key.EntityScope = req.EntityScope
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		if err := is.requireAPIKeyCapacity(ctx, db, req.UserIdentifiers.EntityIdentifiers()); err != nil {
+			return err
+		}
 		return store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.UserIdentifiers.EntityIdentifiers(), key)
 	})
 	if err != nil {
 		return nil, err
 	}
 	key.Key = token
+	is.logAPIKeyOperation(ctx, "Created API key", req.UserIdentifiers.EntityIdentifiers(), key.ID, nil, ttnpb.RightsFrom(key.Rights...))
 	events.Publish(evtCreateUserAPIKey(ctx, req.UserIdentifiers, nil))
 	// TODO: Send notification email (https://github.com/TheThingsNetwork/lorawan-stack/issues/72).
 	return key, nil
 }
 
-func (is *IdentityServer) listUserAPIKeys(ctx context.Context, ids *ttnpb.UserIdentifiers) (keys *ttnpb.APIKeys, err error) {
+// listUserAPIKeys lists the API keys of the user. Deleted API keys that can still be restored are only included if
+// includeDeleted is set.
+func (is *IdentityServer) listUserAPIKeys(ctx context.Context, ids *ttnpb.UserIdentifiers, includeDeleted bool) (keys *ttnpb.APIKeys, err error) {
 	if err = rights.RequireUser(ctx, *ids, ttnpb.RIGHT_USER_SETTINGS_API_KEYS); err != nil {
 		return nil, err
 	}
 	keys = &ttnpb.APIKeys{}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
-		keys.APIKeys, err = store.GetAPIKeyStore(db).FindAPIKeys(ctx, ids.EntityIdentifiers())
-		return err
+		keyStore := store.GetAPIKeyStore(db)
+		keys.APIKeys, err = keyStore.FindAPIKeys(ctx, ids.EntityIdentifiers())
+		if err != nil || !includeDeleted {
+			return err
+		}
+		deleted, err := keyStore.FindDeletedAPIKeys(ctx, ids.EntityIdentifiers())
+		if err != nil {
+			return err
+		}
+		keys.APIKeys = append(keys.APIKeys, deleted...)
+		return nil
 	})
 	if err != nil {
 		return nil, err
@@ -87,31 +135,67 @@ func (is *IdentityServer) listUserAPIKeys(ctx context.Context, ids *ttnpb.UserId
 }
 
 func (is *IdentityServer) updateUserAPIKey(ctx context.Context, req *ttnpb.UpdateUserAPIKeyRequest) (key *ttnpb.APIKey, err error) {
-	// Require that caller has rights to manage API keys.
-	if err = rights.RequireUser(ctx, req.UserIdentifiers, ttnpb.RIGHT_USER_SETTINGS_API_KEYS); err != nil {
-		return nil, err
-	}
-	// Require that caller has at least the rights of the API key.
-	if err = rights.RequireUser(ctx, req.UserIdentifiers, req.Rights...); err != nil {
+	// Require that caller has rights to manage API keys and at least the rights of the API key.
+	if err = rights.RequireAny(ctx,
+		rights.Check{IDs: req.UserIdentifiers.EntityIdentifiers(), Required: []ttnpb.Right{ttnpb.RIGHT_USER_SETTINGS_API_KEYS}},
+		rights.Check{IDs: req.UserIdentifiers.EntityIdentifiers(), Required: req.Rights},
+	); err != nil {
 		return nil, err
 	}
+	var oldRights *ttnpb.Rights
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
-		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.UserIdentifiers.EntityIdentifiers(), &req.APIKey)
+		keyStore := store.GetAPIKeyStore(db)
+		_, oldKey, err := keyStore.GetAPIKey(ctx, req.APIKey.ID)
+		if err != nil {
+			return err
+		}
+		oldRights = ttnpb.RightsFrom(oldKey.Rights...)
+		key, err = keyStore.UpdateAPIKey(ctx, req.UserIdentifiers.EntityIdentifiers(), &req.APIKey)
 		return err
 	})
 	if err != nil {
 		return nil, err
 	}
-	if key == nil {
+	if len(req.Rights) == 0 {
+		is.logAPIKeyOperation(ctx, "Deleted API key", req.UserIdentifiers.EntityIdentifiers(), req.APIKey.ID, oldRights, nil)
+		events.Publish(evtDeleteUserAPIKey(ctx, req.UserIdentifiers, nil))
 		return &ttnpb.APIKey{}, nil
 	}
 	key.Key = ""
-	if len(req.Rights) > 0 {
-		events.Publish(evtUpdateUserAPIKey(ctx, req.UserIdentifiers, nil))
-		// TODO: Send notification email (https://github.com/TheThingsNetwork/lorawan-stack/issues/72).
-	} else {
-		events.Publish(evtDeleteUserAPIKey(ctx, req.UserIdentifiers, nil))
+	newRights := ttnpb.RightsFrom(req.Rights...)
+	is.logAPIKeyOperation(ctx, "Updated API key", req.UserIdentifiers.EntityIdentifiers(), req.APIKey.ID, oldRights, newRights)
+	events.Publish(evtUpdateUserAPIKey(ctx, req.UserIdentifiers, nil))
+	// Publish the added and removed rights separately, so that expanded API keys can be audited.
+	if added := newRights.Sub(oldRights).Sorted(); len(added.GetRights()) > 0 {
+		events.Publish(evtAddUserAPIKeyRights(ctx, req.UserIdentifiers, added))
+	}
+	if removed := oldRights.Sub(newRights).Sorted(); len(removed.GetRights()) > 0 {
+		events.Publish(evtRemoveUserAPIKeyRights(ctx, req.UserIdentifiers, removed))
 	}
+	// TODO: Send notification email (https://github.com/TheThingsNetwork/lorawan-stack/issues/72).
+	return key, nil
+}
+
+// restoreUserAPIKey restores a deleted API key of the user, if it was deleted within the restore window.
+func (is *IdentityServer) restoreUserAPIKey(ctx context.Context, ids *ttnpb.UserIdentifiers, id string) (key *ttnpb.APIKey, err error) {
+	if err = rights.RequireUser(ctx, *ids, ttnpb.RIGHT_USER_SETTINGS_API_KEYS); err != nil {
+		return nil, err
+	}
+	deletedAfter := time.Now().Add(-is.config.APIKeyJanitor.RestoreWindow)
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		key, err = store.GetAPIKeyStore(db).RestoreAPIKey(ctx, ids.EntityIdentifiers(), id, deletedAfter)
+		if err != nil {
+			return err
+		}
+		// Require that caller has at least the rights of the restored API key.
+		return rights.RequireUser(ctx, *ids, key.Rights...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	key.Key = ""
+	is.logAPIKeyOperation(ctx, "Restored API key", ids.EntityIdentifiers(), id, nil, ttnpb.RightsFrom(key.Rights...))
+	events.Publish(evtRestoreUserAPIKey(ctx, ids, nil))
 	return key, nil
 }
 
@@ -126,8 +210,13 @@ func (ua *userAccess) CreateAPIKey(ctx context.Context, req *ttnpb.CreateUserAPI
 	return ua.createUserAPIKey(ctx, req)
 }
 func (ua *userAccess) ListAPIKeys(ctx context.Context, req *ttnpb.UserIdentifiers) (*ttnpb.APIKeys, error) {
-	return ua.listUserAPIKeys(ctx, req)
+	return ua.listUserAPIKeys(ctx, req, false)
 }
 func (ua *userAccess) UpdateAPIKey(ctx context.Context, req *ttnpb.UpdateUserAPIKeyRequest) (*ttnpb.APIKey, error) {
 	return ua.updateUserAPIKey(ctx, req)
 }
+
+// RestoreAPIKey restores a deleted API key of the user, if it was deleted within the restore window.
+func (ua *userAccess) RestoreAPIKey(ctx context.Context, ids *ttnpb.UserIdentifiers, id string) (*ttnpb.APIKey, error) {
+	return ua.restoreUserAPIKey(ctx, ids, id)
+}
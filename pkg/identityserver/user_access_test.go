@@ -15,12 +15,16 @@
 package identityserver
 
 import (
+	"fmt"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/smartystreets/assertions"
 	"github.com/smartystreets/assertions/should"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"google.golang.org/grpc"
@@ -204,3 +208,291 @@ func TestUserAccessCRUD(t *testing.T) {
 		a.So(err, should.BeNil)
 	})
 }
+
+func TestUserAccessDryRun(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewUserAccessClient(cc)
+
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+
+		before, err := reg.ListAPIKeys(ctx, &userID, creds)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		apiKey, err := reg.CreateAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+			UserIdentifiers: userID,
+			Name:            "dry-run-api-key-name",
+			Rights:          []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+			DryRun:          true,
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(apiKey, should.NotBeNil) {
+			a.So(apiKey.Name, should.Equal, "dry-run-api-key-name")
+			a.So(apiKey.Rights, should.Resemble, []ttnpb.Right{ttnpb.RIGHT_USER_INFO})
+			a.So(apiKey.ID, should.BeEmpty)
+			a.So(apiKey.Key, should.BeEmpty)
+		}
+
+		after, err := reg.ListAPIKeys(ctx, &userID, creds)
+		a.So(err, should.BeNil)
+		a.So(after.APIKeys, should.HaveLength, len(before.APIKeys))
+
+		userID, creds = userAccessUser.UserIdentifiers, userCreds(userAccessUserIdx)
+
+		apiKey, err = reg.CreateAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+			UserIdentifiers: userID,
+			Name:            "dry-run-api-key-name",
+			Rights:          []ttnpb.Right{ttnpb.RIGHT_USER_ALL},
+			DryRun:          true,
+		}, creds)
+
+		a.So(apiKey, should.BeNil)
+		a.So(err, should.NotBeNil)
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	})
+}
+
+func TestUserAccessEntityScope(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+
+		inScope := ttnpb.ApplicationIdentifiers{ApplicationID: "in-scope-app"}
+		outOfScope := ttnpb.ApplicationIdentifiers{ApplicationID: "out-of-scope-app"}
+		for _, ids := range []ttnpb.ApplicationIdentifiers{inScope, outOfScope} {
+			_, err := ttnpb.NewApplicationRegistryClient(cc).Create(ctx, &ttnpb.CreateApplicationRequest{
+				Application:  ttnpb.Application{ApplicationIdentifiers: ids},
+				Collaborator: *userID.OrganizationOrUserIdentifiers(),
+			}, creds)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+		}
+
+		apiKey, err := ttnpb.NewUserAccessClient(cc).CreateAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+			UserIdentifiers: userID,
+			Name:            "scoped-api-key-name",
+			Rights:          []ttnpb.Right{ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_APPLICATION_INFO},
+			EntityScope:     []*ttnpb.EntityIdentifiers{inScope.EntityIdentifiers()},
+		}, creds)
+		if !a.So(err, should.BeNil) || !a.So(apiKey, should.NotBeNil) {
+			t.FailNow()
+		}
+		a.So(apiKey.EntityScope, should.Resemble, []*ttnpb.EntityIdentifiers{inScope.EntityIdentifiers()})
+
+		scopedCreds := grpc.PerRPCCredentials(rpcmetadata.MD{
+			AuthType:      "bearer",
+			AuthValue:     apiKey.Key,
+			AllowInsecure: true,
+		})
+
+		appReg := ttnpb.NewApplicationAccessClient(cc)
+
+		rights, err := appReg.ListRights(ctx, &inScope, scopedCreds)
+		a.So(err, should.BeNil)
+		if a.So(rights, should.NotBeNil) {
+			a.So(rights.Rights, should.Contain, ttnpb.RIGHT_APPLICATION_INFO)
+		}
+
+		rights, err = appReg.ListRights(ctx, &outOfScope, scopedCreds)
+		a.So(err, should.BeNil)
+		if a.So(rights, should.NotBeNil) {
+			a.So(rights.Rights, should.BeEmpty)
+		}
+
+		rights, err = ttnpb.NewUserAccessClient(cc).ListRights(ctx, &userID, scopedCreds)
+		a.So(err, should.BeNil)
+		if a.So(rights, should.NotBeNil) {
+			a.So(rights.Rights, should.BeEmpty)
+		}
+	})
+}
+
+func TestUserAccessReadOnlyAPIKey(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewUserAccessClient(cc)
+
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+
+		apiKey, err := reg.CreateAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+			UserIdentifiers: userID,
+			Name:            "read-only-api-key-name",
+			Rights:          []ttnpb.Right{ttnpb.RIGHT_ALL_READ},
+		}, creds)
+		if !a.So(err, should.BeNil) || !a.So(apiKey, should.NotBeNil) {
+			t.FailNow()
+		}
+		a.So(apiKey.Rights, should.NotBeEmpty)
+		a.So(apiKey.Rights, should.NotContain, ttnpb.RIGHT_ALL_READ)
+		a.So(ttnpb.RightsFrom(apiKey.Rights...).Sub(ttnpb.AllReadRights).Rights, should.BeEmpty)
+
+		limitedKey, err := reg.CreateAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+			UserIdentifiers: userID,
+			Name:            "limited-api-key-name",
+			Rights: []ttnpb.Right{
+				ttnpb.RIGHT_USER_SETTINGS_API_KEYS,
+				ttnpb.RIGHT_USER_INFO,
+				ttnpb.RIGHT_USER_APPLICATIONS_LIST,
+				ttnpb.RIGHT_USER_APPLICATIONS_CREATE,
+			},
+		}, creds)
+		if !a.So(err, should.BeNil) || !a.So(limitedKey, should.NotBeNil) {
+			t.FailNow()
+		}
+		limitedCreds := grpc.PerRPCCredentials(rpcmetadata.MD{
+			AuthType:      "bearer",
+			AuthValue:     limitedKey.Key,
+			AllowInsecure: true,
+		})
+
+		apiKey, err = reg.CreateAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+			UserIdentifiers: userID,
+			Name:            "limited-read-only-api-key-name",
+			Rights:          []ttnpb.Right{ttnpb.RIGHT_ALL_READ},
+		}, limitedCreds)
+		if a.So(err, should.BeNil) && a.So(apiKey, should.NotBeNil) {
+			a.So(apiKey.Rights, should.Resemble, []ttnpb.Right{
+				ttnpb.RIGHT_USER_APPLICATIONS_LIST,
+				ttnpb.RIGHT_USER_INFO,
+			})
+		}
+
+		keys, err := reg.ListAPIKeys(ctx, &userID, creds)
+		if a.So(err, should.BeNil) && a.So(keys, should.NotBeNil) {
+			for _, key := range keys.APIKeys {
+				a.So(key.Rights, should.NotContain, ttnpb.RIGHT_ALL_READ)
+			}
+		}
+	})
+}
+
+func TestUserAccessMaxAPIKeys(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewUserAccessClient(cc)
+
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+
+		keys, err := reg.ListAPIKeys(ctx, &userID, creds)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		is.config.MaxAPIKeys = len(keys.APIKeys) + 3
+
+		var created []*ttnpb.APIKey
+		for i := 0; i < 3; i++ {
+			apiKey, err := reg.CreateAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+				UserIdentifiers: userID,
+				Name:            fmt.Sprintf("max-api-keys-%d", i),
+				Rights:          []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+			}, creds)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			created = append(created, apiKey)
+		}
+
+		_, err = reg.CreateAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+			UserIdentifiers: userID,
+			Name:            "max-api-keys-exceeded",
+			Rights:          []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+		}, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsResourceExhausted(err), should.BeTrue)
+		}
+
+		// Deleted API keys are not counted.
+		_, err = reg.UpdateAPIKey(ctx, &ttnpb.UpdateUserAPIKeyRequest{
+			UserIdentifiers: userID,
+			APIKey: ttnpb.APIKey{
+				ID: created[0].ID,
+			},
+		}, creds)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		_, err = reg.CreateAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+			UserIdentifiers: userID,
+			Name:            "max-api-keys-after-delete",
+			Rights:          []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+		}, creds)
+		a.So(err, should.BeNil)
+	})
+}
+
+func TestUserAccessAPIKeyRightsEvents(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewUserAccessClient(cc)
+
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+
+		created, err := reg.CreateAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+			UserIdentifiers: userID,
+			Name:            "rights-events",
+			Rights:          []ttnpb.Right{ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_USER_SETTINGS_BASIC, ttnpb.RIGHT_USER_APPLICATIONS_LIST},
+		}, creds)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		addCh := make(events.Channel, 1)
+		events.Subscribe("user.api-key.rights.add", addCh)
+		defer events.Unsubscribe("user.api-key.rights.add", addCh)
+		removeCh := make(events.Channel, 1)
+		events.Subscribe("user.api-key.rights.remove", removeCh)
+		defer events.Unsubscribe("user.api-key.rights.remove", removeCh)
+
+		_, err = reg.UpdateAPIKey(ctx, &ttnpb.UpdateUserAPIKeyRequest{
+			UserIdentifiers: userID,
+			APIKey: ttnpb.APIKey{
+				ID:     created.ID,
+				Rights: []ttnpb.Right{ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_USER_SETTINGS_API_KEYS, ttnpb.RIGHT_USER_GATEWAYS_LIST},
+			},
+		}, creds)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		for _, tc := range []struct {
+			Name   string
+			Ch     events.Channel
+			Rights *ttnpb.Rights
+		}{
+			{
+				Name:   "user.api-key.rights.add",
+				Ch:     addCh,
+				Rights: ttnpb.RightsFrom(ttnpb.RIGHT_USER_SETTINGS_API_KEYS, ttnpb.RIGHT_USER_GATEWAYS_LIST).Sorted(),
+			},
+			{
+				Name:   "user.api-key.rights.remove",
+				Ch:     removeCh,
+				Rights: ttnpb.RightsFrom(ttnpb.RIGHT_USER_SETTINGS_BASIC, ttnpb.RIGHT_USER_APPLICATIONS_LIST).Sorted(),
+			},
+		} {
+			select {
+			case evt := <-tc.Ch:
+				a.So(evt.Name(), should.Equal, tc.Name)
+				a.So(evt.Identifiers().GetEntityIdentifiers(), should.Resemble, []*ttnpb.EntityIdentifiers{userID.EntityIdentifiers()})
+				a.So(evt.Data(), should.Resemble, tc.Rights)
+			case <-time.After(test.Delay):
+				t.Fatalf("Expected %s event but nothing received", tc.Name)
+			}
+		}
+	})
+}
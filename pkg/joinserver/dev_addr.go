@@ -0,0 +1,62 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// devAddrConflicts returns the identifiers of the devices other than the one identified by joinEUI and devEUI, which use
+// devAddr in their current session.
+func (js *JoinServer) devAddrConflicts(ctx context.Context, joinEUI, devEUI types.EUI64, devAddr types.DevAddr) ([]ttnpb.EndDeviceIdentifiers, error) {
+	devs, err := js.devices.ListByDevAddr(ctx, devAddr, []string{"ids"})
+	if err != nil {
+		return nil, err
+	}
+	var conflicts []ttnpb.EndDeviceIdentifiers
+	for _, dev := range devs {
+		if dev.JoinEUI.Equal(joinEUI) && dev.DevEUI.Equal(devEUI) {
+			continue
+		}
+		conflicts = append(conflicts, dev.EndDeviceIdentifiers)
+	}
+	return conflicts, nil
+}
+
+// publishDevAddrConflicts logs and publishes the conflicts of the device identified by ids with the devices that use
+// devAddr in their current session. The conflicting devices may belong to other applications, so their identifiers are
+// only logged; the event only contains the DevAddr and the number of conflicts.
+func publishDevAddrConflicts(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, devAddr types.DevAddr, conflicts []ttnpb.EndDeviceIdentifiers) {
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"dev_addr", devAddr,
+		"join_eui", ids.JoinEUI,
+		"dev_eui", ids.DevEUI,
+	))
+	for _, conflict := range conflicts {
+		logger.WithFields(log.Fields(
+			"conflicting_join_eui", conflict.JoinEUI,
+			"conflicting_dev_eui", conflict.DevEUI,
+		)).Warn("DevAddr conflict detected")
+	}
+	events.Publish(evtDevAddrConflict(ctx, ids, map[string]interface{}{
+		"dev_addr":  devAddr,
+		"conflicts": len(conflicts),
+	}))
+}
@@ -0,0 +1,161 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	. "go.thethings.network/lorawan-stack/pkg/joinserver"
+	"go.thethings.network/lorawan-stack/pkg/joinserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestDevAddrConflict(t *testing.T) {
+	for _, reject := range []bool{false, true} {
+		t.Run(fmt.Sprintf("reject=%v", reject), func(t *testing.T) {
+			a := assertions.New(t)
+
+			authorizedCtx := clusterauth.NewContext(test.Context(), nil)
+
+			redisClient, flush := test.NewRedis(t, "joinserver_test", "dev_addr")
+			defer flush()
+			defer redisClient.Close()
+			devReg := &redis.DeviceRegistry{Redis: redisClient}
+			keyReg := &redis.KeyRegistry{Redis: redisClient}
+
+			c := component.MustNew(test.GetLogger(t), &component.Config{})
+			js := test.Must(New(
+				c,
+				&Config{
+					Devices:                devReg,
+					Keys:                   keyReg,
+					JoinEUIPrefixes:        joinEUIPrefixes,
+					RejectDevAddrConflicts: reject,
+				},
+			)).(*JoinServer)
+			test.Must(nil, c.Start())
+			defer c.Close()
+
+			conflicts := make(events.Channel, 2)
+			events.Subscribe("js.join.dev_addr_conflict", conflicts)
+			defer events.Unsubscribe("js.join.dev_addr_conflict", conflicts)
+
+			joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+			devAddr := types.DevAddr{0x42, 0xff, 0xff, 0xff}
+
+			join := func(devEUI types.EUI64) (*ttnpb.JoinResponse, error) {
+				pld := []byte{
+					/* MHDR */
+					0x00,
+
+					/* MACPayload */
+					/** JoinEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+					/** DevEUI **/
+					devEUI[7], devEUI[6], devEUI[5], devEUI[4], devEUI[3], devEUI[2], devEUI[1], devEUI[0],
+					/** DevNonce **/
+					0x01, 0x00,
+				}
+				mic, err := crypto.ComputeJoinRequestMIC(nwkKey, pld)
+				if err != nil {
+					return nil, err
+				}
+				return (NsJsServer{JS: js}).HandleJoin(authorizedCtx, &ttnpb.JoinRequest{
+					SelectedMACVersion: ttnpb.MAC_V1_1,
+					RawPayload:         append(pld, mic[:]...),
+					DevAddr:            devAddr,
+					NetID:              types.NetID{0x42, 0xff, 0xff},
+				})
+			}
+
+			devEUIs := []types.EUI64{
+				{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				{0x42, 0x43, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			}
+			for i := range devEUIs {
+				_, err := CreateDevice(authorizedCtx, devReg, &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						JoinEUI: &joinEUI,
+						DevEUI:  &devEUIs[i],
+					},
+					RootKeys: &ttnpb.RootKeys{
+						AppKey: &ttnpb.KeyEnvelope{
+							Key: appKey[:],
+						},
+						NwkKey: &ttnpb.KeyEnvelope{
+							Key: nwkKey[:],
+						},
+					},
+					LoRaWANVersion:       ttnpb.MAC_V1_1,
+					NetworkServerAddress: nsAddr,
+				})
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+			}
+
+			_, err := join(devEUIs[0])
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			select {
+			case evt := <-conflicts:
+				t.Fatalf("Unexpected conflict event: %v", evt)
+			case <-time.After(test.Delay):
+			}
+
+			_, err = join(devEUIs[1])
+			if reject {
+				a.So(err, should.HaveSameErrorDefinitionAs, ErrDevAddrConflict)
+			} else {
+				a.So(err, should.BeNil)
+			}
+
+			select {
+			case evt := <-conflicts:
+				ids := evt.Identifiers().GetEntityIdentifiers()
+				if a.So(ids, should.HaveLength, 1) {
+					a.So(*ids[0].GetDeviceIDs().DevEUI, should.Equal, devEUIs[1])
+				}
+				a.So(evt.Data(), should.Resemble, map[string]interface{}{
+					"dev_addr":  devAddr,
+					"conflicts": 1,
+				})
+			case <-time.After(test.Delay):
+				t.Fatal("Timed out waiting for conflict event")
+			}
+
+			dev, err := devReg.GetByEUI(authorizedCtx, joinEUI, devEUIs[1], []string{"session"})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			if reject {
+				a.So(dev.Session, should.BeNil)
+			} else if a.So(dev.Session, should.NotBeNil) {
+				a.So(dev.Session.DevAddr, should.Equal, devAddr)
+			}
+		})
+	}
+}
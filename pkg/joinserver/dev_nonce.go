@@ -0,0 +1,91 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"math"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// DevNonceValidator validates the DevNonce of join-requests of an end device.
+type DevNonceValidator interface {
+	// Validate returns an error if the DevNonce may not be used by the end device.
+	Validate(dev *ttnpb.EndDevice, devNonce types.DevNonce) error
+	// Commit stores the DevNonce in the end device, so that it is taken into account in the next validations.
+	// Commit returns the field paths of the end device that are updated.
+	Commit(dev *ttnpb.EndDevice, devNonce types.DevNonce) []string
+}
+
+// UnusedDevNonceValidator is the DevNonceValidator of LoRaWAN 1.0.x, which allows any DevNonce that is not used
+// before by the end device.
+type UnusedDevNonceValidator struct{}
+
+// Validate implements DevNonceValidator.
+func (UnusedDevNonceValidator) Validate(dev *ttnpb.EndDevice, devNonce types.DevNonce) error {
+	dn := uint32(devNonce.Uint16())
+	i := sort.Search(len(dev.UsedDevNonces), func(i int) bool { return dev.UsedDevNonces[i] >= dn })
+	if i < len(dev.UsedDevNonces) && dev.UsedDevNonces[i] == dn {
+		return errReuseDevNonce
+	}
+	return nil
+}
+
+// Commit implements DevNonceValidator.
+func (UnusedDevNonceValidator) Commit(dev *ttnpb.EndDevice, devNonce types.DevNonce) []string {
+	dn := uint32(devNonce.Uint16())
+	i := sort.Search(len(dev.UsedDevNonces), func(i int) bool { return dev.UsedDevNonces[i] >= dn })
+	if i < len(dev.UsedDevNonces) && dev.UsedDevNonces[i] == dn {
+		return nil
+	}
+	dev.UsedDevNonces = append(dev.UsedDevNonces, 0)
+	copy(dev.UsedDevNonces[i+1:], dev.UsedDevNonces[i:])
+	dev.UsedDevNonces[i] = dn
+	return []string{"used_dev_nonces"}
+}
+
+// IncreasingDevNonceValidator is the DevNonceValidator of LoRaWAN 1.1, which requires the DevNonce to be higher
+// than the last DevNonce of the end device, unless the end device resets join nonces.
+type IncreasingDevNonceValidator struct{}
+
+// Validate implements DevNonceValidator.
+func (IncreasingDevNonceValidator) Validate(dev *ttnpb.EndDevice, devNonce types.DevNonce) error {
+	dn := uint32(devNonce.Uint16())
+	if (dn != 0 || dev.LastDevNonce != 0 || dev.LastJoinNonce != 0) && !dev.ResetsJoinNonces {
+		if dn <= dev.LastDevNonce {
+			return errDevNonceTooSmall
+		}
+		if dn == math.MaxUint32 {
+			return errDevNonceTooHigh
+		}
+	}
+	return nil
+}
+
+// Commit implements DevNonceValidator.
+func (IncreasingDevNonceValidator) Commit(dev *ttnpb.EndDevice, devNonce types.DevNonce) []string {
+	dev.LastDevNonce = uint32(devNonce.Uint16())
+	return []string{"last_dev_nonce"}
+}
+
+// devNonceValidators are the DevNonceValidators by LoRaWAN version.
+var devNonceValidators = map[ttnpb.MACVersion]DevNonceValidator{
+	ttnpb.MAC_V1_0:   UnusedDevNonceValidator{},
+	ttnpb.MAC_V1_0_1: UnusedDevNonceValidator{},
+	ttnpb.MAC_V1_0_2: UnusedDevNonceValidator{},
+	ttnpb.MAC_V1_1:   IncreasingDevNonceValidator{},
+}
@@ -0,0 +1,123 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/joinserver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestUnusedDevNonceValidator(t *testing.T) {
+	a := assertions.New(t)
+
+	var v DevNonceValidator = UnusedDevNonceValidator{}
+	dev := &ttnpb.EndDevice{}
+
+	for _, dn := range []types.DevNonce{{0x00, 0x02}, {0x00, 0x00}, {0xff, 0xff}, {0x00, 0x01}} {
+		if !a.So(v.Validate(dev, dn), should.BeNil) {
+			t.FailNow()
+		}
+		a.So(v.Commit(dev, dn), should.Resemble, []string{"used_dev_nonces"})
+	}
+	a.So(dev.UsedDevNonces, should.Resemble, []uint32{0x0, 0x1, 0x2, 0xffff})
+
+	for _, dn := range []types.DevNonce{{0x00, 0x00}, {0x00, 0x02}, {0xff, 0xff}} {
+		a.So(v.Validate(dev, dn), should.HaveSameErrorDefinitionAs, ErrReuseDevNonce)
+	}
+	a.So(v.Validate(dev, types.DevNonce{0x00, 0x03}), should.BeNil)
+}
+
+func TestIncreasingDevNonceValidator(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Device   *ttnpb.EndDevice
+		DevNonce types.DevNonce
+		Error    error
+	}{
+		{
+			Name:     "First join",
+			Device:   &ttnpb.EndDevice{},
+			DevNonce: types.DevNonce{0x00, 0x00},
+		},
+		{
+			Name: "Higher",
+			Device: &ttnpb.EndDevice{
+				LastDevNonce:  0x41,
+				LastJoinNonce: 0x01,
+			},
+			DevNonce: types.DevNonce{0x00, 0x42},
+		},
+		{
+			Name: "Maximum",
+			Device: &ttnpb.EndDevice{
+				LastDevNonce: 0xfffe,
+			},
+			DevNonce: types.DevNonce{0xff, 0xff},
+		},
+		{
+			Name: "Equal",
+			Device: &ttnpb.EndDevice{
+				LastDevNonce: 0x42,
+			},
+			DevNonce: types.DevNonce{0x00, 0x42},
+			Error:    ErrDevNonceTooSmall,
+		},
+		{
+			Name: "Lower",
+			Device: &ttnpb.EndDevice{
+				LastDevNonce: 0x42,
+			},
+			DevNonce: types.DevNonce{0x00, 0x41},
+			Error:    ErrDevNonceTooSmall,
+		},
+		{
+			Name: "Zero after join",
+			Device: &ttnpb.EndDevice{
+				LastJoinNonce: 0x01,
+			},
+			DevNonce: types.DevNonce{0x00, 0x00},
+			Error:    ErrDevNonceTooSmall,
+		},
+		{
+			Name: "Lower with resets join nonces",
+			Device: &ttnpb.EndDevice{
+				LastDevNonce:     0x42,
+				ResetsJoinNonces: true,
+			},
+			DevNonce: types.DevNonce{0x00, 0x01},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			var v DevNonceValidator = IncreasingDevNonceValidator{}
+			err := v.Validate(tc.Device, tc.DevNonce)
+			if tc.Error != nil {
+				a.So(err, should.HaveSameErrorDefinitionAs, tc.Error)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(v.Commit(tc.Device, tc.DevNonce), should.Resemble, []string{"last_dev_nonce"})
+			a.So(tc.Device.LastDevNonce, should.Equal, uint32(tc.DevNonce.Uint16()))
+		})
+	}
+}
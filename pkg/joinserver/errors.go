@@ -22,6 +22,7 @@ var (
 	errDecodePayload             = errors.DefineInvalidArgument("decode_payload", "failed to decode payload")
 	errDeriveAppSKey             = errors.Define("derive_app_s_key", "failed to derive application session key")
 	errDeriveNwkSKeys            = errors.Define("derive_nwk_s_keys", "failed to derive network session keys")
+	errDevAddrConflict           = errors.DefineAlreadyExists("dev_addr_conflict", "DevAddr `{dev_addr}` is used by another device")
 	errDevNonceTooHigh           = errors.DefineInvalidArgument("dev_nonce_too_high", "DevNonce is too high")
 	errDevNonceTooSmall          = errors.DefineInvalidArgument("dev_nonce_too_small", "DevNonce is too small")
 	errDuplicateIdentifiers      = errors.DefineAlreadyExists("duplicate_identifiers", "a device identified by the identifiers already exists")
@@ -32,6 +33,7 @@ var (
 	errGenerateSessionKeyID      = errors.Define("generate_session_key_id", "failed to generate session key ID")
 	errDeviceNotFound            = errors.DefineNotFound("device_not_found", "device not found")
 	errInvalidIdentifiers        = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errJoinEUINotRegistered      = errors.DefineFailedPrecondition("join_eui_not_registered", "JoinEUI `{join_eui}` is not registered")
 	errJoinNonceTooHigh          = errors.Define("join_nonce_too_high", "JoinNonce is too high")
 	errMICMismatch               = errors.DefineInvalidArgument("mic_mismatch", "MIC mismatch")
 	errNoAppKey                  = errors.DefineCorruption("no_app_key", "no AppKey specified")
@@ -41,12 +43,14 @@ var (
 	errNoFNwkSIntKey             = errors.DefineCorruption("no_f_nwk_s_int_key", "no FNwkSIntKey specified")
 	errNoJoinEUI                 = errors.DefineInvalidArgument("no_join_eui", "no JoinEUI specified")
 	errNoJoinRequest             = errors.DefineInvalidArgument("no_join_request", "no JoinRequest specified")
+	errNoNetID                   = errors.DefineNotFound("no_net_id", "no NetID specified")
 	errNoNwkKey                  = errors.DefineCorruption("no_nwk_key", "no NwkKey specified")
 	errNoNwkSEncKey              = errors.DefineCorruption("no_nwk_s_enc_key", "no NwkSEncKey specified")
 	errNoPayload                 = errors.DefineInvalidArgument("no_payload", "no message payload specified")
 	errNoRootKeys                = errors.DefineCorruption("no_root_keys", "no root keys specified")
 	errNoSNwkSIntKey             = errors.DefineCorruption("no_s_nwk_s_int_key", "no SNwkSIntKey specified")
 	errPayloadLengthMismatch     = errors.DefineInvalidArgument("payload_length", "expected length of payload to be equal to 23 got {length}")
+	errPrecomputeKeysRootKeys    = errors.DefineFailedPrecondition("precompute_keys_root_keys", "precomputing session keys requires a NwkKey and AppKey")
 	errProvisionerNotFound       = errors.DefineNotFound("provisioner_not_found", "provisioner `{id}` not found")
 	errProvisionerDecode         = errors.Define("provisioner_decode", "failed to decode provisioning data")
 	errProvisionEntryCount       = errors.DefineInvalidArgument("provision_entry_count", "expected `{expected}` but have `{actual}` entries to provision")
@@ -23,6 +23,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoservices"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/joinserver/provisioning"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
@@ -280,3 +281,71 @@ func (srv jsEndDeviceRegistryServer) Delete(ctx context.Context, ids *ttnpb.EndD
 	}
 	return ttnpb.Empty, err
 }
+
+// StreamJoinEvents implements ttnpb.JsEndDeviceRegistryServer.
+func (srv jsEndDeviceRegistryServer) StreamJoinEvents(req *ttnpb.StreamJoinEventsRequest, stream ttnpb.JsEndDeviceRegistry_StreamJoinEventsServer) error {
+	ctx := stream.Context()
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return err
+	}
+	ch, err := srv.JS.Subscribe(ctx, JoinEventFilter{
+		ApplicationIdentifiers: &req.ApplicationIdentifiers,
+		JoinEUI:                req.JoinEUI,
+		DevEUI:                 req.DevEUI,
+		Outcome:                req.Outcome,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case evt := <-ch:
+			pb, err := events.Proto(evt)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(pb); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// PrecomputeKeys implements ttnpb.JsEndDeviceRegistryServer.
+func (srv jsEndDeviceRegistryServer) PrecomputeKeys(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
+	if ids.JoinEUI == nil || ids.JoinEUI.IsZero() {
+		return nil, errNoJoinEUI
+	}
+	if ids.DevEUI == nil || ids.DevEUI.IsZero() {
+		return nil, errNoDevEUI
+	}
+	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	if err := srv.JS.precomputeKeys(ctx, *ids); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// ListSessions implements ttnpb.JsEndDeviceRegistryServer.
+func (srv jsEndDeviceRegistryServer) ListSessions(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDeviceSessions, error) {
+	if ids.JoinEUI == nil || ids.JoinEUI.IsZero() {
+		return nil, errNoJoinEUI
+	}
+	if ids.DevEUI == nil || ids.DevEUI.IsZero() {
+		return nil, errNoDevEUI
+	}
+	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	sessions, err := srv.JS.listSessions(ctx, *ids)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.EndDeviceSessions{
+		Sessions: sessions,
+	}, nil
+}
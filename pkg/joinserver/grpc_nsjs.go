@@ -15,18 +15,14 @@
 package joinserver
 
 import (
-	"bytes"
 	"context"
-	"encoding/binary"
-	"math"
-	"sort"
-	"time"
 
-	"github.com/oklog/ulid"
 	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoservices"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
@@ -47,19 +43,33 @@ func keyToBytes(k types.AES128Key) []byte {
 	return k[:]
 }
 
-// HandleJoin is called by the Network Server to join a device.
-func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (res *ttnpb.JoinResponse, err error) {
-	// TODO: Authorize using client TLS and application rights (https://github.com/TheThingsNetwork/lorawan-stack/issues/4)
-	if err := clusterauth.Authorized(ctx); err != nil {
-		return nil, err
+// ErrInvalidJoinRequest is returned when a join-request is invalid and all validation errors are reported.
+// The validation errors are the details of the error.
+var ErrInvalidJoinRequest = errors.DefineInvalidArgument("invalid_join_request", "invalid join-request")
+
+// ErrMissingNwkKey is returned when a LoRaWAN 1.1 device joins without a NwkKey and no Crypto Server is available
+// to derive the network keys. LoRaWAN 1.1 devices that are provisioned with only an AppKey cannot join.
+var ErrMissingNwkKey = errors.DefineFailedPrecondition("missing_nwk_key", "NwkKey missing for LoRaWAN `{mac_version}` device `{dev_eui}`")
+
+// validateJoinRequest decodes the payload of the join-request and validates the join-request.
+// If all is false, the first validation error is returned. Otherwise, all validation errors are returned as details
+// of ErrInvalidJoinRequest. Validation stops at errors that make further checks impossible.
+func validateJoinRequest(req *ttnpb.JoinRequest, all bool) error {
+	var details []interface{}
+	// report returns the validation error if all is false. Otherwise, it collects the error and returns nil.
+	report := func(err error) error {
+		if !all {
+			return err
+		}
+		details = append(details, err)
+		return nil
 	}
-
-	logger := log.FromContext(ctx)
-	defer func() {
-		if err != nil {
-			registerRejectJoin(ctx, req, err)
+	result := func() error {
+		if len(details) == 0 {
+			return nil
 		}
-	}()
+		return ErrInvalidJoinRequest.WithDetails(details...)
+	}
 
 	supported := false
 	for _, ver := range supportedMACVersions {
@@ -69,45 +79,86 @@ func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 		}
 	}
 	if !supported {
-		return nil, errUnsupportedLoRaWANVersion.WithAttributes("version", req.SelectedMACVersion)
+		if err := report(errUnsupportedLoRaWANVersion.WithAttributes("version", req.SelectedMACVersion)); err != nil {
+			return err
+		}
 	}
 
 	if req.RawPayload == nil {
-		return nil, errNoPayload
+		if err := report(errNoPayload); err != nil {
+			return err
+		}
+		return result()
 	}
 	if n := len(req.RawPayload); n != 23 {
-		return nil, errPayloadLengthMismatch.WithAttributes("length", n)
+		if err := report(errPayloadLengthMismatch.WithAttributes("length", n)); err != nil {
+			return err
+		}
+		return result()
 	}
 	req.Payload = &ttnpb.Message{}
-	if err = lorawan.UnmarshalMessage(req.RawPayload, req.Payload); err != nil {
-		return nil, errDecodePayload.WithCause(err)
+	if err := lorawan.UnmarshalMessage(req.RawPayload, req.Payload); err != nil {
+		if err := report(errDecodePayload.WithCause(err)); err != nil {
+			return err
+		}
+		return result()
 	}
 
 	if req.Payload.Major != ttnpb.Major_LORAWAN_R1 {
-		return nil, errUnsupportedLoRaWANVersion.WithAttributes("version", req.Payload.Major)
+		if err := report(errUnsupportedLoRaWANVersion.WithAttributes("version", req.Payload.Major)); err != nil {
+			return err
+		}
 	}
 	if req.Payload.MType != ttnpb.MType_JOIN_REQUEST {
-		return nil, errWrongPayloadType.WithAttributes("type", req.Payload.MType)
+		if err := report(errWrongPayloadType.WithAttributes("type", req.Payload.MType)); err != nil {
+			return err
+		}
+		return result()
 	}
 
 	pld := req.Payload.GetJoinRequestPayload()
 	if pld == nil {
-		return nil, errNoJoinRequest
+		if err := report(errNoJoinRequest); err != nil {
+			return err
+		}
+		return result()
 	}
 	if pld.DevEUI.IsZero() {
-		return nil, errNoDevEUI
+		if err := report(errNoDevEUI); err != nil {
+			return err
+		}
 	}
 	if pld.JoinEUI.IsZero() {
-		return nil, errNoJoinEUI
+		if err := report(errNoJoinEUI); err != nil {
+			return err
+		}
 	}
+	return result()
+}
 
-	match := false
-	for _, p := range srv.JS.euiPrefixes {
-		if p.Matches(pld.JoinEUI) {
-			match = true
-			break
+// HandleJoin is called by the Network Server to join a device.
+func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (res *ttnpb.JoinResponse, err error) {
+	// TODO: Authorize using client TLS and application rights (https://github.com/TheThingsNetwork/lorawan-stack/issues/4)
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+
+	logger := log.FromContext(ctx)
+	var ids ttnpb.EndDeviceIdentifiers
+	defer func() {
+		if err != nil {
+			registerRejectJoin(ctx, ids, req, err)
 		}
+	}()
+
+	if err = validateJoinRequest(req, srv.JS.reportAllJoinRequestErrors); err != nil {
+		return nil, err
 	}
+	pld := req.Payload.GetJoinRequestPayload()
+	joinEUI, devEUI := pld.JoinEUI, pld.DevEUI
+	ids.JoinEUI, ids.DevEUI = &joinEUI, &devEUI
+
+	match := srv.JS.matchesJoinEUI(pld.JoinEUI)
 	switch {
 	case !match && req.SelectedMACVersion.Compare(ttnpb.MAC_V1_1) < 0:
 		return nil, errUnknownAppEUI
@@ -117,6 +168,11 @@ func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 		return nil, errForwardJoinRequest
 	}
 
+	conflicts, err := srv.JS.devAddrConflicts(ctx, pld.JoinEUI, pld.DevEUI, req.DevAddr)
+	if err != nil {
+		return nil, err
+	}
+
 	dev, err := srv.JS.devices.SetByEUI(ctx, pld.JoinEUI, pld.DevEUI,
 		[]string{
 			"last_dev_nonce",
@@ -126,35 +182,30 @@ func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 			"used_dev_nonces",
 			"provisioner_id",
 			"provisioning_data",
+			"mac_settings.join_accept_dl_settings",
+			"mac_settings.join_accept_rx_delay",
+			"network_server_address",
+			"application_server_address",
 		},
 		func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if dev == nil {
+				return nil, nil, errDeviceNotFound
+			}
+			ids = dev.EndDeviceIdentifiers
 			paths := make([]string, 0, 3)
 
-			dn := uint32(binary.BigEndian.Uint16(pld.DevNonce[:]))
-			switch req.SelectedMACVersion {
-			case ttnpb.MAC_V1_1:
-				if (dn != 0 || dev.LastDevNonce != 0 || dev.LastJoinNonce != 0) && !dev.ResetsJoinNonces {
-					if dn <= dev.LastDevNonce {
-						return nil, nil, errDevNonceTooSmall
-					}
-					if dn == math.MaxUint32 {
-						return nil, nil, errDevNonceTooHigh
-					}
-				}
-				dev.LastDevNonce = dn
-				paths = append(paths, "last_dev_nonce")
-			case ttnpb.MAC_V1_0, ttnpb.MAC_V1_0_1, ttnpb.MAC_V1_0_2:
-				i := sort.Search(len(dev.UsedDevNonces), func(i int) bool { return dev.UsedDevNonces[i] >= dn })
-				if i < len(dev.UsedDevNonces) && dev.UsedDevNonces[i] == dn {
-					return nil, nil, errReuseDevNonce
-				}
-				dev.UsedDevNonces = append(dev.UsedDevNonces, 0)
-				copy(dev.UsedDevNonces[i+1:], dev.UsedDevNonces[i:])
-				dev.UsedDevNonces[i] = dn
-				paths = append(paths, "used_dev_nonces")
-			default:
+			devNonceValidator, ok := devNonceValidators[req.SelectedMACVersion]
+			if !ok {
 				panic("This statement is unreachable. Fix version check.")
 			}
+			if err := devNonceValidator.Validate(dev, pld.DevNonce); err != nil {
+				return nil, nil, err
+			}
+			paths = append(paths, devNonceValidator.Commit(dev, pld.DevNonce)...)
+
+			if len(conflicts) > 0 && srv.JS.rejectDevAddrConflicts {
+				return nil, nil, errDevAddrConflict.WithAttributes("dev_addr", req.DevAddr)
+			}
 
 			var b []byte
 			if req.CFList == nil {
@@ -170,33 +221,31 @@ func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 				return nil, nil, errEncodePayload.WithCause(err)
 			}
 
-			if dev.LastJoinNonce >= 1<<24-1 {
+			if dev.LastJoinNonce >= types.MaxJoinNonce {
 				return nil, nil, errJoinNonceTooHigh
 			}
-			dev.LastJoinNonce++
+			jn := types.NewJoinNonce(dev.LastJoinNonce).Increment()
+			dev.LastJoinNonce = jn.Uint32()
 			paths = append(paths, "last_join_nonce")
 
-			var jn types.JoinNonce
-			nb := make([]byte, 4)
-			binary.BigEndian.PutUint32(nb, dev.LastJoinNonce)
-			copy(jn[:], nb[1:])
-
+			dlSettings, rxDelay := joinAcceptSettings(dev.MACSettings, req)
 			b, err = lorawan.AppendJoinAcceptPayload(b, ttnpb.JoinAcceptPayload{
 				NetID:      req.NetID,
 				JoinNonce:  jn,
 				CFList:     req.CFList,
 				DevAddr:    req.DevAddr,
-				DLSettings: req.DownlinkSettings,
-				RxDelay:    req.RxDelay,
+				DLSettings: dlSettings,
+				RxDelay:    rxDelay,
 			})
 			if err != nil {
 				return nil, nil, errEncodePayload.WithCause(err)
 			}
 
-			srv.JS.entropyMu.Lock()
-			skID, err := ulid.New(ulid.Timestamp(time.Now()), srv.JS.entropy)
-			srv.JS.entropyMu.Unlock()
+			skID, err := srv.JS.sessionKeyID(dev, jn, pld.DevNonce)
 			if err != nil {
+				return nil, nil, errGenerateSessionKeyID.WithCause(err)
+			}
+			if len(skID) == 0 {
 				return nil, nil, errGenerateSessionKeyID
 			}
 
@@ -229,6 +278,12 @@ func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 				applicationCryptoService = cryptoservices.NewApplicationRPCClient(cs.Conn(), srv.JS.KeyVault, srv.JS.WithClusterAuth())
 			}
 			if networkCryptoService == nil {
+				if req.SelectedMACVersion.Compare(ttnpb.MAC_V1_1) >= 0 {
+					return nil, nil, ErrMissingNwkKey.WithAttributes(
+						"mac_version", req.SelectedMACVersion,
+						"dev_eui", pld.DevEUI,
+					)
+				}
 				return nil, nil, errNoNwkKey
 			}
 			if applicationCryptoService == nil {
@@ -243,27 +298,51 @@ func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 			if err != nil {
 				return nil, nil, errComputeMIC.WithCause(err)
 			}
-			if !bytes.Equal(reqMIC[:], req.RawPayload[19:]) {
+			if !crypto.SecureCompare(reqMIC[:], req.RawPayload[19:]) {
 				return nil, nil, errMICMismatch
 			}
 			resMIC, err := networkCryptoService.JoinAcceptMIC(ctx, cryptoDev, req.SelectedMACVersion, 0xff, pld.DevNonce, b)
 			if err != nil {
 				return nil, nil, errComputeMIC.WithCause(err)
 			}
-			enc, err := networkCryptoService.EncryptJoinAccept(ctx, cryptoDev, req.SelectedMACVersion, append(b[1:], resMIC[:]...))
+			var enc []byte
+			if hasJoinAcceptRootKey(dev, req.SelectedMACVersion) {
+				var key types.AES128Key
+				key, err = selectJoinAcceptKey(ctx, networkCryptoService, applicationCryptoService, cryptoDev, req.SelectedMACVersion, req.Payload.MType)
+				if err == nil {
+					enc, err = crypto.EncryptJoinAccept(key, append(b[1:], resMIC[:]...))
+				}
+			} else {
+				enc, err = networkCryptoService.EncryptJoinAccept(ctx, cryptoDev, req.SelectedMACVersion, append(b[1:], resMIC[:]...))
+			}
 			if err != nil {
 				return nil, nil, errEncryptPayload.WithCause(err)
 			}
-			nwkSKeys, err := networkCryptoService.DeriveNwkSKeys(ctx, cryptoDev, req.SelectedMACVersion, jn, pld.DevNonce, req.NetID)
-			if err != nil {
-				return nil, nil, errDeriveNwkSKeys.WithCause(err)
+			var (
+				nwkSKeys    cryptoservices.NwkSKeys
+				appSKey     types.AES128Key
+				precomputed bool
+			)
+			if req.SelectedMACVersion == ttnpb.MAC_V1_1 {
+				// Only take the precomputed session keys if they apply, so that they remain available otherwise.
+				var keys precomputedKeys
+				if keys, precomputed = srv.JS.precomputedKeys.take(pld.DevEUI, dev.RootKeys, jn, pld.DevNonce, srv.JS.clock.Now()); precomputed {
+					logger.Debug("Use precomputed session keys")
+					nwkSKeys, appSKey = keys.nwkSKeys, keys.appSKey
+				}
 			}
-			appSKey, err := applicationCryptoService.DeriveAppSKey(ctx, cryptoDev, req.SelectedMACVersion, jn, pld.DevNonce, req.NetID)
-			if err != nil {
-				return nil, nil, errDeriveAppSKey.WithCause(err)
+			if !precomputed {
+				nwkSKeys, err = networkCryptoService.DeriveNwkSKeys(ctx, cryptoDev, req.SelectedMACVersion, jn, pld.DevNonce, req.NetID)
+				if err != nil {
+					return nil, nil, errDeriveNwkSKeys.WithCause(err)
+				}
+				appSKey, err = applicationCryptoService.DeriveAppSKey(ctx, cryptoDev, req.SelectedMACVersion, jn, pld.DevNonce, req.NetID)
+				if err != nil {
+					return nil, nil, errDeriveAppSKey.WithCause(err)
+				}
 			}
 			sessionKeys := ttnpb.SessionKeys{
-				SessionKeyID: skID[:],
+				SessionKeyID: skID,
 				FNwkSIntKey: &ttnpb.KeyEnvelope{
 					// TODO: Encrypt key with NS KEK https://github.com/TheThingsNetwork/lorawan-stack/issues/5
 					Key:      nwkSKeys.FNwkSIntKey[:],
@@ -294,11 +373,16 @@ func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 			}
 			_, err = CreateKeys(ctx, srv.JS.keys, *dev.EndDeviceIdentifiers.DevEUI, &res.SessionKeys)
 			if err != nil {
-				return nil, nil, err
+				if srv.JS.keyWrites == nil || !hasRootKeys(dev, req.SelectedMACVersion) ||
+					!srv.JS.keyWrites.push(*dev.EndDeviceIdentifiers.DevEUI, &res.SessionKeys) {
+					return nil, nil, err
+				}
+				logger.WithError(err).Warn("Failed to write session keys, retry in the background")
+				registerDeferKeysWrite(ctx, dev.EndDeviceIdentifiers, err)
 			}
 
 			dev.Session = &ttnpb.Session{
-				StartedAt:   time.Now().UTC(),
+				StartedAt:   srv.JS.clock.Now().UTC(),
 				DevAddr:     req.DevAddr,
 				SessionKeys: res.SessionKeys,
 			}
@@ -306,6 +390,9 @@ func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 
 			return dev, paths, nil
 		})
+	if len(conflicts) > 0 && (err == nil || errors.Resemble(err, errDevAddrConflict)) {
+		publishDevAddrConflicts(ctx, ids, req.DevAddr, conflicts)
+	}
 	if err != nil {
 		logger.WithFields(log.Fields(
 			"join_eui", pld.JoinEUI,
@@ -314,10 +401,29 @@ func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 		return nil, err
 	}
 
+	if srv.JS.nwkSKeys != nil {
+		srv.JS.nwkSKeys.invalidate(pld.DevEUI)
+	}
+	res.NetworkServerAddress = srv.JS.rewriteAddress(dev.NetworkServerAddress)
+	res.ApplicationServerAddress = srv.JS.rewriteAddress(dev.ApplicationServerAddress)
 	registerAcceptJoin(ctx, dev, req)
 	return res, nil
 }
 
+// joinAcceptSettings returns the downlink settings and RxDelay to use in the join accept for the join request.
+// The settings in the MAC settings of the device take precedence over the ones in the join request.
+func joinAcceptSettings(macSettings *ttnpb.MACSettings, req *ttnpb.JoinRequest) (ttnpb.DLSettings, ttnpb.RxDelay) {
+	dlSettings, rxDelay := req.DownlinkSettings, req.RxDelay
+	if override := macSettings.GetJoinAcceptDLSettings(); override != nil {
+		dlSettings.Rx1DROffset = override.Rx1DROffset
+		dlSettings.Rx2DR = override.Rx2DR
+	}
+	if override := macSettings.GetJoinAcceptRxDelay(); override != ttnpb.RX_DELAY_0 {
+		rxDelay = override
+	}
+	return dlSettings, rxDelay
+}
+
 // GetNwkSKeys returns the NwkSKeys associated with session keys identified by the supplied request.
 func (srv nsJsServer) GetNwkSKeys(ctx context.Context, req *ttnpb.SessionKeyRequest) (*ttnpb.NwkSKeysResponse, error) {
 	// TODO: Authorize using client TLS and application rights (https://github.com/TheThingsNetwork/lorawan-stack/issues/4)
@@ -325,6 +431,13 @@ func (srv nsJsServer) GetNwkSKeys(ctx context.Context, req *ttnpb.SessionKeyRequ
 		return nil, err
 	}
 
+	now := srv.JS.clock.Now()
+	if srv.JS.nwkSKeys != nil {
+		if res, ok := srv.JS.nwkSKeys.get(req.DevEUI, req.SessionKeyID, now); ok {
+			return res, nil
+		}
+	}
+
 	ks, err := srv.JS.keys.GetByID(ctx, req.DevEUI, req.SessionKeyID,
 		[]string{
 			"f_nwk_s_int_key",
@@ -346,9 +459,105 @@ func (srv nsJsServer) GetNwkSKeys(ctx context.Context, req *ttnpb.SessionKeyRequ
 		return nil, errNoSNwkSIntKey
 	}
 
-	return &ttnpb.NwkSKeysResponse{
+	res := &ttnpb.NwkSKeysResponse{
 		NwkSEncKey:  *ks.NwkSEncKey,
 		FNwkSIntKey: *ks.FNwkSIntKey,
 		SNwkSIntKey: *ks.SNwkSIntKey,
+	}
+	if srv.JS.nwkSKeys != nil {
+		srv.JS.nwkSKeys.set(req.DevEUI, req.SessionKeyID, res, now)
+	}
+	return res, nil
+}
+
+// GetNwkSKeysBatch returns the NwkSKeys associated with each of the session keys identified by the supplied request.
+// The responses are in the same order as the session key IDs. The responses of session keys that are not found are
+// empty.
+func (srv nsJsServer) GetNwkSKeysBatch(ctx context.Context, req *ttnpb.SessionKeysBatchRequest) (*ttnpb.NwkSKeysBatchResponse, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+
+	devEUI, ids := req.DevEUI, req.SessionKeyIDs
+	now := srv.JS.clock.Now()
+	res := make([]*ttnpb.NwkSKeysResponse, len(ids))
+	var missing []int
+	for i, id := range ids {
+		if srv.JS.nwkSKeys != nil {
+			if cached, ok := srv.JS.nwkSKeys.get(devEUI, id, now); ok {
+				res[i] = cached
+				continue
+			}
+		}
+		missing = append(missing, i)
+	}
+	if len(missing) == 0 {
+		return &ttnpb.NwkSKeysBatchResponse{
+			NwkSKeys: res,
+		}, nil
+	}
+
+	missingIDs := make([][]byte, len(missing))
+	for i, idx := range missing {
+		missingIDs[i] = ids[idx]
+	}
+	kss, err := srv.JS.keys.GetByIDs(ctx, devEUI, missingIDs,
+		[]string{
+			"f_nwk_s_int_key",
+			"nwk_s_enc_key",
+			"s_nwk_s_int_key",
+		},
+	)
+	if err != nil {
+		return nil, errRegistryOperation.WithCause(err)
+	}
+
+	for i, ks := range kss {
+		if ks == nil {
+			res[missing[i]] = &ttnpb.NwkSKeysResponse{}
+			continue
+		}
+		if ks.NwkSEncKey == nil {
+			return nil, errNoNwkSEncKey
+		}
+		if ks.FNwkSIntKey == nil {
+			return nil, errNoFNwkSIntKey
+		}
+		if ks.SNwkSIntKey == nil {
+			return nil, errNoSNwkSIntKey
+		}
+		idx := missing[i]
+		res[idx] = &ttnpb.NwkSKeysResponse{
+			NwkSEncKey:  *ks.NwkSEncKey,
+			FNwkSIntKey: *ks.FNwkSIntKey,
+			SNwkSIntKey: *ks.SNwkSIntKey,
+		}
+		if srv.JS.nwkSKeys != nil {
+			srv.JS.nwkSKeys.set(devEUI, ids[idx], res[idx], now)
+		}
+	}
+	return &ttnpb.NwkSKeysBatchResponse{
+		NwkSKeys: res,
+	}, nil
+}
+
+// GetHomeNetID returns the home NetID of the device identified by the supplied request.
+func (srv nsJsServer) GetHomeNetID(ctx context.Context, req *ttnpb.GetHomeNetIDRequest) (*ttnpb.GetHomeNetIDResponse, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+
+	dev, err := srv.JS.devices.GetByEUI(ctx, req.JoinEUI, req.DevEUI, []string{"net_id"})
+	if errors.IsNotFound(err) {
+		return nil, errDeviceNotFound
+	}
+	if err != nil {
+		return nil, errRegistryOperation.WithCause(err)
+	}
+	if dev.NetID == nil {
+		return nil, errNoNetID
+	}
+	return &ttnpb.GetHomeNetIDResponse{
+		NetID: *dev.NetID,
 	}, nil
 }
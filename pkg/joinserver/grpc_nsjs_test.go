@@ -18,19 +18,23 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/mohae/deepcopy"
 	"github.com/smartystreets/assertions"
 	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/crypto"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
 	. "go.thethings.network/lorawan-stack/pkg/joinserver"
 	"go.thethings.network/lorawan-stack/pkg/joinserver/redis"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/unique"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
 )
@@ -795,6 +799,54 @@ func TestHandleJoin(t *testing.T) {
 			JoinResponse: nil,
 			ValidError:   errors.IsInvalidArgument,
 		},
+		{
+			Name: "1.0.0/tampered payload",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DevEUI:  &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+					JoinEUI: &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				},
+				RootKeys: &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{
+						Key:      appKey[:],
+						KEKLabel: "",
+					},
+				},
+				LoRaWANVersion:       ttnpb.MAC_V1_0,
+				NetworkServerAddress: nsAddr,
+			},
+			JoinRequest: &ttnpb.JoinRequest{
+				SelectedMACVersion: ttnpb.MAC_V1_0,
+				RawPayload: []byte{
+					/* MHDR */
+					0x00,
+
+					/* MACPayload */
+					/** JoinEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+					/** DevEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+					/** DevNonce **/
+					0x02, 0x00,
+
+					/* MIC */
+					0xc4, 0x8, 0x50, 0xcf,
+				},
+				DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+				NetID:   types.NetID{0x42, 0xff, 0xff},
+				DownlinkSettings: ttnpb.DLSettings{
+					OptNeg:      true,
+					Rx1DROffset: 0x7,
+					Rx2DR:       0xf,
+				},
+				RxDelay: 0x42,
+				CFList:  nil,
+			},
+			JoinResponse: nil,
+			ValidError: func(err error) bool {
+				return errors.Resemble(err, ErrMICMismatch)
+			},
+		},
 		{
 			Name: "1.0.0/no payload",
 			Device: &ttnpb.EndDevice{
@@ -1085,6 +1137,8 @@ func TestHandleJoin(t *testing.T) {
 			expectedResp := deepcopy.Copy(tc.JoinResponse).(*ttnpb.JoinResponse)
 			a.So(res.SessionKeyID, should.NotBeEmpty)
 			expectedResp.SessionKeyID = res.SessionKeyID
+			expectedResp.NetworkServerAddress = pb.NetworkServerAddress
+			expectedResp.ApplicationServerAddress = pb.ApplicationServerAddress
 			a.So(res, should.Resemble, expectedResp)
 
 			ret, err = devReg.GetByEUI(authorizedCtx, *pb.EndDeviceIdentifiers.JoinEUI, *pb.EndDeviceIdentifiers.DevEUI, ttnpb.EndDeviceFieldPathsTopLevel)
@@ -1118,6 +1172,270 @@ func TestHandleJoin(t *testing.T) {
 	}
 }
 
+func TestHandleJoinSessionKeyIDFunc(t *testing.T) {
+	a := assertions.New(t)
+
+	authorizedCtx := clusterauth.NewContext(test.Context(), nil)
+
+	redisClient, flush := test.NewRedis(t, "joinserver_test")
+	defer flush()
+	defer redisClient.Close()
+	devReg := &redis.DeviceRegistry{Redis: redisClient}
+	keyReg := &redis.KeyRegistry{Redis: redisClient}
+
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+	type sessionKeyIDCall struct {
+		DevEUI    types.EUI64
+		JoinNonce types.JoinNonce
+		DevNonce  types.DevNonce
+	}
+	var calls []sessionKeyIDCall
+	sessionKeyID := func(dev *ttnpb.EndDevice, joinNonce types.JoinNonce, devNonce types.DevNonce) ([]byte, error) {
+		calls = append(calls, sessionKeyIDCall{
+			DevEUI:    *dev.DevEUI,
+			JoinNonce: joinNonce,
+			DevNonce:  devNonce,
+		})
+		return append(append(joinNonce[:], devNonce[:]...), dev.DevEUI[:]...), nil
+	}
+
+	c := component.MustNew(test.GetLogger(t), &component.Config{})
+	js := NsJsServer{
+		JS: test.Must(New(
+			c,
+			&Config{
+				Devices:          devReg,
+				Keys:             keyReg,
+				JoinEUIPrefixes:  joinEUIPrefixes,
+				SessionKeyIDFunc: sessionKeyID,
+			},
+		)).(*JoinServer),
+	}
+	test.Must(nil, c.Start())
+
+	_, err := CreateDevice(authorizedCtx, devReg, &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			DevEUI:  &devEUI,
+			JoinEUI: &joinEUI,
+		},
+		RootKeys: &ttnpb.RootKeys{
+			AppKey: &ttnpb.KeyEnvelope{Key: appKey[:]},
+			NwkKey: &ttnpb.KeyEnvelope{Key: nwkKey[:]},
+		},
+		LoRaWANVersion:       ttnpb.MAC_V1_1,
+		NetworkServerAddress: nsAddr,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	res, err := js.HandleJoin(authorizedCtx, &ttnpb.JoinRequest{
+		SelectedMACVersion: ttnpb.MAC_V1_1,
+		RawPayload: []byte{
+			/* MHDR */
+			0x00,
+
+			/* MACPayload */
+			/** JoinEUI **/
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+			/** DevEUI **/
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+			/** DevNonce **/
+			0x00, 0x00,
+
+			/* MIC */
+			0x55, 0x17, 0x54, 0x8e,
+		},
+		DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+		NetID:   types.NetID{0x42, 0xff, 0xff},
+		DownlinkSettings: ttnpb.DLSettings{
+			OptNeg:      true,
+			Rx1DROffset: 0x7,
+			Rx2DR:       0xf,
+		},
+		RxDelay: 0x42,
+	})
+	if !a.So(err, should.BeNil) || !a.So(res, should.NotBeNil) {
+		t.FailNow()
+	}
+
+	expectedID := []byte{0x00, 0x00, 0x01, 0x00, 0x00, 0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	a.So(calls, should.Resemble, []sessionKeyIDCall{
+		{
+			DevEUI:    devEUI,
+			JoinNonce: types.JoinNonce{0x00, 0x00, 0x01},
+			DevNonce:  types.DevNonce{0x00, 0x00},
+		},
+	})
+	a.So(res.SessionKeyID, should.Resemble, expectedID)
+
+	ks, err := keyReg.GetByID(authorizedCtx, devEUI, expectedID, ttnpb.SessionKeysFieldPathsTopLevel)
+	if a.So(err, should.BeNil) && a.So(ks, should.NotBeNil) {
+		a.So(ks.SessionKeyID, should.Resemble, expectedID)
+	}
+
+	dev, err := devReg.GetByEUI(authorizedCtx, joinEUI, devEUI, []string{"session"})
+	if a.So(err, should.BeNil) && a.So(dev.Session, should.NotBeNil) {
+		a.So(dev.Session.SessionKeyID, should.Resemble, expectedID)
+	}
+}
+
+func TestHandleJoinAddressRewriter(t *testing.T) {
+	const (
+		internalNSAddr = "ns.internal:8884"
+		externalNSAddr = "ns.example.com:8884"
+		asAddr         = "as.example.com:8884"
+	)
+
+	a := assertions.New(t)
+
+	authorizedCtx := clusterauth.NewContext(test.Context(), nil)
+
+	redisClient, flush := test.NewRedis(t, "joinserver_test")
+	defer flush()
+	defer redisClient.Close()
+	devReg := &redis.DeviceRegistry{Redis: redisClient}
+	keyReg := &redis.KeyRegistry{Redis: redisClient}
+
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+	c := component.MustNew(test.GetLogger(t), &component.Config{})
+	js := NsJsServer{
+		JS: test.Must(New(
+			c,
+			&Config{
+				Devices:         devReg,
+				Keys:            keyReg,
+				JoinEUIPrefixes: joinEUIPrefixes,
+				AddressRewriter: AddressMap(map[string]string{
+					internalNSAddr: externalNSAddr,
+				}),
+			},
+		)).(*JoinServer),
+	}
+	test.Must(nil, c.Start())
+
+	_, err := CreateDevice(authorizedCtx, devReg, &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			DevEUI:  &devEUI,
+			JoinEUI: &joinEUI,
+		},
+		RootKeys: &ttnpb.RootKeys{
+			AppKey: &ttnpb.KeyEnvelope{Key: appKey[:]},
+			NwkKey: &ttnpb.KeyEnvelope{Key: nwkKey[:]},
+		},
+		LoRaWANVersion:           ttnpb.MAC_V1_1,
+		NetworkServerAddress:     internalNSAddr,
+		ApplicationServerAddress: asAddr,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	res, err := js.HandleJoin(authorizedCtx, &ttnpb.JoinRequest{
+		SelectedMACVersion: ttnpb.MAC_V1_1,
+		RawPayload: []byte{
+			/* MHDR */
+			0x00,
+
+			/* MACPayload */
+			/** JoinEUI **/
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+			/** DevEUI **/
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+			/** DevNonce **/
+			0x00, 0x00,
+
+			/* MIC */
+			0x55, 0x17, 0x54, 0x8e,
+		},
+		DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+		NetID:   types.NetID{0x42, 0xff, 0xff},
+		DownlinkSettings: ttnpb.DLSettings{
+			OptNeg:      true,
+			Rx1DROffset: 0x7,
+			Rx2DR:       0xf,
+		},
+		RxDelay: 0x42,
+	})
+	if !a.So(err, should.BeNil) || !a.So(res, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(res.NetworkServerAddress, should.Equal, externalNSAddr)
+	a.So(res.ApplicationServerAddress, should.Equal, asAddr)
+
+	dev, err := devReg.GetByEUI(authorizedCtx, joinEUI, devEUI, []string{"network_server_address", "application_server_address"})
+	if a.So(err, should.BeNil) {
+		a.So(dev.NetworkServerAddress, should.Equal, internalNSAddr)
+		a.So(dev.ApplicationServerAddress, should.Equal, asAddr)
+	}
+}
+
+func TestJoinAcceptSettings(t *testing.T) {
+	req := &ttnpb.JoinRequest{
+		DownlinkSettings: ttnpb.DLSettings{
+			OptNeg:      true,
+			Rx1DROffset: 0x7,
+			Rx2DR:       0xf,
+		},
+		RxDelay: ttnpb.RX_DELAY_5,
+	}
+
+	for _, tc := range []struct {
+		Name               string
+		MACSettings        *ttnpb.MACSettings
+		ExpectedDLSettings ttnpb.DLSettings
+		ExpectedRxDelay    ttnpb.RxDelay
+	}{
+		{
+			Name:               "NoMACSettings",
+			ExpectedDLSettings: req.DownlinkSettings,
+			ExpectedRxDelay:    ttnpb.RX_DELAY_5,
+		},
+		{
+			Name:               "PassThrough",
+			MACSettings:        &ttnpb.MACSettings{},
+			ExpectedDLSettings: req.DownlinkSettings,
+			ExpectedRxDelay:    ttnpb.RX_DELAY_5,
+		},
+		{
+			Name: "RxDelayOverride",
+			MACSettings: &ttnpb.MACSettings{
+				JoinAcceptRxDelay: ttnpb.RX_DELAY_1,
+			},
+			ExpectedDLSettings: req.DownlinkSettings,
+			ExpectedRxDelay:    ttnpb.RX_DELAY_1,
+		},
+		{
+			Name: "DLSettingsOverride",
+			MACSettings: &ttnpb.MACSettings{
+				JoinAcceptRxDelay: ttnpb.RX_DELAY_3,
+				JoinAcceptDLSettings: &ttnpb.DLSettings{
+					OptNeg:      false,
+					Rx1DROffset: 0x2,
+					Rx2DR:       ttnpb.DATA_RATE_2,
+				},
+			},
+			ExpectedDLSettings: ttnpb.DLSettings{
+				OptNeg:      true,
+				Rx1DROffset: 0x2,
+				Rx2DR:       ttnpb.DATA_RATE_2,
+			},
+			ExpectedRxDelay: ttnpb.RX_DELAY_3,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			dlSettings, rxDelay := JoinAcceptSettings(tc.MACSettings, req)
+			a.So(dlSettings, should.Resemble, tc.ExpectedDLSettings)
+			a.So(rxDelay, should.Equal, tc.ExpectedRxDelay)
+		})
+	}
+}
+
 func TestGetNwkSKeys(t *testing.T) {
 	errTest := errors.New("test")
 
@@ -1314,3 +1632,1044 @@ func TestGetNwkSKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestGetHomeNetID(t *testing.T) {
+	errTest := errors.New("test")
+
+	joinEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devEUI := types.EUI64{0x42, 0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff}
+	netID := types.NetID{0x42, 0xff, 0xff}
+
+	for _, tc := range []struct {
+		Name string
+
+		Context func(context.Context) context.Context
+
+		GetByEUI func(context.Context, types.EUI64, types.EUI64, []string) (*ttnpb.EndDevice, error)
+		NetID    types.NetID
+
+		ErrorAssertion func(*testing.T, error) bool
+	}{
+		{
+			Name: "Not authorized",
+			Context: func(ctx context.Context) context.Context {
+				return clusterauth.NewContext(ctx, errTest)
+			},
+			GetByEUI: func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, error) {
+				test.MustTFromContext(ctx).Error("GetByEUI must not be called")
+				return nil, errTest
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.EqualErrorOrDefinition, errTest)
+			},
+		},
+		{
+			Name: "Unknown device",
+			GetByEUI: func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, error) {
+				return nil, errors.DefineNotFound("test_not_found", "not found")
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.EqualErrorOrDefinition, ErrDeviceNotFound)
+			},
+		},
+		{
+			Name: "No NetID",
+			GetByEUI: func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, error) {
+				return &ttnpb.EndDevice{}, nil
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.EqualErrorOrDefinition, ErrNoNetID)
+			},
+		},
+		{
+			Name: "Known device",
+			GetByEUI: func(ctx context.Context, reqJoinEUI, reqDevEUI types.EUI64, paths []string) (*ttnpb.EndDevice, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(reqJoinEUI, should.Resemble, joinEUI)
+				a.So(reqDevEUI, should.Resemble, devEUI)
+				a.So(paths, should.HaveSameElementsDeep, []string{"net_id"})
+				return &ttnpb.EndDevice{
+					NetID: &netID,
+				}, nil
+			},
+			NetID: netID,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ctx := clusterauth.NewContext(test.ContextWithT(test.Context(), t), nil)
+			if tc.Context != nil {
+				ctx = tc.Context(ctx)
+			}
+
+			c := component.MustNew(test.GetLogger(t), &component.Config{})
+			js := NsJsServer{
+				JS: test.Must(New(
+					c,
+					&Config{
+						Keys:    &MockKeyRegistry{},
+						Devices: &MockDeviceRegistry{GetByEUIFunc: tc.GetByEUI},
+					},
+				)).(*JoinServer),
+			}
+			test.Must(nil, c.Start())
+			res, err := js.GetHomeNetID(ctx, &ttnpb.GetHomeNetIDRequest{
+				JoinEUI: joinEUI,
+				DevEUI:  devEUI,
+			})
+
+			if tc.ErrorAssertion != nil {
+				if !tc.ErrorAssertion(t, err) {
+					t.Errorf("Received unexpected error: %s", err)
+				}
+				a.So(res, should.BeNil)
+				return
+			}
+
+			if a.So(err, should.BeNil) {
+				a.So(res.NetID, should.Resemble, tc.NetID)
+			}
+		})
+	}
+}
+
+func TestGetNwkSKeysCache(t *testing.T) {
+	a := assertions.New(t)
+
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+	var gets int
+	keyReg := &MockKeyRegistry{
+		GetByIDFunc: func(ctx context.Context, devEUI types.EUI64, id []byte, paths []string) (*ttnpb.SessionKeys, error) {
+			gets++
+			return &ttnpb.SessionKeys{
+				FNwkSIntKey: ttnpb.NewPopulatedKeyEnvelope(test.Randy, false),
+				SNwkSIntKey: ttnpb.NewPopulatedKeyEnvelope(test.Randy, false),
+				NwkSEncKey:  ttnpb.NewPopulatedKeyEnvelope(test.Randy, false),
+			}, nil
+		},
+	}
+	devReg := &MockDeviceRegistry{
+		SetByEUIFunc: func(ctx context.Context, reqJoinEUI, reqDevEUI types.EUI64, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+			a.So(reqJoinEUI, should.Resemble, joinEUI)
+			a.So(reqDevEUI, should.Resemble, devEUI)
+			return &ttnpb.EndDevice{}, nil
+		},
+	}
+
+	ctx := clusterauth.NewContext(test.ContextWithT(test.Context(), t), nil)
+	c := component.MustNew(test.GetLogger(t), &component.Config{})
+	js := NsJsServer{
+		JS: test.Must(New(
+			c,
+			&Config{
+				Keys:            keyReg,
+				Devices:         devReg,
+				JoinEUIPrefixes: joinEUIPrefixes,
+				NwkSKeysTTL:     time.Hour,
+			},
+		)).(*JoinServer),
+	}
+	test.Must(nil, c.Start())
+
+	req := &ttnpb.SessionKeyRequest{
+		DevEUI:       devEUI,
+		SessionKeyID: []byte{0x11, 0x22, 0x33, 0x44},
+	}
+
+	// The registry is hit once within the TTL.
+	res1, err := js.GetNwkSKeys(ctx, req)
+	a.So(err, should.BeNil)
+	res2, err := js.GetNwkSKeys(ctx, req)
+	a.So(err, should.BeNil)
+	a.So(res2, should.Resemble, res1)
+	a.So(gets, should.Equal, 1)
+
+	// Other sessions are not cached.
+	_, err = js.GetNwkSKeys(ctx, &ttnpb.SessionKeyRequest{
+		DevEUI:       devEUI,
+		SessionKeyID: []byte{0x55, 0x66, 0x77, 0x88},
+	})
+	a.So(err, should.BeNil)
+	a.So(gets, should.Equal, 2)
+
+	// A new join of the device invalidates the cache.
+	_, err = js.HandleJoin(ctx, &ttnpb.JoinRequest{
+		SelectedMACVersion: ttnpb.MAC_V1_0,
+		RawPayload: []byte{
+			/* MHDR */
+			0x00,
+
+			/* MACPayload */
+			/** JoinEUI **/
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+			/** DevEUI **/
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+			/** DevNonce **/
+			0x01, 0x00,
+
+			/* MIC */
+			0xc4, 0x8, 0x50, 0xcf,
+		},
+		DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+		NetID:   types.NetID{0x42, 0xff, 0xff},
+	})
+	a.So(err, should.BeNil)
+	_, err = js.GetNwkSKeys(ctx, req)
+	a.So(err, should.BeNil)
+	a.So(gets, should.Equal, 3)
+}
+
+func TestGetNwkSKeysBatch(t *testing.T) {
+	errTest := errors.New("test")
+
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+	present := &ttnpb.SessionKeys{
+		FNwkSIntKey: ttnpb.NewPopulatedKeyEnvelope(test.Randy, false),
+		SNwkSIntKey: ttnpb.NewPopulatedKeyEnvelope(test.Randy, false),
+		NwkSEncKey:  ttnpb.NewPopulatedKeyEnvelope(test.Randy, false),
+	}
+
+	for _, tc := range []struct {
+		Name      string
+		Context   func(context.Context) context.Context
+		GetByIDs  func(context.Context, types.EUI64, [][]byte, []string) ([]*ttnpb.SessionKeys, error)
+		IDs       [][]byte
+		Responses []*ttnpb.NwkSKeysResponse
+
+		ErrorAssertion func(*testing.T, error) bool
+	}{
+		{
+			Name: "Not authorized",
+			Context: func(ctx context.Context) context.Context {
+				return clusterauth.NewContext(ctx, errTest)
+			},
+			GetByIDs: func(ctx context.Context, devEUI types.EUI64, ids [][]byte, paths []string) ([]*ttnpb.SessionKeys, error) {
+				test.MustTFromContext(ctx).Error("GetByIDs must not be called")
+				return nil, errTest
+			},
+			IDs: [][]byte{{0x11, 0x22, 0x33, 0x44}},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.EqualErrorOrDefinition, errTest)
+			},
+		},
+		{
+			Name: "Registry error",
+			GetByIDs: func(ctx context.Context, devEUI types.EUI64, ids [][]byte, paths []string) ([]*ttnpb.SessionKeys, error) {
+				return nil, errTest
+			},
+			IDs: [][]byte{{0x11, 0x22, 0x33, 0x44}},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(errors.Resemble(err, ErrRegistryOperation), should.BeTrue)
+			},
+		},
+		{
+			Name: "No NwkSEncKey",
+			GetByIDs: func(ctx context.Context, devEUI types.EUI64, ids [][]byte, paths []string) ([]*ttnpb.SessionKeys, error) {
+				return []*ttnpb.SessionKeys{
+					{
+						FNwkSIntKey: ttnpb.NewPopulatedKeyEnvelope(test.Randy, false),
+						SNwkSIntKey: ttnpb.NewPopulatedKeyEnvelope(test.Randy, false),
+					},
+				}, nil
+			},
+			IDs: [][]byte{{0x11, 0x22, 0x33, 0x44}},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.EqualErrorOrDefinition, ErrNoNwkSEncKey)
+			},
+		},
+		{
+			Name: "Present and absent sessions",
+			GetByIDs: func(ctx context.Context, reqDevEUI types.EUI64, ids [][]byte, paths []string) ([]*ttnpb.SessionKeys, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(reqDevEUI, should.Resemble, devEUI)
+				a.So(ids, should.Resemble, [][]byte{{0x11}, {0x22}, {0x33}})
+				a.So(paths, should.HaveSameElementsDeep, []string{
+					"f_nwk_s_int_key",
+					"nwk_s_enc_key",
+					"s_nwk_s_int_key",
+				})
+				return []*ttnpb.SessionKeys{nil, present, nil}, nil
+			},
+			IDs: [][]byte{{0x11}, {0x22}, {0x33}},
+			Responses: []*ttnpb.NwkSKeysResponse{
+				{},
+				{
+					FNwkSIntKey: *present.FNwkSIntKey,
+					SNwkSIntKey: *present.SNwkSIntKey,
+					NwkSEncKey:  *present.NwkSEncKey,
+				},
+				{},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ctx := clusterauth.NewContext(test.ContextWithT(test.Context(), t), nil)
+			if tc.Context != nil {
+				ctx = tc.Context(ctx)
+			}
+
+			c := component.MustNew(test.GetLogger(t), &component.Config{})
+			js := NsJsServer{
+				JS: test.Must(New(
+					c,
+					&Config{
+						Keys:    &MockKeyRegistry{GetByIDsFunc: tc.GetByIDs},
+						Devices: &MockDeviceRegistry{},
+					},
+				)).(*JoinServer),
+			}
+			test.Must(nil, c.Start())
+			res, err := js.GetNwkSKeysBatch(ctx, &ttnpb.SessionKeysBatchRequest{
+				DevEUI:        devEUI,
+				SessionKeyIDs: tc.IDs,
+			})
+
+			if tc.ErrorAssertion != nil {
+				if !tc.ErrorAssertion(t, err) {
+					t.Errorf("Received unexpected error: %s", err)
+				}
+				a.So(res, should.BeNil)
+				return
+			}
+
+			if a.So(err, should.BeNil) {
+				a.So(res.NwkSKeys, should.Resemble, tc.Responses)
+			}
+		})
+	}
+}
+
+func TestHandleJoinKeyWriteBehind(t *testing.T) {
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+	for _, tc := range []struct {
+		Name   string
+		Enable bool
+	}{
+		{
+			Name: "Disabled",
+		},
+		{
+			Name:   "Enabled",
+			Enable: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			authorizedCtx := clusterauth.NewContext(test.Context(), nil)
+
+			redisClient, flush := test.NewRedis(t, "joinserver_test")
+			defer flush()
+			defer redisClient.Close()
+			devReg := &redis.DeviceRegistry{Redis: redisClient}
+			keyReg := &redis.KeyRegistry{Redis: redisClient}
+
+			var unavailable int32 = 1
+			failingKeyReg := &MockKeyRegistry{
+				SetByIDFunc: func(ctx context.Context, devEUI types.EUI64, id []byte, paths []string, f func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error)) (*ttnpb.SessionKeys, error) {
+					if atomic.LoadInt32(&unavailable) == 1 {
+						return nil, errors.New("key registry unavailable")
+					}
+					return keyReg.SetByID(ctx, devEUI, id, paths, f)
+				},
+			}
+
+			c := component.MustNew(test.GetLogger(t), &component.Config{})
+			js := NsJsServer{
+				JS: test.Must(New(
+					c,
+					&Config{
+						Devices:         devReg,
+						Keys:            failingKeyReg,
+						JoinEUIPrefixes: joinEUIPrefixes,
+						KeyWriteBehind: KeyWriteBehindConfig{
+							Enable:        tc.Enable,
+							RetryInterval: test.Delay,
+						},
+					},
+				)).(*JoinServer),
+			}
+			test.Must(nil, c.Start())
+			defer c.Close()
+
+			_, err := CreateDevice(authorizedCtx, devReg, &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DevEUI:  &devEUI,
+					JoinEUI: &joinEUI,
+				},
+				RootKeys: &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{Key: appKey[:]},
+					NwkKey: &ttnpb.KeyEnvelope{Key: nwkKey[:]},
+				},
+				LoRaWANVersion:       ttnpb.MAC_V1_1,
+				NetworkServerAddress: nsAddr,
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			evtCh := make(events.Channel, 1)
+			events.Subscribe("js.join.keys.defer", evtCh)
+			defer events.Unsubscribe("js.join.keys.defer", evtCh)
+
+			res, err := js.HandleJoin(authorizedCtx, &ttnpb.JoinRequest{
+				SelectedMACVersion: ttnpb.MAC_V1_1,
+				RawPayload: []byte{
+					/* MHDR */
+					0x00,
+
+					/* MACPayload */
+					/** JoinEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+					/** DevEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+					/** DevNonce **/
+					0x00, 0x00,
+
+					/* MIC */
+					0x55, 0x17, 0x54, 0x8e,
+				},
+				DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+				NetID:   types.NetID{0x42, 0xff, 0xff},
+				DownlinkSettings: ttnpb.DLSettings{
+					OptNeg:      true,
+					Rx1DROffset: 0x7,
+					Rx2DR:       0xf,
+				},
+				RxDelay: 0x42,
+			})
+			if !tc.Enable {
+				a.So(err, should.NotBeNil)
+				a.So(res, should.BeNil)
+				return
+			}
+			if !a.So(err, should.BeNil) || !a.So(res, should.NotBeNil) {
+				t.FailNow()
+			}
+
+			select {
+			case evt := <-evtCh:
+				a.So(evt.Name(), should.Equal, "js.join.keys.defer")
+			case <-time.After(test.Delay):
+				t.Fatal("Expected defer event but nothing received")
+			}
+
+			dev, err := devReg.GetByEUI(authorizedCtx, joinEUI, devEUI, []string{"session"})
+			if a.So(err, should.BeNil) && a.So(dev.Session, should.NotBeNil) {
+				a.So(dev.Session.SessionKeyID, should.Resemble, res.SessionKeyID)
+			}
+
+			_, err = keyReg.GetByID(authorizedCtx, devEUI, res.SessionKeyID, ttnpb.SessionKeysFieldPathsTopLevel)
+			a.So(errors.IsNotFound(err), should.BeTrue)
+
+			atomic.StoreInt32(&unavailable, 0)
+			deadline := time.Now().Add(10 * test.Delay)
+			for {
+				ks, err := keyReg.GetByID(authorizedCtx, devEUI, res.SessionKeyID, ttnpb.SessionKeysFieldPathsTopLevel)
+				if err == nil {
+					a.So(ks.SessionKeyID, should.Resemble, res.SessionKeyID)
+					break
+				}
+				if time.Now().After(deadline) {
+					t.Fatalf("Expected deferred session keys to be written, last error: %v", err)
+				}
+				time.Sleep(test.Delay)
+			}
+		})
+	}
+}
+
+func TestHandleJoinPrecomputedKeys(t *testing.T) {
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	precomputedAppSKey := types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+
+	for _, tc := range []struct {
+		Name             string
+		LastJoinNonce    uint32
+		LastDevNonce     uint32
+		ResetsJoinNonces bool
+		Elapsed          time.Duration
+		Hit              bool
+	}{
+		{
+			Name: "PredictionMatches",
+			Hit:  true,
+		},
+		{
+			Name:             "PredictionMismatches",
+			LastJoinNonce:    5,
+			LastDevNonce:     7,
+			ResetsJoinNonces: true,
+		},
+		{
+			Name:    "Expired",
+			Elapsed: time.Hour,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			authorizedCtx := clusterauth.NewContext(test.Context(), nil)
+
+			redisClient, flush := test.NewRedis(t, "joinserver_test")
+			defer flush()
+			defer redisClient.Close()
+			devReg := &redis.DeviceRegistry{Redis: redisClient}
+			keyReg := &redis.KeyRegistry{Redis: redisClient}
+
+			clock := &mockClock{now: time.Unix(42, 0).UTC()}
+			c := component.MustNew(test.GetLogger(t), &component.Config{})
+			js := test.Must(New(
+				c,
+				&Config{
+					Devices:         devReg,
+					Keys:            keyReg,
+					JoinEUIPrefixes: joinEUIPrefixes,
+					Clock:           clock,
+					PrecomputedKeys: PrecomputedKeysConfig{
+						TTL: time.Minute,
+					},
+				},
+			)).(*JoinServer)
+			test.Must(nil, c.Start())
+			defer c.Close()
+
+			ids := ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+					ApplicationID: registeredApplicationID,
+				},
+				DeviceID: registeredDeviceID,
+				DevEUI:   &devEUI,
+				JoinEUI:  &joinEUI,
+			}
+			_, err := CreateDevice(authorizedCtx, devReg, &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ids,
+				RootKeys: &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{Key: appKey[:]},
+					NwkKey: &ttnpb.KeyEnvelope{Key: nwkKey[:]},
+				},
+				LastJoinNonce:        tc.LastJoinNonce,
+				LastDevNonce:         tc.LastDevNonce,
+				ResetsJoinNonces:     tc.ResetsJoinNonces,
+				LoRaWANVersion:       ttnpb.MAC_V1_1,
+				NetworkServerAddress: nsAddr,
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			if _, err := (JsDeviceServer{JS: js}).PrecomputeKeys(devicesWriteContext(authorizedCtx), &ids); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			// Replace the precomputed AppSKey, so that a join that is served from the precomputed keys can be told apart.
+			if !a.So(SetPrecomputedAppSKey(js, devEUI, precomputedAppSKey), should.BeTrue) {
+				t.FailNow()
+			}
+			clock.now = clock.now.Add(tc.Elapsed)
+
+			res, err := NsJsServer{JS: js}.HandleJoin(authorizedCtx, &ttnpb.JoinRequest{
+				SelectedMACVersion: ttnpb.MAC_V1_1,
+				RawPayload: []byte{
+					/* MHDR */
+					0x00,
+
+					/* MACPayload */
+					/** JoinEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+					/** DevEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+					/** DevNonce **/
+					0x00, 0x00,
+
+					/* MIC */
+					0x55, 0x17, 0x54, 0x8e,
+				},
+				DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+				NetID:   types.NetID{0x42, 0xff, 0xff},
+			})
+			if !a.So(err, should.BeNil) || !a.So(res, should.NotBeNil) {
+				t.FailNow()
+			}
+			if tc.Hit {
+				a.So(res.SessionKeys.AppSKey.Key, should.Resemble, precomputedAppSKey[:])
+			} else {
+				appSKey := crypto.DeriveAppSKey(appKey, types.NewJoinNonce(tc.LastJoinNonce+1), joinEUI, types.DevNonce{})
+				a.So(res.SessionKeys.AppSKey.Key, should.Resemble, appSKey[:])
+			}
+
+			// The precomputed keys are used or discarded by the join.
+			a.So(SetPrecomputedAppSKey(js, devEUI, precomputedAppSKey), should.BeFalse)
+		})
+	}
+}
+
+func devicesWriteContext(ctx context.Context) context.Context {
+	return rights.NewContext(ctx, rights.Rights{
+		ApplicationRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ttnpb.ApplicationIdentifiers{
+				ApplicationID: registeredApplicationID,
+			}): ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_DEVICES_WRITE),
+		},
+	})
+}
+
+func TestPrecomputeKeys(t *testing.T) {
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: registeredApplicationID,
+		},
+		DeviceID: registeredDeviceID,
+		DevEUI:   &devEUI,
+		JoinEUI:  &joinEUI,
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		ContextFunc    func(context.Context) context.Context
+		Device         *ttnpb.EndDevice
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "Permission denied",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, ids.ApplicationIdentifiers): ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+					},
+				})
+			},
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ids,
+				RootKeys: &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{Key: appKey[:]},
+					NwkKey: &ttnpb.KeyEnvelope{Key: nwkKey[:]},
+				},
+			},
+			ErrorAssertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:        "No root keys",
+			ContextFunc: devicesWriteContext,
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ids,
+				RootKeys: &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{Key: appKey[:]},
+				},
+			},
+			ErrorAssertion: errors.IsFailedPrecondition,
+		},
+		{
+			Name:        "Other application",
+			ContextFunc: devicesWriteContext,
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+						ApplicationID: "other-application",
+					},
+					DeviceID: registeredDeviceID,
+					DevEUI:   &devEUI,
+					JoinEUI:  &joinEUI,
+				},
+				RootKeys: &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{Key: appKey[:]},
+					NwkKey: &ttnpb.KeyEnvelope{Key: nwkKey[:]},
+				},
+			},
+			ErrorAssertion: errors.IsNotFound,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			js := test.Must(New(
+				component.MustNew(test.GetLogger(t), &component.Config{}),
+				&Config{
+					Devices: &MockDeviceRegistry{
+						GetByEUIFunc: func(ctx context.Context, joinEUI types.EUI64, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, error) {
+							return deepcopy.Copy(tc.Device).(*ttnpb.EndDevice), nil
+						},
+					},
+					Keys:            &MockKeyRegistry{},
+					JoinEUIPrefixes: joinEUIPrefixes,
+				},
+			)).(*JoinServer)
+
+			_, err := (JsDeviceServer{JS: js}).PrecomputeKeys(tc.ContextFunc(test.Context()), &ids)
+			a.So(tc.ErrorAssertion(err), should.BeTrue)
+			a.So(SetPrecomputedAppSKey(js, devEUI, types.AES128Key{}), should.BeFalse)
+		})
+	}
+}
+
+func TestPrecomputeKeysSize(t *testing.T) {
+	a := assertions.New(t)
+
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+	js := test.Must(New(
+		component.MustNew(test.GetLogger(t), &component.Config{}),
+		&Config{
+			Devices: &MockDeviceRegistry{
+				GetByEUIFunc: func(ctx context.Context, joinEUI types.EUI64, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, error) {
+					return &ttnpb.EndDevice{
+						EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+							ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+								ApplicationID: registeredApplicationID,
+							},
+							DevEUI:  &devEUI,
+							JoinEUI: &joinEUI,
+						},
+						RootKeys: &ttnpb.RootKeys{
+							AppKey: &ttnpb.KeyEnvelope{Key: appKey[:]},
+							NwkKey: &ttnpb.KeyEnvelope{Key: nwkKey[:]},
+						},
+					}, nil
+				},
+			},
+			Keys:            &MockKeyRegistry{},
+			JoinEUIPrefixes: joinEUIPrefixes,
+			PrecomputedKeys: PrecomputedKeysConfig{
+				Size: 2,
+			},
+		},
+	)).(*JoinServer)
+
+	devEUIs := []types.EUI64{
+		{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01},
+		{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02},
+		{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0x03},
+	}
+	for i := range devEUIs {
+		_, err := (JsDeviceServer{JS: js}).PrecomputeKeys(devicesWriteContext(test.Context()), &ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: registeredApplicationID,
+			},
+			DeviceID: fmt.Sprintf("dev-%d", i),
+			DevEUI:   &devEUIs[i],
+			JoinEUI:  &joinEUI,
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	// The keys of the device that expire first are evicted when the cache is full.
+	var cached int
+	for _, devEUI := range devEUIs {
+		if SetPrecomputedAppSKey(js, devEUI, types.AES128Key{}) {
+			cached++
+		}
+	}
+	a.So(cached, should.Equal, 2)
+}
+
+func TestHandleJoinReportAllErrors(t *testing.T) {
+	authorizedCtx := clusterauth.NewContext(test.Context(), nil)
+
+	newRequest := func() *ttnpb.JoinRequest {
+		return &ttnpb.JoinRequest{
+			SelectedMACVersion: ttnpb.MACVersion(42),
+			RawPayload: []byte{
+				/* MHDR with unsupported Major */
+				0x01,
+
+				/* MACPayload with zero JoinEUI and DevEUI */
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00,
+
+				/* MIC */
+				0x55, 0x17, 0x54, 0x8e,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name      string
+		ReportAll bool
+		Assert    func(*assertions.Assertion, error)
+	}{
+		{
+			Name: "FailFast",
+			Assert: func(a *assertions.Assertion, err error) {
+				ttnErr, ok := errors.From(err)
+				if !a.So(ok, should.BeTrue) {
+					return
+				}
+				a.So(ttnErr.Name(), should.Equal, "lorawan_version")
+				a.So(errors.Details(err), should.BeEmpty)
+			},
+		},
+		{
+			Name:      "ReportAll",
+			ReportAll: true,
+			Assert: func(a *assertions.Assertion, err error) {
+				if !a.So(errors.Resemble(err, ErrInvalidJoinRequest), should.BeTrue) {
+					return
+				}
+				var names []string
+				for _, d := range errors.Details(err) {
+					if ttnErr, ok := errors.From(d.(error)); a.So(ok, should.BeTrue) {
+						names = append(names, ttnErr.Name())
+					}
+				}
+				a.So(names, should.Resemble, []string{
+					"lorawan_version",
+					"lorawan_version",
+					"no_dev_eui",
+					"no_join_eui",
+				})
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			c := component.MustNew(test.GetLogger(t), &component.Config{})
+			js := NsJsServer{
+				JS: test.Must(New(
+					c,
+					&Config{
+						Devices:                    &MockDeviceRegistry{},
+						Keys:                       &MockKeyRegistry{},
+						JoinEUIPrefixes:            joinEUIPrefixes,
+						ReportAllJoinRequestErrors: tc.ReportAll,
+					},
+				)).(*JoinServer),
+			}
+
+			res, err := js.HandleJoin(authorizedCtx, newRequest())
+			a.So(res, should.BeNil)
+			if !a.So(err, should.NotBeNil) {
+				t.FailNow()
+			}
+			tc.Assert(a, err)
+		})
+	}
+}
+
+func TestHandleJoinMissingNwkKey(t *testing.T) {
+	a := assertions.New(t)
+
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+	authorizedCtx := clusterauth.NewContext(test.Context(), nil)
+
+	redisClient, flush := test.NewRedis(t, "joinserver_test")
+	defer flush()
+	defer redisClient.Close()
+	devReg := &redis.DeviceRegistry{Redis: redisClient}
+	keyReg := &redis.KeyRegistry{Redis: redisClient}
+
+	c := component.MustNew(test.GetLogger(t), &component.Config{})
+	js := test.Must(New(
+		c,
+		&Config{
+			Devices:         devReg,
+			Keys:            keyReg,
+			JoinEUIPrefixes: joinEUIPrefixes,
+		},
+	)).(*JoinServer)
+	test.Must(nil, c.Start())
+	defer c.Close()
+
+	// The device is provisioned with only an AppKey, as devices provisioned before the separation of network and
+	// application root keys are.
+	_, err := CreateDevice(authorizedCtx, devReg, &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			DevEUI:  &devEUI,
+			JoinEUI: &joinEUI,
+		},
+		RootKeys: &ttnpb.RootKeys{
+			AppKey: &ttnpb.KeyEnvelope{Key: appKey[:]},
+		},
+		LoRaWANVersion:       ttnpb.MAC_V1_1,
+		NetworkServerAddress: nsAddr,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	res, err := NsJsServer{JS: js}.HandleJoin(authorizedCtx, &ttnpb.JoinRequest{
+		SelectedMACVersion: ttnpb.MAC_V1_1,
+		RawPayload: []byte{
+			/* MHDR */
+			0x00,
+
+			/* MACPayload */
+			/** JoinEUI **/
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+			/** DevEUI **/
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+			/** DevNonce **/
+			0x00, 0x00,
+
+			/* MIC */
+			0x55, 0x17, 0x54, 0x8e,
+		},
+		DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+		NetID:   types.NetID{0x42, 0xff, 0xff},
+	})
+	a.So(res, should.BeNil)
+	a.So(errors.Resemble(err, ErrMissingNwkKey), should.BeTrue)
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+
+	// The failed join does not use up a JoinNonce or DevNonce.
+	dev, err := devReg.GetByEUI(authorizedCtx, joinEUI, devEUI, []string{"last_dev_nonce", "last_join_nonce", "session"})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(dev.LastJoinNonce, should.BeZeroValue)
+	a.So(dev.LastDevNonce, should.BeZeroValue)
+	a.So(dev.Session, should.BeNil)
+}
+
+type mockClock struct {
+	now time.Time
+}
+
+func (c *mockClock) Now() time.Time {
+	return c.now
+}
+
+func TestHandleJoinClock(t *testing.T) {
+	a := assertions.New(t)
+
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	clock := &mockClock{now: time.Unix(42, 0).UTC()}
+
+	authorizedCtx := clusterauth.NewContext(test.Context(), nil)
+
+	redisClient, flush := test.NewRedis(t, "joinserver_test")
+	defer flush()
+	defer redisClient.Close()
+	devReg := &redis.DeviceRegistry{Redis: redisClient}
+	keyReg := &redis.KeyRegistry{Redis: redisClient}
+
+	c := component.MustNew(test.GetLogger(t), &component.Config{})
+	js := test.Must(New(
+		c,
+		&Config{
+			Devices:         devReg,
+			Keys:            keyReg,
+			JoinEUIPrefixes: joinEUIPrefixes,
+			Clock:           clock,
+		},
+	)).(*JoinServer)
+	test.Must(nil, c.Start())
+	defer c.Close()
+
+	_, err := CreateDevice(authorizedCtx, devReg, &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			DevEUI:  &devEUI,
+			JoinEUI: &joinEUI,
+		},
+		RootKeys: &ttnpb.RootKeys{
+			AppKey: &ttnpb.KeyEnvelope{Key: appKey[:]},
+			NwkKey: &ttnpb.KeyEnvelope{Key: nwkKey[:]},
+		},
+		LoRaWANVersion:       ttnpb.MAC_V1_1,
+		NetworkServerAddress: nsAddr,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	res, err := NsJsServer{JS: js}.HandleJoin(authorizedCtx, &ttnpb.JoinRequest{
+		SelectedMACVersion: ttnpb.MAC_V1_1,
+		RawPayload: []byte{
+			/* MHDR */
+			0x00,
+
+			/* MACPayload */
+			/** JoinEUI **/
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+			/** DevEUI **/
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+			/** DevNonce **/
+			0x00, 0x00,
+
+			/* MIC */
+			0x55, 0x17, 0x54, 0x8e,
+		},
+		DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+		NetID:   types.NetID{0x42, 0xff, 0xff},
+	})
+	if !a.So(err, should.BeNil) || !a.So(res, should.NotBeNil) {
+		t.FailNow()
+	}
+
+	dev, err := devReg.GetByEUI(authorizedCtx, joinEUI, devEUI, []string{"session"})
+	if !a.So(err, should.BeNil) || !a.So(dev.Session, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(dev.Session.StartedAt, should.Equal, clock.now)
+}
+
+func TestGetNwkSKeysCacheClock(t *testing.T) {
+	a := assertions.New(t)
+
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	clock := &mockClock{now: time.Unix(42, 0).UTC()}
+
+	var gets int
+	keyReg := &MockKeyRegistry{
+		GetByIDFunc: func(ctx context.Context, devEUI types.EUI64, id []byte, paths []string) (*ttnpb.SessionKeys, error) {
+			gets++
+			return &ttnpb.SessionKeys{
+				FNwkSIntKey: ttnpb.NewPopulatedKeyEnvelope(test.Randy, false),
+				SNwkSIntKey: ttnpb.NewPopulatedKeyEnvelope(test.Randy, false),
+				NwkSEncKey:  ttnpb.NewPopulatedKeyEnvelope(test.Randy, false),
+			}, nil
+		},
+	}
+
+	ctx := clusterauth.NewContext(test.ContextWithT(test.Context(), t), nil)
+	c := component.MustNew(test.GetLogger(t), &component.Config{})
+	js := NsJsServer{
+		JS: test.Must(New(
+			c,
+			&Config{
+				Keys:            keyReg,
+				Devices:         &MockDeviceRegistry{},
+				JoinEUIPrefixes: joinEUIPrefixes,
+				NwkSKeysTTL:     time.Hour,
+				Clock:           clock,
+			},
+		)).(*JoinServer),
+	}
+	test.Must(nil, c.Start())
+
+	req := &ttnpb.SessionKeyRequest{
+		DevEUI:       devEUI,
+		SessionKeyID: []byte{0x11, 0x22, 0x33, 0x44},
+	}
+
+	_, err := js.GetNwkSKeys(ctx, req)
+	a.So(err, should.BeNil)
+	a.So(gets, should.Equal, 1)
+
+	// The cached keys are used until the TTL expires on the clock of the Join Server.
+	clock.now = clock.now.Add(time.Hour - time.Nanosecond)
+	_, err = js.GetNwkSKeys(ctx, req)
+	a.So(err, should.BeNil)
+	a.So(gets, should.Equal, 1)
+
+	clock.now = clock.now.Add(time.Nanosecond)
+	_, err = js.GetNwkSKeys(ctx, req)
+	a.So(err, should.BeNil)
+	a.So(gets, should.Equal, 2)
+}
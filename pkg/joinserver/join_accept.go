@@ -0,0 +1,49 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoservices"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// hasJoinAcceptRootKey returns whether the device has the root key that the join-accept is encrypted with in the
+// given LoRaWAN version. Otherwise, the join-accept is encrypted by the crypto server.
+func hasJoinAcceptRootKey(dev *ttnpb.EndDevice, version ttnpb.MACVersion) bool {
+	if dev.RootKeys == nil {
+		return false
+	}
+	if version.Compare(ttnpb.MAC_V1_1) >= 0 {
+		return dev.RootKeys.NwkKey != nil
+	}
+	return dev.RootKeys.AppKey != nil
+}
+
+// selectJoinAcceptKey returns the root key to encrypt the join-accept with that is sent in response to a message of
+// the given type. The join-accept is encrypted with the NwkKey in LoRaWAN 1.1 and with the AppKey in LoRaWAN 1.0.x.
+// The key is taken from the crypto services, which hold the unwrapped root keys of the device.
+// The Join Server only handles join-requests; other message types are rejected.
+func selectJoinAcceptKey(ctx context.Context, network cryptoservices.Network, application cryptoservices.Application, dev *ttnpb.EndDevice, version ttnpb.MACVersion, msgType ttnpb.MType) (types.AES128Key, error) {
+	if msgType != ttnpb.MType_JOIN_REQUEST {
+		return types.AES128Key{}, errWrongPayloadType.WithAttributes("type", msgType)
+	}
+	if version.Compare(ttnpb.MAC_V1_1) >= 0 {
+		return network.GetNwkKey(ctx, dev)
+	}
+	return application.GetAppKey(ctx, dev)
+}
@@ -0,0 +1,108 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoservices"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestSelectJoinAcceptKey(t *testing.T) {
+	devEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	nwkKey := types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	appKey := types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0xff}
+	dev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			DevEUI: &devEUI,
+		},
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Network        cryptoservices.Network
+		Application    cryptoservices.Application
+		MACVersion     ttnpb.MACVersion
+		MType          ttnpb.MType
+		Key            types.AES128Key
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:        "1.1/Join",
+			Network:     cryptoservices.NewMemory(&nwkKey, nil),
+			Application: cryptoservices.NewMemory(nil, &appKey),
+			MACVersion:  ttnpb.MAC_V1_1,
+			MType:       ttnpb.MType_JOIN_REQUEST,
+			Key:         nwkKey,
+		},
+		{
+			Name:           "1.1/Rejoin",
+			Network:        cryptoservices.NewMemory(&nwkKey, nil),
+			Application:    cryptoservices.NewMemory(nil, &appKey),
+			MACVersion:     ttnpb.MAC_V1_1,
+			MType:          ttnpb.MType_REJOIN_REQUEST,
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:           "1.1/Join/NoNwkKey",
+			Network:        cryptoservices.NewMemory(nil, nil),
+			Application:    cryptoservices.NewMemory(nil, &appKey),
+			MACVersion:     ttnpb.MAC_V1_1,
+			MType:          ttnpb.MType_JOIN_REQUEST,
+			ErrorAssertion: errors.IsDataLoss,
+		},
+		{
+			Name:        "1.0.2/Join",
+			Network:     cryptoservices.NewMemory(nil, &appKey),
+			Application: cryptoservices.NewMemory(nil, &appKey),
+			MACVersion:  ttnpb.MAC_V1_0_2,
+			MType:       ttnpb.MType_JOIN_REQUEST,
+			Key:         appKey,
+		},
+		{
+			Name:           "1.0.2/Rejoin",
+			Network:        cryptoservices.NewMemory(nil, &appKey),
+			Application:    cryptoservices.NewMemory(nil, &appKey),
+			MACVersion:     ttnpb.MAC_V1_0_2,
+			MType:          ttnpb.MType_REJOIN_REQUEST,
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:           "1.1/Uplink",
+			Network:        cryptoservices.NewMemory(&nwkKey, nil),
+			Application:    cryptoservices.NewMemory(nil, &appKey),
+			MACVersion:     ttnpb.MAC_V1_1,
+			MType:          ttnpb.MType_UNCONFIRMED_UP,
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			key, err := selectJoinAcceptKey(test.Context(), tc.Network, tc.Application, dev, tc.MACVersion, tc.MType)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(key, should.Resemble, tc.Key)
+		})
+	}
+}
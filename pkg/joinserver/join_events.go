@@ -0,0 +1,85 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// joinEventOutcomes maps the names of join events to their outcome.
+var joinEventOutcomes = map[string]string{
+	"js.join.accept": "accept",
+	"js.join.reject": "reject",
+}
+
+// JoinEventFilter selects join events. Zero fields match any event.
+type JoinEventFilter struct {
+	ApplicationIdentifiers *ttnpb.ApplicationIdentifiers
+	JoinEUI                *types.EUI64
+	DevEUI                 *types.EUI64
+	// Outcome is either accept or reject.
+	Outcome string
+}
+
+// Match returns whether evt is a join event that matches the filter.
+func (f JoinEventFilter) Match(evt events.Event) bool {
+	outcome, ok := joinEventOutcomes[evt.Name()]
+	if !ok || f.Outcome != "" && f.Outcome != outcome {
+		return false
+	}
+	if f.ApplicationIdentifiers == nil && f.JoinEUI == nil && f.DevEUI == nil {
+		return true
+	}
+	for _, entityIDs := range evt.Identifiers().GetEntityIdentifiers() {
+		ids := entityIDs.GetDeviceIDs()
+		if ids == nil {
+			continue
+		}
+		if f.ApplicationIdentifiers != nil && !ids.ApplicationIdentifiers.Equal(*f.ApplicationIdentifiers) {
+			continue
+		}
+		if f.JoinEUI != nil && (ids.JoinEUI == nil || !ids.JoinEUI.Equal(*f.JoinEUI)) {
+			continue
+		}
+		if f.DevEUI != nil && (ids.DevEUI == nil || !ids.DevEUI.Equal(*f.DevEUI)) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// Subscribe returns a channel of the join events that match the filter.
+// The subscription ends when ctx is done. The channel is not closed.
+func (js *JoinServer) Subscribe(ctx context.Context, filter JoinEventFilter) (events.Channel, error) {
+	ch := make(events.Channel, 16)
+	hdl := events.ContextHandler(ctx, events.HandlerFunc(func(evt events.Event) {
+		if filter.Match(evt) {
+			ch.Notify(evt)
+		}
+	}))
+	if err := events.Subscribe("js.join.*", hdl); err != nil {
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		events.Unsubscribe("js.join.*", hdl)
+	}()
+	return ch, nil
+}
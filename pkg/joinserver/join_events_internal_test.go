@@ -0,0 +1,156 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+const timeout = 10 * test.Delay
+
+type mockStreamJoinEventsServer struct {
+	grpc.ServerStream
+	ctx context.Context
+	ch  chan *ttnpb.Event
+}
+
+func (s *mockStreamJoinEventsServer) Context() context.Context { return s.ctx }
+
+func (s *mockStreamJoinEventsServer) Send(evt *ttnpb.Event) error {
+	s.ch <- evt
+	return nil
+}
+
+func TestStreamJoinEvents(t *testing.T) {
+	fooIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		DeviceID:               "foo-device",
+		JoinEUI:                &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+		DevEUI:                 &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x01},
+	}
+	barIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		DeviceID:               "bar-device",
+		JoinEUI:                &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+		DevEUI:                 &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x02},
+	}
+	bazIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "baz-app"},
+		DeviceID:               "baz-device",
+		JoinEUI:                &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x43},
+		DevEUI:                 &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x03},
+	}
+
+	for _, tc := range []struct {
+		Name     string
+		Request  *ttnpb.StreamJoinEventsRequest
+		Expected []string
+	}{
+		{
+			Name: "Application",
+			Request: &ttnpb.StreamJoinEventsRequest{
+				ApplicationIdentifiers: fooIDs.ApplicationIdentifiers,
+			},
+			Expected: []string{"foo-device/accept", "bar-device/accept", "foo-device/reject"},
+		},
+		{
+			Name: "DevEUI",
+			Request: &ttnpb.StreamJoinEventsRequest{
+				ApplicationIdentifiers: fooIDs.ApplicationIdentifiers,
+				DevEUI:                 fooIDs.DevEUI,
+			},
+			Expected: []string{"foo-device/accept", "foo-device/reject"},
+		},
+		{
+			Name: "JoinEUI",
+			Request: &ttnpb.StreamJoinEventsRequest{
+				ApplicationIdentifiers: bazIDs.ApplicationIdentifiers,
+				JoinEUI:                bazIDs.JoinEUI,
+			},
+			Expected: []string{"baz-device/reject"},
+		},
+		{
+			Name: "Outcome",
+			Request: &ttnpb.StreamJoinEventsRequest{
+				ApplicationIdentifiers: fooIDs.ApplicationIdentifiers,
+				Outcome:                "reject",
+			},
+			Expected: []string{"foo-device/reject"},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ctx, cancel := context.WithCancel(test.Context())
+			defer cancel()
+			ctx = rights.NewContext(ctx, rights.Rights{
+				ApplicationRights: map[string]*ttnpb.Rights{
+					unique.ID(ctx, tc.Request.ApplicationIdentifiers): ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+				},
+			})
+
+			stream := &mockStreamJoinEventsServer{
+				ctx: ctx,
+				ch:  make(chan *ttnpb.Event, 8),
+			}
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- jsEndDeviceRegistryServer{JS: &JoinServer{}}.StreamJoinEvents(tc.Request, stream)
+			}()
+			time.Sleep(test.Delay)
+
+			events.Publish(evtAcceptJoin(ctx, fooIDs, nil))
+			events.Publish(evtAcceptJoin(ctx, barIDs, nil))
+			events.Publish(evtDevAddrConflict(ctx, fooIDs, nil))
+			events.Publish(evtRejectJoin(ctx, fooIDs, nil))
+			events.Publish(evtRejectJoin(ctx, bazIDs, nil))
+
+			for _, expected := range tc.Expected {
+				select {
+				case evt := <-stream.ch:
+					ids := evt.Identifiers.GetEntityIdentifiers()[0].GetDeviceIDs()
+					a.So(ids.DeviceID+"/"+joinEventOutcomes[evt.Name], should.Equal, expected)
+				case <-time.After(timeout):
+					t.Fatalf("Timed out waiting for %s", expected)
+				}
+			}
+			select {
+			case evt := <-stream.ch:
+				t.Fatalf("Unexpected event %s", evt.Name)
+			case <-time.After(test.Delay):
+			}
+
+			cancel()
+			select {
+			case err := <-errCh:
+				a.So(err, should.Equal, context.Canceled)
+			case <-time.After(timeout):
+				t.Fatal("Timed out waiting for stream to end")
+			}
+		})
+	}
+}
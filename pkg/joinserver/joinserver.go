@@ -16,6 +16,7 @@
 package joinserver
 
 import (
+	"context"
 	"io"
 	"math/rand"
 	"sync"
@@ -36,6 +37,44 @@ type Config struct {
 	Devices         DeviceRegistry       `name:"-"`
 	Keys            KeyRegistry          `name:"-"`
 	JoinEUIPrefixes []*types.EUI64Prefix `name:"join-eui-prefix" description:"JoinEUI prefixes handled by this JS"`
+	NwkSKeysTTL     time.Duration        `name:"nwk-s-keys-ttl" description:"Time to cache the network session keys requested by Network Servers (0 is disabled)"`
+
+	RejectDevAddrConflicts bool `name:"reject-dev-addr-conflicts" description:"Reject join-requests with a DevAddr, which is used by the session of another device"`
+
+	ReportAllJoinRequestErrors bool `name:"report-all-join-request-errors" description:"Report all validation errors of invalid join-requests instead of the first one"`
+
+	// SessionKeyIDFunc generates the IDs of the session keys. If nil, random ULIDs are generated.
+	SessionKeyIDFunc SessionKeyIDFunc `name:"-"`
+
+	// Clock is the source of time of the Join Server. If nil, the system clock is used.
+	Clock Clock `name:"-"`
+
+	KeyWriteBehind KeyWriteBehindConfig `name:"key-write-behind"`
+
+	PrecomputedKeys PrecomputedKeysConfig `name:"precomputed-keys"`
+
+	// AddressRewriter rewrites the Network Server and Application Server addresses of devices in join responses.
+	// If nil, the stored addresses are returned unchanged.
+	AddressRewriter AddressRewriter `name:"-"`
+}
+
+// SessionKeyIDFunc returns the ID of the session keys that are derived in a join of dev with joinNonce and devNonce.
+// The function must not modify dev.
+type SessionKeyIDFunc func(dev *ttnpb.EndDevice, joinNonce types.JoinNonce, devNonce types.DevNonce) ([]byte, error)
+
+// AddressRewriter maps an address stored on a device to the address that external peers use to reach it.
+// The function returns the address unchanged if it should not be rewritten.
+type AddressRewriter func(address string) string
+
+// AddressMap returns an AddressRewriter, which rewrites the addresses that are keys of m to the respective values.
+// Addresses that are not keys of m are not rewritten.
+func AddressMap(m map[string]string) AddressRewriter {
+	return func(address string) string {
+		if rewritten, ok := m[address]; ok {
+			return rewritten
+		}
+		return address
+	}
 }
 
 // JoinServer implements the Join Server component.
@@ -49,6 +88,22 @@ type JoinServer struct {
 
 	euiPrefixes []*types.EUI64Prefix
 
+	rejectDevAddrConflicts bool
+
+	reportAllJoinRequestErrors bool
+
+	sessionKeyID SessionKeyIDFunc
+
+	clock Clock
+
+	rewriteAddress AddressRewriter
+
+	nwkSKeys *nwkSKeysCache
+
+	precomputedKeys *precomputedKeysCache
+
+	keyWrites *keyWriteQueue
+
 	entropyMu *sync.Mutex
 	entropy   io.Reader
 
@@ -69,10 +124,37 @@ func New(c *component.Component, conf *Config) (*JoinServer, error) {
 
 		euiPrefixes: conf.JoinEUIPrefixes,
 
+		rejectDevAddrConflicts: conf.RejectDevAddrConflicts,
+
+		reportAllJoinRequestErrors: conf.ReportAllJoinRequestErrors,
+
+		precomputedKeys: newPrecomputedKeysCache(conf.PrecomputedKeys),
+
 		entropyMu: &sync.Mutex{},
 		entropy:   ulid.Monotonic(rand.New(rand.NewSource(time.Now().UnixNano())), 0),
 	}
 
+	if conf.NwkSKeysTTL > 0 {
+		js.nwkSKeys = newNwkSKeysCache(conf.NwkSKeysTTL)
+	}
+	js.rewriteAddress = conf.AddressRewriter
+	if js.rewriteAddress == nil {
+		js.rewriteAddress = func(address string) string { return address }
+	}
+	js.sessionKeyID = conf.SessionKeyIDFunc
+	if js.sessionKeyID == nil {
+		js.sessionKeyID = js.newULIDSessionKeyID
+	}
+	js.clock = conf.Clock
+	if js.clock == nil {
+		js.clock = systemClock{}
+	}
+
+	if conf.KeyWriteBehind.Enable {
+		js.keyWrites = newKeyWriteQueue(conf.Keys, conf.KeyWriteBehind)
+		c.RegisterTask("key_write_behind", js.keyWrites.run, component.TaskRestartOnFailure)
+	}
+
 	js.grpc.jsDevices = jsEndDeviceRegistryServer{JS: js}
 	js.grpc.asJs = asJsServer{JS: js}
 	js.grpc.nsJs = nsJsServer{JS: js}
@@ -85,6 +167,53 @@ func New(c *component.Component, conf *Config) (*JoinServer, error) {
 	return js, nil
 }
 
+// newULIDSessionKeyID returns a new ULID as session key ID.
+func (js *JoinServer) newULIDSessionKeyID(*ttnpb.EndDevice, types.JoinNonce, types.DevNonce) ([]byte, error) {
+	js.entropyMu.Lock()
+	id, err := ulid.New(ulid.Timestamp(js.clock.Now()), js.entropy)
+	js.entropyMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return id[:], nil
+}
+
+// matchesJoinEUI returns whether joinEUI is covered by one of the JoinEUI prefixes of js.
+func (js *JoinServer) matchesJoinEUI(joinEUI types.EUI64) bool {
+	for _, p := range js.euiPrefixes {
+		if p.Matches(joinEUI) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetDefaultJoinEUI returns the default JoinEUI of js.
+// A default JoinEUI is only available if js is configured with exactly one JoinEUI prefix, which is fully specified.
+func (js *JoinServer) GetDefaultJoinEUI() (types.EUI64, bool) {
+	if len(js.euiPrefixes) != 1 || js.euiPrefixes[0].Length != 64 {
+		return types.EUI64{}, false
+	}
+	return js.euiPrefixes[0].EUI64, true
+}
+
+// CreateDevice creates device dev in the device registry of js.
+// If dev has no JoinEUI, the default JoinEUI of js is used, if available.
+// CreateDevice returns errJoinEUINotRegistered if the JoinEUI is not covered by the JoinEUI prefixes of js.
+func (js *JoinServer) CreateDevice(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, error) {
+	if dev.EndDeviceIdentifiers.JoinEUI == nil || dev.EndDeviceIdentifiers.JoinEUI.IsZero() {
+		joinEUI, ok := js.GetDefaultJoinEUI()
+		if !ok {
+			return nil, errNoJoinEUI
+		}
+		dev.EndDeviceIdentifiers.JoinEUI = &joinEUI
+	}
+	if !js.matchesJoinEUI(*dev.EndDeviceIdentifiers.JoinEUI) {
+		return nil, errJoinEUINotRegistered.WithAttributes("join_eui", *dev.EndDeviceIdentifiers.JoinEUI)
+	}
+	return CreateDevice(ctx, js.devices, dev)
+}
+
 // Roles of the gRPC service.
 func (js *JoinServer) Roles() []ttnpb.PeerInfo_Role {
 	return []ttnpb.PeerInfo_Role{ttnpb.PeerInfo_JOIN_SERVER}
@@ -23,15 +23,21 @@ import (
 )
 
 var (
-	ErrDevNonceTooSmall  = errDevNonceTooSmall
-	ErrNoAppSKey         = errNoAppSKey
-	ErrNoFNwkSIntKey     = errNoFNwkSIntKey
-	ErrNoNwkSEncKey      = errNoNwkSEncKey
-	ErrNoSNwkSIntKey     = errNoSNwkSIntKey
-	ErrRegistryOperation = errRegistryOperation
-	ErrReuseDevNonce     = errReuseDevNonce
-
-	KeyToBytes = keyToBytes
+	ErrDevAddrConflict      = errDevAddrConflict
+	ErrDeviceNotFound       = errDeviceNotFound
+	ErrDevNonceTooSmall     = errDevNonceTooSmall
+	ErrJoinEUINotRegistered = errJoinEUINotRegistered
+	ErrMICMismatch          = errMICMismatch
+	ErrNoAppSKey            = errNoAppSKey
+	ErrNoFNwkSIntKey        = errNoFNwkSIntKey
+	ErrNoNetID              = errNoNetID
+	ErrNoNwkSEncKey         = errNoNwkSEncKey
+	ErrNoSNwkSIntKey        = errNoSNwkSIntKey
+	ErrRegistryOperation    = errRegistryOperation
+	ErrReuseDevNonce        = errReuseDevNonce
+
+	JoinAcceptSettings = joinAcceptSettings
+	KeyToBytes         = keyToBytes
 )
 
 type AsJsServer = asJsServer
@@ -39,8 +45,10 @@ type NsJsServer = nsJsServer
 type JsDeviceServer = jsEndDeviceRegistryServer
 
 type MockDeviceRegistry struct {
-	GetByEUIFunc func(context.Context, types.EUI64, types.EUI64, []string) (*ttnpb.EndDevice, error)
-	SetByEUIFunc func(context.Context, types.EUI64, types.EUI64, []string, func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error)
+	GetByEUIFunc      func(context.Context, types.EUI64, types.EUI64, []string) (*ttnpb.EndDevice, error)
+	SetByEUIFunc      func(context.Context, types.EUI64, types.EUI64, []string, func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error)
+	ListByDevAddrFunc func(context.Context, types.DevAddr, []string) ([]*ttnpb.EndDevice, error)
+	DeleteByEUIsFunc  func(context.Context, types.EUI64, []types.EUI64) error
 }
 
 func (r *MockDeviceRegistry) GetByEUI(ctx context.Context, joinEUI types.EUI64, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, error) {
@@ -57,9 +65,26 @@ func (r *MockDeviceRegistry) SetByEUI(ctx context.Context, joinEUI types.EUI64,
 	return r.SetByEUIFunc(ctx, joinEUI, devEUI, paths, f)
 }
 
+func (r *MockDeviceRegistry) ListByDevAddr(ctx context.Context, devAddr types.DevAddr, paths []string) ([]*ttnpb.EndDevice, error) {
+	if r.ListByDevAddrFunc == nil {
+		return nil, errors.New("Not implemented")
+	}
+	return r.ListByDevAddrFunc(ctx, devAddr, paths)
+}
+
+func (r *MockDeviceRegistry) DeleteByEUIs(ctx context.Context, joinEUI types.EUI64, devEUIs []types.EUI64) error {
+	if r.DeleteByEUIsFunc == nil {
+		return errors.New("Not implemented")
+	}
+	return r.DeleteByEUIsFunc(ctx, joinEUI, devEUIs)
+}
+
 type MockKeyRegistry struct {
-	GetByIDFunc func(context.Context, types.EUI64, []byte, []string) (*ttnpb.SessionKeys, error)
-	SetByIDFunc func(context.Context, types.EUI64, []byte, []string, func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error)) (*ttnpb.SessionKeys, error)
+	GetByIDFunc      func(context.Context, types.EUI64, []byte, []string) (*ttnpb.SessionKeys, error)
+	GetByIDsFunc     func(context.Context, types.EUI64, [][]byte, []string) ([]*ttnpb.SessionKeys, error)
+	SetByIDFunc      func(context.Context, types.EUI64, []byte, []string, func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error)) (*ttnpb.SessionKeys, error)
+	ListByEUIFunc    func(context.Context, types.EUI64, []string) ([]*ttnpb.SessionKeys, error)
+	DeleteByEUIsFunc func(context.Context, []types.EUI64) error
 }
 
 func (r *MockKeyRegistry) GetByID(ctx context.Context, devEUI types.EUI64, id []byte, paths []string) (*ttnpb.SessionKeys, error) {
@@ -69,9 +94,44 @@ func (r *MockKeyRegistry) GetByID(ctx context.Context, devEUI types.EUI64, id []
 	return r.GetByIDFunc(ctx, devEUI, id, paths)
 }
 
+func (r *MockKeyRegistry) GetByIDs(ctx context.Context, devEUI types.EUI64, ids [][]byte, paths []string) ([]*ttnpb.SessionKeys, error) {
+	if r.GetByIDsFunc == nil {
+		return nil, errors.New("Not implemented")
+	}
+	return r.GetByIDsFunc(ctx, devEUI, ids, paths)
+}
+
 func (r *MockKeyRegistry) SetByID(ctx context.Context, devEUI types.EUI64, id []byte, paths []string, f func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error)) (*ttnpb.SessionKeys, error) {
 	if r.SetByIDFunc == nil {
 		return nil, errors.New("Not implemented")
 	}
 	return r.SetByIDFunc(ctx, devEUI, id, paths, f)
 }
+
+func (r *MockKeyRegistry) ListByEUI(ctx context.Context, devEUI types.EUI64, paths []string) ([]*ttnpb.SessionKeys, error) {
+	if r.ListByEUIFunc == nil {
+		return nil, errors.New("Not implemented")
+	}
+	return r.ListByEUIFunc(ctx, devEUI, paths)
+}
+
+func (r *MockKeyRegistry) DeleteByEUIs(ctx context.Context, devEUIs []types.EUI64) error {
+	if r.DeleteByEUIsFunc == nil {
+		return errors.New("Not implemented")
+	}
+	return r.DeleteByEUIsFunc(ctx, devEUIs)
+}
+
+// SetPrecomputedAppSKey replaces the AppSKey of the precomputed keys of the device, so that tests can tell whether a
+// join is served from the precomputed keys. It returns whether the device has precomputed keys.
+func SetPrecomputedAppSKey(js *JoinServer, devEUI types.EUI64, appSKey types.AES128Key) bool {
+	js.precomputedKeys.mu.Lock()
+	defer js.precomputedKeys.mu.Unlock()
+	keys, ok := js.precomputedKeys.entries[devEUI]
+	if !ok {
+		return false
+	}
+	keys.appSKey = appSKey
+	js.precomputedKeys.entries[devEUI] = keys
+	return true
+}
@@ -0,0 +1,157 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	. "go.thethings.network/lorawan-stack/pkg/joinserver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestCreateDevice(t *testing.T) {
+	fullPrefix := &types.EUI64Prefix{EUI64: types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}, Length: 64}
+	partialPrefix := &types.EUI64Prefix{EUI64: types.EUI64{0x42, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, Length: 16}
+
+	for _, tc := range []struct {
+		Name            string
+		JoinEUIPrefixes []*types.EUI64Prefix
+		JoinEUI         *types.EUI64
+		ExpectedJoinEUI types.EUI64
+		ErrorAssertion  func(error) bool
+	}{
+		{
+			Name:            "In range",
+			JoinEUIPrefixes: []*types.EUI64Prefix{partialPrefix},
+			JoinEUI:         eui64Ptr(types.EUI64{0x42, 0xff, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}),
+			ExpectedJoinEUI: types.EUI64{0x42, 0xff, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+		},
+		{
+			Name:            "In range of second prefix",
+			JoinEUIPrefixes: []*types.EUI64Prefix{fullPrefix, partialPrefix},
+			JoinEUI:         eui64Ptr(types.EUI64{0x42, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01}),
+			ExpectedJoinEUI: types.EUI64{0x42, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
+		},
+		{
+			Name:            "Out of range",
+			JoinEUIPrefixes: []*types.EUI64Prefix{fullPrefix, partialPrefix},
+			JoinEUI:         eui64Ptr(types.EUI64{0x42, 0xfe, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}),
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, ErrJoinEUINotRegistered)
+			},
+		},
+		{
+			Name:    "No prefixes",
+			JoinEUI: eui64Ptr(types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}),
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, ErrJoinEUINotRegistered)
+			},
+		},
+		{
+			Name:            "Default JoinEUI",
+			JoinEUIPrefixes: []*types.EUI64Prefix{fullPrefix},
+			ExpectedJoinEUI: fullPrefix.EUI64,
+		},
+		{
+			Name:            "No default JoinEUI",
+			JoinEUIPrefixes: []*types.EUI64Prefix{partialPrefix},
+			ErrorAssertion: func(err error) bool {
+				return errors.IsInvalidArgument(err)
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			var created *ttnpb.EndDevice
+			reg := &MockDeviceRegistry{
+				SetByEUIFunc: func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+					a.So(joinEUI, should.Equal, tc.ExpectedJoinEUI)
+					dev, _, err := f(nil)
+					created = dev
+					return dev, err
+				},
+			}
+			js := test.Must(New(
+				component.MustNew(test.GetLogger(t), &component.Config{}),
+				&Config{
+					Devices:         reg,
+					JoinEUIPrefixes: tc.JoinEUIPrefixes,
+				},
+			)).(*JoinServer)
+
+			dev, err := js.CreateDevice(test.Context(), &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					JoinEUI: tc.JoinEUI,
+					DevEUI:  registeredDevEUI,
+				},
+			})
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				a.So(dev, should.BeNil)
+				a.So(created, should.BeNil)
+				return
+			}
+			if !a.So(err, should.BeNil) || !a.So(dev, should.NotBeNil) {
+				t.FailNow()
+			}
+			a.So(*dev.JoinEUI, should.Equal, tc.ExpectedJoinEUI)
+			a.So(created, should.Equal, dev)
+		})
+	}
+}
+
+func TestGetDefaultJoinEUI(t *testing.T) {
+	a := assertions.New(t)
+
+	for _, tc := range []struct {
+		JoinEUIPrefixes []*types.EUI64Prefix
+		JoinEUI         types.EUI64
+		OK              bool
+	}{
+		{},
+		{
+			JoinEUIPrefixes: []*types.EUI64Prefix{{EUI64: types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}, Length: 64}},
+			JoinEUI:         types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+			OK:              true,
+		},
+		{
+			JoinEUIPrefixes: []*types.EUI64Prefix{{EUI64: types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x00}, Length: 56}},
+		},
+		{
+			JoinEUIPrefixes: []*types.EUI64Prefix{
+				{EUI64: types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}, Length: 64},
+				{EUI64: types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x43}, Length: 64},
+			},
+		},
+	} {
+		js := test.Must(New(
+			component.MustNew(test.GetLogger(t), &component.Config{}),
+			&Config{
+				JoinEUIPrefixes: tc.JoinEUIPrefixes,
+			},
+		)).(*JoinServer)
+		joinEUI, ok := js.GetDefaultJoinEUI()
+		a.So(ok, should.Equal, tc.OK)
+		a.So(joinEUI, should.Equal, tc.JoinEUI)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// KeyWriteBehindConfig is the configuration of deferred writes of session keys to the key registry.
+// If enabled, joins of devices whose root keys are in the device registry are accepted when the session keys can
+// not be written to the key registry. The session keys are then written in the background.
+// The deferred writes are queued in memory: they are flushed when the Join Server shuts down, but they are lost if the
+// Join Server stops without shutting down or if the key registry is still unavailable on shutdown.
+type KeyWriteBehindConfig struct {
+	// Enable enables deferred writes of session keys.
+	Enable bool `name:"enable" description:"Accept joins of devices with root keys when the session keys can not be written to the key registry"`
+	// RetryInterval is the interval between retries. Zero means DefaultKeyWriteBehindRetryInterval.
+	RetryInterval time.Duration `name:"retry-interval" description:"Interval between retries of deferred session key writes"`
+	// QueueSize is the maximum number of deferred writes. Zero means unlimited.
+	QueueSize int `name:"queue-size" description:"Maximum number of deferred session key writes"`
+}
+
+// DefaultKeyWriteBehindRetryInterval is the default interval between retries of deferred session key writes.
+const DefaultKeyWriteBehindRetryInterval = 5 * time.Second
+
+type keyWrite struct {
+	devEUI types.EUI64
+	keys   *ttnpb.SessionKeys
+}
+
+// keyWriteQueue queues session key writes that failed, to retry them later.
+type keyWriteQueue struct {
+	keys     KeyRegistry
+	size     int
+	interval time.Duration
+
+	flushMu sync.Mutex
+
+	mu       sync.Mutex
+	pending  []keyWrite
+	flushing int
+}
+
+func newKeyWriteQueue(keys KeyRegistry, conf KeyWriteBehindConfig) *keyWriteQueue {
+	q := &keyWriteQueue{
+		keys:     keys,
+		size:     conf.QueueSize,
+		interval: conf.RetryInterval,
+	}
+	if q.interval <= 0 {
+		q.interval = DefaultKeyWriteBehindRetryInterval
+	}
+	return q
+}
+
+// push queues the write of ks. It returns false if the queue is full. Writes that are being flushed count towards the
+// queue size, so that failed writes can always be queued again.
+func (q *keyWriteQueue) push(devEUI types.EUI64, ks *ttnpb.SessionKeys) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.size > 0 && len(q.pending)+q.flushing >= q.size {
+		return false
+	}
+	q.pending = append(q.pending, keyWrite{
+		devEUI: devEUI,
+		keys:   ks,
+	})
+	return true
+}
+
+// len returns the number of queued writes.
+func (q *keyWriteQueue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.pending)
+}
+
+// flush writes the queued session keys in order. It stops at the first write that fails; the failed write and the
+// writes after it stay queued.
+func (q *keyWriteQueue) flush(ctx context.Context) error {
+	q.flushMu.Lock()
+	defer q.flushMu.Unlock()
+	q.mu.Lock()
+	pending := q.pending
+	q.pending = nil
+	q.flushing = len(pending)
+	q.mu.Unlock()
+	for i, w := range pending {
+		if _, err := CreateKeys(ctx, q.keys, w.devEUI, w.keys); err != nil && !errors.Resemble(err, errDuplicateIdentifiers) {
+			q.mu.Lock()
+			q.pending = append(pending[i:], q.pending...)
+			q.flushing = 0
+			q.mu.Unlock()
+			return err
+		}
+		q.mu.Lock()
+		q.flushing--
+		q.mu.Unlock()
+	}
+	return nil
+}
+
+// run retries the queued writes until ctx is done. When ctx is done, the queued writes are flushed once more, since
+// they are lost otherwise.
+func (q *keyWriteQueue) run(ctx context.Context) error {
+	ticker := time.NewTicker(q.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if q.len() > 0 {
+				logger := log.FromContext(ctx)
+				flushCtx, cancel := context.WithTimeout(log.NewContext(context.Background(), logger), q.interval)
+				err := q.flush(flushCtx)
+				cancel()
+				if err != nil {
+					logger.WithError(err).WithField("pending", q.len()).Error("Failed to write deferred session keys on shutdown")
+				}
+			}
+			return ctx.Err()
+		case <-ticker.C:
+			if q.len() == 0 {
+				continue
+			}
+			if err := q.flush(ctx); err != nil {
+				log.FromContext(ctx).WithError(err).WithField("pending", q.len()).Warn("Failed to write deferred session keys")
+			}
+		}
+	}
+}
+
+// hasRootKeys returns whether the device has the root keys that are needed to handle a join with the MAC version.
+func hasRootKeys(dev *ttnpb.EndDevice, macVersion ttnpb.MACVersion) bool {
+	if dev.RootKeys == nil || dev.RootKeys.AppKey == nil {
+		return false
+	}
+	return macVersion.Compare(ttnpb.MAC_V1_1) < 0 || dev.RootKeys.NwkKey != nil
+}
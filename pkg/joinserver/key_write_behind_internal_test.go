@@ -0,0 +1,134 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+// blockingKeyRegistry is a KeyRegistry of which writes block until they are released.
+type blockingKeyRegistry struct {
+	KeyRegistry
+
+	started chan struct{}
+	release chan error
+
+	mu      sync.Mutex
+	written [][]byte
+}
+
+func (r *blockingKeyRegistry) SetByID(ctx context.Context, devEUI types.EUI64, id []byte, paths []string, f func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error)) (*ttnpb.SessionKeys, error) {
+	r.started <- struct{}{}
+	if err := <-r.release; err != nil {
+		return nil, err
+	}
+	ks, _, err := f(nil)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.written = append(r.written, ks.SessionKeyID)
+	r.mu.Unlock()
+	return ks, nil
+}
+
+func TestKeyWriteQueueSize(t *testing.T) {
+	a := assertions.New(t)
+
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	reg := &blockingKeyRegistry{
+		started: make(chan struct{}),
+		release: make(chan error),
+	}
+	q := newKeyWriteQueue(reg, KeyWriteBehindConfig{
+		Enable:    true,
+		QueueSize: 2,
+	})
+
+	a.So(q.push(devEUI, &ttnpb.SessionKeys{SessionKeyID: []byte{0x1}}), should.BeTrue)
+	a.So(q.push(devEUI, &ttnpb.SessionKeys{SessionKeyID: []byte{0x2}}), should.BeTrue)
+	a.So(q.push(devEUI, &ttnpb.SessionKeys{SessionKeyID: []byte{0x3}}), should.BeFalse)
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- q.flush(test.Context())
+	}()
+
+	// The writes that are being flushed count towards the queue size.
+	<-reg.started
+	a.So(q.push(devEUI, &ttnpb.SessionKeys{SessionKeyID: []byte{0x3}}), should.BeFalse)
+
+	// A failed write is queued again with the writes after it, within the queue size.
+	reg.release <- errors.New("key registry unavailable")
+	a.So(<-errCh, should.NotBeNil)
+	a.So(q.len(), should.Equal, 2)
+	a.So(q.push(devEUI, &ttnpb.SessionKeys{SessionKeyID: []byte{0x3}}), should.BeFalse)
+
+	// Written keys free up the queue.
+	go func() {
+		errCh <- q.flush(test.Context())
+	}()
+	<-reg.started
+	reg.release <- nil
+	<-reg.started
+	a.So(q.push(devEUI, &ttnpb.SessionKeys{SessionKeyID: []byte{0x3}}), should.BeTrue)
+	reg.release <- nil
+	a.So(<-errCh, should.BeNil)
+	a.So(q.len(), should.Equal, 1)
+	a.So(reg.written, should.Resemble, [][]byte{{0x1}, {0x2}})
+}
+
+func TestKeyWriteQueueShutdown(t *testing.T) {
+	a := assertions.New(t)
+
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	reg := &blockingKeyRegistry{
+		started: make(chan struct{}),
+		release: make(chan error),
+	}
+	q := newKeyWriteQueue(reg, KeyWriteBehindConfig{
+		Enable:        true,
+		RetryInterval: time.Hour,
+	})
+	a.So(q.push(devEUI, &ttnpb.SessionKeys{SessionKeyID: []byte{0x1}}), should.BeTrue)
+
+	ctx, cancel := context.WithCancel(test.Context())
+	errCh := make(chan error)
+	go func() {
+		errCh <- q.run(ctx)
+	}()
+	cancel()
+
+	// The queued writes are flushed on shutdown, with a context that is not done.
+	select {
+	case <-reg.started:
+	case <-time.After(timeout):
+		t.Fatal("Expected write on shutdown but nothing written")
+	}
+	reg.release <- nil
+	a.So(<-errCh, should.Equal, context.Canceled)
+	a.So(q.len(), should.Equal, 0)
+	a.So(reg.written, should.Resemble, [][]byte{{0x1}})
+}
@@ -0,0 +1,80 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+type cachedNwkSKeys struct {
+	res     *ttnpb.NwkSKeysResponse
+	expires time.Time
+}
+
+// nwkSKeysCache caches the NwkSKeys responses per DevEUI and session key ID.
+// The cached responses are shared between callers and must not be modified.
+type nwkSKeysCache struct {
+	ttl time.Duration
+
+	mu      sync.RWMutex
+	entries map[types.EUI64]map[string]cachedNwkSKeys
+}
+
+func newNwkSKeysCache(ttl time.Duration) *nwkSKeysCache {
+	return &nwkSKeysCache{
+		ttl:     ttl,
+		entries: make(map[types.EUI64]map[string]cachedNwkSKeys),
+	}
+}
+
+func (c *nwkSKeysCache) get(devEUI types.EUI64, sessionKeyID []byte, now time.Time) (*ttnpb.NwkSKeysResponse, bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[devEUI][string(sessionKeyID)]
+	c.mu.RUnlock()
+	if !ok || !now.Before(entry.expires) {
+		return nil, false
+	}
+	return entry.res, true
+}
+
+func (c *nwkSKeysCache) set(devEUI types.EUI64, sessionKeyID []byte, res *ttnpb.NwkSKeysResponse, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entries, ok := c.entries[devEUI]
+	if !ok {
+		entries = make(map[string]cachedNwkSKeys)
+		c.entries[devEUI] = entries
+	}
+	for id, entry := range entries {
+		if !now.Before(entry.expires) {
+			delete(entries, id)
+		}
+	}
+	entries[string(sessionKeyID)] = cachedNwkSKeys{
+		res:     res,
+		expires: now.Add(c.ttl),
+	}
+}
+
+// invalidate removes the cached responses of the device.
+func (c *nwkSKeysCache) invalidate(devEUI types.EUI64) {
+	c.mu.Lock()
+	delete(c.entries, devEUI)
+	c.mu.Unlock()
+}
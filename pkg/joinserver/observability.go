@@ -27,6 +27,10 @@ import (
 var (
 	evtRejectJoin = events.Define("js.join.reject", "reject join-request")
 	evtAcceptJoin = events.Define("js.join.accept", "accept join-request")
+
+	evtDevAddrConflict = events.Define("js.join.dev_addr_conflict", "detect DevAddr conflict")
+
+	evtDeferKeysWrite = events.Define("js.join.keys.defer", "defer session key write")
 )
 
 const (
@@ -81,11 +85,19 @@ func registerAcceptJoin(ctx context.Context, dev *ttnpb.EndDevice, msg *ttnpb.Jo
 	jsMetrics.joinAccepted.WithLabelValues(ctx, appID).Inc()
 }
 
-func registerRejectJoin(ctx context.Context, req *ttnpb.JoinRequest, err error) {
-	events.Publish(evtRejectJoin(ctx, nil, err))
+func registerRejectJoin(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, req *ttnpb.JoinRequest, err error) {
+	var evtIDs ttnpb.Identifiers
+	if !ids.IsZero() {
+		evtIDs = ids
+	}
+	events.Publish(evtRejectJoin(ctx, evtIDs, err))
 	if ttnErr, ok := errors.From(err); ok {
 		jsMetrics.joinRejected.WithLabelValues(ctx, ttnErr.String()).Inc()
 	} else {
 		jsMetrics.joinRejected.WithLabelValues(ctx, unknown).Inc()
 	}
 }
+
+func registerDeferKeysWrite(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, err error) {
+	events.Publish(evtDeferKeysWrite(ctx, ids, err))
+}
@@ -0,0 +1,175 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoservices"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// precomputedKeys are the session keys that are derived ahead of the expected next join of a device.
+type precomputedKeys struct {
+	rootKeys  *ttnpb.RootKeys
+	joinNonce types.JoinNonce
+	devNonce  types.DevNonce
+	nwkSKeys  cryptoservices.NwkSKeys
+	appSKey   types.AES128Key
+	expires   time.Time
+}
+
+const (
+	// DefaultPrecomputedKeysSize is the default maximum number of devices of which precomputed session keys are cached.
+	DefaultPrecomputedKeysSize = 4096
+	// DefaultPrecomputedKeysTTL is the default time to cache precomputed session keys.
+	DefaultPrecomputedKeysTTL = time.Hour
+)
+
+// PrecomputedKeysConfig represents the configuration of the cache of precomputed session keys.
+type PrecomputedKeysConfig struct {
+	// Size is the maximum number of devices of which precomputed session keys are cached.
+	// Zero means DefaultPrecomputedKeysSize.
+	Size int `name:"size" description:"Maximum number of devices of which precomputed session keys are cached"`
+	// TTL is the time to cache precomputed session keys. Zero means DefaultPrecomputedKeysTTL.
+	TTL time.Duration `name:"ttl" description:"Time to cache precomputed session keys"`
+}
+
+// precomputedKeysCache caches the precomputed session keys per DevEUI.
+type precomputedKeysCache struct {
+	size int
+	ttl  time.Duration
+
+	mu      sync.Mutex
+	entries map[types.EUI64]precomputedKeys
+}
+
+func newPrecomputedKeysCache(conf PrecomputedKeysConfig) *precomputedKeysCache {
+	if conf.Size <= 0 {
+		conf.Size = DefaultPrecomputedKeysSize
+	}
+	if conf.TTL <= 0 {
+		conf.TTL = DefaultPrecomputedKeysTTL
+	}
+	return &precomputedKeysCache{
+		size:    conf.Size,
+		ttl:     conf.TTL,
+		entries: make(map[types.EUI64]precomputedKeys),
+	}
+}
+
+// set caches the precomputed keys of the device. If the cache is full, the expired keys are removed. If the cache is
+// still full, the keys that expire first are removed.
+func (c *precomputedKeysCache) set(devEUI types.EUI64, keys precomputedKeys, now time.Time) {
+	keys.expires = now.Add(c.ttl)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[devEUI]; !ok && len(c.entries) >= c.size {
+		var (
+			oldestEUI     types.EUI64
+			oldestExpires time.Time
+		)
+		for eui, entry := range c.entries {
+			if !now.Before(entry.expires) {
+				delete(c.entries, eui)
+				continue
+			}
+			if oldestExpires.IsZero() || entry.expires.Before(oldestExpires) {
+				oldestEUI, oldestExpires = eui, entry.expires
+			}
+		}
+		if len(c.entries) >= c.size {
+			delete(c.entries, oldestEUI)
+		}
+	}
+	c.entries[devEUI] = keys
+}
+
+// take removes the precomputed keys of the device and returns them if they are not expired and if they are derived from
+// the root keys with the JoinNonce and DevNonce. Keys that do not match are discarded, so that a wrong prediction is
+// not served later.
+func (c *precomputedKeysCache) take(devEUI types.EUI64, rootKeys *ttnpb.RootKeys, jn types.JoinNonce, dn types.DevNonce, now time.Time) (precomputedKeys, bool) {
+	c.mu.Lock()
+	keys, ok := c.entries[devEUI]
+	delete(c.entries, devEUI)
+	c.mu.Unlock()
+	if !ok || !now.Before(keys.expires) || keys.joinNonce != jn || keys.devNonce != dn || !keys.rootKeys.Equal(rootKeys) {
+		return precomputedKeys{}, false
+	}
+	return keys, true
+}
+
+// precomputeKeys derives the session keys of the LoRaWAN 1.1 device for the expected next join and caches them,
+// so that the join-accept of that join can be created without deriving the keys. The next JoinNonce is the one after
+// the last JoinNonce of the device. The next DevNonce is the one after the last DevNonce of the device, or 0 if the
+// device has not joined yet. If the next join uses a different JoinNonce or DevNonce, the precomputed keys are
+// discarded and the keys are derived as usual.
+func (js *JoinServer) precomputeKeys(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) error {
+	dev, err := js.devices.GetByEUI(ctx, *ids.JoinEUI, *ids.DevEUI,
+		[]string{
+			"ids",
+			"last_dev_nonce",
+			"last_join_nonce",
+			"root_keys",
+		},
+	)
+	if errors.IsNotFound(err) {
+		return errDeviceNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if !dev.ApplicationIdentifiers.Equal(ids.ApplicationIdentifiers) {
+		return errDeviceNotFound
+	}
+	if dev.RootKeys == nil || dev.RootKeys.NwkKey == nil || dev.RootKeys.AppKey == nil {
+		return errPrecomputeKeysRootKeys
+	}
+	if dev.LastJoinNonce >= types.MaxJoinNonce {
+		return errJoinNonceTooHigh
+	}
+	if dev.LastDevNonce >= math.MaxUint16 {
+		return errDevNonceTooHigh
+	}
+	jn := types.NewJoinNonce(dev.LastJoinNonce).Increment()
+	var dn types.DevNonce
+	if dev.LastJoinNonce != 0 || dev.LastDevNonce != 0 {
+		dn = types.NewDevNonce(uint16(dev.LastDevNonce)).Increment()
+	}
+	nwkKey, err := cryptoutil.UnwrapAES128Key(*dev.RootKeys.NwkKey, js.KeyVault)
+	if err != nil {
+		return err
+	}
+	appKey, err := cryptoutil.UnwrapAES128Key(*dev.RootKeys.AppKey, js.KeyVault)
+	if err != nil {
+		return err
+	}
+	keys := precomputedKeys{
+		rootKeys:  dev.RootKeys,
+		joinNonce: jn,
+		devNonce:  dn,
+		appSKey:   crypto.DeriveAppSKey(appKey, jn, *ids.JoinEUI, dn),
+	}
+	keys.nwkSKeys.FNwkSIntKey, keys.nwkSKeys.SNwkSIntKey, keys.nwkSKeys.NwkSEncKey = crypto.DeriveNwkSKeys11(nwkKey, jn, *ids.JoinEUI, dn)
+	js.precomputedKeys.set(*ids.DevEUI, keys, js.clock.Now())
+	return nil
+}
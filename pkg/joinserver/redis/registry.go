@@ -15,8 +15,11 @@
 package redis
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis"
@@ -28,8 +31,90 @@ import (
 
 var (
 	errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errDeleteDevices      = errors.Define("delete_devices", "failed to delete `{count}` devices")
+	errDeleteKeys         = errors.Define("delete_keys", "failed to delete session keys of `{count}` devices")
 )
 
+// deleteBatchSize is the number of devices that are deleted in a single transaction.
+const deleteBatchSize = 100
+
+// deleteBatchSizeFor returns the number of devices that are deleted in a single transaction with the client.
+// If the client uses hash tags, the keys of different devices may be in different hash slots, so each device is
+// deleted in its own transaction.
+func deleteBatchSizeFor(cl *ttnredis.Client) int {
+	if cl.HashTags() {
+		return 1
+	}
+	return deleteBatchSize
+}
+
+// deleteInBatches calls f with consecutive batches of at most size devEUIs. Zero EUIs are not passed to f.
+// If f returns an error without the EUIs of the devices that failed, the whole batch failed.
+// The EUIs of the devices that could not be deleted are returned with the last error.
+func deleteInBatches(devEUIs []types.EUI64, size int, f func([]types.EUI64) ([]types.EUI64, error)) ([]string, error) {
+	var failed []string
+	var lastErr error
+	for start := 0; start < len(devEUIs); start += size {
+		end := start + size
+		if end > len(devEUIs) {
+			end = len(devEUIs)
+		}
+		batch := make([]types.EUI64, 0, end-start)
+		for _, devEUI := range devEUIs[start:end] {
+			if devEUI.IsZero() {
+				failed = append(failed, devEUI.String())
+				lastErr = errInvalidIdentifiers
+				continue
+			}
+			batch = append(batch, devEUI)
+		}
+		if len(batch) == 0 {
+			continue
+		}
+		batchFailed, err := f(batch)
+		if err != nil {
+			lastErr = err
+			if batchFailed == nil {
+				batchFailed = batch
+			}
+		}
+		for _, devEUI := range batchFailed {
+			failed = append(failed, devEUI.String())
+		}
+	}
+	return failed, lastErr
+}
+
+// mget gets the values of ks. The values of keys that do not exist are nil.
+// If the client uses hash tags, the keys may be in different hash slots, so the values are fetched with a command per
+// key in a pipeline instead of with a single MGET.
+func mget(cl *ttnredis.Client, ks []string) ([]interface{}, error) {
+	if !cl.HashTags() {
+		return cl.MGet(ks...).Result()
+	}
+	cmds, err := cl.Pipelined(func(p redis.Pipeliner) error {
+		for _, k := range ks {
+			p.Get(k)
+		}
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+	vs := make([]interface{}, len(cmds))
+	for i, cmd := range cmds {
+		s, err := cmd.(*redis.StringCmd).Result()
+		if err == redis.Nil {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		vs[i] = s
+	}
+	return vs, nil
+}
+
 func applyDeviceFieldMask(dst, src *ttnpb.EndDevice, paths ...string) (*ttnpb.EndDevice, error) {
 	if dst == nil {
 		dst = &ttnpb.EndDevice{}
@@ -42,10 +127,17 @@ func applyDeviceFieldMask(dst, src *ttnpb.EndDevice, paths ...string) (*ttnpb.En
 }
 
 // DeviceRegistry is an implementation of joinserver.DeviceRegistry.
+// If the client uses hash tags, the key of a device shares a hash slot with the keys of its session keys in a
+// KeyRegistry. The DevAddr index is then updated outside of the transactions that update the devices.
 type DeviceRegistry struct {
 	Redis *ttnredis.Client
 }
 
+// euiKey returns the key of the device identified by joinEUI and devEUI.
+func (r *DeviceRegistry) euiKey(joinEUI, devEUI types.EUI64) string {
+	return r.Redis.Key(joinEUI.String(), r.Redis.HashTag(devEUI.String()))
+}
+
 // GetByEUI gets device by joinEUI, devEUI.
 func (r *DeviceRegistry) GetByEUI(ctx context.Context, joinEUI types.EUI64, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, error) {
 	if joinEUI.IsZero() || devEUI.IsZero() {
@@ -53,7 +145,7 @@ func (r *DeviceRegistry) GetByEUI(ctx context.Context, joinEUI types.EUI64, devE
 	}
 
 	pb := &ttnpb.EndDevice{}
-	if err := ttnredis.GetProto(r.Redis, r.Redis.Key(joinEUI.String(), devEUI.String())).ScanProto(pb); err != nil {
+	if err := ttnredis.GetProto(r.Redis, r.euiKey(joinEUI, devEUI)).ScanProto(pb); err != nil {
 		return nil, err
 	}
 	return applyDeviceFieldMask(&ttnpb.EndDevice{}, pb, paths...)
@@ -65,9 +157,10 @@ func (r *DeviceRegistry) SetByEUI(ctx context.Context, joinEUI types.EUI64, devE
 		return nil, errInvalidIdentifiers
 	}
 
-	k := r.Redis.Key(joinEUI.String(), devEUI.String())
+	k := r.euiKey(joinEUI, devEUI)
 
 	var pb *ttnpb.EndDevice
+	var index func(redis.Pipeliner)
 	err := r.Redis.Watch(func(tx *redis.Tx) error {
 		var create bool
 		cmd := ttnredis.GetProto(tx, k)
@@ -78,6 +171,7 @@ func (r *DeviceRegistry) SetByEUI(ctx context.Context, joinEUI types.EUI64, devE
 		} else if err != nil {
 			return err
 		}
+		oldDevAddr := sessionDevAddr(stored)
 
 		var err error
 		if stored != nil {
@@ -99,6 +193,11 @@ func (r *DeviceRegistry) SetByEUI(ctx context.Context, joinEUI types.EUI64, devE
 				p.Del(k)
 				return nil
 			}
+			index = func(p redis.Pipeliner) {
+				if oldDevAddr != nil {
+					p.SRem(r.devAddrKey(*oldDevAddr), k)
+				}
+			}
 		} else {
 			pb.JoinEUI = &joinEUI
 			pb.DevEUI = &devEUI
@@ -120,13 +219,30 @@ func (r *DeviceRegistry) SetByEUI(ctx context.Context, joinEUI types.EUI64, devE
 			if err != nil {
 				return err
 			}
+			newDevAddr := sessionDevAddr(stored)
 			f = func(p redis.Pipeliner) error {
 				_, err := ttnredis.SetProto(p, k, stored, 0)
 				return err
 			}
+			index = func(p redis.Pipeliner) {
+				if oldDevAddr != nil && (newDevAddr == nil || !oldDevAddr.Equal(*newDevAddr)) {
+					p.SRem(r.devAddrKey(*oldDevAddr), k)
+				}
+				if newDevAddr != nil {
+					p.SAdd(r.devAddrKey(*newDevAddr), k)
+				}
+			}
 		}
 
-		cmds, err := tx.Pipelined(f)
+		cmds, err := tx.Pipelined(func(p redis.Pipeliner) error {
+			if err := f(p); err != nil {
+				return err
+			}
+			if !r.Redis.HashTags() {
+				index(p)
+			}
+			return nil
+		})
 		if err != nil {
 			return err
 		}
@@ -140,9 +256,142 @@ func (r *DeviceRegistry) SetByEUI(ctx context.Context, joinEUI types.EUI64, devE
 	if err != nil {
 		return nil, err
 	}
+	if r.Redis.HashTags() {
+		if _, err := r.Redis.Pipelined(func(p redis.Pipeliner) error {
+			index(p)
+			return nil
+		}); err != nil {
+			return nil, ttnredis.ConvertError(err)
+		}
+	}
 	return pb, nil
 }
 
+// sessionDevAddr returns the DevAddr of the current session of dev, if any.
+func sessionDevAddr(dev *ttnpb.EndDevice) *types.DevAddr {
+	if dev == nil || dev.Session == nil {
+		return nil
+	}
+	devAddr := dev.Session.DevAddr
+	return &devAddr
+}
+
+// devAddrKey returns the key of the set of device keys, which have a current session with devAddr.
+func (r *DeviceRegistry) devAddrKey(devAddr types.DevAddr) string {
+	return r.Redis.Key("dev_addr", devAddr.String())
+}
+
+// ListByDevAddr lists the devices, which have a current session with devAddr.
+func (r *DeviceRegistry) ListByDevAddr(ctx context.Context, devAddr types.DevAddr, paths []string) ([]*ttnpb.EndDevice, error) {
+	ks, err := r.Redis.SMembers(r.devAddrKey(devAddr)).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	if len(ks) == 0 {
+		return nil, nil
+	}
+	sort.Strings(ks)
+	vs, err := mget(r.Redis, ks)
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	res := make([]*ttnpb.EndDevice, 0, len(vs))
+	for _, v := range vs {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		pb := &ttnpb.EndDevice{}
+		if err := ttnredis.UnmarshalProto(s, pb); err != nil {
+			return nil, err
+		}
+		if pb.Session == nil || !pb.Session.DevAddr.Equal(devAddr) {
+			continue
+		}
+		pb, err = applyDeviceFieldMask(nil, pb, paths...)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, pb)
+	}
+	return res, nil
+}
+
+// DeleteByEUIs deletes the devices identified by joinEUI and each of devEUIs, including their entries in the DevAddr
+// index. The devices are deleted in batches, each in a single transaction. Devices that do not exist are skipped.
+// If devices cannot be deleted, the returned error contains the DevEUIs of these devices.
+func (r *DeviceRegistry) DeleteByEUIs(ctx context.Context, joinEUI types.EUI64, devEUIs []types.EUI64) error {
+	if joinEUI.IsZero() {
+		return errInvalidIdentifiers
+	}
+	failed, err := deleteInBatches(devEUIs, deleteBatchSizeFor(r.Redis), func(batch []types.EUI64) ([]types.EUI64, error) {
+		ks := make([]string, len(batch))
+		for i, devEUI := range batch {
+			ks[i] = r.euiKey(joinEUI, devEUI)
+		}
+		var failed []types.EUI64
+		var lastErr error
+		var devAddrs map[string]types.DevAddr
+		index := func(p redis.Pipeliner) {
+			for k, devAddr := range devAddrs {
+				p.SRem(r.devAddrKey(devAddr), k)
+			}
+		}
+		err := r.Redis.Watch(func(tx *redis.Tx) error {
+			failed, lastErr = nil, nil
+			vs, err := tx.MGet(ks...).Result()
+			if err != nil {
+				return ttnredis.ConvertError(err)
+			}
+			var dels []string
+			devAddrs = make(map[string]types.DevAddr)
+			for i, v := range vs {
+				s, ok := v.(string)
+				if !ok {
+					continue
+				}
+				pb := &ttnpb.EndDevice{}
+				if err := ttnredis.UnmarshalProto(s, pb); err != nil {
+					failed = append(failed, batch[i])
+					lastErr = err
+					continue
+				}
+				dels = append(dels, ks[i])
+				if devAddr := sessionDevAddr(pb); devAddr != nil {
+					devAddrs[ks[i]] = *devAddr
+				}
+			}
+			if len(dels) == 0 {
+				return nil
+			}
+			_, err = tx.Pipelined(func(p redis.Pipeliner) error {
+				p.Del(dels...)
+				if !r.Redis.HashTags() {
+					index(p)
+				}
+				return nil
+			})
+			return err
+		}, ks...)
+		if err != nil {
+			return nil, err
+		}
+		if r.Redis.HashTags() && len(devAddrs) > 0 {
+			if _, err := r.Redis.Pipelined(func(p redis.Pipeliner) error {
+				index(p)
+				return nil
+			}); err != nil {
+				return nil, ttnredis.ConvertError(err)
+			}
+		}
+		return failed, lastErr
+	})
+	if len(failed) > 0 {
+		return errDeleteDevices.WithCause(err).WithAttributes("count", len(failed), "dev_euis", failed)
+	}
+	return nil
+}
+
 func applyKeyFieldMask(dst, src *ttnpb.SessionKeys, paths ...string) (*ttnpb.SessionKeys, error) {
 	if dst == nil {
 		dst = &ttnpb.SessionKeys{}
@@ -151,10 +400,16 @@ func applyKeyFieldMask(dst, src *ttnpb.SessionKeys, paths ...string) (*ttnpb.Ses
 }
 
 // KeyRegistry is an implementation of joinserver.KeyRegistry.
+// If the client uses hash tags, the session keys of a device and the index of their IDs share a hash slot.
 type KeyRegistry struct {
 	Redis *ttnredis.Client
 }
 
+// idKey returns the key of the session keys of devEUI with the base64 encoded ID.
+func (r *KeyRegistry) idKey(devEUI types.EUI64, encodedID string) string {
+	return r.Redis.Key(r.Redis.HashTag(devEUI.String()), encodedID)
+}
+
 // GetByID gets session keys by devEUI, id.
 func (r *KeyRegistry) GetByID(ctx context.Context, devEUI types.EUI64, id []byte, paths []string) (*ttnpb.SessionKeys, error) {
 	if devEUI.IsZero() || len(id) == 0 {
@@ -162,19 +417,96 @@ func (r *KeyRegistry) GetByID(ctx context.Context, devEUI types.EUI64, id []byte
 	}
 
 	pb := &ttnpb.SessionKeys{}
-	if err := ttnredis.GetProto(r.Redis, r.Redis.Key(devEUI.String(), base64.RawStdEncoding.EncodeToString(id))).ScanProto(pb); err != nil {
+	if err := ttnredis.GetProto(r.Redis, r.idKey(devEUI, base64.RawStdEncoding.EncodeToString(id))).ScanProto(pb); err != nil {
 		return nil, err
 	}
 	return applyKeyFieldMask(&ttnpb.SessionKeys{}, pb, paths...)
 }
 
+// GetByIDs gets session keys by devEUI and each of ids in a single round-trip.
+// The session keys are returned in the same order as ids; the session keys that are not found are nil.
+func (r *KeyRegistry) GetByIDs(ctx context.Context, devEUI types.EUI64, ids [][]byte, paths []string) ([]*ttnpb.SessionKeys, error) {
+	if devEUI.IsZero() {
+		return nil, errInvalidIdentifiers
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	ks := make([]string, len(ids))
+	for i, id := range ids {
+		if len(id) == 0 {
+			return nil, errInvalidIdentifiers
+		}
+		ks[i] = r.idKey(devEUI, base64.RawStdEncoding.EncodeToString(id))
+	}
+	vs, err := r.Redis.MGet(ks...).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	res := make([]*ttnpb.SessionKeys, len(ids))
+	for i, v := range vs {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		pb := &ttnpb.SessionKeys{}
+		if err := ttnredis.UnmarshalProto(s, pb); err != nil {
+			return nil, err
+		}
+		res[i], err = applyKeyFieldMask(&ttnpb.SessionKeys{}, pb, paths...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// ListByEUI lists the session keys of devEUI, ordered by session key ID.
+func (r *KeyRegistry) ListByEUI(ctx context.Context, devEUI types.EUI64, paths []string) ([]*ttnpb.SessionKeys, error) {
+	if devEUI.IsZero() {
+		return nil, errInvalidIdentifiers
+	}
+	encodedIDs, err := r.Redis.SMembers(r.indexKey(devEUI)).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	if len(encodedIDs) == 0 {
+		return nil, nil
+	}
+	ids := make([][]byte, 0, len(encodedIDs))
+	for _, encodedID := range encodedIDs {
+		id, err := base64.RawStdEncoding.DecodeString(encodedID)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return bytes.Compare(ids[i], ids[j]) < 0 })
+	ks, err := r.GetByIDs(ctx, devEUI, ids, paths)
+	if err != nil {
+		return nil, err
+	}
+	res := ks[:0]
+	for _, k := range ks {
+		if k != nil {
+			res = append(res, k)
+		}
+	}
+	return res, nil
+}
+
+// indexKey returns the key of the set of session key IDs of devEUI.
+func (r *KeyRegistry) indexKey(devEUI types.EUI64) string {
+	return r.Redis.Key(r.Redis.HashTag(devEUI.String()))
+}
+
 // SetByID sets session keys by devEUI, id.
 func (r *KeyRegistry) SetByID(ctx context.Context, devEUI types.EUI64, id []byte, gets []string, f func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error)) (*ttnpb.SessionKeys, error) {
 	if devEUI.IsZero() || len(id) == 0 {
 		return nil, errInvalidIdentifiers
 	}
 
-	k := r.Redis.Key(devEUI.String(), base64.RawStdEncoding.EncodeToString(id))
+	k := r.idKey(devEUI, base64.RawStdEncoding.EncodeToString(id))
 
 	var pb *ttnpb.SessionKeys
 	err := r.Redis.Watch(func(tx *redis.Tx) error {
@@ -204,6 +536,7 @@ func (r *KeyRegistry) SetByID(ctx context.Context, devEUI types.EUI64, id []byte
 		if pb == nil {
 			f = func(p redis.Pipeliner) error {
 				p.Del(k)
+				p.SRem(r.indexKey(devEUI), base64.RawStdEncoding.EncodeToString(id))
 				return nil
 			}
 		} else {
@@ -221,7 +554,11 @@ func (r *KeyRegistry) SetByID(ctx context.Context, devEUI types.EUI64, id []byte
 			}
 			f = func(p redis.Pipeliner) error {
 				_, err := ttnredis.SetProto(p, k, stored, 0)
-				return err
+				if err != nil {
+					return err
+				}
+				p.SAdd(r.indexKey(devEUI), base64.RawStdEncoding.EncodeToString(id))
+				return nil
 			}
 		}
 
@@ -233,3 +570,69 @@ func (r *KeyRegistry) SetByID(ctx context.Context, devEUI types.EUI64, id []byte
 	}
 	return pb, nil
 }
+
+// DeleteByEUIs deletes all session keys of each of devEUIs, including the index of session key IDs.
+// The session keys are deleted in batches of devices, each in a single transaction.
+// If session keys cannot be deleted, the returned error contains the DevEUIs of the devices.
+func (r *KeyRegistry) DeleteByEUIs(ctx context.Context, devEUIs []types.EUI64) error {
+	failed, err := deleteInBatches(devEUIs, deleteBatchSizeFor(r.Redis), func(batch []types.EUI64) ([]types.EUI64, error) {
+		indexKeys := make([]string, len(batch))
+		for i, devEUI := range batch {
+			indexKeys[i] = r.indexKey(devEUI)
+		}
+		err := r.Redis.Watch(func(tx *redis.Tx) error {
+			dels := append([]string(nil), indexKeys...)
+			for i, k := range indexKeys {
+				encodedIDs, err := tx.SMembers(k).Result()
+				if err != nil {
+					return ttnredis.ConvertError(err)
+				}
+				for _, encodedID := range encodedIDs {
+					dels = append(dels, r.idKey(batch[i], encodedID))
+				}
+			}
+			_, err := tx.Pipelined(func(p redis.Pipeliner) error {
+				p.Del(dels...)
+				return nil
+			})
+			return err
+		}, indexKeys...)
+		return nil, err
+	})
+	if len(failed) > 0 {
+		return errDeleteKeys.WithCause(err).WithAttributes("count", len(failed), "dev_euis", failed)
+	}
+	return nil
+}
+
+// RebuildIndex adds the session keys that are missing in the index of session key IDs of their device, such as the
+// session keys that were stored before the index was maintained. Session keys that are not in the index are not
+// listed by ListByEUI and not deleted by DeleteByEUIs.
+// RebuildIndex returns the number of index entries that are added.
+func (r *KeyRegistry) RebuildIndex(ctx context.Context) (int, error) {
+	prefix := r.Redis.Key("")
+	var n int
+	iter := r.Redis.Scan(0, r.Redis.Key("*"), 0).Iterator()
+	for iter.Next() {
+		parts := strings.Split(strings.TrimPrefix(iter.Val(), prefix), ":")
+		if len(parts) != 2 {
+			continue
+		}
+		var devEUI types.EUI64
+		if err := devEUI.UnmarshalText([]byte(strings.Trim(parts[0], "{}"))); err != nil {
+			continue
+		}
+		if _, err := base64.RawStdEncoding.DecodeString(parts[1]); err != nil {
+			continue
+		}
+		added, err := r.Redis.SAdd(r.indexKey(devEUI), parts[1]).Result()
+		if err != nil {
+			return n, ttnredis.ConvertError(err)
+		}
+		n += int(added)
+	}
+	if err := iter.Err(); err != nil {
+		return n, ttnredis.ConvertError(err)
+	}
+	return n, nil
+}
@@ -25,6 +25,11 @@ import (
 type DeviceRegistry interface {
 	GetByEUI(ctx context.Context, joinEUI types.EUI64, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, error)
 	SetByEUI(ctx context.Context, joinEUI types.EUI64, devEUI types.EUI64, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error)
+	// ListByDevAddr returns the devices, which have a current session with devAddr.
+	ListByDevAddr(ctx context.Context, devAddr types.DevAddr, paths []string) ([]*ttnpb.EndDevice, error)
+	// DeleteByEUIs deletes the devices identified by joinEUI and each of devEUIs.
+	// If devices cannot be deleted, the returned error contains the DevEUIs of these devices.
+	DeleteByEUIs(ctx context.Context, joinEUI types.EUI64, devEUIs []types.EUI64) error
 }
 
 // DeleteDevice deletes device identified by joinEUI, devEUI from r.
@@ -33,6 +38,17 @@ func DeleteDevice(ctx context.Context, r DeviceRegistry, joinEUI types.EUI64, de
 	return err
 }
 
+// DeleteDevices deletes the devices identified by joinEUI and each of devEUIs from devices, and their session keys
+// from keys. The session keys are deleted even if some devices cannot be deleted; the first error is returned.
+func DeleteDevices(ctx context.Context, devices DeviceRegistry, keys KeyRegistry, joinEUI types.EUI64, devEUIs []types.EUI64) error {
+	devErr := devices.DeleteByEUIs(ctx, joinEUI, devEUIs)
+	keyErr := keys.DeleteByEUIs(ctx, devEUIs)
+	if devErr != nil {
+		return devErr
+	}
+	return keyErr
+}
+
 // CreateDevice creates device dev identified by joinEUI, devEUI from dev.EndDeviceIdentifiers at r.
 func CreateDevice(ctx context.Context, r DeviceRegistry, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, error) {
 	if dev.EndDeviceIdentifiers.JoinEUI == nil || dev.EndDeviceIdentifiers.DevEUI == nil {
@@ -53,7 +69,15 @@ func CreateDevice(ctx context.Context, r DeviceRegistry, dev *ttnpb.EndDevice) (
 // KeyRegistry is a registry, containing session keys.
 type KeyRegistry interface {
 	GetByID(ctx context.Context, devEUI types.EUI64, id []byte, paths []string) (*ttnpb.SessionKeys, error)
+	// GetByIDs returns the session keys identified by devEUI and each of ids, in the same order as ids.
+	// The session keys that are not found are nil.
+	GetByIDs(ctx context.Context, devEUI types.EUI64, ids [][]byte, paths []string) ([]*ttnpb.SessionKeys, error)
 	SetByID(ctx context.Context, devEUI types.EUI64, id []byte, paths []string, f func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error)) (*ttnpb.SessionKeys, error)
+	// ListByEUI returns the session keys of the device identified by devEUI, ordered by session key ID.
+	ListByEUI(ctx context.Context, devEUI types.EUI64, paths []string) ([]*ttnpb.SessionKeys, error)
+	// DeleteByEUIs deletes all session keys of each of devEUIs.
+	// If session keys cannot be deleted, the returned error contains the DevEUIs of the devices.
+	DeleteByEUIs(ctx context.Context, devEUIs []types.EUI64) error
 }
 
 // DeleteKeys deletes session keys identified by devEUI, id pair from r.
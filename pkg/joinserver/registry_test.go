@@ -16,6 +16,7 @@ package joinserver_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -45,6 +46,10 @@ func handleDeviceRegistryTest(t *testing.T, reg DeviceRegistry) {
 			JoinEUI: &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
 			DevEUI:  &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
 		},
+		Session: &ttnpb.Session{
+			DevAddr:   types.DevAddr{0x42, 0xff, 0xff, 0xff},
+			StartedAt: time.Unix(42, 0).UTC(),
+		},
 	}
 
 	ret, err := reg.GetByEUI(ctx, *pb.EndDeviceIdentifiers.JoinEUI, *pb.EndDeviceIdentifiers.DevEUI, ttnpb.EndDeviceFieldPathsTopLevel)
@@ -94,6 +99,10 @@ func handleDeviceRegistryTest(t *testing.T, reg DeviceRegistry) {
 	a.So(err, should.BeNil)
 	a.So(ret, should.HaveEmptyDiff, pbOther)
 
+	list, err := reg.ListByDevAddr(ctx, pb.Session.DevAddr, ttnpb.EndDeviceFieldPathsTopLevel)
+	a.So(err, should.BeNil)
+	a.So(list, should.HaveEmptyDiff, []*ttnpb.EndDevice{pb, pbOther})
+
 	err = DeleteDevice(ctx, reg, *pb.EndDeviceIdentifiers.JoinEUI, *pb.EndDeviceIdentifiers.DevEUI)
 	if !a.So(err, should.BeNil) {
 		t.FailNow()
@@ -105,11 +114,39 @@ func handleDeviceRegistryTest(t *testing.T, reg DeviceRegistry) {
 	}
 	a.So(ret, should.BeNil)
 
+	list, err = reg.ListByDevAddr(ctx, pb.Session.DevAddr, ttnpb.EndDeviceFieldPathsTopLevel)
+	a.So(err, should.BeNil)
+	a.So(list, should.HaveEmptyDiff, []*ttnpb.EndDevice{pbOther})
+
+	pbOther.Session.DevAddr = types.DevAddr{0x43, 0xff, 0xff, 0xff}
+	ret, err = reg.SetByEUI(ctx, *pbOther.EndDeviceIdentifiers.JoinEUI, *pbOther.EndDeviceIdentifiers.DevEUI, ttnpb.EndDeviceFieldPathsTopLevel,
+		func(stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			stored.Session = pbOther.Session
+			return stored, []string{"session"}, nil
+		})
+	if !a.So(err, should.BeNil) || !a.So(ret, should.NotBeNil) {
+		t.FailNow()
+	}
+	pbOther.UpdatedAt = ret.UpdatedAt
+	a.So(ret, should.HaveEmptyDiff, pbOther)
+
+	list, err = reg.ListByDevAddr(ctx, pb.Session.DevAddr, ttnpb.EndDeviceFieldPathsTopLevel)
+	a.So(err, should.BeNil)
+	a.So(list, should.BeEmpty)
+
+	list, err = reg.ListByDevAddr(ctx, pbOther.Session.DevAddr, ttnpb.EndDeviceFieldPathsTopLevel)
+	a.So(err, should.BeNil)
+	a.So(list, should.HaveEmptyDiff, []*ttnpb.EndDevice{pbOther})
+
 	err = DeleteDevice(ctx, reg, *pbOther.EndDeviceIdentifiers.JoinEUI, *pbOther.EndDeviceIdentifiers.DevEUI)
 	if !a.So(err, should.BeNil) {
 		t.FailNow()
 	}
 
+	list, err = reg.ListByDevAddr(ctx, pbOther.Session.DevAddr, ttnpb.EndDeviceFieldPathsTopLevel)
+	a.So(err, should.BeNil)
+	a.So(list, should.BeEmpty)
+
 	ret, err = reg.GetByEUI(ctx, *pbOther.EndDeviceIdentifiers.JoinEUI, *pbOther.EndDeviceIdentifiers.DevEUI, ttnpb.EndDeviceFieldPathsTopLevel)
 	if !a.So(err, should.NotBeNil) || !a.So(errors.IsNotFound(err), should.BeTrue) {
 		t.Fatalf("Error received: %v", err)
@@ -145,6 +182,18 @@ func TestDeviceRegistries(t *testing.T) {
 			},
 			N: 8,
 		},
+		{
+			Name: "RedisHashTags",
+			New: func(t testing.TB) (DeviceRegistry, func() error) {
+				cl, flush := test.NewRedisWithHashTags(t, namespace[:]...)
+				reg := &redis.DeviceRegistry{Redis: cl}
+				return reg, func() error {
+					flush()
+					return cl.Close()
+				}
+			},
+			N: 8,
+		},
 	} {
 		for i := 0; i < int(tc.N); i++ {
 			t.Run(fmt.Sprintf("%s/%d", tc.Name, i), func(t *testing.T) {
@@ -193,6 +242,26 @@ func handleKeyRegistryTest(t *testing.T, reg KeyRegistry) {
 	a.So(err, should.BeNil)
 	a.So(ret, should.HaveEmptyDiff, pb)
 
+	pbNext := CopySessionKeys(pb)
+	pbNext.SessionKeyID = []byte{0x11, 0x22, 0x33, 0x45}
+	_, err = CreateKeys(ctx, reg, devEUI, pbNext)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	list, err := reg.ListByEUI(ctx, devEUI, ttnpb.SessionKeysFieldPathsTopLevel)
+	a.So(err, should.BeNil)
+	a.So(list, should.HaveEmptyDiff, []*ttnpb.SessionKeys{pb, pbNext})
+
+	err = DeleteKeys(ctx, reg, devEUI, pbNext.SessionKeyID)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	list, err = reg.ListByEUI(ctx, devEUI, ttnpb.SessionKeysFieldPathsTopLevel)
+	a.So(err, should.BeNil)
+	a.So(list, should.HaveEmptyDiff, []*ttnpb.SessionKeys{pb})
+
 	pbOther := CopySessionKeys(pb)
 	devEUIOther := types.EUI64{0x43, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
 
@@ -223,6 +292,10 @@ func handleKeyRegistryTest(t *testing.T, reg KeyRegistry) {
 	}
 	a.So(ret, should.BeNil)
 
+	list, err = reg.ListByEUI(ctx, devEUI, ttnpb.SessionKeysFieldPathsTopLevel)
+	a.So(err, should.BeNil)
+	a.So(list, should.BeEmpty)
+
 	err = DeleteKeys(ctx, reg, devEUIOther, pbOther.SessionKeyID)
 	if !a.So(err, should.BeNil) {
 		t.FailNow()
@@ -259,6 +332,18 @@ func TestSessionKeyRegistries(t *testing.T) {
 			},
 			N: 8,
 		},
+		{
+			Name: "RedisHashTags",
+			New: func(t testing.TB) (KeyRegistry, func() error) {
+				cl, flush := test.NewRedisWithHashTags(t, namespace[:]...)
+				reg := &redis.KeyRegistry{Redis: cl}
+				return reg, func() error {
+					flush()
+					return cl.Close()
+				}
+			},
+			N: 8,
+		},
 	} {
 		for i := 0; i < int(tc.N); i++ {
 			t.Run(fmt.Sprintf("%s/%d", tc.Name, i), func(t *testing.T) {
@@ -280,3 +365,241 @@ func TestSessionKeyRegistries(t *testing.T) {
 		}
 	}
 }
+
+func TestDeleteDevices(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "joinserver_test")
+	defer func() {
+		flush()
+		cl.Close()
+	}()
+	devReg := &redis.DeviceRegistry{Redis: cl}
+	keyReg := &redis.KeyRegistry{Redis: cl}
+
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devAddr := types.DevAddr{0x42, 0xff, 0xff, 0xff}
+
+	// Span multiple batches and keep the last device.
+	devEUIs := make([]types.EUI64, 150)
+	for i := range devEUIs {
+		devEUIs[i] = types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, byte(i >> 8), byte(i)}
+		_, err := CreateDevice(ctx, devReg, &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				JoinEUI: &joinEUI,
+				DevEUI:  &devEUIs[i],
+			},
+			Session: &ttnpb.Session{
+				DevAddr: devAddr,
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		for _, id := range [][]byte{{0x11, byte(i)}, {0x22, byte(i)}} {
+			ks := ttnpb.NewPopulatedSessionKeys(test.Randy, false)
+			ks.SessionKeyID = id
+			_, err := CreateKeys(ctx, keyReg, devEUIs[i], ks)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+		}
+	}
+	deleted, kept := devEUIs[:len(devEUIs)-1], devEUIs[len(devEUIs)-1]
+
+	err := DeleteDevices(ctx, devReg, keyReg, joinEUI, deleted)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	for _, devEUI := range deleted {
+		_, err := devReg.GetByEUI(ctx, joinEUI, devEUI, ttnpb.EndDeviceFieldPathsTopLevel)
+		a.So(errors.IsNotFound(err), should.BeTrue)
+		keys, err := keyReg.ListByEUI(ctx, devEUI, ttnpb.SessionKeysFieldPathsTopLevel)
+		a.So(err, should.BeNil)
+		a.So(keys, should.BeEmpty)
+	}
+
+	_, err = devReg.GetByEUI(ctx, joinEUI, kept, ttnpb.EndDeviceFieldPathsTopLevel)
+	a.So(err, should.BeNil)
+	keys, err := keyReg.ListByEUI(ctx, kept, ttnpb.SessionKeysFieldPathsTopLevel)
+	a.So(err, should.BeNil)
+	a.So(keys, should.HaveLength, 2)
+
+	devs, err := devReg.ListByDevAddr(ctx, devAddr, []string{"ids"})
+	a.So(err, should.BeNil)
+	if a.So(devs, should.HaveLength, 1) {
+		a.So(*devs[0].DevEUI, should.Equal, kept)
+	}
+
+	// Deleting devices that do not exist succeeds; invalid DevEUIs are reported.
+	a.So(DeleteDevices(ctx, devReg, keyReg, joinEUI, deleted[:2]), should.BeNil)
+	err = DeleteDevices(ctx, devReg, keyReg, joinEUI, []types.EUI64{{}, kept})
+	if a.So(err, should.NotBeNil) {
+		if ttnErr, ok := errors.From(err); a.So(ok, should.BeTrue) {
+			a.So(ttnErr.Attributes()["count"], should.Equal, 1)
+			a.So(ttnErr.Attributes()["dev_euis"], should.Resemble, []string{types.EUI64{}.String()})
+		}
+	}
+	_, err = devReg.GetByEUI(ctx, joinEUI, kept, ttnpb.EndDeviceFieldPathsTopLevel)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestKeyRegistryRebuildIndex(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "joinserver_test")
+	defer func() {
+		flush()
+		cl.Close()
+	}()
+	devReg := &redis.DeviceRegistry{Redis: cl}
+	keyReg := &redis.KeyRegistry{Redis: cl}
+
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	_, err := CreateDevice(ctx, devReg, &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			JoinEUI: &joinEUI,
+			DevEUI:  &devEUI,
+		},
+		Session: &ttnpb.Session{
+			DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	for _, id := range [][]byte{{0x11}, {0x22}} {
+		ks := ttnpb.NewPopulatedSessionKeys(test.Randy, false)
+		ks.SessionKeyID = id
+		_, err := CreateKeys(ctx, keyReg, devEUI, ks)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	// Remove the index, as for session keys that are stored before the index was maintained.
+	if err := cl.Del(cl.Key(cl.HashTag(devEUI.String()))).Err(); err != nil {
+		t.Fatalf("Failed to remove index: %s", err)
+	}
+	keys, err := keyReg.ListByEUI(ctx, devEUI, ttnpb.SessionKeysFieldPathsTopLevel)
+	a.So(err, should.BeNil)
+	a.So(keys, should.BeEmpty)
+
+	n, err := keyReg.RebuildIndex(ctx)
+	a.So(err, should.BeNil)
+	a.So(n, should.Equal, 2)
+
+	keys, err = keyReg.ListByEUI(ctx, devEUI, ttnpb.SessionKeysFieldPathsTopLevel)
+	a.So(err, should.BeNil)
+	a.So(keys, should.HaveLength, 2)
+
+	// The index is complete, so nothing is added.
+	n, err = keyReg.RebuildIndex(ctx)
+	a.So(err, should.BeNil)
+	a.So(n, should.Equal, 0)
+
+	// The session keys in the rebuilt index are deleted with the device.
+	if !a.So(keyReg.DeleteByEUIs(ctx, []types.EUI64{devEUI}), should.BeNil) {
+		t.FailNow()
+	}
+	for _, id := range [][]byte{{0x11}, {0x22}} {
+		_, err := keyReg.GetByID(ctx, devEUI, id, ttnpb.SessionKeysFieldPathsTopLevel)
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+}
+
+// hashTag returns the first hash tag of the Redis key, which determines the Redis Cluster hash slot of the key.
+func hashTag(k string) string {
+	start := strings.IndexByte(k, '{')
+	if start < 0 {
+		return ""
+	}
+	end := strings.IndexByte(k[start+1:], '}')
+	if end <= 0 {
+		return ""
+	}
+	return k[start : start+end+2]
+}
+
+func TestRegistriesHashSlots(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	devCl, devFlush := test.NewRedisWithHashTags(t, "joinserver_test", "devices")
+	defer devFlush()
+	defer devCl.Close()
+	keyCl, keyFlush := test.NewRedisWithHashTags(t, "joinserver_test", "keys")
+	defer keyFlush()
+	defer keyCl.Close()
+	devReg := &redis.DeviceRegistry{Redis: devCl}
+	keyReg := &redis.KeyRegistry{Redis: keyCl}
+
+	joinEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devEUI := types.EUI64{0x42, 0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devAddr := types.DevAddr{0x42, 0xff, 0xff, 0xff}
+
+	_, err := CreateDevice(ctx, devReg, &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			JoinEUI: &joinEUI,
+			DevEUI:  &devEUI,
+		},
+		Session: &ttnpb.Session{
+			DevAddr: devAddr,
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	for _, id := range [][]byte{{0x11}, {0x22}} {
+		ks := ttnpb.NewPopulatedSessionKeys(test.Randy, false)
+		ks.SessionKeyID = id
+		if _, err := CreateKeys(ctx, keyReg, devEUI, ks); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	// The device, its session keys and the index of session key IDs share a hash slot.
+	devKeys, err := devCl.Keys(devCl.Key("*")).Result()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	keyKeys, err := keyCl.Keys(keyCl.Key("*")).Result()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	tag := devCl.HashTag(devEUI.String())
+	var n int
+	for _, k := range append(devKeys, keyKeys...) {
+		if k == devCl.Key("dev_addr", devAddr.String()) {
+			continue
+		}
+		a.So(hashTag(k), should.Equal, tag)
+		n++
+	}
+	a.So(n, should.Equal, 4)
+
+	// The DevAddr index is updated outside of the transaction.
+	devs, err := devReg.ListByDevAddr(ctx, devAddr, []string{"ids"})
+	a.So(err, should.BeNil)
+	a.So(devs, should.HaveLength, 1)
+
+	err = DeleteDevices(ctx, devReg, keyReg, joinEUI, []types.EUI64{devEUI})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	devs, err = devReg.ListByDevAddr(ctx, devAddr, []string{"ids"})
+	a.So(err, should.BeNil)
+	a.So(devs, should.BeEmpty)
+	devKeys, err = devCl.Keys(devCl.Key("*")).Result()
+	a.So(err, should.BeNil)
+	a.So(devKeys, should.BeEmpty)
+	keyKeys, err = keyCl.Keys(keyCl.Key("*")).Result()
+	a.So(err, should.BeNil)
+	a.So(keyKeys, should.BeEmpty)
+}
@@ -0,0 +1,62 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// listSessions returns the session keys of the device, ordered by session key ID.
+// The session keys contain the session key ID and the KEK labels of the keys, but never the (encrypted) keys.
+func (js *JoinServer) listSessions(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) ([]*ttnpb.SessionKeys, error) {
+	dev, err := js.devices.GetByEUI(ctx, *ids.JoinEUI, *ids.DevEUI, []string{"ids"})
+	if errors.IsNotFound(err) {
+		return nil, errDeviceNotFound
+	}
+	if err != nil {
+		return nil, errRegistryOperation.WithCause(err)
+	}
+	if !dev.ApplicationIdentifiers.Equal(ids.ApplicationIdentifiers) {
+		return nil, errDeviceNotFound
+	}
+	sessions, err := js.keys.ListByEUI(ctx, *ids.DevEUI, ttnpb.SessionKeysFieldPathsTopLevel)
+	if err != nil {
+		return nil, errRegistryOperation.WithCause(err)
+	}
+	res := make([]*ttnpb.SessionKeys, 0, len(sessions))
+	for _, ks := range sessions {
+		res = append(res, &ttnpb.SessionKeys{
+			SessionKeyID: ks.SessionKeyID,
+			FNwkSIntKey:  redactKeyEnvelope(ks.FNwkSIntKey),
+			SNwkSIntKey:  redactKeyEnvelope(ks.SNwkSIntKey),
+			NwkSEncKey:   redactKeyEnvelope(ks.NwkSEncKey),
+			AppSKey:      redactKeyEnvelope(ks.AppSKey),
+		})
+	}
+	return res, nil
+}
+
+// redactKeyEnvelope returns a copy of env without the key.
+func redactKeyEnvelope(env *ttnpb.KeyEnvelope) *ttnpb.KeyEnvelope {
+	if env == nil {
+		return nil
+	}
+	return &ttnpb.KeyEnvelope{
+		KEKLabel: env.KEKLabel,
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	. "go.thethings.network/lorawan-stack/pkg/joinserver"
+	"go.thethings.network/lorawan-stack/pkg/joinserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestListSessions(t *testing.T) {
+	a := assertions.New(t)
+
+	authorizedCtx := clusterauth.NewContext(test.Context(), nil)
+
+	redisClient, flush := test.NewRedis(t, "joinserver_test", "sessions")
+	defer flush()
+	defer redisClient.Close()
+	devReg := &redis.DeviceRegistry{Redis: redisClient}
+	keyReg := &redis.KeyRegistry{Redis: redisClient}
+
+	c := component.MustNew(test.GetLogger(t), &component.Config{})
+	js := test.Must(New(
+		c,
+		&Config{
+			Devices:         devReg,
+			Keys:            keyReg,
+			JoinEUIPrefixes: joinEUIPrefixes,
+		},
+	)).(*JoinServer)
+	test.Must(nil, c.Start())
+	defer c.Close()
+
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: registeredApplicationID,
+		},
+		DeviceID: registeredDeviceID,
+		JoinEUI:  &joinEUI,
+		DevEUI:   &devEUI,
+	}
+	_, err := CreateDevice(authorizedCtx, devReg, &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ids,
+		RootKeys: &ttnpb.RootKeys{
+			AppKey: &ttnpb.KeyEnvelope{
+				Key: appKey[:],
+			},
+			NwkKey: &ttnpb.KeyEnvelope{
+				Key: nwkKey[:],
+			},
+		},
+		LoRaWANVersion:       ttnpb.MAC_V1_1,
+		NetworkServerAddress: nsAddr,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	readCtx := rights.NewContext(test.Context(), rights.Rights{
+		ApplicationRights: map[string]*ttnpb.Rights{
+			unique.ID(test.Context(), ids.ApplicationIdentifiers): ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+		},
+	})
+	srv := JsDeviceServer{JS: js}
+
+	// No sessions before the device joins.
+	res, err := srv.ListSessions(readCtx, &ids)
+	if a.So(err, should.BeNil) {
+		a.So(res.Sessions, should.BeEmpty)
+	}
+
+	var sessionKeyIDs [][]byte
+	for _, devNonce := range []byte{0x01, 0x02} {
+		pld := []byte{
+			/* MHDR */
+			0x00,
+
+			/* MACPayload */
+			/** JoinEUI **/
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+			/** DevEUI **/
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+			/** DevNonce **/
+			devNonce, 0x00,
+		}
+		mic, err := crypto.ComputeJoinRequestMIC(nwkKey, pld)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		res, err := (NsJsServer{JS: js}).HandleJoin(authorizedCtx, &ttnpb.JoinRequest{
+			SelectedMACVersion: ttnpb.MAC_V1_1,
+			RawPayload:         append(pld, mic[:]...),
+			DevAddr:            types.DevAddr{0x42, 0xff, 0xff, 0xff},
+			NetID:              types.NetID{0x42, 0xff, 0xff},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		sessionKeyIDs = append(sessionKeyIDs, res.SessionKeys.SessionKeyID)
+	}
+
+	// Both sessions are listed, without keys.
+	res, err = srv.ListSessions(readCtx, &ids)
+	if !a.So(err, should.BeNil) || !a.So(res.Sessions, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	for i, ks := range res.Sessions {
+		a.So(ks.SessionKeyID, should.Resemble, sessionKeyIDs[i])
+		for _, env := range []*ttnpb.KeyEnvelope{ks.FNwkSIntKey, ks.SNwkSIntKey, ks.NwkSEncKey, ks.AppSKey} {
+			if a.So(env, should.NotBeNil) {
+				a.So(env.Key, should.BeEmpty)
+			}
+		}
+	}
+
+	// Unknown device.
+	unknownIDs := ids
+	unknownIDs.DevEUI = &types.EUI64{0x42, 0x43, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	_, err = srv.ListSessions(readCtx, &unknownIDs)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// Device of another application.
+	otherIDs := ids
+	otherIDs.ApplicationIdentifiers = ttnpb.ApplicationIdentifiers{
+		ApplicationID: "other-application",
+	}
+	_, err = srv.ListSessions(rights.NewContext(test.Context(), rights.Rights{
+		ApplicationRights: map[string]*ttnpb.Rights{
+			unique.ID(test.Context(), otherIDs.ApplicationIdentifiers): ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+		},
+	}), &otherIDs)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// Permission denied.
+	_, err = srv.ListSessions(rights.NewContext(test.Context(), rights.Rights{
+		ApplicationRights: map[string]*ttnpb.Rights{
+			unique.ID(test.Context(), ids.ApplicationIdentifiers): ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_INFO),
+		},
+	}), &ids)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+}
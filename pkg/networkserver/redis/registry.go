@@ -16,6 +16,7 @@ package redis
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis"
@@ -255,3 +256,133 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIde
 	}
 	return pb, nil
 }
+
+// Rebuild reconstructs the index of JoinEUI and DevEUI pairs to devices from the stored devices.
+// Index entries that are missing or that refer to another device are set, and index entries that refer to devices that
+// do not exist or that have other EUIs are removed. Each index entry is repaired in a transaction.
+// Rebuild returns the number of index entries that are repaired.
+func (r *DeviceRegistry) Rebuild(ctx context.Context) (int, error) {
+	prefix := r.Redis.Key("")
+	euiPrefix := r.Redis.Key(euiKey, "")
+	addrPrefix := r.Redis.Key(addrKey, "")
+
+	var devKeys, euiKeys []string
+	iter := r.Redis.Scan(0, r.Redis.Key("*"), 0).Iterator()
+	for iter.Next() {
+		k := iter.Val()
+		switch {
+		case strings.HasPrefix(k, euiPrefix):
+			euiKeys = append(euiKeys, k)
+		case strings.HasPrefix(k, addrPrefix):
+		case strings.HasPrefix(k, prefix):
+			devKeys = append(devKeys, k)
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return 0, ttnredis.ConvertError(err)
+	}
+
+	var n int
+	for _, k := range devKeys {
+		repaired, err := r.repairEUIIndex(ctx, k)
+		if err != nil {
+			return n, err
+		}
+		if repaired {
+			n++
+		}
+	}
+	for _, ek := range euiKeys {
+		removed, err := r.removeStaleEUIIndex(ek)
+		if err != nil {
+			return n, err
+		}
+		if removed {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// repairEUIIndex sets the index entry of the device stored at k if it is missing or refers to another device.
+// It returns whether the index entry is set.
+func (r *DeviceRegistry) repairEUIIndex(ctx context.Context, k string) (bool, error) {
+	var repaired bool
+	err := r.Redis.Watch(func(tx *redis.Tx) error {
+		repaired = false
+		stored := &ttnpb.EndDevice{}
+		if err := ttnredis.GetProto(tx, k).ScanProto(stored); errors.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		_, ids := getDevAddrsAndIDs(stored)
+		if ids.JoinEUI == nil || ids.DevEUI == nil {
+			return nil
+		}
+		uid := unique.ID(ctx, ids)
+		ek := r.Redis.Key(euiKey, ids.JoinEUI.String(), ids.DevEUI.String())
+		if err := tx.Watch(ek).Err(); err != nil {
+			return err
+		}
+		s, err := tx.Get(ek).Result()
+		if err != nil && err != redis.Nil {
+			return ttnredis.ConvertError(err)
+		}
+		if err == nil && s == uid {
+			return nil
+		}
+		if _, err := tx.Pipelined(func(p redis.Pipeliner) error {
+			p.Set(ek, uid, 0)
+			return nil
+		}); err != nil {
+			return err
+		}
+		repaired = true
+		return nil
+	}, k)
+	if err != nil {
+		return false, err
+	}
+	return repaired, nil
+}
+
+// removeStaleEUIIndex removes the index entry at ek if the device it refers to does not exist or has other EUIs.
+// It returns whether the index entry is removed.
+func (r *DeviceRegistry) removeStaleEUIIndex(ek string) (bool, error) {
+	var removed bool
+	err := r.Redis.Watch(func(tx *redis.Tx) error {
+		removed = false
+		uid, err := tx.Get(ek).Result()
+		if err == redis.Nil {
+			return nil
+		} else if err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		k := r.Redis.Key(uid)
+		if err := tx.Watch(k).Err(); err != nil {
+			return err
+		}
+		stored := &ttnpb.EndDevice{}
+		if err := ttnredis.GetProto(tx, k).ScanProto(stored); err != nil && !errors.IsNotFound(err) {
+			return err
+		} else if err == nil {
+			_, ids := getDevAddrsAndIDs(stored)
+			if ids.JoinEUI != nil && ids.DevEUI != nil && r.Redis.Key(euiKey, ids.JoinEUI.String(), ids.DevEUI.String()) == ek {
+				return nil
+			}
+		}
+		if _, err := tx.Pipelined(func(p redis.Pipeliner) error {
+			p.Del(ek)
+			return nil
+		}); err != nil {
+			return err
+		}
+		removed = true
+		return nil
+	}, ek)
+	if err != nil {
+		return false, err
+	}
+	return removed, nil
+}
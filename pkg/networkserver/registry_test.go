@@ -25,6 +25,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/networkserver/redis"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/unique"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
 )
@@ -280,3 +281,81 @@ func TestRegistries(t *testing.T) {
 		}
 	}
 }
+
+func TestRedisRegistryRebuild(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "networkserver_test")
+	defer func() {
+		flush()
+		cl.Close()
+	}()
+	reg := &redis.DeviceRegistry{Redis: cl}
+
+	newDevice := func(devID string, joinEUI, devEUI types.EUI64) *ttnpb.EndDevice {
+		dev, err := CreateDevice(ctx, reg, &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				JoinEUI: &joinEUI,
+				DevEUI:  &devEUI,
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+					ApplicationID: "test-app",
+				},
+				DeviceID: devID,
+			},
+		})
+		if err != nil {
+			t.Fatalf("Failed to create device: %s", err)
+		}
+		return dev
+	}
+	missing := newDevice("test-dev-missing",
+		types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01},
+	)
+	wrong := newDevice("test-dev-wrong",
+		types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02},
+	)
+	staleJoinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	staleDevEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0x03}
+
+	// Corrupt the index: remove an entry, let an entry refer to another device and add an entry of unknown EUIs.
+	euiKey := func(joinEUI, devEUI types.EUI64) string {
+		return cl.Key("eui", joinEUI.String(), devEUI.String())
+	}
+	if err := cl.Del(euiKey(*missing.JoinEUI, *missing.DevEUI)).Err(); err != nil {
+		t.Fatalf("Failed to corrupt index: %s", err)
+	}
+	if err := cl.Set(euiKey(*wrong.JoinEUI, *wrong.DevEUI), unique.ID(ctx, missing.EndDeviceIdentifiers), 0).Err(); err != nil {
+		t.Fatalf("Failed to corrupt index: %s", err)
+	}
+	if err := cl.Set(euiKey(staleJoinEUI, staleDevEUI), unique.ID(ctx, wrong.EndDeviceIdentifiers), 0).Err(); err != nil {
+		t.Fatalf("Failed to corrupt index: %s", err)
+	}
+
+	_, err := reg.GetByEUI(ctx, *missing.JoinEUI, *missing.DevEUI, []string{"ids"})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	ret, err := reg.GetByEUI(ctx, *wrong.JoinEUI, *wrong.DevEUI, []string{"ids"})
+	if a.So(err, should.BeNil) {
+		a.So(ret.DeviceID, should.Equal, missing.DeviceID)
+	}
+
+	n, err := reg.Rebuild(ctx)
+	a.So(err, should.BeNil)
+	a.So(n, should.Equal, 3)
+
+	for _, dev := range []*ttnpb.EndDevice{missing, wrong} {
+		ret, err := reg.GetByEUI(ctx, *dev.JoinEUI, *dev.DevEUI, []string{"ids"})
+		if a.So(err, should.BeNil) {
+			a.So(ret.EndDeviceIdentifiers, should.Resemble, dev.EndDeviceIdentifiers)
+		}
+	}
+	_, err = reg.GetByEUI(ctx, staleJoinEUI, staleDevEUI, []string{"ids"})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// The index is consistent, so nothing is repaired.
+	n, err = reg.Rebuild(ctx)
+	a.So(err, should.BeNil)
+	a.So(n, should.Equal, 0)
+}
@@ -0,0 +1,83 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+// hashSlots is the number of hash slots in a Redis Cluster.
+const hashSlots = 16384
+
+// crc16 returns the CRC-16/XMODEM checksum of b, which is the checksum that Redis Cluster uses to hash keys.
+func crc16(b []byte) uint16 {
+	var crc uint16
+	for _, v := range b {
+		crc ^= uint16(v) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// hashSlot returns the Redis Cluster hash slot of the key.
+// If the key contains a hash tag, only the hash tag is hashed, so that keys with the same hash tag share a slot.
+func hashSlot(k string) uint16 {
+	if start := strings.IndexByte(k, '{'); start >= 0 {
+		if end := strings.IndexByte(k[start+1:], '}'); end > 0 {
+			k = k[start+1 : start+1+end]
+		}
+	}
+	return crc16([]byte(k)) % hashSlots
+}
+
+func TestHashSlot(t *testing.T) {
+	for _, tc := range []struct {
+		Key  string
+		Slot uint16
+	}{
+		{Key: "foo", Slot: 12182},
+		{Key: "bar", Slot: 5061},
+		{Key: "123456789", Slot: 12739},
+		{Key: "{user1000}.following", Slot: 3443},
+		{Key: "{user1000}.followers", Slot: 3443},
+		{Key: "foo{}{bar}", Slot: 8363},
+		{Key: "foo{{bar}}zap", Slot: 4015},
+		{Key: "foo{bar}{zap}", Slot: 5061},
+	} {
+		t.Run(tc.Key, func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(hashSlot(tc.Key), should.Equal, tc.Slot)
+		})
+	}
+}
+
+func TestHashTagSlot(t *testing.T) {
+	a := assertions.New(t)
+
+	cl := New(&Config{Redis: config.Redis{HashTags: true}, Namespace: []string{"test"}})
+	defer cl.Close()
+	a.So(hashSlot(cl.Key(cl.HashTag("foo"), "bar")), should.Equal, hashSlot(cl.Key("other", cl.HashTag("foo"))))
+}
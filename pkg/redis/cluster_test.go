@@ -0,0 +1,38 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	. "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestHashTag(t *testing.T) {
+	a := assertions.New(t)
+
+	cl := New(&Config{Namespace: []string{"test"}})
+	defer cl.Close()
+	a.So(cl.HashTags(), should.BeFalse)
+	a.So(cl.Key(cl.HashTag("foo"), "bar"), should.Equal, "test:foo:bar")
+
+	cl = New(&Config{Redis: config.Redis{HashTags: true}, Namespace: []string{"test"}})
+	defer cl.Close()
+	a.So(cl.HashTags(), should.BeTrue)
+	a.So(cl.Key(cl.HashTag("foo"), "bar"), should.Equal, "test:{foo}:bar")
+}
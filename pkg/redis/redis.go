@@ -70,6 +70,7 @@ func Key(ks ...string) string {
 type Client struct {
 	*redis.Client
 	namespace string
+	hashTags  bool
 }
 
 // Config represents Redis configuration.
@@ -82,6 +83,7 @@ type Config struct {
 func New(conf *Config) *Client {
 	return &Client{
 		namespace: Key(append(conf.Redis.Namespace, conf.Namespace...)...),
+		hashTags:  conf.HashTags,
 		Client: redis.NewClient(&redis.Options{
 			Addr:     conf.Address,
 			Password: conf.Password,
@@ -95,6 +97,20 @@ func (cl *Client) Key(ks ...string) string {
 	return Key(append([]string{cl.namespace}, ks...)...)
 }
 
+// HashTags returns whether the client uses hash tags in keys.
+func (cl *Client) HashTags() bool {
+	return cl.hashTags
+}
+
+// HashTag returns k as hash tag if the client uses hash tags, so that all keys that contain the returned value share a
+// Redis Cluster hash slot. If the client does not use hash tags, k is returned as is.
+func (cl *Client) HashTag(k string) string {
+	if !cl.hashTags {
+		return k
+	}
+	return "{" + k + "}"
+}
+
 // ProtoCmd is a command, which can unmarshal its result into a protocol buffer.
 type ProtoCmd struct {
 	result func() (string, error)
@@ -480,6 +480,9 @@ func (dst *CreateApplicationAPIKeyRequest) SetFields(src *CreateApplicationAPIKe
 
 var UpdateApplicationAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.deleted_at",
+	"api_key.entity_scope",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
 	"api_key.name",
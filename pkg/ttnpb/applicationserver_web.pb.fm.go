@@ -60,18 +60,31 @@ func (dst *ApplicationWebhookIdentifiers) SetFields(src *ApplicationWebhookIdent
 }
 
 var ApplicationWebhookFieldPathsNested = []string{
+	"accepted_status_codes",
 	"base_url",
+	"base_url_secret",
+	"compression",
 	"created_at",
+	"default",
+	"default.format",
+	"default.path",
 	"downlink_ack",
+	"downlink_ack.format",
 	"downlink_ack.path",
 	"downlink_failed",
+	"downlink_failed.format",
 	"downlink_failed.path",
 	"downlink_nack",
+	"downlink_nack.format",
 	"downlink_nack.path",
 	"downlink_queued",
+	"downlink_queued.format",
 	"downlink_queued.path",
 	"downlink_sent",
+	"downlink_sent.format",
 	"downlink_sent.path",
+	"exclude_decoded_payload",
+	"exclude_raw_payload",
 	"format",
 	"headers",
 	"ids",
@@ -79,27 +92,46 @@ var ApplicationWebhookFieldPathsNested = []string{
 	"ids.application_ids.application_id",
 	"ids.webhook_id",
 	"join_accept",
+	"join_accept.format",
 	"join_accept.path",
 	"location_solved",
+	"location_solved.format",
 	"location_solved.path",
+	"max_batch_linger",
+	"max_batch_size",
+	"method",
+	"projection_paths",
+	"strict_projection",
 	"updated_at",
 	"uplink_message",
+	"uplink_message.format",
 	"uplink_message.path",
 }
 
 var ApplicationWebhookFieldPathsTopLevel = []string{
+	"accepted_status_codes",
 	"base_url",
+	"base_url_secret",
+	"compression",
 	"created_at",
+	"default",
 	"downlink_ack",
 	"downlink_failed",
 	"downlink_nack",
 	"downlink_queued",
 	"downlink_sent",
+	"exclude_decoded_payload",
+	"exclude_raw_payload",
 	"format",
 	"headers",
 	"ids",
 	"join_accept",
 	"location_solved",
+	"max_batch_linger",
+	"max_batch_size",
+	"method",
+	"projection_paths",
+	"strict_projection",
 	"updated_at",
 	"uplink_message",
 }
@@ -342,6 +374,125 @@ func (dst *ApplicationWebhook) SetFields(src *ApplicationWebhook, paths ...strin
 					dst.LocationSolved = nil
 				}
 			}
+		case "compression":
+			if len(subs) > 0 {
+				return fmt.Errorf("'compression' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Compression = src.Compression
+			} else {
+				var zero string
+				dst.Compression = zero
+			}
+		case "exclude_raw_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'exclude_raw_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExcludeRawPayload = src.ExcludeRawPayload
+			} else {
+				var zero bool
+				dst.ExcludeRawPayload = zero
+			}
+		case "exclude_decoded_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'exclude_decoded_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExcludeDecodedPayload = src.ExcludeDecodedPayload
+			} else {
+				var zero bool
+				dst.ExcludeDecodedPayload = zero
+			}
+		case "base_url_secret":
+			if len(subs) > 0 {
+				return fmt.Errorf("'base_url_secret' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.BaseURLSecret = src.BaseURLSecret
+			} else {
+				var zero bool
+				dst.BaseURLSecret = zero
+			}
+		case "method":
+			if len(subs) > 0 {
+				return fmt.Errorf("'method' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Method = src.Method
+			} else {
+				var zero string
+				dst.Method = zero
+			}
+		case "default":
+			if len(subs) > 0 {
+				newDst := dst.Default
+				if newDst == nil {
+					newDst = &ApplicationWebhook_Message{}
+					dst.Default = newDst
+				}
+				var newSrc *ApplicationWebhook_Message
+				if src != nil {
+					newSrc = src.Default
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Default = src.Default
+				} else {
+					dst.Default = nil
+				}
+			}
+		case "accepted_status_codes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'accepted_status_codes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AcceptedStatusCodes = src.AcceptedStatusCodes
+			} else {
+				dst.AcceptedStatusCodes = nil
+			}
+		case "projection_paths":
+			if len(subs) > 0 {
+				return fmt.Errorf("'projection_paths' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ProjectionPaths = src.ProjectionPaths
+			} else {
+				dst.ProjectionPaths = nil
+			}
+		case "strict_projection":
+			if len(subs) > 0 {
+				return fmt.Errorf("'strict_projection' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StrictProjection = src.StrictProjection
+			} else {
+				var zero bool
+				dst.StrictProjection = zero
+			}
+		case "max_batch_size":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_batch_size' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxBatchSize = src.MaxBatchSize
+			} else {
+				var zero uint32
+				dst.MaxBatchSize = zero
+			}
+		case "max_batch_linger":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_batch_linger' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxBatchLinger = src.MaxBatchLinger
+			} else {
+				var zero time.Duration
+				dst.MaxBatchLinger = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -351,10 +502,12 @@ func (dst *ApplicationWebhook) SetFields(src *ApplicationWebhook, paths ...strin
 }
 
 var ApplicationWebhook_MessageFieldPathsNested = []string{
+	"format",
 	"path",
 }
 
 var ApplicationWebhook_MessageFieldPathsTopLevel = []string{
+	"format",
 	"path",
 }
 
@@ -371,6 +524,16 @@ func (dst *ApplicationWebhook_Message) SetFields(src *ApplicationWebhook_Message
 				var zero string
 				dst.Path = zero
 			}
+		case "format":
+			if len(subs) > 0 {
+				return fmt.Errorf("'format' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Format = src.Format
+			} else {
+				var zero string
+				dst.Format = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -540,18 +703,31 @@ func (dst *ListApplicationWebhooksRequest) SetFields(src *ListApplicationWebhook
 var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"field_mask",
 	"webhook",
+	"webhook.accepted_status_codes",
 	"webhook.base_url",
+	"webhook.base_url_secret",
+	"webhook.compression",
 	"webhook.created_at",
+	"webhook.default",
+	"webhook.default.format",
+	"webhook.default.path",
 	"webhook.downlink_ack",
+	"webhook.downlink_ack.format",
 	"webhook.downlink_ack.path",
 	"webhook.downlink_failed",
+	"webhook.downlink_failed.format",
 	"webhook.downlink_failed.path",
 	"webhook.downlink_nack",
+	"webhook.downlink_nack.format",
 	"webhook.downlink_nack.path",
 	"webhook.downlink_queued",
+	"webhook.downlink_queued.format",
 	"webhook.downlink_queued.path",
 	"webhook.downlink_sent",
+	"webhook.downlink_sent.format",
 	"webhook.downlink_sent.path",
+	"webhook.exclude_decoded_payload",
+	"webhook.exclude_raw_payload",
 	"webhook.format",
 	"webhook.headers",
 	"webhook.ids",
@@ -559,11 +735,19 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"webhook.ids.application_ids.application_id",
 	"webhook.ids.webhook_id",
 	"webhook.join_accept",
+	"webhook.join_accept.format",
 	"webhook.join_accept.path",
 	"webhook.location_solved",
+	"webhook.location_solved.format",
 	"webhook.location_solved.path",
+	"webhook.max_batch_linger",
+	"webhook.max_batch_size",
+	"webhook.method",
+	"webhook.projection_paths",
+	"webhook.strict_projection",
 	"webhook.updated_at",
 	"webhook.uplink_message",
+	"webhook.uplink_message.format",
 	"webhook.uplink_message.path",
 }
 
@@ -610,3 +794,106 @@ func (dst *SetApplicationWebhookRequest) SetFields(src *SetApplicationWebhookReq
 	}
 	return nil
 }
+
+var ApplicationWebhookCaptureFieldPathsNested = []string{
+	"body",
+	"headers",
+	"method",
+	"time",
+	"url",
+}
+
+var ApplicationWebhookCaptureFieldPathsTopLevel = []string{
+	"body",
+	"headers",
+	"method",
+	"time",
+	"url",
+}
+
+func (dst *ApplicationWebhookCapture) SetFields(src *ApplicationWebhookCapture, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Time = src.Time
+			} else {
+				dst.Time = nil
+			}
+		case "method":
+			if len(subs) > 0 {
+				return fmt.Errorf("'method' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Method = src.Method
+			} else {
+				var zero string
+				dst.Method = zero
+			}
+		case "url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.URL = src.URL
+			} else {
+				var zero string
+				dst.URL = zero
+			}
+		case "headers":
+			if len(subs) > 0 {
+				return fmt.Errorf("'headers' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Headers = src.Headers
+			} else {
+				dst.Headers = nil
+			}
+		case "body":
+			if len(subs) > 0 {
+				return fmt.Errorf("'body' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Body = src.Body
+			} else {
+				var zero []byte
+				dst.Body = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ApplicationWebhookCapturesFieldPathsNested = []string{
+	"captures",
+}
+
+var ApplicationWebhookCapturesFieldPathsTopLevel = []string{
+	"captures",
+}
+
+func (dst *ApplicationWebhookCaptures) SetFields(src *ApplicationWebhookCaptures, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "captures":
+			if len(subs) > 0 {
+				return fmt.Errorf("'captures' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Captures = src.Captures
+			} else {
+				dst.Captures = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
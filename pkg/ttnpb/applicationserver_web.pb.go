@@ -51,7 +51,7 @@ type ApplicationWebhookIdentifiers struct {
 func (m *ApplicationWebhookIdentifiers) Reset()      { *m = ApplicationWebhookIdentifiers{} }
 func (*ApplicationWebhookIdentifiers) ProtoMessage() {}
 func (*ApplicationWebhookIdentifiers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_web_cae7911b27bddc2a, []int{0}
+	return fileDescriptor_applicationserver_web_c5277b44908d7ec9, []int{0}
 }
 func (m *ApplicationWebhookIdentifiers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -97,23 +97,52 @@ type ApplicationWebhook struct {
 	Headers map[string]string `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// The format to use for the body.
 	// Supported values depend on the Application Server configuration.
-	Format               string                      `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
-	UplinkMessage        *ApplicationWebhook_Message `protobuf:"bytes,7,opt,name=uplink_message,json=uplinkMessage,proto3" json:"uplink_message,omitempty"`
-	JoinAccept           *ApplicationWebhook_Message `protobuf:"bytes,8,opt,name=join_accept,json=joinAccept,proto3" json:"join_accept,omitempty"`
-	DownlinkAck          *ApplicationWebhook_Message `protobuf:"bytes,9,opt,name=downlink_ack,json=downlinkAck,proto3" json:"downlink_ack,omitempty"`
-	DownlinkNack         *ApplicationWebhook_Message `protobuf:"bytes,10,opt,name=downlink_nack,json=downlinkNack,proto3" json:"downlink_nack,omitempty"`
-	DownlinkSent         *ApplicationWebhook_Message `protobuf:"bytes,11,opt,name=downlink_sent,json=downlinkSent,proto3" json:"downlink_sent,omitempty"`
-	DownlinkFailed       *ApplicationWebhook_Message `protobuf:"bytes,12,opt,name=downlink_failed,json=downlinkFailed,proto3" json:"downlink_failed,omitempty"`
-	DownlinkQueued       *ApplicationWebhook_Message `protobuf:"bytes,13,opt,name=downlink_queued,json=downlinkQueued,proto3" json:"downlink_queued,omitempty"`
-	LocationSolved       *ApplicationWebhook_Message `protobuf:"bytes,14,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	Format         string                      `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
+	UplinkMessage  *ApplicationWebhook_Message `protobuf:"bytes,7,opt,name=uplink_message,json=uplinkMessage,proto3" json:"uplink_message,omitempty"`
+	JoinAccept     *ApplicationWebhook_Message `protobuf:"bytes,8,opt,name=join_accept,json=joinAccept,proto3" json:"join_accept,omitempty"`
+	DownlinkAck    *ApplicationWebhook_Message `protobuf:"bytes,9,opt,name=downlink_ack,json=downlinkAck,proto3" json:"downlink_ack,omitempty"`
+	DownlinkNack   *ApplicationWebhook_Message `protobuf:"bytes,10,opt,name=downlink_nack,json=downlinkNack,proto3" json:"downlink_nack,omitempty"`
+	DownlinkSent   *ApplicationWebhook_Message `protobuf:"bytes,11,opt,name=downlink_sent,json=downlinkSent,proto3" json:"downlink_sent,omitempty"`
+	DownlinkFailed *ApplicationWebhook_Message `protobuf:"bytes,12,opt,name=downlink_failed,json=downlinkFailed,proto3" json:"downlink_failed,omitempty"`
+	DownlinkQueued *ApplicationWebhook_Message `protobuf:"bytes,13,opt,name=downlink_queued,json=downlinkQueued,proto3" json:"downlink_queued,omitempty"`
+	LocationSolved *ApplicationWebhook_Message `protobuf:"bytes,14,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
+	// Compression to apply to the body.
+	// Supported values are empty (no compression) and gzip.
+	Compression string `protobuf:"bytes,15,opt,name=compression,proto3" json:"compression,omitempty"`
+	// Exclude the raw payload (frm_payload) of uplink messages from the body.
+	ExcludeRawPayload bool `protobuf:"varint,16,opt,name=exclude_raw_payload,json=excludeRawPayload,proto3" json:"exclude_raw_payload,omitempty"`
+	// Exclude the decoded payload (decoded_payload) of uplink messages from the body.
+	ExcludeDecodedPayload bool `protobuf:"varint,17,opt,name=exclude_decoded_payload,json=excludeDecodedPayload,proto3" json:"exclude_decoded_payload,omitempty"`
+	// The base URL contains secrets, such as credentials in the query.
+	// Secret base URLs are encrypted at rest and redacted in logs.
+	BaseURLSecret bool `protobuf:"varint,18,opt,name=base_url_secret,json=baseUrlSecret,proto3" json:"base_url_secret,omitempty"`
+	// HTTP method to use for the requests.
+	// Supported values are empty (POST), POST, PUT and PATCH.
+	Method string `protobuf:"bytes,19,opt,name=method,proto3" json:"method,omitempty"`
+	// Message configuration used for message types that have no configuration of their own.
+	// If empty, message types without configuration are not delivered.
+	Default *ApplicationWebhook_Message `protobuf:"bytes,20,opt,name=default,proto3" json:"default,omitempty"`
+	// HTTP status codes of responses that indicate successful delivery.
+	// If empty, all 2xx status codes indicate successful delivery.
+	AcceptedStatusCodes []uint32 `protobuf:"varint,21,rep,packed,name=accepted_status_codes,json=acceptedStatusCodes,proto3" json:"accepted_status_codes,omitempty"`
+	// Paths of the fields to include in JSON bodies, for example end_device_ids.dev_eui.
+	// If empty, all fields are included. Only supported by the JSON format.
+	ProjectionPaths []string `protobuf:"bytes,22,rep,name=projection_paths,json=projectionPaths,proto3" json:"projection_paths,omitempty"`
+	// Fail delivery if a projection path is not present in the message, instead of ignoring the path.
+	StrictProjection bool `protobuf:"varint,23,opt,name=strict_projection,json=strictProjection,proto3" json:"strict_projection,omitempty"`
+	// Maximum number of messages that are delivered in a single request, as an array.
+	// If 0 or 1, each message is delivered in its own request. Batches are only supported by the JSON format.
+	MaxBatchSize uint32 `protobuf:"varint,24,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// Maximum time that a partial batch is held before it is delivered. If 0, partial batches are held for one second.
+	MaxBatchLinger       time.Duration `protobuf:"bytes,25,opt,name=max_batch_linger,json=maxBatchLinger,proto3,stdduration" json:"max_batch_linger"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *ApplicationWebhook) Reset()      { *m = ApplicationWebhook{} }
 func (*ApplicationWebhook) ProtoMessage() {}
 func (*ApplicationWebhook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_web_cae7911b27bddc2a, []int{1}
+	return fileDescriptor_applicationserver_web_c5277b44908d7ec9, []int{1}
 }
 func (m *ApplicationWebhook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -233,9 +262,89 @@ func (m *ApplicationWebhook) GetLocationSolved() *ApplicationWebhook_Message {
 	return nil
 }
 
+func (m *ApplicationWebhook) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *ApplicationWebhook) GetExcludeRawPayload() bool {
+	if m != nil {
+		return m.ExcludeRawPayload
+	}
+	return false
+}
+
+func (m *ApplicationWebhook) GetExcludeDecodedPayload() bool {
+	if m != nil {
+		return m.ExcludeDecodedPayload
+	}
+	return false
+}
+
+func (m *ApplicationWebhook) GetBaseURLSecret() bool {
+	if m != nil {
+		return m.BaseURLSecret
+	}
+	return false
+}
+
+func (m *ApplicationWebhook) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *ApplicationWebhook) GetDefault() *ApplicationWebhook_Message {
+	if m != nil {
+		return m.Default
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetAcceptedStatusCodes() []uint32 {
+	if m != nil {
+		return m.AcceptedStatusCodes
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetProjectionPaths() []string {
+	if m != nil {
+		return m.ProjectionPaths
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetStrictProjection() bool {
+	if m != nil {
+		return m.StrictProjection
+	}
+	return false
+}
+
+func (m *ApplicationWebhook) GetMaxBatchSize() uint32 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
+func (m *ApplicationWebhook) GetMaxBatchLinger() time.Duration {
+	if m != nil {
+		return m.MaxBatchLinger
+	}
+	return 0
+}
+
 type ApplicationWebhook_Message struct {
 	// Path to append to the base URL.
-	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The format to use for the body of this message type, overriding the format of the webhook.
+	// Supported values depend on the Application Server configuration.
+	Format               string   `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -243,7 +352,7 @@ type ApplicationWebhook_Message struct {
 func (m *ApplicationWebhook_Message) Reset()      { *m = ApplicationWebhook_Message{} }
 func (*ApplicationWebhook_Message) ProtoMessage() {}
 func (*ApplicationWebhook_Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_web_cae7911b27bddc2a, []int{1, 1}
+	return fileDescriptor_applicationserver_web_c5277b44908d7ec9, []int{1, 1}
 }
 func (m *ApplicationWebhook_Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -279,6 +388,13 @@ func (m *ApplicationWebhook_Message) GetPath() string {
 	return ""
 }
 
+func (m *ApplicationWebhook_Message) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
 type ApplicationWebhooks struct {
 	Webhooks             []*ApplicationWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
@@ -288,7 +404,7 @@ type ApplicationWebhooks struct {
 func (m *ApplicationWebhooks) Reset()      { *m = ApplicationWebhooks{} }
 func (*ApplicationWebhooks) ProtoMessage() {}
 func (*ApplicationWebhooks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_web_cae7911b27bddc2a, []int{2}
+	return fileDescriptor_applicationserver_web_c5277b44908d7ec9, []int{2}
 }
 func (m *ApplicationWebhooks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -334,7 +450,7 @@ type ApplicationWebhookFormats struct {
 func (m *ApplicationWebhookFormats) Reset()      { *m = ApplicationWebhookFormats{} }
 func (*ApplicationWebhookFormats) ProtoMessage() {}
 func (*ApplicationWebhookFormats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_web_cae7911b27bddc2a, []int{3}
+	return fileDescriptor_applicationserver_web_c5277b44908d7ec9, []int{3}
 }
 func (m *ApplicationWebhookFormats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,7 +496,7 @@ type GetApplicationWebhookRequest struct {
 func (m *GetApplicationWebhookRequest) Reset()      { *m = GetApplicationWebhookRequest{} }
 func (*GetApplicationWebhookRequest) ProtoMessage() {}
 func (*GetApplicationWebhookRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_web_cae7911b27bddc2a, []int{4}
+	return fileDescriptor_applicationserver_web_c5277b44908d7ec9, []int{4}
 }
 func (m *GetApplicationWebhookRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -426,7 +542,7 @@ type ListApplicationWebhooksRequest struct {
 func (m *ListApplicationWebhooksRequest) Reset()      { *m = ListApplicationWebhooksRequest{} }
 func (*ListApplicationWebhooksRequest) ProtoMessage() {}
 func (*ListApplicationWebhooksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_web_cae7911b27bddc2a, []int{5}
+	return fileDescriptor_applicationserver_web_c5277b44908d7ec9, []int{5}
 }
 func (m *ListApplicationWebhooksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -472,7 +588,7 @@ type SetApplicationWebhookRequest struct {
 func (m *SetApplicationWebhookRequest) Reset()      { *m = SetApplicationWebhookRequest{} }
 func (*SetApplicationWebhookRequest) ProtoMessage() {}
 func (*SetApplicationWebhookRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_web_cae7911b27bddc2a, []int{6}
+	return fileDescriptor_applicationserver_web_c5277b44908d7ec9, []int{6}
 }
 func (m *SetApplicationWebhookRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,6 +624,133 @@ func (m *SetApplicationWebhookRequest) GetFieldMask() types.FieldMask {
 	return types.FieldMask{}
 }
 
+type ApplicationWebhookCapture struct {
+	// Time at which the request was sent.
+	Time *time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+	// HTTP method of the request.
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// URL of the request, with the user information and the query values redacted.
+	URL string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// HTTP headers of the request, with the authorization headers and the headers of the webhook redacted.
+	Headers map[string]string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Body of the request, as it was sent.
+	Body                 []byte   `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationWebhookCapture) Reset()      { *m = ApplicationWebhookCapture{} }
+func (*ApplicationWebhookCapture) ProtoMessage() {}
+func (*ApplicationWebhookCapture) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_c5277b44908d7ec9, []int{7}
+}
+func (m *ApplicationWebhookCapture) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhookCapture) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhookCapture.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ApplicationWebhookCapture) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookCapture.Merge(dst, src)
+}
+func (m *ApplicationWebhookCapture) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookCapture) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookCapture.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhookCapture proto.InternalMessageInfo
+
+func (m *ApplicationWebhookCapture) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *ApplicationWebhookCapture) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *ApplicationWebhookCapture) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *ApplicationWebhookCapture) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *ApplicationWebhookCapture) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+type ApplicationWebhookCaptures struct {
+	Captures             []*ApplicationWebhookCapture `protobuf:"bytes,1,rep,name=captures,proto3" json:"captures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ApplicationWebhookCaptures) Reset()      { *m = ApplicationWebhookCaptures{} }
+func (*ApplicationWebhookCaptures) ProtoMessage() {}
+func (*ApplicationWebhookCaptures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_c5277b44908d7ec9, []int{8}
+}
+func (m *ApplicationWebhookCaptures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhookCaptures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhookCaptures.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ApplicationWebhookCaptures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookCaptures.Merge(dst, src)
+}
+func (m *ApplicationWebhookCaptures) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookCaptures) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookCaptures.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhookCaptures proto.InternalMessageInfo
+
+func (m *ApplicationWebhookCaptures) GetCaptures() []*ApplicationWebhookCapture {
+	if m != nil {
+		return m.Captures
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ApplicationWebhookIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookIdentifiers")
 	golang_proto.RegisterType((*ApplicationWebhookIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookIdentifiers")
@@ -529,6 +772,10 @@ func init() {
 	golang_proto.RegisterType((*ListApplicationWebhooksRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhooksRequest")
 	proto.RegisterType((*SetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.SetApplicationWebhookRequest")
 	golang_proto.RegisterType((*SetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.SetApplicationWebhookRequest")
+	proto.RegisterType((*ApplicationWebhookCapture)(nil), "ttn.lorawan.v3.ApplicationWebhookCapture")
+	golang_proto.RegisterType((*ApplicationWebhookCapture)(nil), "ttn.lorawan.v3.ApplicationWebhookCapture")
+	proto.RegisterType((*ApplicationWebhookCaptures)(nil), "ttn.lorawan.v3.ApplicationWebhookCaptures")
+	golang_proto.RegisterType((*ApplicationWebhookCaptures)(nil), "ttn.lorawan.v3.ApplicationWebhookCaptures")
 }
 func (this *ApplicationWebhookIdentifiers) Equal(that interface{}) bool {
 	if that == nil {
@@ -623,6 +870,49 @@ func (this *ApplicationWebhook) Equal(that interface{}) bool {
 	if !this.LocationSolved.Equal(that1.LocationSolved) {
 		return false
 	}
+	if this.Compression != that1.Compression {
+		return false
+	}
+	if this.ExcludeRawPayload != that1.ExcludeRawPayload {
+		return false
+	}
+	if this.ExcludeDecodedPayload != that1.ExcludeDecodedPayload {
+		return false
+	}
+	if this.BaseURLSecret != that1.BaseURLSecret {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if !this.Default.Equal(that1.Default) {
+		return false
+	}
+	if len(this.AcceptedStatusCodes) != len(that1.AcceptedStatusCodes) {
+		return false
+	}
+	for i := range this.AcceptedStatusCodes {
+		if this.AcceptedStatusCodes[i] != that1.AcceptedStatusCodes[i] {
+			return false
+		}
+	}
+	if len(this.ProjectionPaths) != len(that1.ProjectionPaths) {
+		return false
+	}
+	for i := range this.ProjectionPaths {
+		if this.ProjectionPaths[i] != that1.ProjectionPaths[i] {
+			return false
+		}
+	}
+	if this.StrictProjection != that1.StrictProjection {
+		return false
+	}
+	if this.MaxBatchSize != that1.MaxBatchSize {
+		return false
+	}
+	if this.MaxBatchLinger != that1.MaxBatchLinger {
+		return false
+	}
 	return true
 }
 func (this *ApplicationWebhook_Message) Equal(that interface{}) bool {
@@ -647,6 +937,9 @@ func (this *ApplicationWebhook_Message) Equal(that interface{}) bool {
 	if this.Path != that1.Path {
 		return false
 	}
+	if this.Format != that1.Format {
+		return false
+	}
 	return true
 }
 func (this *ApplicationWebhooks) Equal(that interface{}) bool {
@@ -788,6 +1081,80 @@ func (this *SetApplicationWebhookRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ApplicationWebhookCapture) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationWebhookCapture)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookCapture)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.Time == nil {
+		if this.Time != nil {
+			return false
+		}
+	} else if !this.Time.Equal(*that1.Time) {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Body, that1.Body) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationWebhookCaptures) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationWebhookCaptures)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookCaptures)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Captures) != len(that1.Captures) {
+		return false
+	}
+	for i := range this.Captures {
+		if !this.Captures[i].Equal(that1.Captures[i]) {
+			return false
+		}
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -806,6 +1173,9 @@ type ApplicationWebhookRegistryClient interface {
 	List(ctx context.Context, in *ListApplicationWebhooksRequest, opts ...grpc.CallOption) (*ApplicationWebhooks, error)
 	Set(ctx context.Context, in *SetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error)
 	Delete(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// GetCaptures returns the last requests that were sent to the webhook, oldest first.
+	// Requests are only captured if request capture is enabled in the Application Server.
+	GetCaptures(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*ApplicationWebhookCaptures, error)
 }
 
 type applicationWebhookRegistryClient struct {
@@ -861,6 +1231,15 @@ func (c *applicationWebhookRegistryClient) Delete(ctx context.Context, in *Appli
 	return out, nil
 }
 
+func (c *applicationWebhookRegistryClient) GetCaptures(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*ApplicationWebhookCaptures, error) {
+	out := new(ApplicationWebhookCaptures)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/GetCaptures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationWebhookRegistryServer is the server API for ApplicationWebhookRegistry service.
 type ApplicationWebhookRegistryServer interface {
 	GetFormats(context.Context, *types.Empty) (*ApplicationWebhookFormats, error)
@@ -868,6 +1247,9 @@ type ApplicationWebhookRegistryServer interface {
 	List(context.Context, *ListApplicationWebhooksRequest) (*ApplicationWebhooks, error)
 	Set(context.Context, *SetApplicationWebhookRequest) (*ApplicationWebhook, error)
 	Delete(context.Context, *ApplicationWebhookIdentifiers) (*types.Empty, error)
+	// GetCaptures returns the last requests that were sent to the webhook, oldest first.
+	// Requests are only captured if request capture is enabled in the Application Server.
+	GetCaptures(context.Context, *ApplicationWebhookIdentifiers) (*ApplicationWebhookCaptures, error)
 }
 
 func RegisterApplicationWebhookRegistryServer(s *grpc.Server, srv ApplicationWebhookRegistryServer) {
@@ -964,6 +1346,24 @@ func _ApplicationWebhookRegistry_Delete_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationWebhookRegistry_GetCaptures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationWebhookIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).GetCaptures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/GetCaptures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).GetCaptures(ctx, req.(*ApplicationWebhookIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApplicationWebhookRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.ApplicationWebhookRegistry",
 	HandlerType: (*ApplicationWebhookRegistryServer)(nil),
@@ -988,6 +1388,10 @@ var _ApplicationWebhookRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ApplicationWebhookRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "GetCaptures",
+			Handler:    _ApplicationWebhookRegistry_GetCaptures_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/applicationserver_web.proto",
@@ -1173,19 +1577,146 @@ func (m *ApplicationWebhook) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n12
 	}
-	return i, nil
-}
-
-func (m *ApplicationWebhook_Message) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(m.Compression) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Compression)))
+		i += copy(dAtA[i:], m.Compression)
 	}
-	return dAtA[:n], nil
-}
-
+	if m.ExcludeRawPayload {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.ExcludeRawPayload {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.ExcludeDecodedPayload {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.ExcludeDecodedPayload {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.BaseURLSecret {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.BaseURLSecret {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Method) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Method)))
+		i += copy(dAtA[i:], m.Method)
+	}
+	if m.Default != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.Default.Size()))
+		n13, err := m.Default.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if len(m.AcceptedStatusCodes) > 0 {
+		dAtA1 := make([]byte, len(m.AcceptedStatusCodes)*10)
+		var j14 int
+		for _, num := range m.AcceptedStatusCodes {
+			for num >= 1<<7 {
+				dAtA1[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA1[j14] = uint8(num)
+			j14++
+		}
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(j14))
+		i += copy(dAtA[i:], dAtA1[:j14])
+	}
+	if len(m.ProjectionPaths) > 0 {
+		for _, s := range m.ProjectionPaths {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.StrictProjection {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.StrictProjection {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MaxBatchSize != 0 {
+		dAtA[i] = 0xc0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.MaxBatchSize))
+	}
+	dAtA[i] = 0xca
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxBatchLinger)))
+	n16, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxBatchLinger, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n16
+	return i, nil
+}
+
+func (m *ApplicationWebhook_Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
 func (m *ApplicationWebhook_Message) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
@@ -1197,6 +1728,12 @@ func (m *ApplicationWebhook_Message) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Path)))
 		i += copy(dAtA[i:], m.Path)
 	}
+	if len(m.Format) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Format)))
+		i += copy(dAtA[i:], m.Format)
+	}
 	return i, nil
 }
 
@@ -1283,19 +1820,19 @@ func (m *GetApplicationWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.ApplicationWebhookIdentifiers.Size()))
-	n13, err := m.ApplicationWebhookIdentifiers.MarshalTo(dAtA[i:])
+	n17, err := m.ApplicationWebhookIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n13
+	i += n17
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.FieldMask.Size()))
-	n14, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n18, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n18
 	return i, nil
 }
 
@@ -1317,19 +1854,19 @@ func (m *ListApplicationWebhooksRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
-	n15, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
+	n19, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n15
+	i += n19
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.FieldMask.Size()))
-	n16, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n20, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n20
 	return i, nil
 }
 
@@ -1351,19 +1888,112 @@ func (m *SetApplicationWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.ApplicationWebhook.Size()))
-	n17, err := m.ApplicationWebhook.MarshalTo(dAtA[i:])
+	n21, err := m.ApplicationWebhook.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n21
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.FieldMask.Size()))
-	n18, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n22, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n22
+	return i, nil
+}
+
+func (m *ApplicationWebhookCapture) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhookCapture) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Time != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)))
+		n23, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if len(m.Method) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Method)))
+		i += copy(dAtA[i:], m.Method)
+	}
+	if len(m.URL) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.URL)))
+		i += copy(dAtA[i:], m.URL)
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			dAtA[i] = 0x22
+			i++
+			v := m.Headers[k]
+			mapSize := 1 + len(k) + sovApplicationserverWeb(uint64(len(k))) + 1 + len(v) + sovApplicationserverWeb(uint64(len(v)))
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Body) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Body)))
+		i += copy(dAtA[i:], m.Body)
+	}
+	return i, nil
+}
+
+func (m *ApplicationWebhookCaptures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhookCaptures) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Captures) > 0 {
+		for _, msg := range m.Captures {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -1427,6 +2057,28 @@ func NewPopulatedApplicationWebhook(r randyApplicationserverWeb, easy bool) *App
 	if r.Intn(10) != 0 {
 		this.LocationSolved = NewPopulatedApplicationWebhook_Message(r, easy)
 	}
+	this.Compression = randStringApplicationserverWeb(r)
+	this.ExcludeRawPayload = bool(r.Intn(2) == 0)
+	this.ExcludeDecodedPayload = bool(r.Intn(2) == 0)
+	this.BaseURLSecret = bool(r.Intn(2) == 0)
+	this.Method = randStringApplicationserverWeb(r)
+	if r.Intn(10) != 0 {
+		this.Default = NewPopulatedApplicationWebhook_Message(r, easy)
+	}
+	v6 := r.Intn(10)
+	this.AcceptedStatusCodes = make([]uint32, v6)
+	for i := 0; i < v6; i++ {
+		this.AcceptedStatusCodes[i] = r.Uint32()
+	}
+	v7 := r.Intn(10)
+	this.ProjectionPaths = make([]string, v7)
+	for i := 0; i < v7; i++ {
+		this.ProjectionPaths[i] = randStringApplicationserverWeb(r)
+	}
+	this.StrictProjection = bool(r.Intn(2) == 0)
+	this.MaxBatchSize = r.Uint32()
+	v8 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.MaxBatchLinger = *v8
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1435,6 +2087,7 @@ func NewPopulatedApplicationWebhook(r randyApplicationserverWeb, easy bool) *App
 func NewPopulatedApplicationWebhook_Message(r randyApplicationserverWeb, easy bool) *ApplicationWebhook_Message {
 	this := &ApplicationWebhook_Message{}
 	this.Path = randStringApplicationserverWeb(r)
+	this.Format = randStringApplicationserverWeb(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1443,9 +2096,9 @@ func NewPopulatedApplicationWebhook_Message(r randyApplicationserverWeb, easy bo
 func NewPopulatedApplicationWebhooks(r randyApplicationserverWeb, easy bool) *ApplicationWebhooks {
 	this := &ApplicationWebhooks{}
 	if r.Intn(10) != 0 {
-		v6 := r.Intn(5)
-		this.Webhooks = make([]*ApplicationWebhook, v6)
-		for i := 0; i < v6; i++ {
+		v9 := r.Intn(5)
+		this.Webhooks = make([]*ApplicationWebhook, v9)
+		for i := 0; i < v9; i++ {
 			this.Webhooks[i] = NewPopulatedApplicationWebhook(r, easy)
 		}
 	}
@@ -1457,9 +2110,9 @@ func NewPopulatedApplicationWebhooks(r randyApplicationserverWeb, easy bool) *Ap
 func NewPopulatedApplicationWebhookFormats(r randyApplicationserverWeb, easy bool) *ApplicationWebhookFormats {
 	this := &ApplicationWebhookFormats{}
 	if r.Intn(10) != 0 {
-		v7 := r.Intn(10)
+		v10 := r.Intn(10)
 		this.Formats = make(map[string]string)
-		for i := 0; i < v7; i++ {
+		for i := 0; i < v10; i++ {
 			this.Formats[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
 		}
 	}
@@ -1470,10 +2123,10 @@ func NewPopulatedApplicationWebhookFormats(r randyApplicationserverWeb, easy boo
 
 func NewPopulatedGetApplicationWebhookRequest(r randyApplicationserverWeb, easy bool) *GetApplicationWebhookRequest {
 	this := &GetApplicationWebhookRequest{}
-	v8 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
-	this.ApplicationWebhookIdentifiers = *v8
-	v9 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v9
+	v11 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v11
+	v12 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v12
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1481,10 +2134,10 @@ func NewPopulatedGetApplicationWebhookRequest(r randyApplicationserverWeb, easy
 
 func NewPopulatedListApplicationWebhooksRequest(r randyApplicationserverWeb, easy bool) *ListApplicationWebhooksRequest {
 	this := &ListApplicationWebhooksRequest{}
-	v10 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v10
-	v11 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v11
+	v13 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v13
+	v14 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v14
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1492,10 +2145,48 @@ func NewPopulatedListApplicationWebhooksRequest(r randyApplicationserverWeb, eas
 
 func NewPopulatedSetApplicationWebhookRequest(r randyApplicationserverWeb, easy bool) *SetApplicationWebhookRequest {
 	this := &SetApplicationWebhookRequest{}
-	v12 := NewPopulatedApplicationWebhook(r, easy)
-	this.ApplicationWebhook = *v12
-	v13 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v13
+	v15 := NewPopulatedApplicationWebhook(r, easy)
+	this.ApplicationWebhook = *v15
+	v16 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v16
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookCapture(r randyApplicationserverWeb, easy bool) *ApplicationWebhookCapture {
+	this := &ApplicationWebhookCapture{}
+	if r.Intn(10) != 0 {
+		this.Time = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.Method = randStringApplicationserverWeb(r)
+	this.URL = randStringApplicationserverWeb(r)
+	if r.Intn(10) != 0 {
+		v17 := r.Intn(10)
+		this.Headers = make(map[string]string)
+		for i := 0; i < v17; i++ {
+			this.Headers[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
+		}
+	}
+	v18 := r.Intn(100)
+	this.Body = make([]byte, v18)
+	for i := 0; i < v18; i++ {
+		this.Body[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookCaptures(r randyApplicationserverWeb, easy bool) *ApplicationWebhookCaptures {
+	this := &ApplicationWebhookCaptures{}
+	if r.Intn(10) != 0 {
+		v19 := r.Intn(5)
+		this.Captures = make([]*ApplicationWebhookCapture, v19)
+		for i := 0; i < v19; i++ {
+			this.Captures[i] = NewPopulatedApplicationWebhookCapture(r, easy)
+		}
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1520,9 +2211,9 @@ func randUTF8RuneApplicationserverWeb(r randyApplicationserverWeb) rune {
 	return rune(ru + 61)
 }
 func randStringApplicationserverWeb(r randyApplicationserverWeb) string {
-	v14 := r.Intn(100)
-	tmps := make([]rune, v14)
-	for i := 0; i < v14; i++ {
+	v20 := r.Intn(100)
+	tmps := make([]rune, v20)
+	for i := 0; i < v20; i++ {
 		tmps[i] = randUTF8RuneApplicationserverWeb(r)
 	}
 	return string(tmps)
@@ -1544,11 +2235,11 @@ func randFieldApplicationserverWeb(dAtA []byte, r randyApplicationserverWeb, fie
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
-		v15 := r.Int63()
+		v21 := r.Int63()
 		if r.Intn(2) == 0 {
-			v15 *= -1
+			v21 *= -1
 		}
-		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(v15))
+		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(v21))
 	case 1:
 		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1648,6 +2339,48 @@ func (m *ApplicationWebhook) Size() (n int) {
 		l = m.LocationSolved.Size()
 		n += 1 + l + sovApplicationserverWeb(uint64(l))
 	}
+	l = len(m.Compression)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.ExcludeRawPayload {
+		n += 3
+	}
+	if m.ExcludeDecodedPayload {
+		n += 3
+	}
+	if m.BaseURLSecret {
+		n += 3
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.Default != nil {
+		l = m.Default.Size()
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if len(m.AcceptedStatusCodes) > 0 {
+		l = 0
+		for _, e := range m.AcceptedStatusCodes {
+			l += sovApplicationserverWeb(uint64(e))
+		}
+		n += 2 + sovApplicationserverWeb(uint64(l)) + l
+	}
+	if len(m.ProjectionPaths) > 0 {
+		for _, s := range m.ProjectionPaths {
+			l = len(s)
+			n += 2 + l + sovApplicationserverWeb(uint64(l))
+		}
+	}
+	if m.StrictProjection {
+		n += 3
+	}
+	if m.MaxBatchSize != 0 {
+		n += 2 + sovApplicationserverWeb(uint64(m.MaxBatchSize))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxBatchLinger)
+	n += 2 + l + sovApplicationserverWeb(uint64(l))
 	return n
 }
 
@@ -1661,6 +2394,10 @@ func (m *ApplicationWebhook_Message) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApplicationserverWeb(uint64(l))
 	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
 	return n
 }
 
@@ -1735,6 +2472,54 @@ func (m *SetApplicationWebhookRequest) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationWebhookCapture) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApplicationserverWeb(uint64(len(k))) + 1 + len(v) + sovApplicationserverWeb(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApplicationserverWeb(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationWebhookCaptures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Captures) > 0 {
+		for _, e := range m.Captures {
+			l = e.Size()
+			n += 1 + l + sovApplicationserverWeb(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovApplicationserverWeb(x uint64) (n int) {
 	for {
 		n++
@@ -1788,6 +2573,17 @@ func (this *ApplicationWebhook) String() string {
 		`DownlinkFailed:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkFailed), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
 		`DownlinkQueued:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkQueued), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
 		`LocationSolved:` + strings.Replace(fmt.Sprintf("%v", this.LocationSolved), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`Compression:` + fmt.Sprintf("%v", this.Compression) + `,`,
+		`ExcludeRawPayload:` + fmt.Sprintf("%v", this.ExcludeRawPayload) + `,`,
+		`ExcludeDecodedPayload:` + fmt.Sprintf("%v", this.ExcludeDecodedPayload) + `,`,
+		`BaseURLSecret:` + fmt.Sprintf("%v", this.BaseURLSecret) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`Default:` + strings.Replace(fmt.Sprintf("%v", this.Default), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`AcceptedStatusCodes:` + fmt.Sprintf("%v", this.AcceptedStatusCodes) + `,`,
+		`ProjectionPaths:` + fmt.Sprintf("%v", this.ProjectionPaths) + `,`,
+		`StrictProjection:` + fmt.Sprintf("%v", this.StrictProjection) + `,`,
+		`MaxBatchSize:` + fmt.Sprintf("%v", this.MaxBatchSize) + `,`,
+		`MaxBatchLinger:` + strings.Replace(strings.Replace(this.MaxBatchLinger.String(), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1798,6 +2594,7 @@ func (this *ApplicationWebhook_Message) String() string {
 	}
 	s := strings.Join([]string{`&ApplicationWebhook_Message{`,
 		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1865,6 +2662,40 @@ func (this *SetApplicationWebhookRequest) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationWebhookCapture) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&ApplicationWebhookCapture{`,
+		`Time:` + strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhookCaptures) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhookCaptures{`,
+		`Captures:` + strings.Replace(fmt.Sprintf("%v", this.Captures), "ApplicationWebhookCapture", "ApplicationWebhookCapture", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringApplicationserverWeb(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2541,7 +3372,329 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Compression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeRawPayload", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeRawPayload = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeDecodedPayload", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeDecodedPayload = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseURLSecret", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BaseURLSecret = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Default == nil {
+				m.Default = &ApplicationWebhook_Message{}
+			}
+			if err := m.Default.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverWeb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AcceptedStatusCodes = append(m.AcceptedStatusCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverWeb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApplicationserverWeb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AcceptedStatusCodes) == 0 {
+					m.AcceptedStatusCodes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AcceptedStatusCodes = append(m.AcceptedStatusCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedStatusCodes", wireType)
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectionPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectionPaths = append(m.ProjectionPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictProjection", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictProjection = bool(v != 0)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchLinger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxBatchLinger, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
 			if err != nil {
@@ -2620,6 +3773,35 @@ func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
@@ -2867,7 +4049,227 @@ func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Formats[mapkey] = mapvalue
+			m.Formats[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetApplicationWebhookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetApplicationWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListApplicationWebhooksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListApplicationWebhooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2890,7 +4292,7 @@ func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
+func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2913,15 +4315,15 @@ func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetApplicationWebhookRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetApplicationWebhookRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetApplicationWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetApplicationWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2945,7 +4347,7 @@ func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationWebhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3000,7 +4402,7 @@ func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationWebhookCapture) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3023,15 +4425,15 @@ func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListApplicationWebhooksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationWebhookCapture: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListApplicationWebhooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationWebhookCapture: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3055,13 +4457,74 @@ func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3085,8 +4548,127 @@ func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverWeb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3110,7 +4692,7 @@ func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationWebhookCaptures) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3133,45 +4715,15 @@ func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetApplicationWebhookRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationWebhookCaptures: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetApplicationWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationWebhookCaptures: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhook", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserverWeb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ApplicationWebhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Captures", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3195,7 +4747,8 @@ func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Captures = append(m.Captures, &ApplicationWebhookCapture{})
+			if err := m.Captures[len(m.Captures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3326,86 +4879,116 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("lorawan-stack/api/applicationserver_web.proto", fileDescriptor_applicationserver_web_cae7911b27bddc2a)
+	proto.RegisterFile("lorawan-stack/api/applicationserver_web.proto", fileDescriptor_applicationserver_web_c5277b44908d7ec9)
 }
 func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/applicationserver_web.proto", fileDescriptor_applicationserver_web_cae7911b27bddc2a)
-}
-
-var fileDescriptor_applicationserver_web_cae7911b27bddc2a = []byte{
-	// 1179 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0x41, 0x6c, 0xd3, 0x56,
-	0x1c, 0xc6, 0xfd, 0x48, 0x69, 0x9a, 0x17, 0x28, 0xd3, 0x63, 0x43, 0x5e, 0x80, 0x97, 0xca, 0x6c,
-	0xa8, 0x20, 0x62, 0x4f, 0x45, 0x42, 0x5b, 0x35, 0x0d, 0x35, 0x83, 0x76, 0xd5, 0x60, 0x0c, 0x67,
-	0x08, 0x6d, 0x88, 0x45, 0x2f, 0xf1, 0x4b, 0x62, 0xe2, 0xd8, 0xc6, 0xef, 0xa5, 0x19, 0x43, 0x48,
-	0x68, 0x27, 0x8e, 0x48, 0xbb, 0xec, 0x36, 0xb4, 0xcb, 0xd8, 0x4e, 0x1c, 0x39, 0xec, 0x80, 0xb4,
-	0x4b, 0x4f, 0x53, 0xa5, 0x5d, 0x38, 0xb5, 0xc4, 0xd9, 0x81, 0x23, 0x47, 0x8e, 0x93, 0xed, 0xe7,
-	0xd4, 0x4d, 0x4a, 0xdb, 0xb4, 0xdb, 0xa9, 0x7e, 0x79, 0xff, 0xef, 0xf7, 0xbe, 0xf7, 0xe5, 0xff,
-	0x5e, 0x5c, 0x58, 0xb0, 0x1c, 0x8f, 0x74, 0x88, 0x5d, 0x60, 0x9c, 0x54, 0x9b, 0x1a, 0x71, 0x4d,
-	0x8d, 0xb8, 0xae, 0x65, 0x56, 0x09, 0x37, 0x1d, 0x9b, 0x51, 0x6f, 0x89, 0x7a, 0xe5, 0x0e, 0xad,
-	0xa8, 0xae, 0xe7, 0x70, 0x07, 0x4d, 0x72, 0x6e, 0xab, 0x42, 0xa2, 0x2e, 0x9d, 0xcd, 0x15, 0xea,
-	0x26, 0x6f, 0xb4, 0x2b, 0x6a, 0xd5, 0x69, 0x69, 0x75, 0xa7, 0xee, 0x68, 0x61, 0x59, 0xa5, 0x5d,
-	0x0b, 0x47, 0xe1, 0x20, 0x7c, 0x8a, 0xe4, 0xb9, 0x73, 0x89, 0xf2, 0x56, 0xc7, 0xe4, 0x4d, 0xa7,
-	0xa3, 0xd5, 0x9d, 0x42, 0x38, 0x59, 0x58, 0x22, 0x96, 0x69, 0x10, 0xee, 0x78, 0x4c, 0xeb, 0x3f,
-	0x0a, 0xdd, 0xb1, 0xba, 0xe3, 0xd4, 0x2d, 0x1a, 0xd9, 0xb3, 0x6d, 0x87, 0x47, 0xee, 0xc4, 0xec,
-	0x51, 0x31, 0xdb, 0x5f, 0x9b, 0xb6, 0x5c, 0x7e, 0x47, 0x4c, 0x4e, 0x0d, 0x4e, 0xd6, 0x4c, 0x6a,
-	0x19, 0xe5, 0x16, 0x61, 0x4d, 0x51, 0x91, 0x1f, 0xac, 0xe0, 0x66, 0x8b, 0x32, 0x4e, 0x5a, 0xae,
-	0x28, 0x38, 0x31, 0x9c, 0x91, 0x69, 0x50, 0x9b, 0x9b, 0x35, 0x93, 0x7a, 0xc2, 0x84, 0xf2, 0x17,
-	0x80, 0xc7, 0xe7, 0xd6, 0x93, 0xbb, 0x4e, 0x2b, 0x0d, 0xc7, 0x69, 0x2e, 0xae, 0xd7, 0xa1, 0xaf,
-	0xe1, 0xa1, 0x44, 0xb4, 0x65, 0xd3, 0x60, 0x32, 0x98, 0x02, 0xd3, 0xd9, 0x99, 0x93, 0xea, 0xc6,
-	0x54, 0xd5, 0x04, 0x27, 0x01, 0x28, 0x4e, 0x2c, 0xaf, 0xe6, 0xa5, 0x95, 0xd5, 0x3c, 0xd0, 0x27,
-	0x49, 0xb2, 0x82, 0x21, 0x1d, 0xc2, 0x4e, 0xb4, 0x60, 0xd9, 0x34, 0xe4, 0x7d, 0x53, 0x60, 0x3a,
-	0x53, 0x3c, 0xeb, 0xaf, 0xe6, 0x33, 0xb1, 0x8d, 0x0b, 0xfe, 0x5a, 0x5e, 0x81, 0xf8, 0xdb, 0x1b,
-	0xa4, 0xf0, 0xfd, 0x07, 0x85, 0x8f, 0x6e, 0x4e, 0x9f, 0x9f, 0xbd, 0x51, 0xb8, 0x79, 0x3e, 0x1e,
-	0x9e, 0xba, 0x3b, 0x73, 0xe6, 0xde, 0x7b, 0xdf, 0xbd, 0xaf, 0x67, 0x3a, 0xb1, 0x6f, 0x65, 0x6d,
-	0x02, 0xa2, 0xe1, 0x0d, 0xa1, 0x45, 0x98, 0x5a, 0x77, 0x5e, 0xd8, 0xc2, 0xf9, 0x70, 0x02, 0x89,
-	0x0d, 0x04, 0x0c, 0xf4, 0x29, 0x84, 0x55, 0x8f, 0x12, 0x4e, 0x8d, 0x32, 0xe1, 0xa1, 0xeb, 0xec,
-	0x4c, 0x4e, 0x8d, 0xbe, 0x0d, 0x35, 0xfe, 0x36, 0xd4, 0xaf, 0xe2, 0x6f, 0x23, 0x92, 0x3f, 0x5c,
-	0xcb, 0x03, 0x3d, 0x23, 0x74, 0x73, 0x3c, 0x80, 0xb4, 0x5d, 0x23, 0x86, 0xa4, 0x46, 0x81, 0x08,
-	0xdd, 0x1c, 0x47, 0x27, 0xe1, 0x44, 0x85, 0x30, 0x5a, 0x6e, 0x7b, 0x96, 0x3c, 0x16, 0xa6, 0x97,
-	0xf5, 0x57, 0xf3, 0xe9, 0x22, 0x61, 0xf4, 0x9a, 0x7e, 0x49, 0x4f, 0x07, 0x93, 0xd7, 0x3c, 0x0b,
-	0x2d, 0xc2, 0x74, 0x83, 0x12, 0x83, 0x7a, 0x4c, 0xde, 0x3f, 0x95, 0x9a, 0xce, 0xce, 0x68, 0xdb,
-	0x07, 0xa0, 0x7e, 0x16, 0x29, 0x2e, 0xda, 0xdc, 0xbb, 0xa3, 0xc7, 0x7a, 0x74, 0x04, 0x8e, 0xd7,
-	0x1c, 0xaf, 0x45, 0xb8, 0x3c, 0x1e, 0x2c, 0xa8, 0x8b, 0x11, 0xba, 0x0a, 0x27, 0xdb, 0xae, 0x65,
-	0xda, 0xcd, 0x72, 0x8b, 0x32, 0x46, 0xea, 0x54, 0x4e, 0x87, 0x7b, 0x3a, 0xbd, 0x83, 0x95, 0x2e,
-	0x47, 0x0a, 0xfd, 0x60, 0x44, 0x10, 0x43, 0xf4, 0x39, 0xcc, 0xde, 0x72, 0x4c, 0xbb, 0x4c, 0xaa,
-	0x55, 0xea, 0x72, 0x79, 0x62, 0x64, 0x1e, 0x0c, 0xe4, 0x73, 0xa1, 0x1a, 0x5d, 0x86, 0x07, 0x0c,
-	0xa7, 0x63, 0x87, 0x0e, 0x49, 0xb5, 0x29, 0x67, 0x46, 0xa6, 0x65, 0x63, 0xfd, 0x5c, 0xb5, 0x89,
-	0xae, 0xc0, 0x83, 0x7d, 0x9c, 0x1d, 0xf0, 0xe0, 0xc8, 0xbc, 0xbe, 0x9f, 0x2f, 0xc8, 0x00, 0x90,
-	0x51, 0x9b, 0xcb, 0xd9, 0xdd, 0x03, 0x4b, 0xd4, 0xe6, 0xa8, 0x04, 0x0f, 0xf5, 0x81, 0x35, 0x62,
-	0x5a, 0xd4, 0x90, 0x0f, 0x8c, 0x8c, 0x9c, 0x8c, 0x11, 0xf3, 0x21, 0x61, 0x03, 0xf4, 0x76, 0x9b,
-	0xb6, 0xa9, 0x21, 0x1f, 0xdc, 0x3d, 0xf4, 0x6a, 0x48, 0x08, 0xa0, 0x96, 0x23, 0x6e, 0x17, 0xe6,
-	0x58, 0x4b, 0xd4, 0x90, 0x27, 0x47, 0x87, 0xc6, 0x88, 0x52, 0x48, 0xc8, 0xcd, 0xc2, 0x03, 0xc9,
-	0x06, 0x46, 0x6f, 0xc1, 0x54, 0x93, 0xde, 0x09, 0xcf, 0x7f, 0x46, 0x0f, 0x1e, 0xd1, 0xdb, 0x70,
-	0xff, 0x12, 0xb1, 0xda, 0x34, 0xba, 0x77, 0xf4, 0x68, 0x30, 0xbb, 0xef, 0x43, 0x90, 0x3b, 0x0e,
-	0xd3, 0x71, 0x0f, 0x22, 0x38, 0xe6, 0x12, 0xde, 0x10, 0xba, 0xf0, 0x59, 0xb9, 0x06, 0x0f, 0x0f,
-	0x1b, 0x61, 0xe8, 0x13, 0x38, 0x21, 0x6e, 0xa1, 0xe0, 0x9a, 0x09, 0x4e, 0x99, 0xb2, 0xbd, 0x7f,
-	0xbd, 0xaf, 0x51, 0x7e, 0x03, 0xf0, 0xdd, 0xe1, 0x82, 0xf9, 0xf0, 0x78, 0x31, 0xf4, 0x25, 0x4c,
-	0x47, 0x27, 0x2d, 0x86, 0x9f, 0xdb, 0x1e, 0x2e, 0xb4, 0xaa, 0xf8, 0x2b, 0x4e, 0xb2, 0xc0, 0x04,
-	0x09, 0x25, 0x27, 0x46, 0x49, 0x48, 0xf9, 0x1d, 0xc0, 0x63, 0x0b, 0x94, 0x6f, 0xb2, 0x1f, 0x7a,
-	0xbb, 0x4d, 0x19, 0xff, 0x2f, 0xaf, 0xdb, 0xf3, 0x10, 0xae, 0xff, 0xf6, 0xbd, 0xf1, 0xba, 0x9d,
-	0x0f, 0x4a, 0x2e, 0x13, 0xd6, 0x2c, 0x8e, 0x05, 0x72, 0x3d, 0x53, 0x8b, 0x3f, 0x50, 0xfe, 0x00,
-	0x10, 0x5f, 0x32, 0xd9, 0x26, 0x6e, 0x59, 0x6c, 0xf7, 0x7f, 0xfc, 0x8d, 0xdb, 0xb3, 0xfd, 0x5f,
-	0x01, 0x3c, 0x56, 0xda, 0x2a, 0xeb, 0x79, 0x98, 0x16, 0x4d, 0x24, 0x4c, 0xef, 0xa0, 0xef, 0x12,
-	0x86, 0x63, 0xf1, 0x9e, 0x9d, 0xce, 0x2c, 0x8f, 0xc3, 0xdc, 0x66, 0x36, 0xeb, 0x26, 0x0b, 0x1a,
-	0xcc, 0x82, 0x70, 0x81, 0xf2, 0xb8, 0xa1, 0x8f, 0x0c, 0x91, 0x2f, 0x06, 0xaf, 0x3f, 0xb9, 0x53,
-	0x3b, 0xee, 0x6b, 0xe5, 0xe8, 0x0f, 0x7f, 0xff, 0xf3, 0xe3, 0xbe, 0x77, 0xd0, 0x61, 0x8d, 0x30,
-	0x4d, 0xec, 0xa2, 0x20, 0xda, 0x1b, 0x3d, 0x01, 0x30, 0xb5, 0x40, 0x39, 0x3a, 0x33, 0xc8, 0xdb,
-	0xaa, 0x6f, 0x73, 0x3b, 0x88, 0x4e, 0xb9, 0x1e, 0x2e, 0x7b, 0x15, 0x5d, 0x09, 0x96, 0x4d, 0xbe,
-	0x75, 0x6a, 0x77, 0x4d, 0x83, 0xa9, 0x03, 0x8d, 0x34, 0x30, 0xbe, 0x17, 0x1b, 0x15, 0xd5, 0xeb,
-	0xef, 0x3f, 0xf7, 0xd0, 0xcf, 0x00, 0x8e, 0x05, 0x8d, 0x8a, 0xd4, 0x41, 0x17, 0x5b, 0xb7, 0x6f,
-	0xee, 0xc4, 0xf6, 0xae, 0x99, 0x52, 0x0c, 0x6d, 0x7f, 0x8c, 0x66, 0x87, 0x6d, 0xef, 0xd4, 0x32,
-	0xfa, 0x13, 0xc0, 0x54, 0x69, 0xb3, 0x50, 0x4b, 0x7b, 0x0d, 0xf5, 0x56, 0xe8, 0xce, 0x50, 0xca,
-	0xc3, 0xee, 0xc4, 0xea, 0xea, 0x68, 0xe1, 0x26, 0x55, 0x89, 0x90, 0x67, 0xc1, 0x69, 0xf4, 0x08,
-	0xc0, 0xf1, 0x0b, 0xd4, 0xa2, 0x9c, 0xa2, 0xd1, 0xae, 0xa6, 0xdc, 0x1b, 0x9a, 0x56, 0xb9, 0x12,
-	0xba, 0x5f, 0x3c, 0xbd, 0xb0, 0xfb, 0x6c, 0xfb, 0x8e, 0x83, 0x4f, 0x8b, 0xbf, 0x80, 0xe5, 0x2e,
-	0x06, 0x2b, 0x5d, 0x0c, 0x9e, 0x77, 0xb1, 0xf4, 0xa2, 0x8b, 0xa5, 0x97, 0x5d, 0x2c, 0xbd, 0xea,
-	0x62, 0xe9, 0x75, 0x17, 0x83, 0xfb, 0x3e, 0x06, 0x0f, 0x7c, 0x2c, 0x3d, 0xf6, 0x31, 0x78, 0xe2,
-	0x63, 0xe9, 0xa9, 0x8f, 0xa5, 0x67, 0x3e, 0x96, 0x96, 0x7d, 0x0c, 0x56, 0x7c, 0x0c, 0x9e, 0xfb,
-	0x58, 0x7a, 0xe1, 0x63, 0xf0, 0xd2, 0xc7, 0xd2, 0x2b, 0x1f, 0x83, 0xd7, 0x3e, 0x96, 0xee, 0xf7,
-	0xb0, 0xf4, 0xa0, 0x87, 0xc1, 0xc3, 0x1e, 0x96, 0x7e, 0xea, 0x61, 0xf0, 0xa8, 0x87, 0xa5, 0xc7,
-	0x3d, 0x2c, 0x3d, 0xe9, 0x61, 0xf0, 0xb4, 0x87, 0xc1, 0xb3, 0x1e, 0x06, 0xdf, 0x9c, 0xa9, 0x3b,
-	0x2a, 0x6f, 0x50, 0xde, 0x30, 0xed, 0x3a, 0x53, 0x6d, 0xca, 0x3b, 0x8e, 0xd7, 0xd4, 0x36, 0xfe,
-	0x1f, 0xe1, 0x36, 0xeb, 0x1a, 0xe7, 0xb6, 0x5b, 0xa9, 0x8c, 0x87, 0x29, 0x9c, 0xfd, 0x37, 0x00,
-	0x00, 0xff, 0xff, 0xeb, 0x39, 0xe7, 0x6a, 0x8d, 0x0d, 0x00, 0x00,
+	golang_proto.RegisterFile("lorawan-stack/api/applicationserver_web.proto", fileDescriptor_applicationserver_web_c5277b44908d7ec9)
+}
+
+var fileDescriptor_applicationserver_web_c5277b44908d7ec9 = []byte{
+	// 1651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xde, 0x11, 0x65, 0x51, 0x1c, 0xea, 0xcf, 0xa3, 0xc8, 0x59, 0x31, 0xee, 0x90, 0x60, 0xdc,
+	0x80, 0x72, 0xcd, 0x65, 0x23, 0xb7, 0x46, 0x23, 0x14, 0x35, 0x24, 0xcb, 0x56, 0x8c, 0xda, 0xb5,
+	0xbc, 0xb4, 0x91, 0xb6, 0x41, 0xbc, 0x18, 0xee, 0x8e, 0xa8, 0x35, 0x97, 0xbb, 0x9b, 0x9d, 0xa1,
+	0x68, 0x39, 0x36, 0x10, 0xf4, 0x94, 0x4b, 0x81, 0x00, 0xbd, 0xe4, 0xd6, 0xa0, 0x87, 0x36, 0xed,
+	0xc9, 0xc7, 0x1c, 0x7a, 0x08, 0xda, 0x43, 0x7d, 0x2a, 0x0c, 0xf4, 0x92, 0x93, 0x1c, 0x2d, 0x7b,
+	0xc8, 0x31, 0xc7, 0x1c, 0x8b, 0x9d, 0x9d, 0xa5, 0x68, 0x52, 0x96, 0x49, 0xcb, 0x3d, 0x71, 0x66,
+	0xde, 0xfb, 0xbe, 0xf9, 0xe6, 0xcd, 0xbc, 0x37, 0xb3, 0x84, 0x65, 0xc7, 0x0b, 0x48, 0x9b, 0xb8,
+	0x65, 0xc6, 0x89, 0xd9, 0xa8, 0x10, 0xdf, 0xae, 0x10, 0xdf, 0x77, 0x6c, 0x93, 0x70, 0xdb, 0x73,
+	0x19, 0x0d, 0x76, 0x68, 0x60, 0xb4, 0x69, 0x4d, 0xf3, 0x03, 0x8f, 0x7b, 0x68, 0x86, 0x73, 0x57,
+	0x93, 0x10, 0x6d, 0xe7, 0x7c, 0xae, 0x5c, 0xb7, 0xf9, 0x76, 0xab, 0xa6, 0x99, 0x5e, 0xb3, 0x52,
+	0xf7, 0xea, 0x5e, 0x45, 0xb8, 0xd5, 0x5a, 0x5b, 0xa2, 0x27, 0x3a, 0xa2, 0x15, 0xc3, 0x73, 0x17,
+	0x7a, 0xdc, 0x9b, 0x6d, 0x9b, 0x37, 0xbc, 0x76, 0xa5, 0xee, 0x95, 0x85, 0xb1, 0xbc, 0x43, 0x1c,
+	0xdb, 0x22, 0xdc, 0x0b, 0x58, 0xa5, 0xdb, 0x94, 0xb8, 0xd3, 0x75, 0xcf, 0xab, 0x3b, 0x34, 0x96,
+	0xe7, 0xba, 0x1e, 0x8f, 0xd5, 0x49, 0x2b, 0x96, 0xd6, 0xee, 0xdc, 0x56, 0x2b, 0x10, 0x0e, 0xd2,
+	0xfe, 0x46, 0xbf, 0x9d, 0x36, 0x7d, 0xbe, 0x2b, 0x8d, 0x85, 0x7e, 0xe3, 0x96, 0x4d, 0x1d, 0xcb,
+	0x68, 0x12, 0xd6, 0x90, 0x1e, 0xf9, 0x7e, 0x0f, 0x6e, 0x37, 0x29, 0xe3, 0xa4, 0xe9, 0x4b, 0x87,
+	0x37, 0x07, 0x63, 0x68, 0x5b, 0xd4, 0xe5, 0xf6, 0x96, 0x4d, 0x03, 0x29, 0xb2, 0xf8, 0x6f, 0x00,
+	0x7f, 0xb0, 0x7a, 0x10, 0xd9, 0xf7, 0x68, 0x6d, 0xdb, 0xf3, 0x1a, 0x57, 0x0f, 0xfc, 0xd0, 0x6f,
+	0xe0, 0x6c, 0x4f, 0xe8, 0x0d, 0xdb, 0x62, 0x2a, 0x28, 0x80, 0x52, 0x76, 0xf9, 0x2d, 0xed, 0xd9,
+	0xa8, 0x6b, 0x3d, 0x3c, 0x3d, 0x04, 0x6b, 0x93, 0x8f, 0xf7, 0xf2, 0xca, 0x93, 0xbd, 0x3c, 0xd0,
+	0x67, 0x48, 0xaf, 0x07, 0x43, 0x3a, 0x84, 0xed, 0x78, 0x42, 0xc3, 0xb6, 0xd4, 0xb1, 0x02, 0x28,
+	0x65, 0xd6, 0xce, 0x87, 0x7b, 0xf9, 0x4c, 0x22, 0x63, 0x3d, 0x7c, 0x9a, 0x2f, 0x42, 0x7c, 0xe7,
+	0x7d, 0x52, 0xbe, 0xff, 0xe3, 0xf2, 0x3b, 0x1f, 0x94, 0x2e, 0xae, 0xbc, 0x5f, 0xfe, 0xe0, 0x62,
+	0xd2, 0x5d, 0xfa, 0x68, 0xf9, 0xdc, 0xc3, 0x33, 0xf7, 0x7e, 0xa8, 0x67, 0xda, 0x89, 0xee, 0xe2,
+	0xbf, 0xa6, 0x21, 0x1a, 0x5c, 0x10, 0xba, 0x0a, 0x53, 0x07, 0xca, 0xcb, 0x47, 0x28, 0x1f, 0x8c,
+	0x40, 0xcf, 0x02, 0x22, 0x0e, 0x74, 0x09, 0x42, 0x33, 0xa0, 0x84, 0x53, 0xcb, 0x20, 0x5c, 0xa8,
+	0xce, 0x2e, 0xe7, 0xb4, 0x78, 0x37, 0xb4, 0x64, 0x37, 0xb4, 0x5b, 0xc9, 0x6e, 0xc4, 0xf0, 0x4f,
+	0x9f, 0xe6, 0x81, 0x9e, 0x91, 0xb8, 0x55, 0x1e, 0x91, 0xb4, 0x7c, 0x2b, 0x21, 0x49, 0x8d, 0x42,
+	0x22, 0x71, 0xab, 0x1c, 0xbd, 0x05, 0x27, 0x6b, 0x84, 0x51, 0xa3, 0x15, 0x38, 0xea, 0xb8, 0x88,
+	0x5e, 0x36, 0xdc, 0xcb, 0xa7, 0xd7, 0x08, 0xa3, 0xb7, 0xf5, 0x6b, 0x7a, 0x3a, 0x32, 0xde, 0x0e,
+	0x1c, 0x74, 0x15, 0xa6, 0xb7, 0x29, 0xb1, 0x68, 0xc0, 0xd4, 0x13, 0x85, 0x54, 0x29, 0xbb, 0x5c,
+	0x79, 0x71, 0x00, 0xb4, 0x77, 0x63, 0xc4, 0x65, 0x97, 0x07, 0xbb, 0x7a, 0x82, 0x47, 0xa7, 0xe0,
+	0xc4, 0x96, 0x17, 0x34, 0x09, 0x57, 0x27, 0xa2, 0x09, 0x75, 0xd9, 0x43, 0x37, 0xe1, 0x4c, 0xcb,
+	0x77, 0x6c, 0xb7, 0x61, 0x34, 0x29, 0x63, 0xa4, 0x4e, 0xd5, 0xb4, 0x58, 0xd3, 0xd9, 0x21, 0x66,
+	0xba, 0x1e, 0x23, 0xf4, 0xe9, 0x98, 0x41, 0x76, 0xd1, 0x2f, 0x61, 0xf6, 0xae, 0x67, 0xbb, 0x06,
+	0x31, 0x4d, 0xea, 0x73, 0x75, 0x72, 0x64, 0x3e, 0x18, 0xc1, 0x57, 0x05, 0x1a, 0x5d, 0x87, 0x53,
+	0x96, 0xd7, 0x76, 0x85, 0x42, 0x62, 0x36, 0xd4, 0xcc, 0xc8, 0x6c, 0xd9, 0x04, 0xbf, 0x6a, 0x36,
+	0xd0, 0x0d, 0x38, 0xdd, 0xa5, 0x73, 0x23, 0x3e, 0x38, 0x32, 0x5f, 0x57, 0xcf, 0xaf, 0x48, 0x1f,
+	0x21, 0xa3, 0x2e, 0x57, 0xb3, 0x2f, 0x4f, 0x58, 0xa5, 0x2e, 0x47, 0x55, 0x38, 0xdb, 0x25, 0xdc,
+	0x22, 0xb6, 0x43, 0x2d, 0x75, 0x6a, 0x64, 0xca, 0x99, 0x84, 0xe2, 0x8a, 0x60, 0x78, 0x86, 0xf4,
+	0xc3, 0x16, 0x6d, 0x51, 0x4b, 0x9d, 0x7e, 0x79, 0xd2, 0x9b, 0x82, 0x21, 0x22, 0x75, 0x3c, 0x59,
+	0x5d, 0x98, 0xe7, 0xec, 0x50, 0x4b, 0x9d, 0x19, 0x9d, 0x34, 0xa1, 0xa8, 0x0a, 0x06, 0xf4, 0x36,
+	0xcc, 0x9a, 0x5e, 0xd3, 0x0f, 0x28, 0x63, 0xb6, 0xe7, 0xaa, 0xb3, 0x22, 0x3b, 0x66, 0xc3, 0xa7,
+	0xf9, 0x2c, 0xcc, 0xdc, 0x29, 0x3d, 0xa8, 0xdf, 0xb7, 0xfd, 0xa5, 0x33, 0x7a, 0xaf, 0x0f, 0xd2,
+	0xe0, 0x3c, 0xbd, 0x67, 0x3a, 0x2d, 0x8b, 0x1a, 0x01, 0x69, 0x1b, 0x3e, 0xd9, 0x75, 0x3c, 0x62,
+	0xa9, 0x73, 0x05, 0x50, 0x9a, 0xd4, 0x4f, 0x4a, 0x93, 0x4e, 0xda, 0x9b, 0xb1, 0x01, 0x5d, 0x80,
+	0xaf, 0x27, 0xfe, 0x16, 0x35, 0x3d, 0x8b, 0x5a, 0x5d, 0xcc, 0x49, 0x81, 0x59, 0x90, 0xe6, 0xf5,
+	0xd8, 0x9a, 0xe0, 0xde, 0x81, 0xb3, 0x49, 0xd6, 0x1a, 0x8c, 0x9a, 0x01, 0xe5, 0x2a, 0x8a, 0xfc,
+	0xd7, 0x4e, 0x86, 0x7b, 0xf9, 0x69, 0x99, 0xbc, 0x55, 0x61, 0xd0, 0xa7, 0x65, 0x0a, 0xc7, 0x5d,
+	0xf4, 0x36, 0x9c, 0x68, 0x52, 0xbe, 0xed, 0x59, 0xea, 0xbc, 0x58, 0xd0, 0x62, 0xf8, 0x34, 0xbf,
+	0x00, 0xe7, 0xef, 0x94, 0x1e, 0x6c, 0xde, 0xa8, 0xde, 0x7a, 0xb0, 0x79, 0xfb, 0xd6, 0x83, 0xcd,
+	0xd5, 0x5b, 0x97, 0xde, 0x5d, 0x3a, 0xa3, 0x4b, 0x47, 0xb4, 0x0e, 0xd3, 0x16, 0xdd, 0x22, 0x2d,
+	0x87, 0xab, 0xaf, 0x8d, 0x1c, 0xd5, 0x04, 0x8a, 0x96, 0xe1, 0x42, 0x9c, 0x86, 0xd4, 0x32, 0x18,
+	0x27, 0xbc, 0xc5, 0x8c, 0x68, 0x4d, 0x4c, 0x5d, 0x28, 0xa4, 0x4a, 0xd3, 0xfa, 0x7c, 0x62, 0xac,
+	0x0a, 0xdb, 0xa5, 0xc8, 0x84, 0x96, 0xe0, 0x9c, 0x1f, 0x78, 0x77, 0xa9, 0x29, 0x76, 0xd6, 0x27,
+	0x7c, 0x9b, 0xa9, 0xa7, 0x0a, 0xa9, 0x52, 0x46, 0x9f, 0x3d, 0x18, 0xdf, 0x8c, 0x86, 0xd1, 0x8f,
+	0xe0, 0x49, 0xc6, 0x03, 0xdb, 0xe4, 0xc6, 0x81, 0x45, 0x7d, 0x5d, 0x04, 0x71, 0x2e, 0x36, 0x6c,
+	0x76, 0xc7, 0xd1, 0x19, 0x38, 0xd3, 0x24, 0xf7, 0x8c, 0x1a, 0xe1, 0xe6, 0xb6, 0xc1, 0xec, 0xfb,
+	0x54, 0x55, 0x0b, 0xa0, 0x34, 0xad, 0x4f, 0x35, 0xc9, 0xbd, 0xb5, 0x68, 0xb0, 0x6a, 0xdf, 0xa7,
+	0xe8, 0x3a, 0x9c, 0x3b, 0xf0, 0x72, 0x6c, 0xb7, 0x4e, 0x03, 0x75, 0x51, 0x04, 0x60, 0x71, 0xa0,
+	0xcc, 0xae, 0xcb, 0x8b, 0x39, 0xae, 0xb2, 0x9f, 0x45, 0x55, 0x76, 0x26, 0x21, 0xbb, 0x26, 0xa0,
+	0xb9, 0x15, 0x38, 0xd5, 0x5b, 0x10, 0xd1, 0x1c, 0x4c, 0x35, 0xe8, 0xae, 0xb8, 0x4f, 0x32, 0x7a,
+	0xd4, 0x44, 0xaf, 0xc1, 0x13, 0x3b, 0xc4, 0x69, 0xd1, 0xf8, 0x1e, 0xd3, 0xe3, 0xce, 0xca, 0xd8,
+	0xcf, 0x40, 0xee, 0xa7, 0x30, 0x9d, 0xd4, 0x34, 0x04, 0xc7, 0xa3, 0x40, 0x48, 0x9c, 0x68, 0xf7,
+	0x94, 0xd4, 0xb1, 0xde, 0x92, 0x5a, 0xbc, 0x0d, 0xe7, 0x07, 0xb7, 0x86, 0xa1, 0x5f, 0xc0, 0x49,
+	0x79, 0xdb, 0x45, 0xd7, 0x59, 0x54, 0xcd, 0x8b, 0x2f, 0xde, 0x51, 0xbd, 0x8b, 0x29, 0xfe, 0x15,
+	0xc0, 0xc5, 0x41, 0x87, 0x2b, 0x62, 0x4e, 0x86, 0x36, 0x61, 0x3a, 0x9e, 0x3e, 0x21, 0xbf, 0xf0,
+	0x62, 0x72, 0x89, 0xd5, 0xe4, 0xaf, 0xbc, 0x31, 0x24, 0x4d, 0x14, 0xb9, 0x5e, 0xc3, 0x28, 0x91,
+	0x2b, 0xfe, 0x0d, 0xc0, 0xd3, 0x1b, 0x94, 0x1f, 0xb2, 0x1e, 0xfa, 0x61, 0x8b, 0x32, 0xfe, 0x2a,
+	0xaf, 0xf5, 0x8b, 0x10, 0x1e, 0xbc, 0xb1, 0x9e, 0x7b, 0xad, 0x5f, 0x89, 0x5c, 0xae, 0x13, 0xd6,
+	0x58, 0x1b, 0x8f, 0xe0, 0x7a, 0x66, 0x2b, 0x19, 0x28, 0xfe, 0x1d, 0x40, 0x7c, 0xcd, 0x66, 0x87,
+	0xa8, 0x65, 0x89, 0xdc, 0xff, 0xe3, 0x5b, 0xea, 0xd8, 0xf2, 0xff, 0x02, 0xe0, 0xe9, 0xea, 0x51,
+	0xb1, 0xbe, 0x02, 0xd3, 0xf2, 0x10, 0x49, 0xd1, 0x43, 0x9c, 0xbb, 0x1e, 0xc1, 0x09, 0xf8, 0xf8,
+	0x4a, 0xff, 0x3c, 0x76, 0xd8, 0x09, 0xbe, 0x44, 0x7c, 0xde, 0x0a, 0x28, 0xfa, 0x09, 0x1c, 0x8f,
+	0x5e, 0xc2, 0x52, 0xe3, 0x51, 0x6f, 0xaa, 0x71, 0xf1, 0x9e, 0x12, 0xde, 0x51, 0x12, 0xca, 0xca,
+	0x2a, 0x93, 0x50, 0x96, 0xcf, 0x45, 0x98, 0x8a, 0x5e, 0x57, 0x29, 0x51, 0x6e, 0xd3, 0xe1, 0x5e,
+	0x3e, 0x15, 0xbd, 0xac, 0xa2, 0xb1, 0x28, 0x55, 0x92, 0x57, 0xd5, 0xf8, 0xb0, 0xa9, 0x22, 0x45,
+	0x3e, 0xe7, 0x71, 0x85, 0xe0, 0x78, 0xcd, 0xb3, 0x76, 0xd5, 0x13, 0x05, 0x50, 0x9a, 0xd2, 0x45,
+	0xfb, 0x38, 0x85, 0xa7, 0x68, 0xc2, 0xdc, 0x73, 0x25, 0x30, 0x74, 0x19, 0x4e, 0x9a, 0xb2, 0x2d,
+	0x73, 0x7d, 0x69, 0xe8, 0x05, 0xe8, 0x5d, 0xe8, 0xf2, 0xef, 0x27, 0x0f, 0x9b, 0x45, 0xa7, 0x75,
+	0x9b, 0x45, 0x7a, 0x1d, 0x08, 0x37, 0x28, 0x4f, 0xca, 0xcb, 0xa9, 0x81, 0xed, 0xb8, 0x1c, 0x7d,
+	0xf4, 0xe4, 0x96, 0x86, 0xae, 0x32, 0xc5, 0x37, 0x7e, 0xf7, 0x9f, 0xff, 0xfe, 0x61, 0x6c, 0x01,
+	0xcd, 0x57, 0x08, 0xab, 0xc8, 0x33, 0x55, 0x96, 0xc5, 0x06, 0x3d, 0x02, 0x30, 0xb5, 0x41, 0x39,
+	0x3a, 0xd7, 0xcf, 0x77, 0x54, 0x15, 0xc9, 0x0d, 0x71, 0x90, 0x8b, 0xef, 0x89, 0x69, 0x6f, 0xa2,
+	0x1b, 0xd1, 0xb4, 0xbd, 0xdf, 0xa2, 0x95, 0x8f, 0x6c, 0x8b, 0x69, 0x7d, 0x69, 0xdd, 0xd7, 0x7f,
+	0x98, 0x08, 0x95, 0xde, 0x07, 0x5f, 0x3d, 0x0f, 0xd1, 0x1f, 0x01, 0x1c, 0x8f, 0xca, 0x06, 0xd2,
+	0xfa, 0x55, 0x1c, 0x5d, 0x4c, 0x72, 0x6f, 0xbe, 0x58, 0x35, 0x2b, 0xae, 0x09, 0xd9, 0x3f, 0x47,
+	0x2b, 0x83, 0xb2, 0x87, 0x95, 0x8c, 0xfe, 0x09, 0x60, 0xaa, 0x7a, 0x58, 0x50, 0xab, 0xc7, 0x0d,
+	0xea, 0x5d, 0xa1, 0xce, 0x2a, 0x1a, 0x83, 0xea, 0xe4, 0xec, 0xda, 0x68, 0xc1, 0xed, 0x45, 0xf5,
+	0x04, 0x79, 0x05, 0x9c, 0x45, 0x9f, 0x03, 0x38, 0xb1, 0x4e, 0x1d, 0xca, 0x29, 0x1a, 0xed, 0xa2,
+	0xc8, 0x3d, 0xe7, 0xd0, 0x16, 0x6f, 0x08, 0xf5, 0x57, 0xcf, 0x6e, 0xbc, 0x7c, 0x6c, 0xbb, 0x8a,
+	0xc5, 0x51, 0xf8, 0x07, 0x80, 0xd9, 0x0d, 0xca, 0xbb, 0x19, 0x3a, 0xa2, 0xce, 0xb3, 0x43, 0xa7,
+	0x2f, 0x2b, 0xfe, 0x5a, 0x68, 0xd7, 0xd1, 0xe6, 0x2b, 0xd2, 0x5e, 0x49, 0xea, 0xc1, 0xda, 0x9f,
+	0xc0, 0xe3, 0x7d, 0x0c, 0x9e, 0xec, 0x63, 0xf0, 0xf5, 0x3e, 0x56, 0xbe, 0xd9, 0xc7, 0xca, 0xb7,
+	0xfb, 0x58, 0xf9, 0x6e, 0x1f, 0x2b, 0xdf, 0xef, 0x63, 0xf0, 0x71, 0x88, 0xc1, 0x27, 0x21, 0x56,
+	0xbe, 0x08, 0x31, 0x78, 0x14, 0x62, 0xe5, 0xcb, 0x10, 0x2b, 0x5f, 0x85, 0x58, 0x79, 0x1c, 0x62,
+	0xf0, 0x24, 0xc4, 0xe0, 0xeb, 0x10, 0x2b, 0xdf, 0x84, 0x18, 0x7c, 0x1b, 0x62, 0xe5, 0xbb, 0x10,
+	0x83, 0xef, 0x43, 0xac, 0x7c, 0xdc, 0xc1, 0xca, 0x27, 0x1d, 0x0c, 0x3e, 0xed, 0x60, 0xe5, 0xb3,
+	0x0e, 0x06, 0x9f, 0x77, 0xb0, 0xf2, 0x45, 0x07, 0x2b, 0x8f, 0x3a, 0x18, 0x7c, 0xd9, 0xc1, 0xe0,
+	0xab, 0x0e, 0x06, 0xbf, 0x3d, 0x57, 0xf7, 0x34, 0xbe, 0x4d, 0xf9, 0xb6, 0xed, 0xd6, 0x99, 0xe6,
+	0x52, 0xde, 0xf6, 0x82, 0x46, 0xe5, 0xd9, 0xbf, 0x40, 0xfc, 0x46, 0xbd, 0xc2, 0xb9, 0xeb, 0xd7,
+	0x6a, 0x13, 0x62, 0x2b, 0xcf, 0xff, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x68, 0x55, 0x82, 0x68, 0x68,
+	0x12, 0x00, 0x00,
 }
@@ -9,6 +9,7 @@ import github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-valid
 import proto "github.com/gogo/protobuf/proto"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
+import _ "github.com/golang/protobuf/ptypes/duration"
 import _ "github.com/golang/protobuf/ptypes/empty"
 import _ "github.com/golang/protobuf/ptypes/timestamp"
 import _ "github.com/mwitkow/go-proto-validators"
@@ -37,6 +38,10 @@ func (this *ApplicationWebhookIdentifiers) Validate() error {
 	}
 	return nil
 }
+
+var _regex_ApplicationWebhook_Compression = regexp.MustCompile(`^(|gzip)$`)
+var _regex_ApplicationWebhook_Method = regexp.MustCompile(`^(|POST|PUT|PATCH)$`)
+
 func (this *ApplicationWebhook) Validate() error {
 	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.ApplicationWebhookIdentifiers)); err != nil {
 		return github_com_mwitkow_go_proto_validators.FieldError("ApplicationWebhookIdentifiers", err)
@@ -88,6 +93,20 @@ func (this *ApplicationWebhook) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("LocationSolved", err)
 		}
 	}
+	if !_regex_ApplicationWebhook_Compression.MatchString(this.Compression) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Compression", fmt.Errorf(`value '%v' must be a string conforming to regex "^(|gzip)$"`, this.Compression))
+	}
+	if !_regex_ApplicationWebhook_Method.MatchString(this.Method) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Method", fmt.Errorf(`value '%v' must be a string conforming to regex "^(|POST|PUT|PATCH)$"`, this.Method))
+	}
+	if this.Default != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Default); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Default", err)
+		}
+	}
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.MaxBatchLinger)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxBatchLinger", err)
+	}
 	return nil
 }
 func (this *ApplicationWebhook_Message) Validate() error {
@@ -134,3 +153,22 @@ func (this *SetApplicationWebhookRequest) Validate() error {
 	}
 	return nil
 }
+func (this *ApplicationWebhookCapture) Validate() error {
+	if this.Time != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Time); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Time", err)
+		}
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *ApplicationWebhookCaptures) Validate() error {
+	for _, item := range this.Captures {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Captures", err)
+			}
+		}
+	}
+	return nil
+}
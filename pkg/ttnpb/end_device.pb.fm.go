@@ -889,6 +889,11 @@ var MACSettingsFieldPathsNested = []string{
 	"adr_margin",
 	"class_b_timeout",
 	"class_c_timeout",
+	"join_accept_dl_settings",
+	"join_accept_dl_settings.opt_neg",
+	"join_accept_dl_settings.rx1_dr_offset",
+	"join_accept_dl_settings.rx2_dr",
+	"join_accept_rx_delay",
 	"status_count_periodicity",
 	"status_time_periodicity",
 	"use_adr",
@@ -898,6 +903,8 @@ var MACSettingsFieldPathsTopLevel = []string{
 	"adr_margin",
 	"class_b_timeout",
 	"class_c_timeout",
+	"join_accept_dl_settings",
+	"join_accept_rx_delay",
 	"status_count_periodicity",
 	"status_time_periodicity",
 	"use_adr",
@@ -966,6 +973,37 @@ func (dst *MACSettings) SetFields(src *MACSettings, paths ...string) error {
 				var zero uint32
 				dst.StatusCountPeriodicity = zero
 			}
+		case "join_accept_rx_delay":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_accept_rx_delay' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinAcceptRxDelay = src.JoinAcceptRxDelay
+			} else {
+				var zero RxDelay
+				dst.JoinAcceptRxDelay = zero
+			}
+		case "join_accept_dl_settings":
+			if len(subs) > 0 {
+				newDst := dst.JoinAcceptDLSettings
+				if newDst == nil {
+					newDst = &DLSettings{}
+					dst.JoinAcceptDLSettings = newDst
+				}
+				var newSrc *DLSettings
+				if src != nil {
+					newSrc = src.JoinAcceptDLSettings
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.JoinAcceptDLSettings = src.JoinAcceptDLSettings
+				} else {
+					dst.JoinAcceptDLSettings = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1567,6 +1605,11 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_settings.adr_margin",
 	"mac_settings.class_b_timeout",
 	"mac_settings.class_c_timeout",
+	"mac_settings.join_accept_dl_settings",
+	"mac_settings.join_accept_dl_settings.opt_neg",
+	"mac_settings.join_accept_dl_settings.rx1_dr_offset",
+	"mac_settings.join_accept_dl_settings.rx2_dr",
+	"mac_settings.join_accept_rx_delay",
 	"mac_settings.status_count_periodicity",
 	"mac_settings.status_time_periodicity",
 	"mac_settings.use_adr",
@@ -1841,6 +1884,7 @@ var EndDeviceFieldPathsNested = []string{
 	"version_ids.firmware_version",
 	"version_ids.hardware_version",
 	"version_ids.model_id",
+	"webhooks",
 }
 
 var EndDeviceFieldPathsTopLevel = []string{
@@ -1890,6 +1934,7 @@ var EndDeviceFieldPathsTopLevel = []string{
 	"used_dev_nonces",
 	"uses_32_bit_f_cnt",
 	"version_ids",
+	"webhooks",
 }
 
 func (dst *EndDevice) SetFields(src *EndDevice, paths ...string) error {
@@ -2441,6 +2486,15 @@ func (dst *EndDevice) SetFields(src *EndDevice, paths ...string) error {
 			} else {
 				dst.ProvisioningData = nil
 			}
+		case "webhooks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'webhooks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Webhooks = src.Webhooks
+			} else {
+				dst.Webhooks = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -2531,6 +2585,11 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.class_b_timeout",
 	"end_device.mac_settings.class_c_timeout",
+	"end_device.mac_settings.join_accept_dl_settings",
+	"end_device.mac_settings.join_accept_dl_settings.opt_neg",
+	"end_device.mac_settings.join_accept_dl_settings.rx1_dr_offset",
+	"end_device.mac_settings.join_accept_dl_settings.rx2_dr",
+	"end_device.mac_settings.join_accept_rx_delay",
 	"end_device.mac_settings.status_count_periodicity",
 	"end_device.mac_settings.status_time_periodicity",
 	"end_device.mac_settings.use_adr",
@@ -2805,6 +2864,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.version_ids.firmware_version",
 	"end_device.version_ids.hardware_version",
 	"end_device.version_ids.model_id",
+	"end_device.webhooks",
 }
 
 var CreateEndDeviceRequestFieldPathsTopLevel = []string{
@@ -2894,6 +2954,11 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.class_b_timeout",
 	"end_device.mac_settings.class_c_timeout",
+	"end_device.mac_settings.join_accept_dl_settings",
+	"end_device.mac_settings.join_accept_dl_settings.opt_neg",
+	"end_device.mac_settings.join_accept_dl_settings.rx1_dr_offset",
+	"end_device.mac_settings.join_accept_dl_settings.rx2_dr",
+	"end_device.mac_settings.join_accept_rx_delay",
 	"end_device.mac_settings.status_count_periodicity",
 	"end_device.mac_settings.status_time_periodicity",
 	"end_device.mac_settings.use_adr",
@@ -3168,6 +3233,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.version_ids.firmware_version",
 	"end_device.version_ids.hardware_version",
 	"end_device.version_ids.model_id",
+	"end_device.webhooks",
 	"field_mask",
 }
 
@@ -3410,6 +3476,11 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"device.mac_settings.adr_margin",
 	"device.mac_settings.class_b_timeout",
 	"device.mac_settings.class_c_timeout",
+	"device.mac_settings.join_accept_dl_settings",
+	"device.mac_settings.join_accept_dl_settings.opt_neg",
+	"device.mac_settings.join_accept_dl_settings.rx1_dr_offset",
+	"device.mac_settings.join_accept_dl_settings.rx2_dr",
+	"device.mac_settings.join_accept_rx_delay",
 	"device.mac_settings.status_count_periodicity",
 	"device.mac_settings.status_time_periodicity",
 	"device.mac_settings.use_adr",
@@ -3684,6 +3755,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"device.version_ids.firmware_version",
 	"device.version_ids.hardware_version",
 	"device.version_ids.model_id",
+	"device.webhooks",
 	"field_mask",
 }
 
@@ -62,7 +62,7 @@ var PowerState_value = map[string]int32{
 }
 
 func (PowerState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{0}
+	return fileDescriptor_end_device_874110f5a83f96f1, []int{0}
 }
 
 type Session struct {
@@ -87,7 +87,7 @@ type Session struct {
 func (m *Session) Reset()      { *m = Session{} }
 func (*Session) ProtoMessage() {}
 func (*Session) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{0}
+	return fileDescriptor_end_device_874110f5a83f96f1, []int{0}
 }
 func (m *Session) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -199,7 +199,7 @@ type MACParameters struct {
 func (m *MACParameters) Reset()      { *m = MACParameters{} }
 func (*MACParameters) ProtoMessage() {}
 func (*MACParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{1}
+	return fileDescriptor_end_device_874110f5a83f96f1, []int{1}
 }
 func (m *MACParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -379,7 +379,7 @@ type MACParameters_Channel struct {
 func (m *MACParameters_Channel) Reset()      { *m = MACParameters_Channel{} }
 func (*MACParameters_Channel) ProtoMessage() {}
 func (*MACParameters_Channel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{1, 0}
+	return fileDescriptor_end_device_874110f5a83f96f1, []int{1, 0}
 }
 func (m *MACParameters_Channel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +456,7 @@ type EndDeviceBrand struct {
 func (m *EndDeviceBrand) Reset()      { *m = EndDeviceBrand{} }
 func (*EndDeviceBrand) ProtoMessage() {}
 func (*EndDeviceBrand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{2}
+	return fileDescriptor_end_device_874110f5a83f96f1, []int{2}
 }
 func (m *EndDeviceBrand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -524,7 +524,7 @@ type EndDeviceModel struct {
 func (m *EndDeviceModel) Reset()      { *m = EndDeviceModel{} }
 func (*EndDeviceModel) ProtoMessage() {}
 func (*EndDeviceModel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{3}
+	return fileDescriptor_end_device_874110f5a83f96f1, []int{3}
 }
 func (m *EndDeviceModel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,7 +587,7 @@ type EndDeviceVersionIdentifiers struct {
 func (m *EndDeviceVersionIdentifiers) Reset()      { *m = EndDeviceVersionIdentifiers{} }
 func (*EndDeviceVersionIdentifiers) ProtoMessage() {}
 func (*EndDeviceVersionIdentifiers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{4}
+	return fileDescriptor_end_device_874110f5a83f96f1, []int{4}
 }
 func (m *EndDeviceVersionIdentifiers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +683,7 @@ type EndDeviceVersion struct {
 func (m *EndDeviceVersion) Reset()      { *m = EndDeviceVersion{} }
 func (*EndDeviceVersion) ProtoMessage() {}
 func (*EndDeviceVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{5}
+	return fileDescriptor_end_device_874110f5a83f96f1, []int{5}
 }
 func (m *EndDeviceVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -822,15 +822,21 @@ type MACSettings struct {
 	// The interval after which a DevStatusReq MACCommand shall be sent.
 	StatusTimePeriodicity time.Duration `protobuf:"bytes,5,opt,name=status_time_periodicity,json=statusTimePeriodicity,proto3,stdduration" json:"status_time_periodicity"`
 	// Number of uplink messages after which a DevStatusReq MACCommand shall be sent.
-	StatusCountPeriodicity uint32   `protobuf:"varint,6,opt,name=status_count_periodicity,json=statusCountPeriodicity,proto3" json:"status_count_periodicity,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	StatusCountPeriodicity uint32 `protobuf:"varint,6,opt,name=status_count_periodicity,json=statusCountPeriodicity,proto3" json:"status_count_periodicity,omitempty"`
+	// RxDelay to use in join accepts instead of the one in the join request.
+	// RX_DELAY_0 uses the RxDelay of the join request. As RX_DELAY_0 and RX_DELAY_1 both mean 1 second, use RX_DELAY_1 to override to 1 second.
+	JoinAcceptRxDelay RxDelay `protobuf:"varint,7,opt,name=join_accept_rx_delay,json=joinAcceptRxDelay,proto3,enum=ttn.lorawan.v3.RxDelay" json:"join_accept_rx_delay,omitempty"`
+	// Downlink settings to use in join accepts instead of the ones in the join request.
+	// The Rx1DROffset and Rx2DR are overridden. OptNeg is always taken from the join request.
+	JoinAcceptDLSettings *DLSettings `protobuf:"bytes,8,opt,name=join_accept_dl_settings,json=joinAcceptDlSettings,proto3" json:"join_accept_dl_settings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *MACSettings) Reset()      { *m = MACSettings{} }
 func (*MACSettings) ProtoMessage() {}
 func (*MACSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{6}
+	return fileDescriptor_end_device_874110f5a83f96f1, []int{6}
 }
 func (m *MACSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -901,6 +907,20 @@ func (m *MACSettings) GetStatusCountPeriodicity() uint32 {
 	return 0
 }
 
+func (m *MACSettings) GetJoinAcceptRxDelay() RxDelay {
+	if m != nil {
+		return m.JoinAcceptRxDelay
+	}
+	return RX_DELAY_0
+}
+
+func (m *MACSettings) GetJoinAcceptDLSettings() *DLSettings {
+	if m != nil {
+		return m.JoinAcceptDLSettings
+	}
+	return nil
+}
+
 // MACState represents the state of MAC layer of the device.
 // MACState is reset on each join for OTAA or ResetInd for ABP devices.
 // This is used internally by the Network Server and is read only.
@@ -947,7 +967,7 @@ type MACState struct {
 func (m *MACState) Reset()      { *m = MACState{} }
 func (*MACState) ProtoMessage() {}
 func (*MACState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{7}
+	return fileDescriptor_end_device_874110f5a83f96f1, []int{7}
 }
 func (m *MACState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1081,7 +1101,7 @@ type MACState_JoinAccept struct {
 func (m *MACState_JoinAccept) Reset()      { *m = MACState_JoinAccept{} }
 func (*MACState_JoinAccept) ProtoMessage() {}
 func (*MACState_JoinAccept) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{7, 0}
+	return fileDescriptor_end_device_874110f5a83f96f1, []int{7, 0}
 }
 func (m *MACState_JoinAccept) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1270,15 +1290,18 @@ type EndDevice struct {
 	// ID of the provisioner. Stored in Join Server.
 	ProvisionerID string `protobuf:"bytes,45,opt,name=provisioner_id,json=provisionerId,proto3" json:"provisioner_id,omitempty"`
 	// Vendor-specific provisioning data. Stored in Join Server.
-	ProvisioningData     *types.Struct `protobuf:"bytes,46,opt,name=provisioning_data,json=provisioningData,proto3" json:"provisioning_data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	ProvisioningData *types.Struct `protobuf:"bytes,46,opt,name=provisioning_data,json=provisioningData,proto3" json:"provisioning_data,omitempty"`
+	// Webhooks to which uplink messages of this end device are forwarded in addition to the application webhooks.
+	// Webhooks with the same ID as an application webhook override the application webhook. Stored in Application Server.
+	Webhooks             []*ApplicationWebhook `protobuf:"bytes,47,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *EndDevice) Reset()      { *m = EndDevice{} }
 func (*EndDevice) ProtoMessage() {}
 func (*EndDevice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{8}
+	return fileDescriptor_end_device_874110f5a83f96f1, []int{8}
 }
 func (m *EndDevice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1615,6 +1638,13 @@ func (m *EndDevice) GetProvisioningData() *types.Struct {
 	return nil
 }
 
+func (m *EndDevice) GetWebhooks() []*ApplicationWebhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
 type EndDevices struct {
 	EndDevices           []*EndDevice `protobuf:"bytes,1,rep,name=end_devices,json=endDevices,proto3" json:"end_devices,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -1624,7 +1654,7 @@ type EndDevices struct {
 func (m *EndDevices) Reset()      { *m = EndDevices{} }
 func (*EndDevices) ProtoMessage() {}
 func (*EndDevices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{9}
+	return fileDescriptor_end_device_874110f5a83f96f1, []int{9}
 }
 func (m *EndDevices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1669,7 +1699,7 @@ type CreateEndDeviceRequest struct {
 func (m *CreateEndDeviceRequest) Reset()      { *m = CreateEndDeviceRequest{} }
 func (*CreateEndDeviceRequest) ProtoMessage() {}
 func (*CreateEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{10}
+	return fileDescriptor_end_device_874110f5a83f96f1, []int{10}
 }
 func (m *CreateEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1708,7 +1738,7 @@ type UpdateEndDeviceRequest struct {
 func (m *UpdateEndDeviceRequest) Reset()      { *m = UpdateEndDeviceRequest{} }
 func (*UpdateEndDeviceRequest) ProtoMessage() {}
 func (*UpdateEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{11}
+	return fileDescriptor_end_device_874110f5a83f96f1, []int{11}
 }
 func (m *UpdateEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1754,7 +1784,7 @@ type GetEndDeviceRequest struct {
 func (m *GetEndDeviceRequest) Reset()      { *m = GetEndDeviceRequest{} }
 func (*GetEndDeviceRequest) ProtoMessage() {}
 func (*GetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{12}
+	return fileDescriptor_end_device_874110f5a83f96f1, []int{12}
 }
 func (m *GetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1807,7 +1837,7 @@ type ListEndDevicesRequest struct {
 func (m *ListEndDevicesRequest) Reset()      { *m = ListEndDevicesRequest{} }
 func (*ListEndDevicesRequest) ProtoMessage() {}
 func (*ListEndDevicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{13}
+	return fileDescriptor_end_device_874110f5a83f96f1, []int{13}
 }
 func (m *ListEndDevicesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1874,7 +1904,7 @@ type SetEndDeviceRequest struct {
 func (m *SetEndDeviceRequest) Reset()      { *m = SetEndDeviceRequest{} }
 func (*SetEndDeviceRequest) ProtoMessage() {}
 func (*SetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{14}
+	return fileDescriptor_end_device_874110f5a83f96f1, []int{14}
 }
 func (m *SetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2336,6 +2366,12 @@ func (this *MACSettings) Equal(that interface{}) bool {
 	if this.StatusCountPeriodicity != that1.StatusCountPeriodicity {
 		return false
 	}
+	if this.JoinAcceptRxDelay != that1.JoinAcceptRxDelay {
+		return false
+	}
+	if !this.JoinAcceptDLSettings.Equal(that1.JoinAcceptDLSettings) {
+		return false
+	}
 	return true
 }
 func (this *MACState) Equal(that interface{}) bool {
@@ -2642,6 +2678,14 @@ func (this *EndDevice) Equal(that interface{}) bool {
 	if !this.ProvisioningData.Equal(that1.ProvisioningData) {
 		return false
 	}
+	if len(this.Webhooks) != len(that1.Webhooks) {
+		return false
+	}
+	for i := range this.Webhooks {
+		if !this.Webhooks[i].Equal(that1.Webhooks[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *EndDevices) Equal(that interface{}) bool {
@@ -3396,6 +3440,21 @@ func (m *MACSettings) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.StatusCountPeriodicity))
 	}
+	if m.JoinAcceptRxDelay != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.JoinAcceptRxDelay))
+	}
+	if m.JoinAcceptDLSettings != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.JoinAcceptDLSettings.Size()))
+		n10, err := m.JoinAcceptDLSettings.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
 	return i, nil
 }
 
@@ -3417,19 +3476,19 @@ func (m *MACState) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.CurrentParameters.Size()))
-	n10, err := m.CurrentParameters.MarshalTo(dAtA[i:])
+	n11, err := m.CurrentParameters.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n10
+	i += n11
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.DesiredParameters.Size()))
-	n11, err := m.DesiredParameters.MarshalTo(dAtA[i:])
+	n12, err := m.DesiredParameters.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n12
 	if m.DeviceClass != 0 {
 		dAtA[i] = 0x18
 		i++
@@ -3444,11 +3503,11 @@ func (m *MACState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastConfirmedDownlinkAt)))
-		n12, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastConfirmedDownlinkAt, dAtA[i:])
+		n13, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastConfirmedDownlinkAt, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n13
 	}
 	if m.LastDevStatusFCntUp != 0 {
 		dAtA[i] = 0x30
@@ -3464,11 +3523,11 @@ func (m *MACState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.PendingApplicationDownlink.Size()))
-		n13, err := m.PendingApplicationDownlink.MarshalTo(dAtA[i:])
+		n14, err := m.PendingApplicationDownlink.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n14
 	}
 	if len(m.QueuedResponses) > 0 {
 		for _, msg := range m.QueuedResponses {
@@ -3498,21 +3557,21 @@ func (m *MACState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.QueuedJoinAccept.Size()))
-		n14, err := m.QueuedJoinAccept.MarshalTo(dAtA[i:])
+		n15, err := m.QueuedJoinAccept.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n15
 	}
 	if m.PendingJoinRequest != nil {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.PendingJoinRequest.Size()))
-		n15, err := m.PendingJoinRequest.MarshalTo(dAtA[i:])
+		n16, err := m.PendingJoinRequest.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n16
 	}
 	if m.RxWindowsAvailable {
 		dAtA[i] = 0x68
@@ -3551,19 +3610,19 @@ func (m *MACState_JoinAccept) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.Request.Size()))
-	n16, err := m.Request.MarshalTo(dAtA[i:])
+	n17, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n17
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.Keys.Size()))
-	n17, err := m.Keys.MarshalTo(dAtA[i:])
+	n18, err := m.Keys.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n18
 	return i, nil
 }
 
@@ -3585,27 +3644,27 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.EndDeviceIdentifiers.Size()))
-	n18, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
+	n19, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n19
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)))
-	n19, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
+	n20, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n20
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)))
-	n20, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i:])
+	n21, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n21
 	if len(m.Name) > 0 {
 		dAtA[i] = 0x22
 		i++
@@ -3639,11 +3698,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.VersionIDs.Size()))
-		n21, err := m.VersionIDs.MarshalTo(dAtA[i:])
+		n22, err := m.VersionIDs.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n22
 	}
 	if len(m.ServiceProfileID) > 0 {
 		dAtA[i] = 0x42
@@ -3689,11 +3748,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 				dAtA[i] = 0x12
 				i++
 				i = encodeVarintEndDevice(dAtA, i, uint64(v.Size()))
-				n22, err := v.MarshalTo(dAtA[i:])
+				n23, err := v.MarshalTo(dAtA[i:])
 				if err != nil {
 					return 0, err
 				}
-				i += n22
+				i += n23
 			}
 		}
 	}
@@ -3743,11 +3802,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.DefaultMACParameters.Size()))
-		n23, err := m.DefaultMACParameters.MarshalTo(dAtA[i:])
+		n24, err := m.DefaultMACParameters.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n24
 	}
 	if m.MinFrequency != 0 {
 		dAtA[i] = 0x98
@@ -3817,11 +3876,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.RootKeys.Size()))
-		n24, err := m.RootKeys.MarshalTo(dAtA[i:])
+		n25, err := m.RootKeys.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n25
 	}
 	if m.NetID != nil {
 		dAtA[i] = 0xd2
@@ -3829,11 +3888,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.NetID.Size()))
-		n25, err := m.NetID.MarshalTo(dAtA[i:])
+		n26, err := m.NetID.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n26
 	}
 	if m.MACSettings != nil {
 		dAtA[i] = 0xda
@@ -3841,11 +3900,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.MACSettings.Size()))
-		n26, err := m.MACSettings.MarshalTo(dAtA[i:])
+		n27, err := m.MACSettings.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n27
 	}
 	if m.MACState != nil {
 		dAtA[i] = 0xe2
@@ -3853,11 +3912,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.MACState.Size()))
-		n27, err := m.MACState.MarshalTo(dAtA[i:])
+		n28, err := m.MACState.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n28
 	}
 	if m.Session != nil {
 		dAtA[i] = 0xea
@@ -3865,11 +3924,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.Session.Size()))
-		n28, err := m.Session.MarshalTo(dAtA[i:])
+		n29, err := m.Session.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n29
 	}
 	if m.PendingSession != nil {
 		dAtA[i] = 0xf2
@@ -3877,11 +3936,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.PendingSession.Size()))
-		n29, err := m.PendingSession.MarshalTo(dAtA[i:])
+		n30, err := m.PendingSession.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n30
 	}
 	if m.LastDevNonce != 0 {
 		dAtA[i] = 0xf8
@@ -3891,23 +3950,23 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastDevNonce))
 	}
 	if len(m.UsedDevNonces) > 0 {
-		dAtA31 := make([]byte, len(m.UsedDevNonces)*10)
-		var j30 int
+		dAtA32 := make([]byte, len(m.UsedDevNonces)*10)
+		var j31 int
 		for _, num := range m.UsedDevNonces {
 			for num >= 1<<7 {
-				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j30++
+				j31++
 			}
-			dAtA31[j30] = uint8(num)
-			j30++
+			dAtA32[j31] = uint8(num)
+			j31++
 		}
 		dAtA[i] = 0x82
 		i++
 		dAtA[i] = 0x2
 		i++
-		i = encodeVarintEndDevice(dAtA, i, uint64(j30))
-		i += copy(dAtA[i:], dAtA31[:j30])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j31))
+		i += copy(dAtA[i:], dAtA32[:j31])
 	}
 	if m.LastJoinNonce != 0 {
 		dAtA[i] = 0x88
@@ -3936,11 +3995,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt)))
-		n32, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDevStatusReceivedAt, dAtA[i:])
+		n33, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDevStatusReceivedAt, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n33
 	}
 	if m.PowerState != 0 {
 		dAtA[i] = 0xa8
@@ -4026,11 +4085,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.Formatters.Size()))
-		n33, err := m.Formatters.MarshalTo(dAtA[i:])
+		n34, err := m.Formatters.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n34
 	}
 	if len(m.ProvisionerID) > 0 {
 		dAtA[i] = 0xea
@@ -4046,11 +4105,25 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.ProvisioningData.Size()))
-		n34, err := m.ProvisioningData.MarshalTo(dAtA[i:])
+		n35, err := m.ProvisioningData.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n35
+	}
+	if len(m.Webhooks) > 0 {
+		for _, msg := range m.Webhooks {
+			dAtA[i] = 0xfa
+			i++
+			dAtA[i] = 0x2
+			i++
+			i = encodeVarintEndDevice(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
@@ -4103,11 +4176,11 @@ func (m *CreateEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.EndDevice.Size()))
-	n35, err := m.EndDevice.MarshalTo(dAtA[i:])
+	n36, err := m.EndDevice.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n35
+	i += n36
 	return i, nil
 }
 
@@ -4129,19 +4202,19 @@ func (m *UpdateEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.EndDevice.Size()))
-	n36, err := m.EndDevice.MarshalTo(dAtA[i:])
+	n37, err := m.EndDevice.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n36
+	i += n37
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.FieldMask.Size()))
-	n37, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n38, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n37
+	i += n38
 	return i, nil
 }
 
@@ -4163,19 +4236,19 @@ func (m *GetEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.EndDeviceIdentifiers.Size()))
-	n38, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
+	n39, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n38
+	i += n39
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.FieldMask.Size()))
-	n39, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n40, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n39
+	i += n40
 	return i, nil
 }
 
@@ -4197,19 +4270,19 @@ func (m *ListEndDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
-	n40, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
+	n41, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n40
+	i += n41
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.FieldMask.Size()))
-	n41, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n42, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n41
+	i += n42
 	if len(m.Order) > 0 {
 		dAtA[i] = 0x1a
 		i++
@@ -4247,19 +4320,19 @@ func (m *SetEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.Device.Size()))
-	n42, err := m.Device.MarshalTo(dAtA[i:])
+	n43, err := m.Device.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n42
+	i += n43
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.FieldMask.Size()))
-	n43, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n44, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n43
+	i += n44
 	return i, nil
 }
 
@@ -4336,6 +4409,10 @@ func NewPopulatedMACSettings(r randyEndDevice, easy bool) *MACSettings {
 	v7 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
 	this.StatusTimePeriodicity = *v7
 	this.StatusCountPeriodicity = r.Uint32()
+	this.JoinAcceptRxDelay = RxDelay([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if r.Intn(10) != 0 {
+		this.JoinAcceptDLSettings = NewPopulatedDLSettings(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4770,6 +4847,13 @@ func (m *MACSettings) Size() (n int) {
 	if m.StatusCountPeriodicity != 0 {
 		n += 1 + sovEndDevice(uint64(m.StatusCountPeriodicity))
 	}
+	if m.JoinAcceptRxDelay != 0 {
+		n += 1 + sovEndDevice(uint64(m.JoinAcceptRxDelay))
+	}
+	if m.JoinAcceptDLSettings != nil {
+		l = m.JoinAcceptDLSettings.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
 	return n
 }
 
@@ -5037,6 +5121,12 @@ func (m *EndDevice) Size() (n int) {
 		l = m.ProvisioningData.Size()
 		n += 2 + l + sovEndDevice(uint64(l))
 	}
+	if len(m.Webhooks) > 0 {
+		for _, e := range m.Webhooks {
+			l = e.Size()
+			n += 2 + l + sovEndDevice(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5272,6 +5362,8 @@ func (this *MACSettings) String() string {
 		`ClassCTimeout:` + strings.Replace(strings.Replace(this.ClassCTimeout.String(), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
 		`StatusTimePeriodicity:` + strings.Replace(strings.Replace(this.StatusTimePeriodicity.String(), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
 		`StatusCountPeriodicity:` + fmt.Sprintf("%v", this.StatusCountPeriodicity) + `,`,
+		`JoinAcceptRxDelay:` + fmt.Sprintf("%v", this.JoinAcceptRxDelay) + `,`,
+		`JoinAcceptDLSettings:` + strings.Replace(fmt.Sprintf("%v", this.JoinAcceptDLSettings), "DLSettings", "DLSettings", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5381,6 +5473,7 @@ func (this *EndDevice) String() string {
 		`Formatters:` + strings.Replace(fmt.Sprintf("%v", this.Formatters), "MessagePayloadFormatters", "MessagePayloadFormatters", 1) + `,`,
 		`ProvisionerID:` + fmt.Sprintf("%v", this.ProvisionerID) + `,`,
 		`ProvisioningData:` + strings.Replace(fmt.Sprintf("%v", this.ProvisioningData), "Struct", "types.Struct", 1) + `,`,
+		`Webhooks:` + strings.Replace(fmt.Sprintf("%v", this.Webhooks), "ApplicationWebhook", "ApplicationWebhook", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7282,6 +7375,58 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinAcceptRxDelay", wireType)
+			}
+			m.JoinAcceptRxDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinAcceptRxDelay |= (RxDelay(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinAcceptDLSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JoinAcceptDLSettings == nil {
+				m.JoinAcceptDLSettings = &DLSettings{}
+			}
+			if err := m.JoinAcceptDLSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -9316,6 +9461,37 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Webhooks = append(m.Webhooks, &ApplicationWebhook{})
+			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -10111,227 +10287,233 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_end_device_f8ea6acb7b9cd33a)
+	proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_end_device_874110f5a83f96f1)
 }
 func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_end_device_f8ea6acb7b9cd33a)
-}
-
-var fileDescriptor_end_device_f8ea6acb7b9cd33a = []byte{
-	// 3434 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4d, 0x6c, 0x1c, 0xc7,
-	0x95, 0x9e, 0x1e, 0x52, 0xe4, 0x4c, 0x0d, 0xe7, 0xaf, 0x48, 0x49, 0x6d, 0xda, 0xee, 0xa1, 0x29,
-	0xd9, 0xa1, 0x1d, 0x71, 0x28, 0x51, 0xf6, 0xc6, 0x51, 0xb2, 0x90, 0x67, 0x38, 0x54, 0x4c, 0x9b,
-	0xa2, 0xb8, 0x25, 0xc9, 0x5a, 0xc7, 0x91, 0x1b, 0xc5, 0xe9, 0xe2, 0xb0, 0xcd, 0x99, 0xee, 0x4e,
-	0x55, 0x0d, 0x39, 0xdc, 0x1f, 0x20, 0x97, 0x05, 0x72, 0x4b, 0x16, 0xd8, 0x05, 0x72, 0x59, 0x20,
-	0x58, 0xec, 0x02, 0xc1, 0x62, 0x0f, 0x39, 0xfa, 0x98, 0xa3, 0x8f, 0x3e, 0x06, 0x39, 0x8c, 0xa2,
-	0xe1, 0x25, 0xc7, 0x00, 0x7b, 0xc9, 0x71, 0x51, 0x3f, 0xfd, 0x33, 0x3f, 0x94, 0x49, 0x7b, 0xbd,
-	0x17, 0xa2, 0xfb, 0xbd, 0xef, 0x7d, 0xfd, 0xaa, 0x5e, 0xd5, 0xab, 0xf7, 0x6a, 0x08, 0x96, 0xdb,
-	0x3e, 0xc5, 0xc7, 0xd8, 0x5b, 0x65, 0x1c, 0x37, 0x0f, 0xd7, 0x70, 0xe0, 0xae, 0x11, 0xcf, 0xb1,
-	0x1d, 0x72, 0xe4, 0x36, 0x49, 0x35, 0xa0, 0x3e, 0xf7, 0x61, 0x81, 0x73, 0xaf, 0xaa, 0x71, 0xd5,
-	0xa3, 0xdb, 0x8b, 0xab, 0x2d, 0x97, 0x1f, 0x74, 0xf7, 0xaa, 0x4d, 0xbf, 0xb3, 0xd6, 0xf2, 0x5b,
-	0xfe, 0x9a, 0x84, 0xed, 0x75, 0xf7, 0xe5, 0x9b, 0x7c, 0x91, 0x4f, 0xca, 0x7c, 0xf1, 0xaf, 0x12,
-	0xf0, 0xce, 0xb1, 0xcb, 0x0f, 0xfd, 0xe3, 0xb5, 0x96, 0xbf, 0x2a, 0x95, 0xab, 0x47, 0xb8, 0xed,
-	0x3a, 0x98, 0xfb, 0x94, 0xad, 0x45, 0x8f, 0xda, 0xee, 0x95, 0x96, 0xef, 0xb7, 0xda, 0x44, 0xfa,
-	0x84, 0x3d, 0xcf, 0xe7, 0x98, 0xbb, 0xbe, 0xc7, 0xb4, 0xd6, 0xd2, 0xda, 0xe8, 0xdb, 0x4e, 0x97,
-	0x4a, 0x80, 0xd6, 0x2f, 0x8d, 0xea, 0xf7, 0x5d, 0xd2, 0x76, 0xec, 0x0e, 0x66, 0x87, 0x23, 0xfc,
-	0x11, 0x82, 0x71, 0xda, 0x6d, 0x72, 0xad, 0xad, 0x8c, 0x6a, 0xb9, 0xdb, 0x21, 0x8c, 0xe3, 0x4e,
-	0xa0, 0x01, 0xd7, 0xc6, 0x67, 0xce, 0x75, 0x88, 0xc7, 0xdd, 0x7d, 0x97, 0xd0, 0xd0, 0xcb, 0x57,
-	0xc6, 0x41, 0x9f, 0xf9, 0xae, 0x77, 0xb6, 0xf6, 0x90, 0x9c, 0x84, 0xb6, 0x95, 0x71, 0x6d, 0x18,
-	0x04, 0x3d, 0xc4, 0x71, 0x40, 0x87, 0x30, 0x86, 0x5b, 0x84, 0xbd, 0x08, 0xc1, 0xb1, 0x83, 0x39,
-	0x56, 0x88, 0xe5, 0x5f, 0x4c, 0x81, 0xd9, 0x87, 0x84, 0x31, 0xd7, 0xf7, 0xe0, 0x13, 0x90, 0x71,
-	0xc8, 0x91, 0x8d, 0x1d, 0x87, 0x9a, 0xe9, 0x25, 0x63, 0x65, 0xae, 0xfe, 0xc3, 0x2f, 0xfa, 0x95,
-	0xd4, 0x1f, 0xfa, 0x95, 0xb7, 0x5b, 0x7e, 0x95, 0x1f, 0x10, 0x7e, 0xe0, 0x7a, 0x2d, 0x56, 0xf5,
-	0x08, 0x3f, 0xf6, 0xe9, 0xe1, 0xda, 0x30, 0x79, 0x70, 0xd8, 0x5a, 0xe3, 0x27, 0x01, 0x61, 0xd5,
-	0x06, 0x39, 0xaa, 0x39, 0x0e, 0x45, 0xb3, 0x8e, 0x7a, 0x80, 0xdf, 0x07, 0xd3, 0x62, 0x5c, 0xe6,
-	0xd4, 0x92, 0xb1, 0x92, 0x5b, 0x7f, 0xb9, 0x3a, 0xbc, 0x9e, 0xaa, 0xfa, 0xfb, 0x1f, 0x92, 0x13,
-	0x56, 0xcf, 0x88, 0x2f, 0x7e, 0xd9, 0xaf, 0x18, 0x48, 0x9a, 0xc0, 0xd7, 0x40, 0xbe, 0x8d, 0x19,
-	0xb7, 0xf7, 0xed, 0xa6, 0xc7, 0xed, 0x6e, 0x60, 0x4e, 0x2f, 0x19, 0x2b, 0x79, 0x04, 0x84, 0xf0,
-	0xde, 0x86, 0xc7, 0x1f, 0x07, 0x70, 0x05, 0x94, 0x25, 0xc4, 0xd3, 0x20, 0xc7, 0x3f, 0xf6, 0xcc,
-	0x4b, 0x12, 0x26, 0x6d, 0x77, 0x04, 0xae, 0xe1, 0x1f, 0x7b, 0x11, 0x12, 0x27, 0x91, 0x33, 0x31,
-	0xb2, 0x16, 0x21, 0xab, 0x60, 0x41, 0x22, 0x9b, 0xbe, 0xb7, 0x9f, 0x04, 0xcf, 0x4a, 0x70, 0x49,
-	0xe8, 0x36, 0x7c, 0x6f, 0x3f, 0xc2, 0x6f, 0x00, 0xc0, 0x38, 0xa6, 0x9c, 0x38, 0x36, 0xe6, 0x66,
-	0x46, 0x8e, 0x73, 0xb1, 0xaa, 0x96, 0x50, 0x35, 0x5c, 0x42, 0xd5, 0x47, 0xe1, 0x12, 0x52, 0xc3,
-	0xfc, 0xe5, 0xb3, 0x8a, 0x81, 0xb2, 0xda, 0xae, 0xc6, 0x3f, 0x98, 0xce, 0x18, 0xa5, 0xf4, 0xf2,
-	0xb3, 0x1c, 0xc8, 0xdf, 0xaf, 0x6d, 0xec, 0x62, 0x8a, 0x3b, 0x84, 0x13, 0xca, 0xe0, 0x1b, 0x20,
-	0xd3, 0xc1, 0x3d, 0x9b, 0xb8, 0x34, 0x30, 0x8d, 0x25, 0x63, 0x25, 0x5d, 0xcf, 0x0d, 0xfa, 0x95,
-	0xd9, 0xfb, 0xb8, 0xb7, 0xb9, 0x85, 0x76, 0xd1, 0x6c, 0x07, 0xf7, 0x36, 0x5d, 0x1a, 0xc0, 0xb7,
-	0x40, 0xb9, 0x1b, 0xb4, 0x5d, 0xef, 0xd0, 0x76, 0x8e, 0x49, 0xbb, 0x6d, 0x8b, 0x15, 0x2b, 0x03,
-	0x99, 0x41, 0x45, 0xa5, 0x68, 0x08, 0xb9, 0xf0, 0x02, 0x56, 0xc1, 0xbc, 0x18, 0xd0, 0x28, 0x7a,
-	0x4a, 0xa2, 0xcb, 0xa1, 0x2a, 0xc6, 0xef, 0x81, 0x79, 0xec, 0x50, 0x5b, 0xac, 0x1c, 0x9b, 0x62,
-	0x4e, 0x6c, 0xd7, 0x73, 0x48, 0x4f, 0x46, 0xa3, 0xb0, 0xfe, 0xea, 0x68, 0x44, 0x1b, 0x98, 0x63,
-	0x84, 0x39, 0xd9, 0x12, 0xa0, 0xfa, 0xc2, 0xa0, 0x5f, 0x29, 0xd5, 0x1a, 0x68, 0x48, 0x8a, 0x4a,
-	0xd8, 0xa1, 0x43, 0x12, 0xf8, 0x1e, 0x80, 0xe2, 0x1b, 0xbc, 0x67, 0x07, 0xfe, 0x31, 0xa1, 0xfa,
-	0x13, 0x32, 0x92, 0xf5, 0xf9, 0x41, 0xbf, 0x52, 0xac, 0x35, 0xd0, 0xa3, 0xde, 0xae, 0xd0, 0x29,
-	0x8a, 0x22, 0x76, 0x68, 0x52, 0x00, 0x6f, 0x82, 0x39, 0xc1, 0xe0, 0xed, 0xd9, 0x9c, 0x62, 0x8f,
-	0xa9, 0xd8, 0xd6, 0x0b, 0x83, 0x7e, 0x05, 0xd4, 0x1a, 0x68, 0x67, 0xef, 0x91, 0x90, 0x22, 0x80,
-	0x1d, 0xaa, 0x9f, 0xe1, 0x6d, 0x90, 0x17, 0x16, 0xb8, 0x79, 0x68, 0xb7, 0xdd, 0x8e, 0xcb, 0x55,
-	0x84, 0xeb, 0xc5, 0x41, 0xbf, 0x92, 0xab, 0x35, 0x50, 0xad, 0x79, 0xb8, 0x2d, 0xc4, 0x28, 0x87,
-	0x1d, 0x1a, 0xbe, 0x24, 0x8d, 0x1c, 0xd2, 0xc6, 0x27, 0x32, 0xe0, 0x43, 0x46, 0x0d, 0x21, 0x0e,
-	0x8d, 0xe4, 0x0b, 0x7c, 0x1b, 0x64, 0x69, 0xef, 0x96, 0x36, 0xc8, 0xca, 0x79, 0xbb, 0x3a, 0x3a,
-	0x6f, 0xa8, 0xa7, 0x0c, 0x33, 0xb4, 0x77, 0x4b, 0x59, 0xad, 0x81, 0x05, 0x69, 0x15, 0xcd, 0xbb,
-	0xbf, 0xbf, 0xcf, 0x08, 0x37, 0x81, 0x5c, 0x88, 0x65, 0x81, 0xd3, 0x73, 0xf8, 0x40, 0x2a, 0xe0,
-	0x36, 0x98, 0xa7, 0xbd, 0xf5, 0xb1, 0x40, 0xe5, 0xce, 0x11, 0x28, 0x54, 0xa2, 0xbd, 0xf5, 0xe1,
-	0x90, 0x5c, 0x03, 0x79, 0xc1, 0xb6, 0x4f, 0xc9, 0x4f, 0xbb, 0xc4, 0x6b, 0x9e, 0x98, 0x73, 0x4b,
-	0xc6, 0xca, 0x34, 0x9a, 0xa3, 0xbd, 0xf5, 0x7b, 0xa1, 0x0c, 0xfe, 0x18, 0x5c, 0xa5, 0x44, 0xa4,
-	0x35, 0xb9, 0x86, 0xec, 0x80, 0x50, 0xd7, 0x77, 0xdc, 0xa6, 0xcb, 0x4f, 0xcc, 0xbc, 0xfc, 0xec,
-	0xf2, 0xd8, 0x38, 0x25, 0x5c, 0x2c, 0xac, 0xcd, 0x5e, 0xe0, 0x7b, 0xc4, 0xe3, 0xe8, 0x32, 0x8d,
-	0x64, 0xbb, 0x31, 0x01, 0x7c, 0x0a, 0x4c, 0xcd, 0xdd, 0xf4, 0xbb, 0x1e, 0x1f, 0x22, 0x2f, 0x48,
-	0xf2, 0x6b, 0x93, 0xc9, 0x37, 0x04, 0x3c, 0x62, 0xbf, 0x42, 0x63, 0x61, 0x92, 0x7e, 0x0b, 0x14,
-	0xc4, 0xd6, 0x72, 0xba, 0xfc, 0xc4, 0x6e, 0x9e, 0x34, 0xdb, 0xc4, 0x2c, 0x4e, 0x26, 0xad, 0xb5,
-	0x5a, 0x94, 0xb4, 0x30, 0x27, 0x4e, 0xa3, 0xcb, 0x4f, 0x36, 0x04, 0x14, 0xcd, 0x75, 0x70, 0x2f,
-	0x7a, 0x83, 0x35, 0x90, 0x69, 0x1e, 0x60, 0xcf, 0x23, 0x6d, 0x66, 0x96, 0x96, 0xa6, 0x56, 0x72,
-	0xeb, 0xaf, 0x8f, 0x92, 0x0c, 0x6d, 0xeb, 0xea, 0x86, 0x42, 0xa3, 0xc8, 0x4c, 0x6c, 0xca, 0xc0,
-	0xf5, 0x5a, 0x36, 0x6b, 0xfb, 0x3c, 0x31, 0xe7, 0x65, 0x39, 0xe7, 0x65, 0xa1, 0x7a, 0xd8, 0xf6,
-	0x79, 0x3c, 0xf1, 0x4f, 0xc0, 0x4b, 0x31, 0x7e, 0x34, 0xe2, 0xf0, 0x3c, 0x11, 0xbf, 0x1c, 0x92,
-	0x0e, 0x87, 0xfd, 0x4d, 0x50, 0xda, 0x23, 0xb8, 0xe9, 0x7b, 0x09, 0x2f, 0xe6, 0xa5, 0x17, 0x45,
-	0x25, 0x8f, 0x7c, 0x58, 0xfc, 0xaf, 0x34, 0x98, 0xd5, 0x23, 0x11, 0x66, 0x3a, 0x01, 0xc5, 0x66,
-	0x86, 0x32, 0x53, 0xf2, 0xd8, 0xf5, 0x55, 0x00, 0xa3, 0xfc, 0x13, 0x83, 0xd3, 0x6a, 0xa4, 0xa1,
-	0x26, 0x86, 0x6f, 0x83, 0xf9, 0x8e, 0xeb, 0x8d, 0x8d, 0x71, 0xea, 0x5c, 0xab, 0xba, 0xe3, 0x7a,
-	0xc3, 0xc3, 0x13, 0x6c, 0x22, 0xea, 0x5f, 0x23, 0x99, 0xa1, 0x92, 0x08, 0xfa, 0xe8, 0x1e, 0x21,
-	0x1e, 0xde, 0x6b, 0x13, 0x5b, 0x0d, 0x52, 0x66, 0xac, 0x0c, 0x9a, 0x53, 0xc2, 0xc7, 0x52, 0x76,
-	0x67, 0xfa, 0xf3, 0x5f, 0x57, 0x52, 0xea, 0xef, 0x72, 0x07, 0x14, 0x36, 0x3d, 0xa7, 0x21, 0x4b,
-	0xac, 0x3a, 0xc5, 0x9e, 0x03, 0xaf, 0x80, 0xb4, 0xeb, 0xc8, 0xa9, 0xca, 0xd6, 0x67, 0x06, 0xfd,
-	0x4a, 0x7a, 0xab, 0x81, 0xd2, 0xae, 0x03, 0x21, 0x98, 0xf6, 0xb0, 0x4e, 0xe2, 0x59, 0x24, 0x9f,
-	0xe1, 0x4b, 0x60, 0xaa, 0x4b, 0xdb, 0x72, 0xe8, 0xd9, 0xfa, 0xec, 0xa0, 0x5f, 0x99, 0x7a, 0x8c,
-	0xb6, 0x91, 0x90, 0xc1, 0x05, 0x70, 0xa9, 0xed, 0xb7, 0x7c, 0x66, 0x4e, 0x2f, 0x4d, 0xad, 0x64,
-	0x91, 0x7a, 0x59, 0x76, 0x12, 0x9f, 0xbb, 0xef, 0x3b, 0xa4, 0x2d, 0x0e, 0x94, 0x3d, 0xf1, 0x5d,
-	0x3b, 0xfa, 0xa8, 0x3c, 0x50, 0xa4, 0x2f, 0x5b, 0x0d, 0x34, 0x2b, 0x95, 0x5b, 0xa1, 0x5b, 0xe9,
-	0x33, 0xdd, 0x9a, 0x8a, 0xdd, 0x5a, 0xfe, 0xd7, 0x34, 0x78, 0x39, 0xfa, 0xcc, 0x47, 0x84, 0x8a,
-	0x13, 0x7d, 0x2b, 0xae, 0x87, 0xe0, 0x83, 0xb1, 0x6f, 0xbe, 0x9d, 0xf8, 0xe6, 0xe0, 0x59, 0xe5,
-	0x75, 0xf0, 0xda, 0xa7, 0x9f, 0xe0, 0xd5, 0xbf, 0xbb, 0xb9, 0xfa, 0xfd, 0xa7, 0x2b, 0x77, 0xef,
-	0x7c, 0xb2, 0xfa, 0xf4, 0x6e, 0xf8, 0xfa, 0xe6, 0xdf, 0xaf, 0xdf, 0xf8, 0xc7, 0xeb, 0xff, 0xf0,
-	0xe9, 0xf5, 0xde, 0xeb, 0xb1, 0x73, 0x0f, 0x40, 0xa6, 0x23, 0x46, 0x63, 0x47, 0x2e, 0x4a, 0x42,
-	0x39, 0xc2, 0x0b, 0x11, 0x4a, 0x96, 0x2d, 0x47, 0xac, 0xde, 0x03, 0x4c, 0x9d, 0x63, 0x4c, 0x89,
-	0x7d, 0xa4, 0x06, 0xa0, 0x47, 0x58, 0x0c, 0xe5, 0x7a, 0x5c, 0x02, 0xba, 0xef, 0xd2, 0xce, 0x10,
-	0x74, 0x5a, 0x41, 0x43, 0xb9, 0x86, 0x2e, 0xff, 0xcb, 0x2c, 0x28, 0x8d, 0xce, 0x0b, 0xfc, 0x11,
-	0x98, 0x72, 0x1d, 0x26, 0xe7, 0x21, 0xb7, 0xfe, 0xdd, 0xd1, 0x05, 0xf7, 0x82, 0x69, 0x4c, 0xd4,
-	0x47, 0x82, 0x01, 0x3e, 0x01, 0x45, 0x6d, 0x18, 0xf9, 0x91, 0x96, 0xab, 0x78, 0x71, 0x42, 0xee,
-	0xd1, 0x74, 0x75, 0x38, 0xe8, 0x57, 0x0a, 0xdb, 0x3e, 0xc2, 0x4f, 0x6a, 0x3b, 0x5a, 0x86, 0x0a,
-	0x1a, 0x1a, 0x7a, 0x88, 0xc1, 0x7c, 0x48, 0x1c, 0x1c, 0x9c, 0x0c, 0xcd, 0xc7, 0x04, 0xf2, 0xdd,
-	0xf7, 0x3f, 0x0e, 0xc9, 0x2f, 0x0f, 0xfa, 0x95, 0xb2, 0x26, 0x8f, 0xc5, 0xa8, 0xac, 0xd1, 0xbb,
-	0x07, 0x27, 0xe1, 0x27, 0xee, 0x82, 0x72, 0xb4, 0xf3, 0xed, 0xa0, 0x8d, 0x3d, 0x11, 0x49, 0x39,
-	0x8b, 0xea, 0xb4, 0x8f, 0x76, 0xff, 0x6e, 0x1b, 0x7b, 0x5b, 0x0d, 0x54, 0xdc, 0x1f, 0x12, 0x88,
-	0xe5, 0x39, 0x13, 0x1c, 0xf8, 0xdc, 0x67, 0xe6, 0x25, 0xb9, 0xde, 0xf5, 0x1b, 0x5c, 0x01, 0x25,
-	0xd6, 0x0d, 0x02, 0x9f, 0x72, 0x66, 0x37, 0xdb, 0x98, 0x31, 0x7b, 0x4f, 0x56, 0x02, 0x19, 0x54,
-	0x08, 0xe5, 0x1b, 0x42, 0x5c, 0x9f, 0x80, 0x6c, 0xca, 0x02, 0x60, 0x14, 0xb9, 0x01, 0x3b, 0xe0,
-	0x8a, 0x43, 0xf6, 0x71, 0xb7, 0xcd, 0xed, 0x0e, 0x6e, 0xda, 0x41, 0x94, 0xc6, 0x75, 0xb1, 0xf7,
-	0xea, 0x0b, 0x73, 0x7d, 0xdd, 0x1c, 0xf4, 0x2b, 0x0b, 0x0d, 0x45, 0x30, 0xa4, 0x41, 0x0b, 0x9a,
-	0xf6, 0x3e, 0x6e, 0x26, 0x4a, 0xbe, 0x6b, 0x20, 0x2f, 0xf2, 0x5d, 0x9c, 0x19, 0xb3, 0xea, 0xdc,
-	0xed, 0xb8, 0x71, 0xea, 0x95, 0x20, 0xdc, 0x4b, 0x80, 0x80, 0x06, 0xe1, 0x5e, 0x0c, 0x5a, 0x02,
-	0x73, 0x94, 0x30, 0xc2, 0x99, 0x2a, 0x63, 0x65, 0x21, 0x90, 0x41, 0x40, 0xc9, 0x44, 0xfd, 0x0a,
-	0x7f, 0x00, 0xca, 0x5d, 0x46, 0x98, 0x7d, 0x7b, 0xdd, 0xde, 0x73, 0x75, 0xa5, 0x2d, 0xcf, 0xf9,
-	0x4c, 0xbd, 0x3c, 0xe8, 0x57, 0xf2, 0x8f, 0x19, 0x61, 0xb7, 0xd7, 0xeb, 0xae, 0xac, 0xb7, 0x51,
-	0xbe, 0x9b, 0x7c, 0x15, 0x3e, 0x44, 0x33, 0x28, 0x4e, 0x58, 0x79, 0xe2, 0x67, 0xd0, 0x5c, 0x28,
-	0xfc, 0xc0, 0x77, 0x3d, 0x78, 0x03, 0x40, 0xed, 0x83, 0x3c, 0xc9, 0x3d, 0xdf, 0x6b, 0x12, 0x26,
-	0x8f, 0xef, 0x0c, 0x2a, 0x29, 0x8d, 0xc0, 0xed, 0x48, 0x39, 0x7c, 0x0a, 0x60, 0x38, 0xd5, 0xfb,
-	0x3e, 0xed, 0x60, 0x2e, 0xa7, 0xb9, 0x28, 0xa7, 0x79, 0x65, 0x6c, 0x9a, 0x55, 0xc3, 0xb3, 0x8b,
-	0x4f, 0xda, 0x3e, 0x76, 0xee, 0x45, 0xf8, 0xfa, 0xb4, 0xd8, 0x28, 0xa8, 0xac, 0x99, 0x62, 0x85,
-	0xce, 0xc1, 0xff, 0x3c, 0x05, 0x72, 0xf7, 0x6b, 0x1b, 0x0f, 0x09, 0xe7, 0xa2, 0xa7, 0x81, 0xd7,
-	0xc0, 0x6c, 0x97, 0x11, 0x1b, 0x3b, 0x54, 0xee, 0xca, 0x4c, 0x1d, 0x0c, 0xfa, 0x95, 0x99, 0xc7,
-	0x8c, 0xd4, 0x1a, 0x08, 0xcd, 0x74, 0x19, 0xa9, 0x39, 0x14, 0xde, 0x00, 0xa2, 0x74, 0xb4, 0x3b,
-	0x98, 0xb6, 0x5c, 0xb5, 0xd1, 0xf2, 0xf5, 0xfc, 0xa0, 0x5f, 0xc9, 0xd6, 0x1a, 0xe8, 0xbe, 0x14,
-	0xa2, 0x2c, 0x76, 0xa8, 0x7a, 0x84, 0x1f, 0x82, 0xa2, 0x5e, 0x7d, 0xb2, 0x2e, 0xf2, 0xbb, 0x5c,
-	0x37, 0x40, 0x2f, 0x8d, 0x35, 0x06, 0x0d, 0xdd, 0xbb, 0xaa, 0xed, 0xfd, 0x2b, 0xd1, 0x17, 0xe4,
-	0xa5, 0x6d, 0xfd, 0x91, 0xb2, 0x8c, 0xc9, 0x9a, 0x11, 0xd9, 0xf4, 0x45, 0xc9, 0x36, 0x42, 0xb2,
-	0x4f, 0xc0, 0x55, 0xc6, 0x31, 0xef, 0xb2, 0xf1, 0x82, 0xed, 0xd2, 0xf9, 0x49, 0x2f, 0x2b, 0x8e,
-	0xd1, 0x8a, 0xed, 0x5d, 0x60, 0x6a, 0xf2, 0xf1, 0x8a, 0x4d, 0xf5, 0x5a, 0x57, 0x94, 0x7e, 0xb4,
-	0x18, 0x5b, 0xfe, 0xcf, 0x2c, 0xc8, 0x88, 0x98, 0x70, 0xcc, 0x09, 0x44, 0x00, 0x36, 0xbb, 0x94,
-	0x12, 0xc1, 0x10, 0x6f, 0x36, 0xe3, 0x3c, 0x9b, 0x4d, 0x87, 0x5e, 0x9b, 0x27, 0x76, 0x15, 0x12,
-	0x2b, 0x8b, 0xb9, 0x94, 0x38, 0x49, 0xce, 0xf4, 0x05, 0x38, 0xb5, 0x79, 0x82, 0xf3, 0x5d, 0x30,
-	0xa7, 0x2e, 0x4b, 0x54, 0x02, 0xd1, 0x19, 0xf2, 0xf2, 0x28, 0x9b, 0x4c, 0x23, 0x28, 0xa7, 0xa0,
-	0xf2, 0x65, 0x52, 0xee, 0x9e, 0xfe, 0x3f, 0xc9, 0xdd, 0x4f, 0xc1, 0x62, 0xd4, 0xbc, 0xba, 0xb4,
-	0x43, 0x1c, 0x3b, 0x2a, 0xb5, 0x30, 0xd7, 0x11, 0x7e, 0x51, 0x73, 0x3a, 0x2d, 0x1b, 0xd3, 0xab,
-	0x61, 0x93, 0x2b, 0x29, 0x1a, 0x9a, 0xa1, 0xc6, 0xe1, 0x3b, 0xc0, 0x94, 0xf4, 0x0e, 0x39, 0xb2,
-	0x75, 0xa4, 0xa3, 0xee, 0x5c, 0x05, 0x78, 0x5e, 0xe8, 0x1b, 0xe4, 0xe8, 0xa1, 0xd4, 0xea, 0x36,
-	0x1d, 0x81, 0xcb, 0x71, 0xb1, 0x9a, 0x5c, 0x14, 0xb3, 0x72, 0xd0, 0xd6, 0xd8, 0x99, 0xa2, 0x2b,
-	0x53, 0xb5, 0x42, 0xd0, 0x7c, 0x30, 0xf4, 0xae, 0xd6, 0x1a, 0x01, 0xaf, 0x04, 0xc4, 0x73, 0x04,
-	0x2d, 0x0e, 0x82, 0xb6, 0xdb, 0x94, 0x6b, 0x34, 0x1a, 0xae, 0xce, 0xcd, 0xe3, 0xc5, 0x7c, 0x8c,
-	0x0d, 0xc7, 0x85, 0x16, 0x35, 0xd1, 0x04, 0x1d, 0xdc, 0x04, 0xa5, 0x9f, 0x76, 0x49, 0x97, 0x38,
-	0x36, 0x25, 0x2c, 0xf0, 0x3d, 0x46, 0x98, 0x99, 0x95, 0x25, 0xfe, 0xa4, 0x50, 0x6d, 0xf8, 0x9d,
-	0x0e, 0xf6, 0x1c, 0x54, 0x54, 0x36, 0x28, 0x34, 0x11, 0x34, 0xa1, 0xb7, 0x32, 0x3d, 0x33, 0xce,
-	0x4c, 0xf0, 0xd5, 0x34, 0xda, 0x06, 0x69, 0x13, 0xf8, 0x37, 0x00, 0x6a, 0x6f, 0x64, 0x36, 0xc5,
-	0xcd, 0x26, 0x09, 0x54, 0x5e, 0x9f, 0x30, 0xd4, 0x70, 0x3f, 0x55, 0x45, 0x82, 0xad, 0x49, 0x28,
-	0xd2, 0x83, 0x89, 0x25, 0xf0, 0x3e, 0x58, 0x08, 0x3d, 0x93, 0x9c, 0xda, 0x3d, 0x79, 0x0a, 0x4c,
-	0xb8, 0xb0, 0x11, 0x96, 0xda, 0x1d, 0x04, 0xb5, 0x61, 0x42, 0x06, 0x6f, 0x8a, 0xa6, 0xd5, 0x3e,
-	0x76, 0x3d, 0xc7, 0x3f, 0x66, 0x36, 0x3e, 0xc2, 0x6e, 0x5b, 0x94, 0xc2, 0xfa, 0x6c, 0x80, 0xb4,
-	0xf7, 0x44, 0xa9, 0x6a, 0xa1, 0x66, 0xf1, 0x3f, 0x0c, 0x00, 0x12, 0xfe, 0x2c, 0x83, 0xd9, 0x40,
-	0x65, 0x74, 0xb9, 0xe3, 0xe7, 0xea, 0x99, 0xc1, 0xb3, 0xca, 0x74, 0x90, 0xeb, 0xbd, 0x8a, 0x42,
-	0x05, 0xfc, 0x01, 0x98, 0x0d, 0xdd, 0x4c, 0x7f, 0xa5, 0x9b, 0x7a, 0xff, 0x86, 0x16, 0xf0, 0x9d,
-	0xf3, 0xdf, 0x48, 0x29, 0x4b, 0x09, 0xd7, 0x67, 0xc7, 0x3f, 0x99, 0x20, 0x1b, 0xd5, 0x68, 0xf0,
-	0xbd, 0x64, 0x2d, 0x77, 0xfd, 0xcc, 0x5a, 0xee, 0x05, 0x45, 0xdc, 0x06, 0x00, 0x4d, 0x4a, 0xb0,
-	0xbe, 0x3c, 0x4a, 0x5f, 0xe4, 0xf2, 0x48, 0xdb, 0xd5, 0xb8, 0x20, 0xe9, 0x06, 0x4e, 0x48, 0x32,
-	0x75, 0x11, 0x12, 0x6d, 0x57, 0xe3, 0x51, 0x61, 0x3f, 0x9d, 0xe8, 0x37, 0x96, 0x40, 0xce, 0x21,
-	0xac, 0x49, 0xdd, 0x40, 0xec, 0x09, 0x99, 0x3e, 0xb2, 0x28, 0x29, 0x82, 0x5b, 0x00, 0x60, 0xce,
-	0xa9, 0xbb, 0xd7, 0xe5, 0x84, 0x99, 0x33, 0x72, 0x45, 0xbf, 0x79, 0xe6, 0x44, 0x54, 0x6b, 0x11,
-	0x76, 0xd3, 0xe3, 0xf4, 0x04, 0x25, 0x8c, 0xe1, 0x4f, 0x40, 0x4e, 0xe7, 0x42, 0x5b, 0x4c, 0xea,
-	0xec, 0xc5, 0x0b, 0x64, 0x79, 0xd9, 0x13, 0xca, 0x1b, 0x0c, 0x81, 0xa3, 0x10, 0xc3, 0x60, 0x1d,
-	0x40, 0x46, 0xa8, 0x4c, 0xd6, 0x01, 0xf5, 0xf7, 0xdd, 0x36, 0x11, 0x25, 0x67, 0x46, 0x96, 0x9c,
-	0xf2, 0x92, 0xea, 0xa1, 0xd2, 0xee, 0x2a, 0xe5, 0x56, 0x03, 0x95, 0xd8, 0xb0, 0xc4, 0x81, 0x6f,
-	0x83, 0x2b, 0xfa, 0xfe, 0xd3, 0x16, 0x3a, 0x42, 0xe5, 0x7d, 0x29, 0x61, 0x4c, 0x96, 0x68, 0x59,
-	0xb4, 0xa0, 0xb5, 0x0f, 0xa5, 0xb2, 0xa6, 0x74, 0xf0, 0x87, 0x60, 0x31, 0x99, 0xa0, 0x46, 0x2c,
-	0x81, 0xb4, 0x34, 0x13, 0x88, 0x61, 0xeb, 0x2a, 0x98, 0x97, 0xdb, 0x72, 0xc4, 0x2c, 0x27, 0xcd,
-	0xca, 0x42, 0x35, 0x8c, 0xbf, 0x07, 0xb2, 0x6d, 0x5f, 0x11, 0x31, 0x73, 0x4e, 0xc6, 0x63, 0xe5,
-	0xec, 0x78, 0x6c, 0x87, 0x50, 0x15, 0x8e, 0xd8, 0x74, 0x62, 0x21, 0x9d, 0x3f, 0x77, 0x21, 0x5d,
-	0x98, 0x58, 0x48, 0x4f, 0x38, 0xf5, 0x8a, 0xdf, 0x66, 0xc7, 0x52, 0xfa, 0xb6, 0x3b, 0x96, 0xf2,
-	0x05, 0x3a, 0x96, 0xb3, 0xbb, 0x08, 0xf8, 0xff, 0xd2, 0x45, 0xcc, 0x9f, 0xa7, 0x8b, 0x58, 0x38,
-	0x47, 0x17, 0x71, 0xf9, 0x7c, 0x5d, 0xc4, 0x95, 0xaf, 0xdb, 0x45, 0x5c, 0x3d, 0x77, 0x17, 0x61,
-	0x9e, 0xd1, 0x45, 0xbc, 0x03, 0xb2, 0xd4, 0xf7, 0xb9, 0x2d, 0xd3, 0xfc, 0x4b, 0x72, 0x76, 0xcd,
-	0xb1, 0x9b, 0x42, 0xdf, 0xe7, 0x22, 0xc7, 0xa3, 0x0c, 0xd5, 0x4f, 0xf0, 0x23, 0x30, 0xe3, 0x11,
-	0x2e, 0xe2, 0xba, 0x28, 0x0f, 0x9e, 0xbb, 0x7f, 0xe8, 0x57, 0xd6, 0x2f, 0xf4, 0xeb, 0xc7, 0x0e,
-	0xe1, 0x5b, 0x8d, 0x41, 0xbf, 0x72, 0x49, 0x3e, 0xa0, 0x4b, 0x1e, 0xe1, 0xf2, 0xb6, 0x62, 0x4e,
-	0x44, 0x9c, 0xe9, 0x7e, 0xc3, 0x7c, 0x79, 0xf2, 0xc1, 0x93, 0x68, 0x49, 0xd4, 0x75, 0x72, 0x42,
-	0x80, 0x72, 0x1d, 0xdc, 0x8c, 0x1a, 0x96, 0x0d, 0x90, 0x95, 0x84, 0xe2, 0x70, 0x37, 0x5f, 0x99,
-	0x3c, 0xbe, 0xf0, 0xf0, 0xaf, 0xcf, 0x0d, 0xfa, 0x95, 0xa8, 0xb4, 0x46, 0x19, 0xc1, 0x23, 0x8b,
-	0xec, 0x5b, 0x60, 0x96, 0xa9, 0xa3, 0xce, 0x7c, 0x55, 0x52, 0x5c, 0x3d, 0xe3, 0x24, 0x44, 0x21,
-	0x0e, 0xbe, 0x07, 0xc2, 0x82, 0xc4, 0x0e, 0x4d, 0xad, 0x17, 0x9b, 0x16, 0x34, 0x3e, 0xfc, 0x99,
-	0xe9, 0x3a, 0x28, 0x44, 0xf5, 0xa3, 0x0c, 0xa2, 0x59, 0x91, 0x55, 0xe3, 0x9c, 0xae, 0x1a, 0x65,
-	0x00, 0xe1, 0x1b, 0xa0, 0xd8, 0x65, 0xc4, 0x89, 0x51, 0xcc, 0x5c, 0x5a, 0x9a, 0x5a, 0xc9, 0xcb,
-	0xa5, 0xe3, 0x84, 0x30, 0x26, 0x70, 0x92, 0x2d, 0x5e, 0x13, 0xe6, 0x6b, 0xf1, 0x2f, 0x3a, 0xd1,
-	0x82, 0x80, 0xdf, 0xd3, 0x38, 0xfa, 0x99, 0xee, 0x4b, 0x6e, 0x9a, 0xcb, 0xb2, 0x81, 0x2b, 0x0d,
-	0xfa, 0x95, 0xb9, 0x6d, 0xcc, 0x38, 0xfa, 0x40, 0x76, 0x24, 0x37, 0x95, 0x23, 0xe8, 0x33, 0xf5,
-	0x36, 0x6e, 0x78, 0xcb, 0xbc, 0x36, 0xd1, 0xf0, 0xd6, 0x90, 0xe1, 0x2d, 0xf8, 0x29, 0x78, 0x79,
-	0xb4, 0x4e, 0xa6, 0xa4, 0x49, 0xdc, 0x23, 0x75, 0x44, 0x5f, 0xbf, 0x48, 0x1d, 0x1e, 0x15, 0xd3,
-	0x48, 0x33, 0xd4, 0xc4, 0x8e, 0xcb, 0xa9, 0xdf, 0x49, 0xd4, 0x1a, 0x78, 0xfd, 0x8c, 0x44, 0x27,
-	0x20, 0x2a, 0xee, 0x20, 0x88, 0x9e, 0xe1, 0x2a, 0x80, 0x7b, 0xb2, 0x21, 0x3e, 0x11, 0xb5, 0x78,
-	0x93, 0x78, 0x1c, 0xb7, 0x88, 0xf9, 0xc6, 0x92, 0xb1, 0x92, 0x46, 0x65, 0xad, 0xd9, 0x8d, 0x14,
-	0xf0, 0x3b, 0xa0, 0x18, 0xf5, 0x10, 0xba, 0xfd, 0xfd, 0xce, 0x92, 0xb1, 0x72, 0x09, 0x15, 0x42,
-	0xb1, 0x6e, 0x7a, 0xb1, 0xd8, 0xa4, 0xc2, 0x4a, 0xb4, 0xd2, 0xfa, 0x42, 0x94, 0x99, 0x2b, 0xf2,
-	0x0c, 0x1a, 0xcb, 0x6e, 0xea, 0x6e, 0x54, 0xb7, 0xf0, 0xea, 0x04, 0x46, 0xd2, 0xb8, 0xd6, 0x40,
-	0x4a, 0xc7, 0xc4, 0xce, 0x96, 0x12, 0x87, 0x6a, 0x09, 0x6c, 0x80, 0x82, 0xfe, 0x44, 0x48, 0xff,
-	0xe6, 0x39, 0xe8, 0x51, 0x5e, 0x19, 0x85, 0x2c, 0x1f, 0x00, 0xcd, 0x1c, 0x75, 0x0b, 0xcc, 0x7c,
-	0x4b, 0xf2, 0x54, 0xc6, 0x2e, 0x80, 0xc3, 0x21, 0x6a, 0xa6, 0xa2, 0x32, 0x0c, 0xc5, 0x4c, 0xb4,
-	0x21, 0xba, 0x22, 0x9f, 0xd4, 0x85, 0x30, 0xf3, 0xbb, 0x92, 0xf7, 0x7c, 0x6d, 0x88, 0x22, 0x9a,
-	0xa0, 0x62, 0xf0, 0x7d, 0x00, 0x12, 0x17, 0x22, 0x37, 0x2e, 0x76, 0x21, 0x82, 0x12, 0xb6, 0x10,
-	0x83, 0x42, 0x40, 0xfd, 0x23, 0x57, 0xec, 0x47, 0x42, 0x45, 0xb6, 0x5b, 0x95, 0xa7, 0xd8, 0x1d,
-	0x91, 0xa9, 0x77, 0x63, 0xcd, 0x45, 0xee, 0x51, 0xf3, 0x09, 0xc6, 0x2d, 0x07, 0x36, 0x40, 0x39,
-	0x12, 0x88, 0x64, 0xe1, 0x60, 0x8e, 0xcd, 0xaa, 0xce, 0x14, 0xa3, 0x6b, 0xfe, 0xa1, 0xfc, 0xe5,
-	0x1d, 0x95, 0x92, 0x16, 0x0d, 0xcc, 0xf1, 0xe2, 0x5f, 0x83, 0xe2, 0x48, 0xb9, 0x08, 0x4b, 0x60,
-	0xea, 0x90, 0xa8, 0xdf, 0x15, 0xb2, 0x48, 0x3c, 0xc2, 0x05, 0x70, 0xe9, 0x08, 0xb7, 0xbb, 0xe1,
-	0x35, 0xb9, 0x7a, 0xb9, 0x93, 0x7e, 0xd7, 0x58, 0xfc, 0x08, 0x14, 0x86, 0xab, 0x9b, 0x09, 0xd6,
-	0xd5, 0xa4, 0xf5, 0x84, 0x24, 0x1a, 0x12, 0x24, 0x78, 0x75, 0x1f, 0xf0, 0x3e, 0x00, 0x51, 0x15,
-	0xc5, 0xe0, 0x1d, 0x90, 0x8b, 0xff, 0x73, 0x42, 0xf4, 0x03, 0x53, 0xf2, 0x22, 0xe5, 0xac, 0xb2,
-	0x0b, 0x01, 0x12, 0xd9, 0x2e, 0xff, 0x04, 0x5c, 0xd9, 0x90, 0x95, 0x7c, 0xac, 0xd6, 0x8d, 0x4a,
-	0x1d, 0x80, 0x98, 0x55, 0x37, 0x19, 0x67, 0x93, 0x26, 0x3a, 0x8b, 0x6c, 0x44, 0xbf, 0xfc, 0x6f,
-	0x06, 0xb8, 0xf2, 0x58, 0xd6, 0xf8, 0xdf, 0x06, 0x3d, 0xbc, 0x0b, 0x40, 0xfc, 0xbf, 0x15, 0x67,
-	0xb6, 0x2f, 0xf7, 0x04, 0xe4, 0x3e, 0x66, 0x87, 0xba, 0xa1, 0xca, 0xee, 0x87, 0x82, 0xe5, 0xff,
-	0x36, 0xc0, 0xfc, 0x8f, 0x08, 0x1f, 0x73, 0xee, 0x11, 0x28, 0xc4, 0xce, 0xd9, 0x5f, 0xbf, 0xc9,
-	0x9a, 0x23, 0xb1, 0x9e, 0x7d, 0x73, 0x77, 0xff, 0xc7, 0x00, 0x97, 0xb7, 0x5d, 0x16, 0xfb, 0xcb,
-	0x42, 0x87, 0x3f, 0x06, 0xc5, 0x64, 0x02, 0x88, 0x3d, 0x7e, 0xe3, 0x05, 0x5b, 0x7f, 0xb2, 0xcf,
-	0x05, 0x9c, 0x44, 0x7c, 0x73, 0xaf, 0xc5, 0x26, 0xf1, 0xa9, 0x43, 0xa8, 0xfe, 0x49, 0x43, 0xbd,
-	0xc8, 0x5f, 0x8c, 0xe4, 0xcf, 0xde, 0xea, 0xdf, 0x2a, 0xd4, 0x8b, 0x68, 0x03, 0x03, 0x71, 0x1c,
-	0xa8, 0x7f, 0xa2, 0x90, 0xcf, 0xcb, 0xbf, 0x30, 0xc0, 0xfc, 0xc3, 0x09, 0x41, 0xfa, 0x1e, 0x98,
-	0x39, 0xef, 0xea, 0x51, 0x3e, 0x69, 0xf8, 0x37, 0x1e, 0xd1, 0x5b, 0xf7, 0x00, 0x88, 0x0f, 0x37,
-	0x58, 0x06, 0xf9, 0xdd, 0x07, 0x4f, 0x36, 0x91, 0xfd, 0x78, 0xe7, 0xc3, 0x9d, 0x07, 0x4f, 0x76,
-	0x4a, 0xa9, 0x58, 0x54, 0xaf, 0x3d, 0x7a, 0xb4, 0x89, 0x3e, 0x2e, 0x19, 0x10, 0x82, 0x82, 0x12,
-	0x6d, 0xfe, 0xed, 0xa3, 0x4d, 0xb4, 0x53, 0xdb, 0x2e, 0xa5, 0xeb, 0xff, 0x6e, 0x7c, 0xf1, 0xdc,
-	0x32, 0xbe, 0x7c, 0x6e, 0x19, 0xbf, 0x7f, 0x6e, 0xa5, 0xfe, 0xf8, 0xdc, 0x4a, 0xfd, 0xe9, 0xb9,
-	0x95, 0xfa, 0xf3, 0x73, 0x2b, 0xf5, 0x97, 0xe7, 0x96, 0xf1, 0xb3, 0x81, 0x65, 0xfc, 0x7c, 0x60,
-	0xa5, 0x7e, 0x33, 0xb0, 0x8c, 0xdf, 0x0e, 0xac, 0xd4, 0xe7, 0x03, 0x2b, 0xf5, 0xbb, 0x81, 0x95,
-	0xfa, 0x62, 0x60, 0x19, 0x5f, 0x0e, 0x2c, 0xe3, 0xf7, 0x03, 0x2b, 0xf5, 0xc7, 0x81, 0x65, 0xfc,
-	0x69, 0x60, 0xa5, 0xfe, 0x3c, 0xb0, 0x8c, 0xbf, 0x0c, 0xac, 0xd4, 0xcf, 0x4e, 0xad, 0xd4, 0xcf,
-	0x4f, 0x2d, 0xe3, 0x97, 0xa7, 0x56, 0xea, 0x57, 0xa7, 0x96, 0xf1, 0xeb, 0x53, 0x2b, 0xf5, 0x9b,
-	0x53, 0x2b, 0xf5, 0xdb, 0x53, 0xcb, 0xf8, 0xfc, 0xd4, 0x32, 0x7e, 0x77, 0x6a, 0x19, 0x3f, 0xbe,
-	0x71, 0xde, 0xaa, 0x92, 0x7b, 0xc1, 0xde, 0xde, 0x8c, 0x9c, 0x91, 0xdb, 0xff, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x1f, 0x91, 0x75, 0x03, 0xb0, 0x25, 0x00, 0x00,
+	golang_proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_end_device_874110f5a83f96f1)
+}
+
+var fileDescriptor_end_device_874110f5a83f96f1 = []byte{
+	// 3524 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4d, 0x70, 0x1b, 0xc7,
+	0x95, 0xc6, 0x90, 0x94, 0x08, 0x3c, 0x92, 0x00, 0xd8, 0xa4, 0xa4, 0x31, 0x6d, 0x03, 0x34, 0x25,
+	0x3b, 0xb4, 0x23, 0x82, 0x12, 0x65, 0x6f, 0x1c, 0x25, 0xbb, 0x32, 0x40, 0x50, 0x31, 0x65, 0x4a,
+	0xe2, 0xb6, 0x24, 0x6b, 0x1d, 0x47, 0x9e, 0x6a, 0x62, 0x9a, 0xd4, 0x98, 0xc0, 0xcc, 0xa4, 0xbb,
+	0x41, 0x82, 0xfb, 0x53, 0x95, 0x63, 0x6e, 0xc9, 0x61, 0xb7, 0x2a, 0x97, 0xad, 0x4a, 0x6d, 0xed,
+	0x56, 0xa5, 0xb6, 0xf6, 0x90, 0xa3, 0x8f, 0xb9, 0x6c, 0x95, 0x8f, 0x3e, 0xa6, 0x72, 0x80, 0x23,
+	0xf0, 0x92, 0x63, 0xaa, 0xf6, 0x92, 0xe3, 0x56, 0xff, 0xcc, 0x0f, 0x7e, 0x48, 0x93, 0xf6, 0x7a,
+	0x2f, 0xac, 0x99, 0xf7, 0xbe, 0xf7, 0xcd, 0xeb, 0xee, 0xd7, 0xfd, 0xde, 0x6b, 0x10, 0x96, 0x9a,
+	0x01, 0x23, 0x87, 0xc4, 0x5f, 0xe1, 0x82, 0x34, 0xf6, 0x57, 0x49, 0xe8, 0xad, 0x52, 0xdf, 0x75,
+	0x5c, 0x7a, 0xe0, 0x35, 0x68, 0x25, 0x64, 0x81, 0x08, 0x50, 0x5e, 0x08, 0xbf, 0x62, 0x70, 0x95,
+	0x83, 0x5b, 0x0b, 0x2b, 0x7b, 0x9e, 0x78, 0xde, 0xde, 0xa9, 0x34, 0x82, 0xd6, 0xea, 0x5e, 0xb0,
+	0x17, 0xac, 0x2a, 0xd8, 0x4e, 0x7b, 0x57, 0xbd, 0xa9, 0x17, 0xf5, 0xa4, 0xcd, 0x17, 0xfe, 0x2a,
+	0x05, 0x6f, 0x1d, 0x7a, 0x62, 0x3f, 0x38, 0x5c, 0xdd, 0x0b, 0x56, 0x94, 0x72, 0xe5, 0x80, 0x34,
+	0x3d, 0x97, 0x88, 0x80, 0xf1, 0xd5, 0xf8, 0xd1, 0xd8, 0xbd, 0xb2, 0x17, 0x04, 0x7b, 0x4d, 0xaa,
+	0x7c, 0x22, 0xbe, 0x1f, 0x08, 0x22, 0xbc, 0xc0, 0xe7, 0x46, 0x5b, 0x32, 0xda, 0xf8, 0xdb, 0x6e,
+	0x9b, 0x29, 0x80, 0xd1, 0x2f, 0x0e, 0xea, 0x77, 0x3d, 0xda, 0x74, 0x9d, 0x16, 0xe1, 0xfb, 0x03,
+	0xfc, 0x31, 0x82, 0x0b, 0xd6, 0x6e, 0x08, 0xa3, 0x2d, 0x0f, 0x6a, 0x85, 0xd7, 0xa2, 0x5c, 0x90,
+	0x56, 0x68, 0x00, 0x2b, 0xc3, 0x33, 0x47, 0xc2, 0xb0, 0xe9, 0x35, 0xb4, 0x9b, 0x94, 0x1d, 0x50,
+	0xe6, 0x1c, 0xd2, 0x1d, 0x03, 0xbf, 0x3a, 0x0c, 0xf7, 0x5c, 0xea, 0x0b, 0x6f, 0xd7, 0xa3, 0x2c,
+	0x1a, 0xd4, 0x2b, 0xc3, 0xa0, 0x4f, 0x03, 0xcf, 0x3f, 0x59, 0xbb, 0x4f, 0x8f, 0x22, 0xdb, 0xf2,
+	0xb0, 0x36, 0x5a, 0x33, 0x33, 0x23, 0xc3, 0x80, 0x16, 0xe5, 0x9c, 0xec, 0x51, 0x7e, 0x1a, 0x42,
+	0x10, 0x97, 0x08, 0xa2, 0x11, 0x4b, 0xbf, 0x18, 0x87, 0xc9, 0x47, 0x94, 0x73, 0x2f, 0xf0, 0xd1,
+	0x53, 0xc8, 0xba, 0xf4, 0xc0, 0x21, 0xae, 0xcb, 0xec, 0xb1, 0x45, 0x6b, 0x79, 0xba, 0xf6, 0xc3,
+	0xcf, 0xbb, 0xe5, 0xcc, 0x1f, 0xba, 0xe5, 0xb7, 0xf7, 0x82, 0x8a, 0x78, 0x4e, 0xc5, 0x73, 0xcf,
+	0xdf, 0xe3, 0x15, 0x9f, 0x8a, 0xc3, 0x80, 0xed, 0xaf, 0xf6, 0x93, 0x87, 0xfb, 0x7b, 0xab, 0xe2,
+	0x28, 0xa4, 0xbc, 0x52, 0xa7, 0x07, 0x55, 0xd7, 0x65, 0x78, 0xd2, 0xd5, 0x0f, 0xe8, 0xfb, 0x30,
+	0x21, 0xc7, 0x65, 0x8f, 0x2f, 0x5a, 0xcb, 0x53, 0x6b, 0x2f, 0x57, 0xfa, 0xc3, 0xaf, 0x62, 0xbe,
+	0xff, 0x01, 0x3d, 0xe2, 0xb5, 0xac, 0xfc, 0xe2, 0x17, 0xdd, 0xb2, 0x85, 0x95, 0x09, 0x7a, 0x0d,
+	0x66, 0x9a, 0x84, 0x0b, 0x67, 0xd7, 0x69, 0xf8, 0xc2, 0x69, 0x87, 0xf6, 0xc4, 0xa2, 0xb5, 0x3c,
+	0x83, 0x41, 0x0a, 0xef, 0xae, 0xfb, 0xe2, 0x49, 0x88, 0x96, 0x61, 0x56, 0x41, 0x7c, 0x03, 0x72,
+	0x83, 0x43, 0xdf, 0xbe, 0xa0, 0x60, 0xca, 0xf6, 0x81, 0xc4, 0xd5, 0x83, 0x43, 0x3f, 0x46, 0x92,
+	0x34, 0xf2, 0x62, 0x82, 0xac, 0xc6, 0xc8, 0x0a, 0xcc, 0x2b, 0x64, 0x23, 0xf0, 0x77, 0xd3, 0xe0,
+	0x49, 0x05, 0x2e, 0x4a, 0xdd, 0x7a, 0xe0, 0xef, 0xc6, 0xf8, 0x75, 0x00, 0x2e, 0x08, 0x13, 0xd4,
+	0x75, 0x88, 0xb0, 0xb3, 0x6a, 0x9c, 0x0b, 0x15, 0x1d, 0x71, 0x95, 0x28, 0xe2, 0x2a, 0x8f, 0xa3,
+	0x88, 0xd3, 0xc3, 0xfc, 0xe5, 0x97, 0x65, 0x0b, 0xe7, 0x8c, 0x5d, 0x55, 0xdc, 0x9b, 0xc8, 0x5a,
+	0xc5, 0xb1, 0xa5, 0x2f, 0xa7, 0x60, 0xe6, 0x7e, 0x75, 0x7d, 0x9b, 0x30, 0xd2, 0xa2, 0x82, 0x32,
+	0x8e, 0xde, 0x80, 0x6c, 0x8b, 0x74, 0x1c, 0xea, 0xb1, 0xd0, 0xb6, 0x16, 0xad, 0xe5, 0xb1, 0xda,
+	0x54, 0xaf, 0x5b, 0x9e, 0xbc, 0x4f, 0x3a, 0x1b, 0x9b, 0x78, 0x1b, 0x4f, 0xb6, 0x48, 0x67, 0xc3,
+	0x63, 0x21, 0x7a, 0x0b, 0x66, 0xdb, 0x61, 0xd3, 0xf3, 0xf7, 0x1d, 0xf7, 0x90, 0x36, 0x9b, 0x8e,
+	0x0c, 0x70, 0xb5, 0x90, 0x59, 0x5c, 0xd0, 0x8a, 0xba, 0x94, 0x4b, 0x2f, 0x50, 0x05, 0xe6, 0xe4,
+	0x80, 0x06, 0xd1, 0xe3, 0x0a, 0x3d, 0x1b, 0xa9, 0x12, 0xfc, 0x0e, 0xcc, 0x11, 0x97, 0x39, 0x32,
+	0x72, 0x1c, 0x46, 0x04, 0x75, 0x3c, 0xdf, 0xa5, 0x1d, 0xb5, 0x1a, 0xf9, 0xb5, 0x57, 0x07, 0x57,
+	0xb4, 0x4e, 0x04, 0xc1, 0x44, 0xd0, 0x4d, 0x09, 0xaa, 0xcd, 0xf7, 0xba, 0xe5, 0x62, 0xb5, 0x8e,
+	0xfb, 0xa4, 0xb8, 0x48, 0x5c, 0xd6, 0x27, 0x41, 0xef, 0x01, 0x92, 0xdf, 0x10, 0x1d, 0x27, 0x0c,
+	0x0e, 0x29, 0x33, 0x9f, 0x50, 0x2b, 0x59, 0x9b, 0xeb, 0x75, 0xcb, 0x85, 0x6a, 0x1d, 0x3f, 0xee,
+	0x6c, 0x4b, 0x9d, 0xa6, 0x28, 0x10, 0x97, 0xa5, 0x05, 0xe8, 0x06, 0x4c, 0x4b, 0x06, 0x7f, 0xc7,
+	0x11, 0x8c, 0xf8, 0x5c, 0xaf, 0x6d, 0x2d, 0xdf, 0xeb, 0x96, 0xa1, 0x5a, 0xc7, 0x0f, 0x76, 0x1e,
+	0x4b, 0x29, 0x06, 0xe2, 0x32, 0xf3, 0x8c, 0x6e, 0xc1, 0x8c, 0xb4, 0x20, 0x8d, 0x7d, 0xa7, 0xe9,
+	0xb5, 0x3c, 0xa1, 0x57, 0xb8, 0x56, 0xe8, 0x75, 0xcb, 0x53, 0xd5, 0x3a, 0xae, 0x36, 0xf6, 0xb7,
+	0xa4, 0x18, 0x4f, 0x11, 0x97, 0x45, 0x2f, 0x69, 0x23, 0x97, 0x36, 0xc9, 0x91, 0x5a, 0xf0, 0x3e,
+	0xa3, 0xba, 0x14, 0x47, 0x46, 0xea, 0x05, 0xbd, 0x0d, 0x39, 0xd6, 0xb9, 0x69, 0x0c, 0x72, 0x6a,
+	0xde, 0xae, 0x0c, 0xce, 0x1b, 0xee, 0x68, 0xc3, 0x2c, 0xeb, 0xdc, 0xd4, 0x56, 0xab, 0x30, 0xaf,
+	0xac, 0xe2, 0x79, 0x0f, 0x76, 0x77, 0x39, 0x15, 0x36, 0xa8, 0x40, 0x9c, 0x95, 0x38, 0x33, 0x87,
+	0x0f, 0x95, 0x02, 0x6d, 0xc1, 0x1c, 0xeb, 0xac, 0x0d, 0x2d, 0xd4, 0xd4, 0x19, 0x16, 0x0a, 0x17,
+	0x59, 0x67, 0xad, 0x7f, 0x49, 0xae, 0xc2, 0x8c, 0x64, 0xdb, 0x65, 0xf4, 0xa7, 0x6d, 0xea, 0x37,
+	0x8e, 0xec, 0xe9, 0x45, 0x6b, 0x79, 0x02, 0x4f, 0xb3, 0xce, 0xda, 0xdd, 0x48, 0x86, 0x7e, 0x0c,
+	0x57, 0x18, 0x95, 0xc7, 0x9a, 0x8a, 0x21, 0x27, 0xa4, 0xcc, 0x0b, 0x5c, 0xaf, 0xe1, 0x89, 0x23,
+	0x7b, 0x46, 0x7d, 0x76, 0x69, 0x68, 0x9c, 0x0a, 0x2e, 0x03, 0x6b, 0xa3, 0x13, 0x06, 0x3e, 0xf5,
+	0x05, 0xbe, 0xc4, 0x62, 0xd9, 0x76, 0x42, 0x80, 0x9e, 0x81, 0x6d, 0xb8, 0x1b, 0x41, 0xdb, 0x17,
+	0x7d, 0xe4, 0x79, 0x45, 0x7e, 0x75, 0x34, 0xf9, 0xba, 0x84, 0xc7, 0xec, 0x97, 0x59, 0x22, 0x4c,
+	0xd3, 0x6f, 0x42, 0x5e, 0x6e, 0x2d, 0xb7, 0x2d, 0x8e, 0x9c, 0xc6, 0x51, 0xa3, 0x49, 0xed, 0xc2,
+	0x68, 0xd2, 0xea, 0xde, 0x1e, 0xa3, 0x7b, 0x44, 0x50, 0xb7, 0xde, 0x16, 0x47, 0xeb, 0x12, 0x8a,
+	0xa7, 0x5b, 0xa4, 0x13, 0xbf, 0xa1, 0x2a, 0x64, 0x1b, 0xcf, 0x89, 0xef, 0xd3, 0x26, 0xb7, 0x8b,
+	0x8b, 0xe3, 0xcb, 0x53, 0x6b, 0xaf, 0x0f, 0x92, 0xf4, 0x6d, 0xeb, 0xca, 0xba, 0x46, 0xe3, 0xd8,
+	0x4c, 0x6e, 0xca, 0xd0, 0xf3, 0xf7, 0x1c, 0xde, 0x0c, 0x44, 0x6a, 0xce, 0x67, 0xd5, 0x9c, 0xcf,
+	0x4a, 0xd5, 0xa3, 0x66, 0x20, 0x92, 0x89, 0x7f, 0x0a, 0x2f, 0x25, 0xf8, 0xc1, 0x15, 0x47, 0x67,
+	0x59, 0xf1, 0x4b, 0x11, 0x69, 0xff, 0xb2, 0xbf, 0x09, 0xc5, 0x1d, 0x4a, 0x1a, 0x81, 0x9f, 0xf2,
+	0x62, 0x4e, 0x79, 0x51, 0xd0, 0xf2, 0xd8, 0x87, 0x85, 0xff, 0x1c, 0x83, 0x49, 0x33, 0x12, 0x69,
+	0x66, 0x0e, 0xa0, 0xc4, 0xcc, 0xd2, 0x66, 0x5a, 0x9e, 0xb8, 0xbe, 0x02, 0x28, 0x3e, 0x7f, 0x12,
+	0xf0, 0x98, 0x1e, 0x69, 0xa4, 0x49, 0xe0, 0x5b, 0x30, 0xd7, 0xf2, 0xfc, 0xa1, 0x31, 0x8e, 0x9f,
+	0x29, 0xaa, 0x5b, 0x9e, 0xdf, 0x3f, 0x3c, 0xc9, 0x26, 0x57, 0xfd, 0x6b, 0x1c, 0x66, 0xb8, 0x28,
+	0x17, 0x7d, 0x70, 0x8f, 0x50, 0x9f, 0xec, 0x34, 0xa9, 0xa3, 0x07, 0xa9, 0x4e, 0xac, 0x2c, 0x9e,
+	0xd6, 0xc2, 0x27, 0x4a, 0x76, 0x7b, 0xe2, 0xb3, 0x5f, 0x97, 0x33, 0xfa, 0xef, 0x52, 0x0b, 0xf2,
+	0x1b, 0xbe, 0x5b, 0x57, 0x15, 0x59, 0x8d, 0x11, 0xdf, 0x45, 0x97, 0x61, 0xcc, 0x73, 0xd5, 0x54,
+	0xe5, 0x6a, 0x17, 0x7b, 0xdd, 0xf2, 0xd8, 0x66, 0x1d, 0x8f, 0x79, 0x2e, 0x42, 0x30, 0xe1, 0x13,
+	0x73, 0x88, 0xe7, 0xb0, 0x7a, 0x46, 0x2f, 0xc1, 0x78, 0x9b, 0x35, 0xd5, 0xd0, 0x73, 0xb5, 0xc9,
+	0x5e, 0xb7, 0x3c, 0xfe, 0x04, 0x6f, 0x61, 0x29, 0x43, 0xf3, 0x70, 0xa1, 0x19, 0xec, 0x05, 0xdc,
+	0x9e, 0x58, 0x1c, 0x5f, 0xce, 0x61, 0xfd, 0xb2, 0xe4, 0xa6, 0x3e, 0x77, 0x3f, 0x70, 0x69, 0x53,
+	0x26, 0x94, 0x1d, 0xf9, 0x5d, 0x27, 0xfe, 0xa8, 0x4a, 0x28, 0xca, 0x97, 0xcd, 0x3a, 0x9e, 0x54,
+	0xca, 0xcd, 0xc8, 0xad, 0xb1, 0x13, 0xdd, 0x1a, 0x4f, 0xdc, 0x5a, 0xfa, 0x97, 0x31, 0x78, 0x39,
+	0xfe, 0xcc, 0x87, 0x94, 0xc9, 0x8c, 0xbe, 0x99, 0xd4, 0x43, 0xe8, 0xe1, 0xd0, 0x37, 0xdf, 0x4e,
+	0x7d, 0xb3, 0xf7, 0x65, 0xf9, 0x75, 0x78, 0xed, 0x93, 0x8f, 0xc9, 0xca, 0xdf, 0xdf, 0x58, 0xf9,
+	0xfe, 0xb3, 0xe5, 0x3b, 0xb7, 0x3f, 0x5e, 0x79, 0x76, 0x27, 0x7a, 0x7d, 0xf3, 0x1f, 0xd6, 0xae,
+	0xff, 0xd3, 0xb5, 0x7f, 0xfc, 0xe4, 0x5a, 0xe7, 0xf5, 0xc4, 0xb9, 0x87, 0x90, 0x6d, 0xc9, 0xd1,
+	0x38, 0xb1, 0x8b, 0x8a, 0x50, 0x8d, 0xf0, 0x5c, 0x84, 0x8a, 0x65, 0xd3, 0x95, 0xd1, 0xfb, 0x9c,
+	0x30, 0xf7, 0x90, 0x30, 0xea, 0x1c, 0xe8, 0x01, 0x98, 0x11, 0x16, 0x22, 0xb9, 0x19, 0x97, 0x84,
+	0xee, 0x7a, 0xac, 0xd5, 0x07, 0x9d, 0xd0, 0xd0, 0x48, 0x6e, 0xa0, 0x4b, 0xff, 0x3c, 0x09, 0xc5,
+	0xc1, 0x79, 0x41, 0x3f, 0x82, 0x71, 0xcf, 0xe5, 0x6a, 0x1e, 0xa6, 0xd6, 0xbe, 0x3b, 0x18, 0x70,
+	0xa7, 0x4c, 0x63, 0xaa, 0x3e, 0x92, 0x0c, 0xe8, 0x29, 0x14, 0x8c, 0x61, 0xec, 0xc7, 0x98, 0x8a,
+	0xe2, 0x85, 0x11, 0x67, 0x8f, 0xa1, 0xab, 0xa1, 0x5e, 0xb7, 0x9c, 0xdf, 0x0a, 0x30, 0x79, 0x5a,
+	0x7d, 0x60, 0x64, 0x38, 0x6f, 0xa0, 0x91, 0x87, 0x04, 0xe6, 0x22, 0xe2, 0xf0, 0xf9, 0x51, 0xdf,
+	0x7c, 0x8c, 0x20, 0xdf, 0x7e, 0xff, 0xa3, 0x88, 0xfc, 0x52, 0xaf, 0x5b, 0x9e, 0x35, 0xe4, 0x89,
+	0x18, 0xcf, 0x1a, 0xf4, 0xf6, 0xf3, 0xa3, 0xe8, 0x13, 0x77, 0x60, 0x36, 0xde, 0xf9, 0x4e, 0xd8,
+	0x24, 0xbe, 0x5c, 0x49, 0x35, 0x8b, 0x3a, 0xdb, 0xc7, 0xbb, 0x7f, 0xbb, 0x49, 0xfc, 0xcd, 0x3a,
+	0x2e, 0xec, 0xf6, 0x09, 0x64, 0x78, 0x5e, 0x0c, 0x9f, 0x07, 0x22, 0xe0, 0xf6, 0x05, 0x15, 0xef,
+	0xe6, 0x0d, 0x2d, 0x43, 0x91, 0xb7, 0xc3, 0x30, 0x60, 0x82, 0x3b, 0x8d, 0x26, 0xe1, 0xdc, 0xd9,
+	0x51, 0x95, 0x40, 0x16, 0xe7, 0x23, 0xf9, 0xba, 0x14, 0xd7, 0x46, 0x20, 0x1b, 0xaa, 0x00, 0x18,
+	0x44, 0xae, 0xa3, 0x16, 0x5c, 0x76, 0xe9, 0x2e, 0x69, 0x37, 0x85, 0xd3, 0x22, 0x0d, 0x27, 0x8c,
+	0x8f, 0x71, 0x53, 0xec, 0xbd, 0x7a, 0xea, 0x59, 0x5f, 0xb3, 0x7b, 0xdd, 0xf2, 0x7c, 0x5d, 0x13,
+	0xf4, 0x69, 0xf0, 0xbc, 0xa1, 0xbd, 0x4f, 0x1a, 0xa9, 0x92, 0xef, 0x2a, 0xcc, 0xc8, 0xf3, 0x2e,
+	0x39, 0x19, 0x73, 0x3a, 0xef, 0xb6, 0xbc, 0xe4, 0xe8, 0x55, 0x20, 0xd2, 0x49, 0x81, 0xc0, 0x80,
+	0x48, 0x27, 0x01, 0x2d, 0xc2, 0x34, 0xa3, 0x9c, 0x0a, 0xae, 0xcb, 0x58, 0x55, 0x08, 0x64, 0x31,
+	0x68, 0x99, 0xac, 0x5f, 0xd1, 0x0f, 0x60, 0xb6, 0xcd, 0x29, 0x77, 0x6e, 0xad, 0x39, 0x3b, 0x9e,
+	0xa9, 0xb4, 0x55, 0x9e, 0xcf, 0xd6, 0x66, 0x7b, 0xdd, 0xf2, 0xcc, 0x13, 0x4e, 0xf9, 0xad, 0xb5,
+	0x9a, 0xa7, 0xea, 0x6d, 0x3c, 0xd3, 0x4e, 0xbf, 0x4a, 0x1f, 0xe2, 0x19, 0x94, 0x19, 0x56, 0x65,
+	0xfc, 0x2c, 0x9e, 0x8e, 0x84, 0xf7, 0x02, 0xcf, 0x47, 0xd7, 0x01, 0x19, 0x1f, 0x54, 0x26, 0xf7,
+	0x03, 0xbf, 0x41, 0xb9, 0x4a, 0xdf, 0x59, 0x5c, 0xd4, 0x1a, 0x89, 0x7b, 0xa0, 0xe4, 0xe8, 0x19,
+	0xa0, 0x68, 0xaa, 0x77, 0x03, 0xd6, 0x22, 0x42, 0x4d, 0x73, 0x41, 0x4d, 0xf3, 0xf2, 0xd0, 0x34,
+	0xeb, 0x86, 0x67, 0x9b, 0x1c, 0x35, 0x03, 0xe2, 0xde, 0x8d, 0xf1, 0xb5, 0x09, 0xb9, 0x51, 0xf0,
+	0xac, 0x61, 0x4a, 0x14, 0xe6, 0x0c, 0xfe, 0x7c, 0x02, 0xa6, 0xee, 0x57, 0xd7, 0x1f, 0x51, 0x21,
+	0x64, 0x4f, 0x83, 0xae, 0xc2, 0x64, 0x9b, 0x53, 0x87, 0xb8, 0x4c, 0xed, 0xca, 0x6c, 0x0d, 0x7a,
+	0xdd, 0xf2, 0xc5, 0x27, 0x9c, 0x56, 0xeb, 0x18, 0x5f, 0x6c, 0x73, 0x5a, 0x75, 0x19, 0xba, 0x0e,
+	0xb2, 0x74, 0x74, 0x5a, 0x84, 0xed, 0x79, 0x7a, 0xa3, 0xcd, 0xd4, 0x66, 0x7a, 0xdd, 0x72, 0xae,
+	0x5a, 0xc7, 0xf7, 0x95, 0x10, 0xe7, 0x88, 0xcb, 0xf4, 0x23, 0xfa, 0x00, 0x0a, 0x26, 0xfa, 0x54,
+	0x5d, 0x14, 0xb4, 0x85, 0x69, 0x80, 0x5e, 0x1a, 0x6a, 0x0c, 0xea, 0xa6, 0xd5, 0xd5, 0xdb, 0xfb,
+	0x57, 0xb2, 0x2f, 0x98, 0x51, 0xb6, 0xb5, 0xc7, 0xda, 0x32, 0x21, 0x6b, 0xc4, 0x64, 0x13, 0xe7,
+	0x25, 0x5b, 0x8f, 0xc8, 0x3e, 0x86, 0x2b, 0x5c, 0x10, 0xd1, 0xe6, 0xc3, 0x05, 0xdb, 0x85, 0xb3,
+	0x93, 0x5e, 0xd2, 0x1c, 0x83, 0x15, 0xdb, 0xbb, 0x60, 0x1b, 0xf2, 0xe1, 0x8a, 0x4d, 0xf7, 0x5a,
+	0x97, 0xb5, 0x7e, 0xa8, 0x18, 0x7b, 0x1f, 0xe6, 0x55, 0x7c, 0x90, 0x46, 0x83, 0x86, 0xc2, 0x61,
+	0x1d, 0x53, 0x2c, 0x4f, 0x9e, 0x5e, 0x2c, 0xcf, 0x4a, 0xa3, 0xaa, 0xb2, 0x31, 0x22, 0xb4, 0x0f,
+	0x57, 0xd2, 0x4c, 0x6e, 0xd3, 0xe1, 0x66, 0xa1, 0xe3, 0xde, 0x6c, 0x30, 0xc9, 0x6f, 0x45, 0xa1,
+	0xa0, 0xf7, 0xea, 0xbd, 0x98, 0x33, 0xd1, 0xe0, 0xf9, 0xe4, 0x4b, 0xf5, 0x66, 0x24, 0x5d, 0xfa,
+	0x8f, 0x1c, 0x64, 0x65, 0x28, 0x09, 0x22, 0x28, 0xc2, 0x80, 0x1a, 0x6d, 0xc6, 0xa8, 0x1c, 0x78,
+	0x72, 0x46, 0x58, 0x67, 0x39, 0x23, 0x4c, 0xc4, 0x1a, 0xf3, 0xd4, 0x61, 0x80, 0xe5, 0x86, 0xe0,
+	0x1e, 0xa3, 0x6e, 0x9a, 0x73, 0xec, 0x1c, 0x9c, 0xc6, 0x3c, 0xc5, 0xf9, 0x2e, 0x4c, 0xeb, 0x2b,
+	0x21, 0x7d, 0xee, 0x99, 0x83, 0xfd, 0xd2, 0x20, 0x9b, 0x3a, 0xfd, 0xf0, 0x94, 0x86, 0xaa, 0x97,
+	0x51, 0x29, 0x67, 0xe2, 0xff, 0x24, 0xe5, 0x3c, 0x83, 0x85, 0xb8, 0xe7, 0xf6, 0x58, 0x8b, 0xba,
+	0x4e, 0x5c, 0x21, 0x12, 0x61, 0x02, 0xf3, 0xb4, 0x9e, 0x7a, 0x42, 0xf5, 0xd3, 0x57, 0xa2, 0xde,
+	0x5c, 0x51, 0xd4, 0x0d, 0x43, 0x55, 0xa0, 0x77, 0xc0, 0x56, 0xf4, 0x2e, 0x3d, 0x70, 0x4c, 0x80,
+	0xc6, 0x97, 0x0a, 0x3a, 0x2e, 0xe7, 0xa4, 0xbe, 0x4e, 0x0f, 0x1e, 0x29, 0xad, 0xb9, 0x5d, 0xc0,
+	0x70, 0x29, 0xa9, 0xb1, 0xd3, 0xb1, 0xac, 0xa3, 0xb2, 0x34, 0x94, 0x0a, 0x4d, 0x41, 0xad, 0x03,
+	0x1b, 0xcf, 0x85, 0x7d, 0xef, 0x3a, 0xd0, 0x29, 0xbc, 0x12, 0x52, 0xdf, 0x95, 0xb4, 0xa9, 0x1b,
+	0xa6, 0x78, 0xb8, 0x26, 0x46, 0x87, 0x7b, 0x90, 0x04, 0x1b, 0x8d, 0x0b, 0x2f, 0x18, 0xa2, 0x11,
+	0x3a, 0xb4, 0x01, 0xc5, 0x9f, 0xb6, 0x69, 0x9b, 0xba, 0x0e, 0xa3, 0x3c, 0x0c, 0x7c, 0x4e, 0xb9,
+	0x9d, 0x53, 0x9d, 0xc9, 0xa8, 0xa5, 0x5a, 0x0f, 0x5a, 0x2d, 0xe2, 0xbb, 0xb8, 0xa0, 0x6d, 0x70,
+	0x64, 0x22, 0x69, 0x22, 0x6f, 0x55, 0x56, 0xe1, 0x82, 0xdb, 0xf0, 0xd5, 0x34, 0xc6, 0x06, 0x1b,
+	0x13, 0xf4, 0xb7, 0x80, 0x8c, 0x37, 0xa9, 0xad, 0xa9, 0xd2, 0xd1, 0x88, 0xa1, 0x46, 0xfb, 0xa9,
+	0x92, 0xec, 0x43, 0x6c, 0x06, 0x93, 0x48, 0xd0, 0x7d, 0x98, 0x8f, 0x3c, 0x53, 0x9c, 0xc6, 0x3d,
+	0x95, 0xbc, 0x46, 0xdc, 0x33, 0x49, 0x4b, 0xe3, 0x0e, 0x46, 0xc6, 0x30, 0x25, 0x43, 0x37, 0x64,
+	0xaf, 0xed, 0x1c, 0x7a, 0xbe, 0x1b, 0x1c, 0x72, 0x87, 0x1c, 0x10, 0xaf, 0x29, 0x2b, 0x78, 0x93,
+	0xd2, 0x10, 0xeb, 0x3c, 0xd5, 0xaa, 0x6a, 0xa4, 0x59, 0xf8, 0x77, 0x0b, 0x20, 0xe5, 0xcf, 0x12,
+	0x4c, 0x86, 0x3a, 0x11, 0xa9, 0x1d, 0x3f, 0x5d, 0xcb, 0xf6, 0xbe, 0x2c, 0x4f, 0x84, 0x53, 0x9d,
+	0x57, 0x71, 0xa4, 0x40, 0x3f, 0x80, 0xc9, 0xc8, 0xcd, 0xb1, 0xaf, 0x74, 0xd3, 0xec, 0xdf, 0xc8,
+	0x02, 0xbd, 0x73, 0xf6, 0x8b, 0x34, 0x6d, 0xa9, 0xe0, 0x26, 0xe5, 0xfd, 0xb7, 0x0d, 0xb9, 0xb8,
+	0xb4, 0x44, 0xef, 0xa5, 0x4b, 0xd0, 0x6b, 0x27, 0x96, 0xa0, 0xa7, 0xd4, 0x9e, 0xeb, 0x00, 0x0d,
+	0x46, 0x89, 0xb9, 0xf3, 0x1a, 0x3b, 0xcf, 0x9d, 0x97, 0xb1, 0xab, 0x0a, 0x49, 0xd2, 0x0e, 0xdd,
+	0x88, 0x64, 0xfc, 0x3c, 0x24, 0xc6, 0xae, 0x2a, 0xe2, 0x7e, 0x64, 0x22, 0xd5, 0x26, 0x2d, 0xc2,
+	0x94, 0x4b, 0x79, 0x83, 0x79, 0xa1, 0xdc, 0x13, 0xea, 0xf8, 0xc8, 0xe1, 0xb4, 0x08, 0x6d, 0x02,
+	0x10, 0x21, 0x98, 0xb7, 0xd3, 0x16, 0x94, 0xdb, 0x17, 0x55, 0x44, 0xbf, 0x79, 0xe2, 0x44, 0x54,
+	0xaa, 0x31, 0x76, 0xc3, 0x17, 0xec, 0x08, 0xa7, 0x8c, 0xd1, 0x4f, 0x60, 0xca, 0x9c, 0x85, 0x8e,
+	0x9c, 0xd4, 0xc9, 0xf3, 0xd7, 0xf5, 0xea, 0x8e, 0x2a, 0x92, 0xd7, 0x39, 0x86, 0x83, 0x08, 0xc3,
+	0x51, 0x0d, 0x10, 0xa7, 0x4c, 0x1d, 0xd6, 0x21, 0x0b, 0x76, 0xbd, 0x26, 0x95, 0x95, 0x72, 0x56,
+	0x55, 0xca, 0xea, 0x6e, 0xed, 0x91, 0xd6, 0x6e, 0x6b, 0xe5, 0x66, 0x1d, 0x17, 0x79, 0xbf, 0xc4,
+	0x45, 0x6f, 0xc3, 0x65, 0x73, 0x6d, 0xeb, 0x98, 0x9b, 0x6c, 0xe2, 0xba, 0x8c, 0x72, 0xae, 0x2a,
+	0xcb, 0x1c, 0x9e, 0x37, 0xda, 0x47, 0x4a, 0x59, 0xd5, 0x3a, 0xf4, 0x43, 0x58, 0x48, 0x1f, 0x50,
+	0x03, 0x96, 0xa0, 0x2c, 0xed, 0x14, 0xa2, 0xdf, 0xba, 0x02, 0x73, 0x6a, 0x5b, 0x0e, 0x98, 0x4d,
+	0x29, 0x33, 0x95, 0xb5, 0xfb, 0xf1, 0x77, 0x21, 0xd7, 0x0c, 0xcc, 0x6d, 0xbb, 0x3d, 0xad, 0xd6,
+	0x63, 0xf9, 0xe4, 0xf5, 0xd8, 0x8a, 0xa0, 0x7a, 0x39, 0x12, 0xd3, 0x91, 0xf5, 0xff, 0xcc, 0x99,
+	0xeb, 0xff, 0xfc, 0xc8, 0xfa, 0x7f, 0x44, 0xd6, 0x2b, 0x7c, 0x9b, 0x8d, 0x56, 0xf1, 0xdb, 0x6e,
+	0xb4, 0x66, 0xcf, 0xd1, 0x68, 0x9d, 0xdc, 0xfc, 0xa0, 0xff, 0x97, 0xe6, 0x67, 0xee, 0x2c, 0xcd,
+	0xcf, 0xfc, 0x19, 0x9a, 0x9f, 0x4b, 0x67, 0x6b, 0x7e, 0x2e, 0x7f, 0xdd, 0xe6, 0xe7, 0xca, 0x99,
+	0x9b, 0x1f, 0xfb, 0x84, 0xe6, 0xe7, 0x1d, 0xc8, 0xb1, 0x20, 0x10, 0x8e, 0x3a, 0xe6, 0x5f, 0x52,
+	0xb3, 0x6b, 0x0f, 0x15, 0xbe, 0x41, 0x20, 0xe4, 0x19, 0x8f, 0xb3, 0xcc, 0x3c, 0xa1, 0x0f, 0xe1,
+	0xa2, 0x4f, 0x85, 0x5c, 0xd7, 0x05, 0x95, 0x78, 0xee, 0xfc, 0xa1, 0x5b, 0x5e, 0x3b, 0xd7, 0x8f,
+	0x36, 0x0f, 0xa8, 0xd8, 0xac, 0xf7, 0xba, 0xe5, 0x0b, 0xea, 0x01, 0x5f, 0xf0, 0xa9, 0x50, 0x97,
+	0x2c, 0xd3, 0x72, 0xc5, 0xe3, 0xea, 0xf9, 0xe5, 0xd1, 0x89, 0x27, 0xd5, 0x49, 0xe9, 0x5b, 0xf0,
+	0x94, 0x00, 0x4f, 0xb5, 0x48, 0x23, 0xee, 0xb3, 0xd6, 0x21, 0xa7, 0x08, 0x65, 0x72, 0xb7, 0x5f,
+	0x19, 0x3d, 0xbe, 0x28, 0xf9, 0xd7, 0xa6, 0x7b, 0xdd, 0x72, 0x5c, 0x5a, 0xe3, 0xac, 0xe4, 0x51,
+	0x45, 0xf6, 0x4d, 0x98, 0xe4, 0x3a, 0xd5, 0xd9, 0xaf, 0x2a, 0x8a, 0x2b, 0x27, 0x64, 0x42, 0x1c,
+	0xe1, 0xd0, 0x7b, 0x10, 0x15, 0x24, 0x4e, 0x64, 0x5a, 0x3a, 0xdd, 0x34, 0x6f, 0xf0, 0xd1, 0xaf,
+	0x63, 0xd7, 0x20, 0x1f, 0xd7, 0x8f, 0x6a, 0x11, 0xed, 0xb2, 0xaa, 0x1a, 0xa7, 0x4d, 0xd5, 0xa8,
+	0x16, 0x10, 0xbd, 0x01, 0x85, 0x36, 0xa7, 0x6e, 0x82, 0xe2, 0xf6, 0xe2, 0xe2, 0xf8, 0xf2, 0x8c,
+	0x0a, 0x1d, 0x37, 0x82, 0x71, 0x89, 0x53, 0x6c, 0x49, 0x4c, 0xd8, 0xaf, 0x25, 0x3f, 0x44, 0xc5,
+	0x01, 0x81, 0xbe, 0x67, 0x70, 0xec, 0x53, 0xd3, 0x4e, 0xdd, 0xb0, 0x97, 0x54, 0xdf, 0x59, 0xec,
+	0x75, 0xcb, 0xd3, 0x5b, 0x84, 0x0b, 0x7c, 0x4f, 0x35, 0x52, 0x37, 0xb4, 0x23, 0xf8, 0x53, 0xfd,
+	0x36, 0x6c, 0x78, 0xd3, 0xbe, 0x3a, 0xd2, 0xf0, 0x66, 0x9f, 0xe1, 0x4d, 0xf4, 0x09, 0xbc, 0x3c,
+	0x58, 0x27, 0x33, 0xda, 0xa0, 0xde, 0x81, 0x4e, 0xd1, 0xd7, 0xce, 0x53, 0x87, 0xc7, 0xc5, 0x34,
+	0x36, 0x0c, 0x55, 0xb9, 0xe3, 0xa6, 0xf4, 0xcf, 0x3b, 0x3a, 0x06, 0x5e, 0x3f, 0xe1, 0xa0, 0x93,
+	0x10, 0xbd, 0xee, 0x10, 0xc6, 0xcf, 0x68, 0x05, 0xd0, 0x8e, 0xea, 0xe3, 0x8f, 0x64, 0x2d, 0xde,
+	0xa0, 0xbe, 0x20, 0x7b, 0xd4, 0x7e, 0x63, 0xd1, 0x5a, 0x1e, 0xc3, 0xb3, 0x46, 0xb3, 0x1d, 0x2b,
+	0xd0, 0x77, 0xa0, 0x10, 0xf7, 0x10, 0xa6, 0x6b, 0xff, 0xce, 0xa2, 0xb5, 0x7c, 0x01, 0xe7, 0x23,
+	0xb1, 0xe9, 0xd5, 0x89, 0xdc, 0xa4, 0xd2, 0xca, 0x91, 0x0d, 0xbe, 0xbe, 0xc7, 0xe5, 0xf6, 0xb2,
+	0xca, 0x41, 0x43, 0xa7, 0x9b, 0xbe, 0xd2, 0x35, 0x37, 0x0f, 0x3a, 0x03, 0x63, 0x65, 0x5c, 0xad,
+	0x63, 0xad, 0xe3, 0x72, 0x67, 0x2b, 0x89, 0xcb, 0x8c, 0x04, 0xd5, 0x21, 0x6f, 0x3e, 0x11, 0xd1,
+	0xbf, 0x79, 0x06, 0x7a, 0x3c, 0xa3, 0x8d, 0x22, 0x96, 0x7b, 0x60, 0x98, 0xe3, 0x6e, 0x81, 0xdb,
+	0x6f, 0x29, 0x9e, 0xf2, 0x50, 0x4b, 0x1b, 0x0d, 0xd1, 0x30, 0x15, 0xb4, 0x61, 0x24, 0xe6, 0xb2,
+	0x0d, 0x31, 0x15, 0xf9, 0xa8, 0x2e, 0x84, 0xdb, 0xdf, 0x55, 0xbc, 0x67, 0x6b, 0x43, 0x34, 0xd1,
+	0x08, 0x15, 0x47, 0xef, 0x03, 0xa4, 0xee, 0x71, 0xae, 0x9f, 0xef, 0x1e, 0x07, 0xa7, 0x6c, 0x11,
+	0x81, 0x7c, 0xc8, 0x82, 0x03, 0x4f, 0xee, 0x47, 0xca, 0xe4, 0x69, 0xb7, 0xa2, 0xb2, 0xd8, 0x6d,
+	0x79, 0x52, 0x6f, 0x27, 0x9a, 0xf3, 0x5c, 0xff, 0xce, 0xa4, 0x18, 0x37, 0x5d, 0x54, 0x87, 0xd9,
+	0x58, 0x20, 0x0f, 0x0b, 0x97, 0x08, 0x62, 0x57, 0xcc, 0x49, 0x31, 0x18, 0xf3, 0x8f, 0xd4, 0xff,
+	0x17, 0xe0, 0x62, 0xda, 0xa2, 0x4e, 0x04, 0x41, 0x7f, 0x03, 0xd9, 0x43, 0xba, 0xf3, 0x3c, 0x08,
+	0xf6, 0xb9, 0xbd, 0xaa, 0x66, 0x71, 0xe9, 0x94, 0x59, 0x7c, 0xaa, 0xa1, 0x38, 0xb6, 0x59, 0xf8,
+	0x6b, 0x28, 0x0c, 0x94, 0x9b, 0xa8, 0x08, 0xe3, 0xfb, 0x54, 0xff, 0x9c, 0x92, 0xc3, 0xf2, 0x11,
+	0xcd, 0xc3, 0x85, 0x03, 0xd2, 0x6c, 0x47, 0xbf, 0x0e, 0xe8, 0x97, 0xdb, 0x63, 0xef, 0x5a, 0x0b,
+	0x1f, 0x42, 0xbe, 0xbf, 0x3a, 0x1a, 0x61, 0x5d, 0x49, 0x5b, 0x8f, 0x38, 0x84, 0x23, 0x82, 0x14,
+	0xaf, 0xe9, 0x23, 0xde, 0x07, 0x88, 0xab, 0x30, 0x8e, 0x6e, 0xc3, 0x54, 0xf2, 0xff, 0x25, 0xb2,
+	0x9f, 0x18, 0x57, 0xf7, 0x47, 0x27, 0x95, 0x6d, 0x18, 0x68, 0x6c, 0xbb, 0xf4, 0x13, 0xb8, 0xbc,
+	0xae, 0x3a, 0x81, 0x44, 0x6d, 0x1a, 0x9d, 0x1a, 0x40, 0xc2, 0x6a, 0x9a, 0x94, 0x93, 0x49, 0x53,
+	0x9d, 0x49, 0x2e, 0xa6, 0x5f, 0xfa, 0x57, 0x0b, 0x2e, 0x3f, 0x51, 0x3d, 0xc2, 0xb7, 0x41, 0x8f,
+	0xee, 0x00, 0x24, 0xff, 0x81, 0x72, 0x62, 0xfb, 0x73, 0x57, 0x42, 0xee, 0x13, 0xbe, 0x6f, 0x1a,
+	0xb2, 0xdc, 0x6e, 0x24, 0x58, 0xfa, 0x2f, 0x0b, 0xe6, 0x7e, 0x44, 0xc5, 0x90, 0x73, 0x8f, 0x21,
+	0x9f, 0x38, 0xe7, 0x7c, 0xfd, 0x26, 0x6d, 0x9a, 0x26, 0x7a, 0xfe, 0xcd, 0xdd, 0xfd, 0x1f, 0x0b,
+	0x2e, 0x6d, 0x79, 0x3c, 0xf1, 0x97, 0x47, 0x0e, 0x7f, 0x04, 0x85, 0xf4, 0x01, 0x92, 0x78, 0xfc,
+	0xc6, 0x29, 0x41, 0x3f, 0xda, 0xe7, 0x3c, 0x49, 0x23, 0xbe, 0xb9, 0xd7, 0x72, 0x93, 0x04, 0xcc,
+	0xa5, 0xcc, 0xfc, 0x92, 0xa3, 0x5f, 0xd4, 0x0f, 0x65, 0xea, 0xd7, 0x7e, 0xfd, 0xdf, 0x24, 0xfa,
+	0x45, 0xb6, 0x91, 0xa1, 0x4c, 0x27, 0xfa, 0x7f, 0x47, 0xd4, 0xf3, 0xd2, 0x2f, 0x2c, 0x98, 0x7b,
+	0x34, 0x62, 0x91, 0xbe, 0x07, 0x17, 0xcf, 0x1a, 0x3d, 0xda, 0x27, 0x03, 0xff, 0xc6, 0x23, 0x7a,
+	0xeb, 0x2e, 0x40, 0x92, 0x1c, 0xd1, 0x2c, 0xcc, 0x6c, 0x3f, 0x7c, 0xba, 0x81, 0x9d, 0x27, 0x0f,
+	0x3e, 0x78, 0xf0, 0xf0, 0xe9, 0x83, 0x62, 0x26, 0x11, 0xd5, 0xaa, 0x8f, 0x1f, 0x6f, 0xe0, 0x8f,
+	0x8a, 0x16, 0x42, 0x90, 0xd7, 0xa2, 0x8d, 0xbf, 0x7b, 0xbc, 0x81, 0x1f, 0x54, 0xb7, 0x8a, 0x63,
+	0xb5, 0x7f, 0xb3, 0x3e, 0x7f, 0x51, 0xb2, 0xbe, 0x78, 0x51, 0xb2, 0x7e, 0xff, 0xa2, 0x94, 0xf9,
+	0xe3, 0x8b, 0x52, 0xe6, 0x4f, 0x2f, 0x4a, 0x99, 0x3f, 0xbf, 0x28, 0x65, 0xfe, 0xf2, 0xa2, 0x64,
+	0xfd, 0xac, 0x57, 0xb2, 0x7e, 0xde, 0x2b, 0x65, 0x7e, 0xd3, 0x2b, 0x59, 0xbf, 0xed, 0x95, 0x32,
+	0x9f, 0xf5, 0x4a, 0x99, 0xdf, 0xf5, 0x4a, 0x99, 0xcf, 0x7b, 0x25, 0xeb, 0x8b, 0x5e, 0xc9, 0xfa,
+	0x7d, 0xaf, 0x94, 0xf9, 0x63, 0xaf, 0x64, 0xfd, 0xa9, 0x57, 0xca, 0xfc, 0xb9, 0x57, 0xb2, 0xfe,
+	0xd2, 0x2b, 0x65, 0x7e, 0x76, 0x5c, 0xca, 0xfc, 0xfc, 0xb8, 0x64, 0xfd, 0xf2, 0xb8, 0x94, 0xf9,
+	0xd5, 0x71, 0xc9, 0xfa, 0xf5, 0x71, 0x29, 0xf3, 0x9b, 0xe3, 0x52, 0xe6, 0xb7, 0xc7, 0x25, 0xeb,
+	0xb3, 0xe3, 0x92, 0xf5, 0xbb, 0xe3, 0x92, 0xf5, 0xe3, 0xeb, 0x67, 0xad, 0x4a, 0x85, 0x1f, 0xee,
+	0xec, 0x5c, 0x54, 0x33, 0x72, 0xeb, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xce, 0x07, 0xfe, 0xec,
+	0xd6, 0x26, 0x00, 0x00,
 }
@@ -95,6 +95,11 @@ func (this *MACSettings) Validate() error {
 	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.StatusTimePeriodicity)); err != nil {
 		return github_com_mwitkow_go_proto_validators.FieldError("StatusTimePeriodicity", err)
 	}
+	if this.JoinAcceptDLSettings != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.JoinAcceptDLSettings); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("JoinAcceptDLSettings", err)
+		}
+	}
 	return nil
 }
 func (this *MACState) Validate() error {
@@ -254,6 +259,13 @@ func (this *EndDevice) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("ProvisioningData", err)
 		}
 	}
+	for _, item := range this.Webhooks {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Webhooks", err)
+			}
+		}
+	}
 	return nil
 }
 func (this *EndDevices) Validate() error {
@@ -1089,6 +1089,9 @@ func (dst *CreateGatewayAPIKeyRequest) SetFields(src *CreateGatewayAPIKeyRequest
 
 var UpdateGatewayAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.deleted_at",
+	"api_key.entity_scope",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
 	"api_key.name",
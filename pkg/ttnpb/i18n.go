@@ -159,4 +159,5 @@ func init() {
 	defineEnum(RIGHT_SEND_INVITES, "send user invites")
 
 	defineEnum(RIGHT_ALL, "all possible rights")
+	defineEnum(RIGHT_ALL_READ, "all read rights")
 }
@@ -8,6 +8,9 @@ var AuthInfoResponseFieldPathsNested = []string{
 	"access_method",
 	"access_method.api_key",
 	"access_method.api_key.api_key",
+	"access_method.api_key.api_key.deleted_at",
+	"access_method.api_key.api_key.entity_scope",
+	"access_method.api_key.api_key.expires_at",
 	"access_method.api_key.api_key.id",
 	"access_method.api_key.api_key.key",
 	"access_method.api_key.api_key.name",
@@ -157,6 +160,9 @@ func (dst *AuthInfoResponse) SetFields(src *AuthInfoResponse, paths ...string) e
 
 var AuthInfoResponse_APIKeyAccessFieldPathsNested = []string{
 	"api_key",
+	"api_key.deleted_at",
+	"api_key.entity_scope",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
 	"api_key.name",
@@ -235,3 +241,288 @@ func (dst *AuthInfoResponse_APIKeyAccess) SetFields(src *AuthInfoResponse_APIKey
 	}
 	return nil
 }
+
+var RotateAPIKeyRequestFieldPathsNested = []string{
+	"api_key_id",
+	"entity_ids",
+	"entity_ids.ids",
+	"entity_ids.ids.application_ids",
+	"entity_ids.ids.application_ids.application_id",
+	"entity_ids.ids.client_ids",
+	"entity_ids.ids.client_ids.client_id",
+	"entity_ids.ids.device_ids",
+	"entity_ids.ids.device_ids.application_ids",
+	"entity_ids.ids.device_ids.application_ids.application_id",
+	"entity_ids.ids.device_ids.dev_addr",
+	"entity_ids.ids.device_ids.dev_eui",
+	"entity_ids.ids.device_ids.device_id",
+	"entity_ids.ids.device_ids.join_eui",
+	"entity_ids.ids.gateway_ids",
+	"entity_ids.ids.gateway_ids.eui",
+	"entity_ids.ids.gateway_ids.gateway_id",
+	"entity_ids.ids.organization_ids",
+	"entity_ids.ids.organization_ids.organization_id",
+	"entity_ids.ids.user_ids",
+	"entity_ids.ids.user_ids.email",
+	"entity_ids.ids.user_ids.user_id",
+}
+
+var RotateAPIKeyRequestFieldPathsTopLevel = []string{
+	"api_key_id",
+	"entity_ids",
+}
+
+func (dst *RotateAPIKeyRequest) SetFields(src *RotateAPIKeyRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "entity_ids":
+			if len(subs) > 0 {
+				newDst := dst.EntityIDs
+				if newDst == nil {
+					newDst = &EntityIdentifiers{}
+					dst.EntityIDs = newDst
+				}
+				var newSrc *EntityIdentifiers
+				if src != nil {
+					newSrc = src.EntityIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EntityIDs = src.EntityIDs
+				} else {
+					dst.EntityIDs = nil
+				}
+			}
+		case "api_key_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'api_key_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.APIKeyID = src.APIKeyID
+			} else {
+				var zero string
+				dst.APIKeyID = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var GetAPIKeyAccessRequestFieldPathsNested = []string{
+	"api_key_id",
+}
+
+var GetAPIKeyAccessRequestFieldPathsTopLevel = []string{
+	"api_key_id",
+}
+
+func (dst *GetAPIKeyAccessRequest) SetFields(src *GetAPIKeyAccessRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "api_key_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'api_key_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.APIKeyID = src.APIKeyID
+			} else {
+				var zero string
+				dst.APIKeyID = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var EntityRightsFieldPathsNested = []string{
+	"entity_ids",
+	"entity_ids.ids",
+	"entity_ids.ids.application_ids",
+	"entity_ids.ids.application_ids.application_id",
+	"entity_ids.ids.client_ids",
+	"entity_ids.ids.client_ids.client_id",
+	"entity_ids.ids.device_ids",
+	"entity_ids.ids.device_ids.application_ids",
+	"entity_ids.ids.device_ids.application_ids.application_id",
+	"entity_ids.ids.device_ids.dev_addr",
+	"entity_ids.ids.device_ids.dev_eui",
+	"entity_ids.ids.device_ids.device_id",
+	"entity_ids.ids.device_ids.join_eui",
+	"entity_ids.ids.gateway_ids",
+	"entity_ids.ids.gateway_ids.eui",
+	"entity_ids.ids.gateway_ids.gateway_id",
+	"entity_ids.ids.organization_ids",
+	"entity_ids.ids.organization_ids.organization_id",
+	"entity_ids.ids.user_ids",
+	"entity_ids.ids.user_ids.email",
+	"entity_ids.ids.user_ids.user_id",
+	"rights",
+	"rights.rights",
+}
+
+var EntityRightsFieldPathsTopLevel = []string{
+	"entity_ids",
+	"rights",
+}
+
+func (dst *EntityRights) SetFields(src *EntityRights, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "entity_ids":
+			if len(subs) > 0 {
+				newDst := dst.EntityIDs
+				if newDst == nil {
+					newDst = &EntityIdentifiers{}
+					dst.EntityIDs = newDst
+				}
+				var newSrc *EntityIdentifiers
+				if src != nil {
+					newSrc = src.EntityIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EntityIDs = src.EntityIDs
+				} else {
+					dst.EntityIDs = nil
+				}
+			}
+		case "rights":
+			if len(subs) > 0 {
+				newDst := dst.Rights
+				if newDst == nil {
+					newDst = &Rights{}
+					dst.Rights = newDst
+				}
+				var newSrc *Rights
+				if src != nil {
+					newSrc = src.Rights
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Rights = src.Rights
+				} else {
+					dst.Rights = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var APIKeyAccessFieldPathsNested = []string{
+	"entities",
+}
+
+var APIKeyAccessFieldPathsTopLevel = []string{
+	"entities",
+}
+
+func (dst *APIKeyAccess) SetFields(src *APIKeyAccess, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "entities":
+			if len(subs) > 0 {
+				return fmt.Errorf("'entities' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Entities = src.Entities
+			} else {
+				dst.Entities = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var TransferAPIKeyRequestFieldPathsNested = []string{
+	"api_key_id",
+	"new_owner_ids",
+	"new_owner_ids.ids",
+	"new_owner_ids.ids.application_ids",
+	"new_owner_ids.ids.application_ids.application_id",
+	"new_owner_ids.ids.client_ids",
+	"new_owner_ids.ids.client_ids.client_id",
+	"new_owner_ids.ids.device_ids",
+	"new_owner_ids.ids.device_ids.application_ids",
+	"new_owner_ids.ids.device_ids.application_ids.application_id",
+	"new_owner_ids.ids.device_ids.dev_addr",
+	"new_owner_ids.ids.device_ids.dev_eui",
+	"new_owner_ids.ids.device_ids.device_id",
+	"new_owner_ids.ids.device_ids.join_eui",
+	"new_owner_ids.ids.gateway_ids",
+	"new_owner_ids.ids.gateway_ids.eui",
+	"new_owner_ids.ids.gateway_ids.gateway_id",
+	"new_owner_ids.ids.organization_ids",
+	"new_owner_ids.ids.organization_ids.organization_id",
+	"new_owner_ids.ids.user_ids",
+	"new_owner_ids.ids.user_ids.email",
+	"new_owner_ids.ids.user_ids.user_id",
+}
+
+var TransferAPIKeyRequestFieldPathsTopLevel = []string{
+	"api_key_id",
+	"new_owner_ids",
+}
+
+func (dst *TransferAPIKeyRequest) SetFields(src *TransferAPIKeyRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "api_key_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'api_key_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.APIKeyID = src.APIKeyID
+			} else {
+				var zero string
+				dst.APIKeyID = zero
+			}
+		case "new_owner_ids":
+			if len(subs) > 0 {
+				newDst := dst.NewOwnerIDs
+				if newDst == nil {
+					newDst = &EntityIdentifiers{}
+					dst.NewOwnerIDs = newDst
+				}
+				var newSrc *EntityIdentifiers
+				if src != nil {
+					newSrc = src.NewOwnerIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.NewOwnerIDs = src.NewOwnerIDs
+				} else {
+					dst.NewOwnerIDs = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -47,7 +47,7 @@ type AuthInfoResponse struct {
 func (m *AuthInfoResponse) Reset()      { *m = AuthInfoResponse{} }
 func (*AuthInfoResponse) ProtoMessage() {}
 func (*AuthInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_identityserver_f7dc84ee4809122e, []int{0}
+	return fileDescriptor_identityserver_d32ba8e022178a81, []int{0}
 }
 func (m *AuthInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -205,7 +205,7 @@ type AuthInfoResponse_APIKeyAccess struct {
 func (m *AuthInfoResponse_APIKeyAccess) Reset()      { *m = AuthInfoResponse_APIKeyAccess{} }
 func (*AuthInfoResponse_APIKeyAccess) ProtoMessage() {}
 func (*AuthInfoResponse_APIKeyAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_identityserver_f7dc84ee4809122e, []int{0, 0}
+	return fileDescriptor_identityserver_d32ba8e022178a81, []int{0, 0}
 }
 func (m *AuthInfoResponse_APIKeyAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,11 +241,271 @@ func (m *AuthInfoResponse_APIKeyAccess) GetEntityIDs() EntityIdentifiers {
 	return EntityIdentifiers{}
 }
 
+type RotateAPIKeyRequest struct {
+	EntityIDs            *EntityIdentifiers `protobuf:"bytes,1,opt,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
+	APIKeyID             string             `protobuf:"bytes,2,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *RotateAPIKeyRequest) Reset()      { *m = RotateAPIKeyRequest{} }
+func (*RotateAPIKeyRequest) ProtoMessage() {}
+func (*RotateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_identityserver_d32ba8e022178a81, []int{1}
+}
+func (m *RotateAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateAPIKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RotateAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateAPIKeyRequest.Merge(dst, src)
+}
+func (m *RotateAPIKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateAPIKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateAPIKeyRequest proto.InternalMessageInfo
+
+func (m *RotateAPIKeyRequest) GetEntityIDs() *EntityIdentifiers {
+	if m != nil {
+		return m.EntityIDs
+	}
+	return nil
+}
+
+func (m *RotateAPIKeyRequest) GetAPIKeyID() string {
+	if m != nil {
+		return m.APIKeyID
+	}
+	return ""
+}
+
+type GetAPIKeyAccessRequest struct {
+	APIKeyID             string   `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAPIKeyAccessRequest) Reset()      { *m = GetAPIKeyAccessRequest{} }
+func (*GetAPIKeyAccessRequest) ProtoMessage() {}
+func (*GetAPIKeyAccessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_identityserver_d32ba8e022178a81, []int{2}
+}
+func (m *GetAPIKeyAccessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAPIKeyAccessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAPIKeyAccessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetAPIKeyAccessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAPIKeyAccessRequest.Merge(dst, src)
+}
+func (m *GetAPIKeyAccessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAPIKeyAccessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAPIKeyAccessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAPIKeyAccessRequest proto.InternalMessageInfo
+
+func (m *GetAPIKeyAccessRequest) GetAPIKeyID() string {
+	if m != nil {
+		return m.APIKeyID
+	}
+	return ""
+}
+
+type EntityRights struct {
+	EntityIDs            *EntityIdentifiers `protobuf:"bytes,1,opt,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
+	Rights               *Rights            `protobuf:"bytes,2,opt,name=rights,proto3" json:"rights,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *EntityRights) Reset()      { *m = EntityRights{} }
+func (*EntityRights) ProtoMessage() {}
+func (*EntityRights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_identityserver_d32ba8e022178a81, []int{3}
+}
+func (m *EntityRights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntityRights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntityRights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *EntityRights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntityRights.Merge(dst, src)
+}
+func (m *EntityRights) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntityRights) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntityRights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntityRights proto.InternalMessageInfo
+
+func (m *EntityRights) GetEntityIDs() *EntityIdentifiers {
+	if m != nil {
+		return m.EntityIDs
+	}
+	return nil
+}
+
+func (m *EntityRights) GetRights() *Rights {
+	if m != nil {
+		return m.Rights
+	}
+	return nil
+}
+
+type APIKeyAccess struct {
+	Entities             []*EntityRights `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *APIKeyAccess) Reset()      { *m = APIKeyAccess{} }
+func (*APIKeyAccess) ProtoMessage() {}
+func (*APIKeyAccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_identityserver_d32ba8e022178a81, []int{4}
+}
+func (m *APIKeyAccess) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *APIKeyAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_APIKeyAccess.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *APIKeyAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIKeyAccess.Merge(dst, src)
+}
+func (m *APIKeyAccess) XXX_Size() int {
+	return m.Size()
+}
+func (m *APIKeyAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIKeyAccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIKeyAccess proto.InternalMessageInfo
+
+func (m *APIKeyAccess) GetEntities() []*EntityRights {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
+type TransferAPIKeyRequest struct {
+	APIKeyID string `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	// The entity to transfer the API key to. This must be an entity of the same type as the current owner.
+	NewOwnerIDs          *EntityIdentifiers `protobuf:"bytes,2,opt,name=new_owner_ids,json=newOwnerIds,proto3" json:"new_owner_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TransferAPIKeyRequest) Reset()      { *m = TransferAPIKeyRequest{} }
+func (*TransferAPIKeyRequest) ProtoMessage() {}
+func (*TransferAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_identityserver_d32ba8e022178a81, []int{5}
+}
+func (m *TransferAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferAPIKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TransferAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferAPIKeyRequest.Merge(dst, src)
+}
+func (m *TransferAPIKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferAPIKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferAPIKeyRequest proto.InternalMessageInfo
+
+func (m *TransferAPIKeyRequest) GetAPIKeyID() string {
+	if m != nil {
+		return m.APIKeyID
+	}
+	return ""
+}
+
+func (m *TransferAPIKeyRequest) GetNewOwnerIDs() *EntityIdentifiers {
+	if m != nil {
+		return m.NewOwnerIDs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AuthInfoResponse)(nil), "ttn.lorawan.v3.AuthInfoResponse")
 	golang_proto.RegisterType((*AuthInfoResponse)(nil), "ttn.lorawan.v3.AuthInfoResponse")
 	proto.RegisterType((*AuthInfoResponse_APIKeyAccess)(nil), "ttn.lorawan.v3.AuthInfoResponse.APIKeyAccess")
 	golang_proto.RegisterType((*AuthInfoResponse_APIKeyAccess)(nil), "ttn.lorawan.v3.AuthInfoResponse.APIKeyAccess")
+	proto.RegisterType((*RotateAPIKeyRequest)(nil), "ttn.lorawan.v3.RotateAPIKeyRequest")
+	golang_proto.RegisterType((*RotateAPIKeyRequest)(nil), "ttn.lorawan.v3.RotateAPIKeyRequest")
+	proto.RegisterType((*GetAPIKeyAccessRequest)(nil), "ttn.lorawan.v3.GetAPIKeyAccessRequest")
+	golang_proto.RegisterType((*GetAPIKeyAccessRequest)(nil), "ttn.lorawan.v3.GetAPIKeyAccessRequest")
+	proto.RegisterType((*EntityRights)(nil), "ttn.lorawan.v3.EntityRights")
+	golang_proto.RegisterType((*EntityRights)(nil), "ttn.lorawan.v3.EntityRights")
+	proto.RegisterType((*APIKeyAccess)(nil), "ttn.lorawan.v3.APIKeyAccess")
+	golang_proto.RegisterType((*APIKeyAccess)(nil), "ttn.lorawan.v3.APIKeyAccess")
+	proto.RegisterType((*TransferAPIKeyRequest)(nil), "ttn.lorawan.v3.TransferAPIKeyRequest")
+	golang_proto.RegisterType((*TransferAPIKeyRequest)(nil), "ttn.lorawan.v3.TransferAPIKeyRequest")
 }
 func (this *AuthInfoResponse) Equal(that interface{}) bool {
 	if that == nil {
@@ -355,6 +615,140 @@ func (this *AuthInfoResponse_APIKeyAccess) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RotateAPIKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RotateAPIKeyRequest)
+	if !ok {
+		that2, ok := that.(RotateAPIKeyRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EntityIDs.Equal(that1.EntityIDs) {
+		return false
+	}
+	if this.APIKeyID != that1.APIKeyID {
+		return false
+	}
+	return true
+}
+func (this *GetAPIKeyAccessRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetAPIKeyAccessRequest)
+	if !ok {
+		that2, ok := that.(GetAPIKeyAccessRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.APIKeyID != that1.APIKeyID {
+		return false
+	}
+	return true
+}
+func (this *EntityRights) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EntityRights)
+	if !ok {
+		that2, ok := that.(EntityRights)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EntityIDs.Equal(that1.EntityIDs) {
+		return false
+	}
+	if !this.Rights.Equal(that1.Rights) {
+		return false
+	}
+	return true
+}
+func (this *APIKeyAccess) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*APIKeyAccess)
+	if !ok {
+		that2, ok := that.(APIKeyAccess)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Entities) != len(that1.Entities) {
+		return false
+	}
+	for i := range this.Entities {
+		if !this.Entities[i].Equal(that1.Entities[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *TransferAPIKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransferAPIKeyRequest)
+	if !ok {
+		that2, ok := that.(TransferAPIKeyRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.APIKeyID != that1.APIKeyID {
+		return false
+	}
+	if !this.NewOwnerIDs.Equal(that1.NewOwnerIDs) {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -370,6 +764,15 @@ const _ = grpc.SupportPackageIsVersion4
 type EntityAccessClient interface {
 	// AuthInfo returns information about the authentication that is used on the request.
 	AuthInfo(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*AuthInfoResponse, error)
+	// RotateAPIKey generates a new secret for the API key, keeping its ID and rights.
+	// The old secret stops authenticating. The new secret is only returned in this response.
+	RotateAPIKey(ctx context.Context, in *RotateAPIKeyRequest, opts ...grpc.CallOption) (*APIKey, error)
+	// GetAPIKeyAccess returns the entities that the API key can access, with the rights that it has on each of them.
+	// Entities on which the API key has no rights are left out.
+	GetAPIKeyAccess(ctx context.Context, in *GetAPIKeyAccessRequest, opts ...grpc.CallOption) (*APIKeyAccess, error)
+	// TransferAPIKey transfers the API key to a new owner, keeping its ID, secret and rights.
+	// The new owner must have at least the rights of the API key on the entities that the current owner is a member of.
+	TransferAPIKey(ctx context.Context, in *TransferAPIKeyRequest, opts ...grpc.CallOption) (*APIKey, error)
 }
 
 type entityAccessClient struct {
@@ -389,10 +792,46 @@ func (c *entityAccessClient) AuthInfo(ctx context.Context, in *types.Empty, opts
 	return out, nil
 }
 
-// EntityAccessServer is the server API for EntityAccess service.
-type EntityAccessServer interface {
-	// AuthInfo returns information about the authentication that is used on the request.
+func (c *entityAccessClient) RotateAPIKey(ctx context.Context, in *RotateAPIKeyRequest, opts ...grpc.CallOption) (*APIKey, error) {
+	out := new(APIKey)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.EntityAccess/RotateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityAccessClient) GetAPIKeyAccess(ctx context.Context, in *GetAPIKeyAccessRequest, opts ...grpc.CallOption) (*APIKeyAccess, error) {
+	out := new(APIKeyAccess)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.EntityAccess/GetAPIKeyAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityAccessClient) TransferAPIKey(ctx context.Context, in *TransferAPIKeyRequest, opts ...grpc.CallOption) (*APIKey, error) {
+	out := new(APIKey)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.EntityAccess/TransferAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EntityAccessServer is the server API for EntityAccess service.
+type EntityAccessServer interface {
+	// AuthInfo returns information about the authentication that is used on the request.
 	AuthInfo(context.Context, *types.Empty) (*AuthInfoResponse, error)
+	// RotateAPIKey generates a new secret for the API key, keeping its ID and rights.
+	// The old secret stops authenticating. The new secret is only returned in this response.
+	RotateAPIKey(context.Context, *RotateAPIKeyRequest) (*APIKey, error)
+	// GetAPIKeyAccess returns the entities that the API key can access, with the rights that it has on each of them.
+	// Entities on which the API key has no rights are left out.
+	GetAPIKeyAccess(context.Context, *GetAPIKeyAccessRequest) (*APIKeyAccess, error)
+	// TransferAPIKey transfers the API key to a new owner, keeping its ID, secret and rights.
+	// The new owner must have at least the rights of the API key on the entities that the current owner is a member of.
+	TransferAPIKey(context.Context, *TransferAPIKeyRequest) (*APIKey, error)
 }
 
 func RegisterEntityAccessServer(s *grpc.Server, srv EntityAccessServer) {
@@ -417,6 +856,60 @@ func _EntityAccess_AuthInfo_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EntityAccess_RotateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityAccessServer).RotateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.EntityAccess/RotateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityAccessServer).RotateAPIKey(ctx, req.(*RotateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityAccess_GetAPIKeyAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAPIKeyAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityAccessServer).GetAPIKeyAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.EntityAccess/GetAPIKeyAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityAccessServer).GetAPIKeyAccess(ctx, req.(*GetAPIKeyAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityAccess_TransferAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityAccessServer).TransferAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.EntityAccess/TransferAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityAccessServer).TransferAPIKey(ctx, req.(*TransferAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _EntityAccess_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.EntityAccess",
 	HandlerType: (*EntityAccessServer)(nil),
@@ -425,6 +918,18 @@ var _EntityAccess_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AuthInfo",
 			Handler:    _EntityAccess_AuthInfo_Handler,
 		},
+		{
+			MethodName: "RotateAPIKey",
+			Handler:    _EntityAccess_RotateAPIKey_Handler,
+		},
+		{
+			MethodName: "GetAPIKeyAccess",
+			Handler:    _EntityAccess_GetAPIKeyAccess_Handler,
+		},
+		{
+			MethodName: "TransferAPIKey",
+			Handler:    _EntityAccess_TransferAPIKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/identityserver.proto",
@@ -527,6 +1032,166 @@ func (m *AuthInfoResponse_APIKeyAccess) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *RotateAPIKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.EntityIDs != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintIdentityserver(dAtA, i, uint64(m.EntityIDs.Size()))
+		n7, err := m.EntityIDs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if len(m.APIKeyID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintIdentityserver(dAtA, i, uint64(len(m.APIKeyID)))
+		i += copy(dAtA[i:], m.APIKeyID)
+	}
+	return i, nil
+}
+
+func (m *GetAPIKeyAccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAPIKeyAccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.APIKeyID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintIdentityserver(dAtA, i, uint64(len(m.APIKeyID)))
+		i += copy(dAtA[i:], m.APIKeyID)
+	}
+	return i, nil
+}
+
+func (m *EntityRights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntityRights) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.EntityIDs != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintIdentityserver(dAtA, i, uint64(m.EntityIDs.Size()))
+		n8, err := m.EntityIDs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.Rights != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintIdentityserver(dAtA, i, uint64(m.Rights.Size()))
+		n9, err := m.Rights.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+
+func (m *APIKeyAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *APIKeyAccess) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entities) > 0 {
+		for _, msg := range m.Entities {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintIdentityserver(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *TransferAPIKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.APIKeyID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintIdentityserver(dAtA, i, uint64(len(m.APIKeyID)))
+		i += copy(dAtA[i:], m.APIKeyID)
+	}
+	if m.NewOwnerIDs != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintIdentityserver(dAtA, i, uint64(m.NewOwnerIDs.Size()))
+		n10, err := m.NewOwnerIDs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+
 func encodeVarintIdentityserver(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -574,6 +1239,63 @@ func NewPopulatedAuthInfoResponse_APIKeyAccess(r randyIdentityserver, easy bool)
 	return this
 }
 
+func NewPopulatedRotateAPIKeyRequest(r randyIdentityserver, easy bool) *RotateAPIKeyRequest {
+	this := &RotateAPIKeyRequest{}
+	if r.Intn(10) != 0 {
+		this.EntityIDs = NewPopulatedEntityIdentifiers(r, easy)
+	}
+	this.APIKeyID = randStringIdentityserver(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetAPIKeyAccessRequest(r randyIdentityserver, easy bool) *GetAPIKeyAccessRequest {
+	this := &GetAPIKeyAccessRequest{}
+	this.APIKeyID = randStringIdentityserver(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEntityRights(r randyIdentityserver, easy bool) *EntityRights {
+	this := &EntityRights{}
+	if r.Intn(10) != 0 {
+		this.EntityIDs = NewPopulatedEntityIdentifiers(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Rights = NewPopulatedRights(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAPIKeyAccess(r randyIdentityserver, easy bool) *APIKeyAccess {
+	this := &APIKeyAccess{}
+	if r.Intn(10) != 0 {
+		v3 := r.Intn(5)
+		this.Entities = make([]*EntityRights, v3)
+		for i := 0; i < v3; i++ {
+			this.Entities[i] = NewPopulatedEntityRights(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTransferAPIKeyRequest(r randyIdentityserver, easy bool) *TransferAPIKeyRequest {
+	this := &TransferAPIKeyRequest{}
+	this.APIKeyID = randStringIdentityserver(r)
+	if r.Intn(10) != 0 {
+		this.NewOwnerIDs = NewPopulatedEntityIdentifiers(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyIdentityserver interface {
 	Float32() float32
 	Float64() float64
@@ -593,9 +1315,9 @@ func randUTF8RuneIdentityserver(r randyIdentityserver) rune {
 	return rune(ru + 61)
 }
 func randStringIdentityserver(r randyIdentityserver) string {
-	v3 := r.Intn(100)
-	tmps := make([]rune, v3)
-	for i := 0; i < v3; i++ {
+	v4 := r.Intn(100)
+	tmps := make([]rune, v4)
+	for i := 0; i < v4; i++ {
 		tmps[i] = randUTF8RuneIdentityserver(r)
 	}
 	return string(tmps)
@@ -617,11 +1339,11 @@ func randFieldIdentityserver(dAtA []byte, r randyIdentityserver, fieldNumber int
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateIdentityserver(dAtA, uint64(key))
-		v4 := r.Int63()
+		v5 := r.Int63()
 		if r.Intn(2) == 0 {
-			v4 *= -1
+			v5 *= -1
 		}
-		dAtA = encodeVarintPopulateIdentityserver(dAtA, uint64(v4))
+		dAtA = encodeVarintPopulateIdentityserver(dAtA, uint64(v5))
 	case 1:
 		dAtA = encodeVarintPopulateIdentityserver(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -699,33 +1421,112 @@ func (m *AuthInfoResponse_APIKeyAccess) Size() (n int) {
 	return n
 }
 
-func sovIdentityserver(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *RotateAPIKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntityIDs != nil {
+		l = m.EntityIDs.Size()
+		n += 1 + l + sovIdentityserver(uint64(l))
+	}
+	l = len(m.APIKeyID)
+	if l > 0 {
+		n += 1 + l + sovIdentityserver(uint64(l))
 	}
 	return n
 }
-func sozIdentityserver(x uint64) (n int) {
-	return sovIdentityserver((x << 1) ^ uint64((int64(x) >> 63)))
-}
-func (this *AuthInfoResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetAPIKeyAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&AuthInfoResponse{`,
-		`AccessMethod:` + fmt.Sprintf("%v", this.AccessMethod) + `,`,
-		`UniversalRights:` + strings.Replace(fmt.Sprintf("%v", this.UniversalRights), "Rights", "Rights", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AuthInfoResponse_APIKey) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.APIKeyID)
+	if l > 0 {
+		n += 1 + l + sovIdentityserver(uint64(l))
+	}
+	return n
+}
+
+func (m *EntityRights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntityIDs != nil {
+		l = m.EntityIDs.Size()
+		n += 1 + l + sovIdentityserver(uint64(l))
+	}
+	if m.Rights != nil {
+		l = m.Rights.Size()
+		n += 1 + l + sovIdentityserver(uint64(l))
+	}
+	return n
+}
+
+func (m *APIKeyAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entities) > 0 {
+		for _, e := range m.Entities {
+			l = e.Size()
+			n += 1 + l + sovIdentityserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransferAPIKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.APIKeyID)
+	if l > 0 {
+		n += 1 + l + sovIdentityserver(uint64(l))
+	}
+	if m.NewOwnerIDs != nil {
+		l = m.NewOwnerIDs.Size()
+		n += 1 + l + sovIdentityserver(uint64(l))
+	}
+	return n
+}
+
+func sovIdentityserver(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozIdentityserver(x uint64) (n int) {
+	return sovIdentityserver((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *AuthInfoResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuthInfoResponse{`,
+		`AccessMethod:` + fmt.Sprintf("%v", this.AccessMethod) + `,`,
+		`UniversalRights:` + strings.Replace(fmt.Sprintf("%v", this.UniversalRights), "Rights", "Rights", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuthInfoResponse_APIKey) String() string {
+	if this == nil {
+		return "nil"
 	}
 	s := strings.Join([]string{`&AuthInfoResponse_APIKey{`,
 		`APIKey:` + strings.Replace(fmt.Sprintf("%v", this.APIKey), "AuthInfoResponse_APIKeyAccess", "AuthInfoResponse_APIKeyAccess", 1) + `,`,
@@ -747,22 +1548,332 @@ func (this *AuthInfoResponse_APIKeyAccess) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&AuthInfoResponse_APIKeyAccess{`,
-		`APIKey:` + strings.Replace(strings.Replace(this.APIKey.String(), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
-		`EntityIDs:` + strings.Replace(strings.Replace(this.EntityIDs.String(), "EntityIdentifiers", "EntityIdentifiers", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringIdentityserver(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	s := strings.Join([]string{`&AuthInfoResponse_APIKeyAccess{`,
+		`APIKey:` + strings.Replace(strings.Replace(this.APIKey.String(), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
+		`EntityIDs:` + strings.Replace(strings.Replace(this.EntityIDs.String(), "EntityIdentifiers", "EntityIdentifiers", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RotateAPIKeyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RotateAPIKeyRequest{`,
+		`EntityIDs:` + strings.Replace(fmt.Sprintf("%v", this.EntityIDs), "EntityIdentifiers", "EntityIdentifiers", 1) + `,`,
+		`APIKeyID:` + fmt.Sprintf("%v", this.APIKeyID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetAPIKeyAccessRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetAPIKeyAccessRequest{`,
+		`APIKeyID:` + fmt.Sprintf("%v", this.APIKeyID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EntityRights) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EntityRights{`,
+		`EntityIDs:` + strings.Replace(fmt.Sprintf("%v", this.EntityIDs), "EntityIdentifiers", "EntityIdentifiers", 1) + `,`,
+		`Rights:` + strings.Replace(fmt.Sprintf("%v", this.Rights), "Rights", "Rights", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *APIKeyAccess) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&APIKeyAccess{`,
+		`Entities:` + strings.Replace(fmt.Sprintf("%v", this.Entities), "EntityRights", "EntityRights", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransferAPIKeyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransferAPIKeyRequest{`,
+		`APIKeyID:` + fmt.Sprintf("%v", this.APIKeyID) + `,`,
+		`NewOwnerIDs:` + strings.Replace(fmt.Sprintf("%v", this.NewOwnerIDs), "EntityIdentifiers", "EntityIdentifiers", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringIdentityserver(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AuthInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentityserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AuthInfoResponse_APIKeyAccess{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.AccessMethod = &AuthInfoResponse_APIKey{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OAuthAccessToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &OAuthAccessToken{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.AccessMethod = &AuthInfoResponse_OAuthAccessToken{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniversalRights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UniversalRights == nil {
+				m.UniversalRights = &Rights{}
+			}
+			if err := m.UniversalRights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentityserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthInfoResponse_APIKeyAccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentityserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: APIKeyAccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: APIKeyAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.APIKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EntityIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentityserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *AuthInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *RotateAPIKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -785,15 +1896,15 @@ func (m *AuthInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RotateAPIKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RotateAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityIDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -817,15 +1928,174 @@ func (m *AuthInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AuthInfoResponse_APIKeyAccess{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.EntityIDs == nil {
+				m.EntityIDs = &EntityIdentifiers{}
+			}
+			if err := m.EntityIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.AccessMethod = &AuthInfoResponse_APIKey{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OAuthAccessToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIKeyID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentityserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAPIKeyAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentityserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAPIKeyAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAPIKeyAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIKeyID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentityserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EntityRights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentityserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntityRights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntityRights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityIDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -849,15 +2119,16 @@ func (m *AuthInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &OAuthAccessToken{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.EntityIDs == nil {
+				m.EntityIDs = &EntityIdentifiers{}
+			}
+			if err := m.EntityIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.AccessMethod = &AuthInfoResponse_OAuthAccessToken{v}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UniversalRights", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -881,10 +2152,10 @@ func (m *AuthInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UniversalRights == nil {
-				m.UniversalRights = &Rights{}
+			if m.Rights == nil {
+				m.Rights = &Rights{}
 			}
-			if err := m.UniversalRights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -909,7 +2180,7 @@ func (m *AuthInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthInfoResponse_APIKeyAccess) Unmarshal(dAtA []byte) error {
+func (m *APIKeyAccess) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -940,7 +2211,7 @@ func (m *AuthInfoResponse_APIKeyAccess) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entities", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -964,13 +2235,93 @@ func (m *AuthInfoResponse_APIKeyAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.APIKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entities = append(m.Entities, &EntityRights{})
+			if err := m.Entities[len(m.Entities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentityserver(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if skippy < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferAPIKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentityserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferAPIKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIKeyID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EntityIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwnerIDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -994,7 +2345,10 @@ func (m *AuthInfoResponse_APIKeyAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EntityIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NewOwnerIDs == nil {
+				m.NewOwnerIDs = &EntityIdentifiers{}
+			}
+			if err := m.NewOwnerIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1125,49 +2479,66 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("lorawan-stack/api/identityserver.proto", fileDescriptor_identityserver_f7dc84ee4809122e)
+	proto.RegisterFile("lorawan-stack/api/identityserver.proto", fileDescriptor_identityserver_d32ba8e022178a81)
 }
 func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/identityserver.proto", fileDescriptor_identityserver_f7dc84ee4809122e)
-}
-
-var fileDescriptor_identityserver_f7dc84ee4809122e = []byte{
-	// 581 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x3f, 0x48, 0x1c, 0x4f,
-	0x14, 0x9e, 0x51, 0xf0, 0xa7, 0xf3, 0x33, 0xf1, 0xb2, 0x04, 0x91, 0x4b, 0xf2, 0xce, 0x18, 0x08,
-	0x29, 0xe2, 0x2c, 0x68, 0x95, 0xf2, 0x96, 0x08, 0x8a, 0x45, 0xc2, 0xc6, 0x22, 0xa4, 0x39, 0xf6,
-	0xce, 0xb9, 0xdd, 0xe1, 0x74, 0x66, 0xd9, 0x99, 0x53, 0xae, 0x93, 0x54, 0x96, 0x81, 0x34, 0xa9,
-	0x42, 0x48, 0x65, 0x69, 0x69, 0x69, 0x13, 0xb0, 0x14, 0xd2, 0x58, 0x1d, 0xee, 0x6c, 0x0a, 0x4b,
-	0x4b, 0xcb, 0x70, 0xb3, 0x7b, 0x17, 0xdd, 0x23, 0xa4, 0x9b, 0x79, 0xdf, 0xf7, 0xbe, 0xf7, 0xef,
-	0x23, 0xcf, 0x77, 0x64, 0x12, 0xec, 0x07, 0x62, 0x59, 0xe9, 0xa0, 0xd5, 0x71, 0x83, 0x98, 0xbb,
-	0x7c, 0x9b, 0x09, 0xcd, 0x75, 0x4f, 0xb1, 0x64, 0x8f, 0x25, 0x34, 0x4e, 0xa4, 0x96, 0xce, 0x7d,
-	0xad, 0x05, 0x2d, 0xb8, 0x74, 0x6f, 0xb5, 0xba, 0x1c, 0x72, 0x1d, 0x75, 0x9b, 0xb4, 0x25, 0x77,
-	0xdd, 0x50, 0x86, 0xd2, 0xb5, 0xb4, 0x66, 0xb7, 0x6d, 0x7f, 0xf6, 0x63, 0x5f, 0x79, 0x7a, 0xf5,
-	0x71, 0x28, 0x65, 0xb8, 0xc3, 0xac, 0x7e, 0x20, 0x84, 0xd4, 0x81, 0xe6, 0x52, 0xa8, 0x02, 0x7d,
-	0x54, 0xa0, 0x23, 0x0d, 0xb6, 0x1b, 0xeb, 0x5e, 0x01, 0x3e, 0xfb, 0x5b, 0x87, 0x6d, 0xce, 0x92,
-	0xa1, 0xc2, 0x93, 0x71, 0x92, 0x0c, 0xba, 0x3a, 0x2a, 0x60, 0x18, 0x87, 0x13, 0x1e, 0x46, 0xba,
-	0x48, 0x5f, 0xfa, 0x31, 0x49, 0x2a, 0xf5, 0xae, 0x8e, 0x36, 0x44, 0x5b, 0xfa, 0x4c, 0xc5, 0x52,
-	0x28, 0xe6, 0x6c, 0x91, 0xff, 0x82, 0x98, 0x37, 0x3a, 0xac, 0xb7, 0x80, 0x17, 0xf1, 0x8b, 0xff,
-	0x57, 0x96, 0xe9, 0xdd, 0x25, 0xd0, 0x72, 0x0a, 0xad, 0xbf, 0xdd, 0xd8, 0x64, 0xbd, 0x7a, 0xab,
-	0xc5, 0x94, 0xf2, 0x88, 0xe9, 0xd7, 0xa6, 0xf2, 0xc8, 0x3a, 0xf2, 0xa7, 0x82, 0x98, 0x6f, 0xb2,
-	0x9e, 0xd3, 0x26, 0x8e, 0xed, 0xac, 0x11, 0x58, 0x56, 0x43, 0xcb, 0x0e, 0x13, 0x0b, 0x13, 0xb6,
-	0xc0, 0x62, 0xb9, 0xc0, 0x9b, 0x41, 0x85, 0x5c, 0x6e, 0x6b, 0xc0, 0xf3, 0x1e, 0x9a, 0x7e, 0xad,
-	0x52, 0x8e, 0xae, 0x23, 0xbf, 0x62, 0x35, 0x6f, 0xc5, 0x9c, 0x3a, 0xa9, 0x74, 0x05, 0xdf, 0x63,
-	0x89, 0x0a, 0x76, 0x1a, 0xf9, 0xb0, 0x0b, 0x93, 0xb6, 0xca, 0x7c, 0xb9, 0x8a, 0x6f, 0x51, 0x7f,
-	0x6e, 0xc4, 0xcf, 0x03, 0xd5, 0xaf, 0x98, 0xcc, 0xde, 0x9e, 0xc8, 0x79, 0x55, 0xde, 0xc8, 0x98,
-	0x54, 0x4e, 0xf7, 0xa6, 0xcf, 0xfa, 0x35, 0x74, 0xde, 0xaf, 0xe1, 0xd1, 0xd8, 0xef, 0x08, 0xc9,
-	0x5d, 0xd5, 0xe0, 0xdb, 0xaa, 0x18, 0xf7, 0x69, 0x39, 0x7b, 0xcd, 0x32, 0x36, 0xfe, 0x5c, 0xd7,
-	0x7b, 0x30, 0x10, 0x32, 0xfd, 0xda, 0x4c, 0x01, 0xbd, 0x56, 0xfe, 0x0c, 0x2b, 0x58, 0xca, 0x9b,
-	0x23, 0xf7, 0x8a, 0x2d, 0xee, 0x32, 0x1d, 0xc9, 0xed, 0x95, 0x88, 0xcc, 0xe6, 0xc4, 0xa2, 0xe1,
-	0xf7, 0x64, 0x7a, 0x78, 0x23, 0x67, 0x9e, 0xe6, 0x2e, 0xa3, 0x43, 0x97, 0xd1, 0xb5, 0x81, 0xcb,
-	0xaa, 0x8b, 0xff, 0xba, 0xea, 0x92, 0xf3, 0xf1, 0xe7, 0xaf, 0xcf, 0x13, 0xb3, 0x0e, 0x71, 0xed,
-	0xe1, 0xb8, 0x68, 0x4b, 0xef, 0x3b, 0x3e, 0x4b, 0x01, 0x9f, 0xa7, 0x80, 0x2f, 0x52, 0x40, 0x97,
-	0x29, 0xa0, 0xab, 0x14, 0xd0, 0x75, 0x0a, 0xe8, 0x26, 0x05, 0x7c, 0x60, 0x00, 0x1f, 0x1a, 0x40,
-	0x47, 0x06, 0xf0, 0xb1, 0x01, 0x74, 0x62, 0x00, 0x9d, 0x1a, 0x40, 0x67, 0x06, 0xf0, 0xb9, 0x01,
-	0x7c, 0x61, 0x00, 0x5d, 0x1a, 0xc0, 0x57, 0x06, 0xd0, 0xb5, 0x01, 0x7c, 0x63, 0x00, 0x1d, 0x64,
-	0x80, 0x0e, 0x33, 0xc0, 0x9f, 0x32, 0x40, 0x5f, 0x32, 0xc0, 0xdf, 0x32, 0x40, 0x47, 0x19, 0xa0,
-	0xe3, 0x0c, 0xf0, 0x49, 0x06, 0xf8, 0x34, 0x03, 0xfc, 0xe1, 0x65, 0x28, 0xa9, 0x8e, 0x98, 0x8e,
-	0xb8, 0x08, 0x15, 0x15, 0x4c, 0xef, 0xcb, 0xa4, 0xe3, 0xde, 0x75, 0x77, 0xdc, 0x09, 0x5d, 0xad,
-	0x45, 0xdc, 0x6c, 0x4e, 0xd9, 0x51, 0x57, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xea, 0xef, 0xf4,
-	0xeb, 0xe5, 0x03, 0x00, 0x00,
+	golang_proto.RegisterFile("lorawan-stack/api/identityserver.proto", fileDescriptor_identityserver_d32ba8e022178a81)
+}
+
+var fileDescriptor_identityserver_d32ba8e022178a81 = []byte{
+	// 853 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x68, 0x1b, 0x47,
+	0x18, 0x9d, 0xb1, 0xc1, 0x95, 0xc7, 0x72, 0xec, 0x4e, 0x5b, 0x63, 0x54, 0x67, 0xd6, 0xdd, 0x34,
+	0xc1, 0x98, 0x7a, 0x17, 0x9c, 0x4b, 0x9b, 0x9b, 0x85, 0x43, 0x2d, 0x02, 0x75, 0xd9, 0x9a, 0x52,
+	0x7a, 0x11, 0x2b, 0x69, 0xb4, 0x5a, 0x64, 0xcf, 0x6c, 0x77, 0x46, 0x16, 0x22, 0xb4, 0x84, 0x9e,
+	0x72, 0x6b, 0x69, 0x2f, 0x85, 0x42, 0x29, 0x3d, 0xe5, 0x98, 0x63, 0x8e, 0xb9, 0x14, 0x7c, 0x34,
+	0xf4, 0x92, 0x43, 0x11, 0xd1, 0x6c, 0x0f, 0x39, 0xe6, 0x98, 0x63, 0xd9, 0xd9, 0x91, 0x22, 0xad,
+	0xa4, 0xb4, 0x81, 0xdc, 0xb4, 0xf3, 0xbd, 0x79, 0xef, 0xfb, 0x79, 0xf3, 0x09, 0xdd, 0x38, 0xe5,
+	0xb1, 0xdf, 0xf5, 0xd9, 0x9e, 0x90, 0x7e, 0xbd, 0xed, 0xfa, 0x51, 0xe8, 0x86, 0x0d, 0xca, 0x64,
+	0x28, 0x7b, 0x82, 0xc6, 0xe7, 0x34, 0x76, 0xa2, 0x98, 0x4b, 0x8e, 0xaf, 0x48, 0xc9, 0x1c, 0x83,
+	0x75, 0xce, 0x6f, 0x96, 0xf6, 0x82, 0x50, 0xb6, 0x3a, 0x35, 0xa7, 0xce, 0xcf, 0xdc, 0x80, 0x07,
+	0xdc, 0xd5, 0xb0, 0x5a, 0xa7, 0xa9, 0xbf, 0xf4, 0x87, 0xfe, 0x95, 0x5d, 0x2f, 0x6d, 0x05, 0x9c,
+	0x07, 0xa7, 0x54, 0xf3, 0xfb, 0x8c, 0x71, 0xe9, 0xcb, 0x90, 0x33, 0x61, 0xa2, 0xef, 0x9b, 0xe8,
+	0x88, 0x83, 0x9e, 0x45, 0xb2, 0x67, 0x82, 0xd7, 0xe6, 0x65, 0xd8, 0x0c, 0x69, 0x3c, 0x64, 0xb8,
+	0x3a, 0x0d, 0xe2, 0x7e, 0x47, 0xb6, 0x4c, 0x98, 0x4c, 0x87, 0xe3, 0x30, 0x68, 0x49, 0x73, 0xdd,
+	0xfe, 0x73, 0x11, 0xad, 0x1f, 0x74, 0x64, 0xab, 0xc2, 0x9a, 0xdc, 0xa3, 0x22, 0xe2, 0x4c, 0x50,
+	0x7c, 0x82, 0xde, 0xf2, 0xa3, 0xb0, 0xda, 0xa6, 0xbd, 0x4d, 0xb8, 0x0d, 0x77, 0x56, 0xf6, 0xf7,
+	0x9c, 0xc9, 0x26, 0x38, 0xf9, 0x2b, 0xce, 0xc1, 0xe7, 0x95, 0x3b, 0xb4, 0x77, 0x50, 0xaf, 0x53,
+	0x21, 0xca, 0x48, 0xf5, 0xad, 0xa5, 0xec, 0xe4, 0x08, 0x78, 0x4b, 0x7e, 0x14, 0xde, 0xa1, 0x3d,
+	0xdc, 0x44, 0x58, 0x67, 0x56, 0xf5, 0x35, 0xaa, 0x2a, 0x79, 0x9b, 0xb2, 0xcd, 0x05, 0x2d, 0xb0,
+	0x9d, 0x17, 0x38, 0x4e, 0x15, 0x32, 0xba, 0x93, 0x14, 0x57, 0x7e, 0x57, 0xf5, 0xad, 0xf5, 0xfc,
+	0xe9, 0x11, 0xf0, 0xd6, 0x35, 0xe7, 0xd8, 0x19, 0x3e, 0x40, 0xeb, 0x1d, 0x16, 0x9e, 0xd3, 0x58,
+	0xf8, 0xa7, 0xd5, 0xac, 0xd8, 0xcd, 0x45, 0xad, 0xb2, 0x91, 0x57, 0xf1, 0x74, 0xd4, 0x5b, 0x1b,
+	0xe1, 0xb3, 0x83, 0xd2, 0x6f, 0x10, 0x15, 0xc7, 0x2b, 0xc2, 0x9f, 0xe4, 0x3b, 0x32, 0x45, 0x95,
+	0xc1, 0xcb, 0x85, 0x8b, 0xbe, 0x05, 0x2e, 0xfb, 0x16, 0x1c, 0x95, 0xfd, 0x05, 0x42, 0x99, 0xab,
+	0xaa, 0x61, 0x43, 0x98, 0x72, 0x3f, 0xc8, 0xdf, 0xbe, 0xad, 0x11, 0x95, 0x97, 0xd3, 0x2d, 0xbf,
+	0x9d, 0x12, 0xa9, 0xbe, 0xb5, 0x6c, 0x42, 0x87, 0xc2, 0x5b, 0xa6, 0x06, 0x25, 0xca, 0x6b, 0x68,
+	0xd5, 0x74, 0xf1, 0x8c, 0xca, 0x16, 0x6f, 0xd8, 0x3f, 0x41, 0xf4, 0x8e, 0x97, 0x9a, 0x8b, 0x66,
+	0x89, 0x78, 0xf4, 0x9b, 0x0e, 0x15, 0x12, 0x1f, 0x4f, 0xa8, 0xc3, 0xff, 0xab, 0xbe, 0x3a, 0x4f,
+	0x19, 0xef, 0x22, 0x64, 0x3a, 0x51, 0x0d, 0x1b, 0xba, 0x9c, 0xe5, 0x72, 0x51, 0xf5, 0xad, 0x42,
+	0xa6, 0x5b, 0x39, 0xf4, 0x0a, 0x59, 0xe1, 0x95, 0x86, 0x7d, 0x88, 0x36, 0x3e, 0xa5, 0x72, 0xbc,
+	0x91, 0xc3, 0xb4, 0x26, 0x59, 0xe0, 0x2b, 0x59, 0x7e, 0x80, 0xa8, 0x98, 0xa5, 0x92, 0x4d, 0xe7,
+	0xcd, 0xd7, 0xe4, 0xa0, 0x25, 0xe3, 0x93, 0x85, 0x57, 0xfa, 0xc4, 0xa0, 0xec, 0xa3, 0x9c, 0x3b,
+	0x3e, 0x46, 0x05, 0x4d, 0x16, 0xd2, 0x34, 0x9d, 0xc5, 0x9d, 0x95, 0xfd, 0xad, 0xd9, 0xe9, 0x18,
+	0x9e, 0x11, 0xda, 0xfe, 0x15, 0xa2, 0xf7, 0x4e, 0x62, 0x9f, 0x89, 0x26, 0x8d, 0x27, 0x07, 0xf7,
+	0x1a, 0x1d, 0xc2, 0x5f, 0xa2, 0x55, 0x46, 0xbb, 0x55, 0xde, 0x65, 0x34, 0x7e, 0x3d, 0x97, 0xad,
+	0xa9, 0xbe, 0xb5, 0xf2, 0x19, 0xed, 0x1e, 0xa7, 0x57, 0xd3, 0xae, 0xac, 0xb0, 0xe1, 0x47, 0x43,
+	0xec, 0xff, 0xbd, 0x38, 0xec, 0xbc, 0x29, 0xf4, 0x2b, 0x54, 0x18, 0xbe, 0x7c, 0xbc, 0xe1, 0x64,
+	0xbb, 0xcb, 0x19, 0xee, 0x2e, 0xe7, 0x76, 0xba, 0xbb, 0x4a, 0xdb, 0xff, 0xb5, 0x2b, 0x6c, 0xfc,
+	0xfd, 0x5f, 0xff, 0xfc, 0xbc, 0x50, 0xc4, 0xc8, 0xd5, 0xeb, 0x20, 0x4c, 0xd9, 0x3a, 0xa8, 0x38,
+	0x6e, 0x5f, 0x7c, 0x6d, 0x6a, 0x04, 0xd3, 0xe6, 0x2e, 0xcd, 0x79, 0x84, 0xf6, 0x8e, 0x16, 0xb0,
+	0xed, 0xab, 0xe9, 0xbe, 0xdb, 0x6b, 0xd3, 0x9e, 0x70, 0xef, 0xbe, 0x6c, 0xe6, 0xb7, 0x6e, 0xac,
+	0xb9, 0x6e, 0xc1, 0x5d, 0xfc, 0x1d, 0x5a, 0xcb, 0x39, 0x14, 0xdf, 0xc8, 0x93, 0xce, 0xb6, 0x70,
+	0x69, 0x6b, 0xb6, 0x78, 0x06, 0xb2, 0xaf, 0xeb, 0x14, 0x2c, 0x3c, 0x2f, 0x85, 0xec, 0xf5, 0xe2,
+	0xbb, 0xe8, 0xca, 0xe4, 0xf8, 0xf1, 0xf5, 0x3c, 0xed, 0x4c, 0x7b, 0xcc, 0x2d, 0x7d, 0x57, 0xeb,
+	0x7e, 0x68, 0x5b, 0x73, 0x74, 0xa5, 0x61, 0xbb, 0x05, 0x77, 0xcb, 0x7f, 0xc0, 0x8b, 0x01, 0x81,
+	0x97, 0x03, 0x02, 0x9f, 0x0c, 0x08, 0x78, 0x3a, 0x20, 0xe0, 0xd9, 0x80, 0x80, 0xe7, 0x03, 0x02,
+	0x5e, 0x0c, 0x08, 0xbc, 0xa7, 0x08, 0xbc, 0xaf, 0x08, 0x78, 0xa0, 0x08, 0x7c, 0xa8, 0x08, 0x78,
+	0xa4, 0x08, 0x78, 0xac, 0x08, 0xb8, 0x50, 0x04, 0x5e, 0x2a, 0x02, 0x9f, 0x28, 0x02, 0x9e, 0x2a,
+	0x02, 0x9f, 0x29, 0x02, 0x9e, 0x2b, 0x02, 0x5f, 0x28, 0x02, 0xee, 0x25, 0x04, 0xdc, 0x4f, 0x08,
+	0xfc, 0x31, 0x21, 0xe0, 0x97, 0x84, 0xc0, 0xdf, 0x13, 0x02, 0x1e, 0x24, 0x04, 0x3c, 0x4c, 0x08,
+	0x7c, 0x94, 0x10, 0xf8, 0x38, 0x21, 0xf0, 0xeb, 0x8f, 0x02, 0xee, 0xc8, 0x16, 0x95, 0xad, 0x90,
+	0x05, 0xc2, 0x61, 0x54, 0x76, 0x79, 0xdc, 0x76, 0x27, 0xff, 0xa7, 0xa2, 0x76, 0xe0, 0x4a, 0xc9,
+	0xa2, 0x5a, 0x6d, 0x49, 0xdb, 0xeb, 0xe6, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x21, 0x8e,
+	0xa1, 0xaf, 0x07, 0x00, 0x00,
 }
@@ -50,3 +50,45 @@ func (this *AuthInfoResponse_APIKeyAccess) Validate() error {
 	}
 	return nil
 }
+func (this *RotateAPIKeyRequest) Validate() error {
+	if this.EntityIDs != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.EntityIDs); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("EntityIDs", err)
+		}
+	}
+	return nil
+}
+func (this *GetAPIKeyAccessRequest) Validate() error {
+	return nil
+}
+func (this *EntityRights) Validate() error {
+	if this.EntityIDs != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.EntityIDs); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("EntityIDs", err)
+		}
+	}
+	if this.Rights != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Rights); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Rights", err)
+		}
+	}
+	return nil
+}
+func (this *APIKeyAccess) Validate() error {
+	for _, item := range this.Entities {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Entities", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *TransferAPIKeyRequest) Validate() error {
+	if this.NewOwnerIDs != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NewOwnerIDs); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NewOwnerIDs", err)
+		}
+	}
+	return nil
+}
@@ -213,8 +213,10 @@ func (dst *JoinRequest) SetFields(src *JoinRequest, paths ...string) error {
 }
 
 var JoinResponseFieldPathsNested = []string{
+	"application_server_address",
 	"correlation_ids",
 	"lifetime",
+	"network_server_address",
 	"raw_payload",
 	"session_keys",
 	"session_keys.app_s_key",
@@ -233,8 +235,10 @@ var JoinResponseFieldPathsNested = []string{
 }
 
 var JoinResponseFieldPathsTopLevel = []string{
+	"application_server_address",
 	"correlation_ids",
 	"lifetime",
+	"network_server_address",
 	"raw_payload",
 	"session_keys",
 }
@@ -289,6 +293,26 @@ func (dst *JoinResponse) SetFields(src *JoinResponse, paths ...string) error {
 			} else {
 				dst.CorrelationIDs = nil
 			}
+		case "network_server_address":
+			if len(subs) > 0 {
+				return fmt.Errorf("'network_server_address' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NetworkServerAddress = src.NetworkServerAddress
+			} else {
+				var zero string
+				dst.NetworkServerAddress = zero
+			}
+		case "application_server_address":
+			if len(subs) > 0 {
+				return fmt.Errorf("'application_server_address' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ApplicationServerAddress = src.ApplicationServerAddress
+			} else {
+				var zero string
+				dst.ApplicationServerAddress = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
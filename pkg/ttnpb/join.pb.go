@@ -54,7 +54,7 @@ type JoinRequest struct {
 func (m *JoinRequest) Reset()      { *m = JoinRequest{} }
 func (*JoinRequest) ProtoMessage() {}
 func (*JoinRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_join_be0cf60f6ae1ac14, []int{0}
+	return fileDescriptor_join_eee116a7ff48b57c, []int{0}
 }
 func (m *JoinRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -133,18 +133,22 @@ func (m *JoinRequest) GetCorrelationIDs() []string {
 }
 
 type JoinResponse struct {
-	RawPayload           []byte `protobuf:"bytes,1,opt,name=raw_payload,json=rawPayload,proto3" json:"raw_payload,omitempty"`
-	SessionKeys          `protobuf:"bytes,2,opt,name=session_keys,json=sessionKeys,proto3,embedded=session_keys" json:"session_keys"`
-	Lifetime             time.Duration `protobuf:"bytes,3,opt,name=lifetime,proto3,stdduration" json:"lifetime"`
-	CorrelationIDs       []string      `protobuf:"bytes,4,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	RawPayload     []byte `protobuf:"bytes,1,opt,name=raw_payload,json=rawPayload,proto3" json:"raw_payload,omitempty"`
+	SessionKeys    `protobuf:"bytes,2,opt,name=session_keys,json=sessionKeys,proto3,embedded=session_keys" json:"session_keys"`
+	Lifetime       time.Duration `protobuf:"bytes,3,opt,name=lifetime,proto3,stdduration" json:"lifetime"`
+	CorrelationIDs []string      `protobuf:"bytes,4,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
+	// The address of the Network Server of the device, rewritten to be routable by the receiver of the join response.
+	NetworkServerAddress string `protobuf:"bytes,5,opt,name=network_server_address,json=networkServerAddress,proto3" json:"network_server_address,omitempty"`
+	// The address of the Application Server of the device, rewritten to be routable by the receiver of the join response.
+	ApplicationServerAddress string   `protobuf:"bytes,6,opt,name=application_server_address,json=applicationServerAddress,proto3" json:"application_server_address,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
 }
 
 func (m *JoinResponse) Reset()      { *m = JoinResponse{} }
 func (*JoinResponse) ProtoMessage() {}
 func (*JoinResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_join_be0cf60f6ae1ac14, []int{1}
+	return fileDescriptor_join_eee116a7ff48b57c, []int{1}
 }
 func (m *JoinResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -194,6 +198,20 @@ func (m *JoinResponse) GetCorrelationIDs() []string {
 	return nil
 }
 
+func (m *JoinResponse) GetNetworkServerAddress() string {
+	if m != nil {
+		return m.NetworkServerAddress
+	}
+	return ""
+}
+
+func (m *JoinResponse) GetApplicationServerAddress() string {
+	if m != nil {
+		return m.ApplicationServerAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*JoinRequest)(nil), "ttn.lorawan.v3.JoinRequest")
 	golang_proto.RegisterType((*JoinRequest)(nil), "ttn.lorawan.v3.JoinRequest")
@@ -289,6 +307,12 @@ func (this *JoinResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.NetworkServerAddress != that1.NetworkServerAddress {
+		return false
+	}
+	if this.ApplicationServerAddress != that1.ApplicationServerAddress {
+		return false
+	}
 	return true
 }
 func (m *JoinRequest) Marshal() (dAtA []byte, err error) {
@@ -436,6 +460,18 @@ func (m *JoinResponse) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.NetworkServerAddress) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintJoin(dAtA, i, uint64(len(m.NetworkServerAddress)))
+		i += copy(dAtA[i:], m.NetworkServerAddress)
+	}
+	if len(m.ApplicationServerAddress) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintJoin(dAtA, i, uint64(len(m.ApplicationServerAddress)))
+		i += copy(dAtA[i:], m.ApplicationServerAddress)
+	}
 	return i, nil
 }
 
@@ -464,6 +500,8 @@ func NewPopulatedJoinResponse(r randyJoin, easy bool) *JoinResponse {
 	for i := 0; i < v4; i++ {
 		this.CorrelationIDs[i] = randStringJoin(r)
 	}
+	this.NetworkServerAddress = randStringJoin(r)
+	this.ApplicationServerAddress = randStringJoin(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -600,6 +638,14 @@ func (m *JoinResponse) Size() (n int) {
 			n += 1 + l + sovJoin(uint64(l))
 		}
 	}
+	l = len(m.NetworkServerAddress)
+	if l > 0 {
+		n += 1 + l + sovJoin(uint64(l))
+	}
+	l = len(m.ApplicationServerAddress)
+	if l > 0 {
+		n += 1 + l + sovJoin(uint64(l))
+	}
 	return n
 }
 
@@ -643,6 +689,8 @@ func (this *JoinResponse) String() string {
 		`SessionKeys:` + strings.Replace(strings.Replace(this.SessionKeys.String(), "SessionKeys", "SessionKeys", 1), `&`, ``, 1) + `,`,
 		`Lifetime:` + strings.Replace(strings.Replace(this.Lifetime.String(), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
 		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
+		`NetworkServerAddress:` + fmt.Sprintf("%v", this.NetworkServerAddress) + `,`,
+		`ApplicationServerAddress:` + fmt.Sprintf("%v", this.ApplicationServerAddress) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1108,6 +1156,64 @@ func (m *JoinResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.CorrelationIDs = append(m.CorrelationIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkServerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkServerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationServerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApplicationServerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipJoin(dAtA[iNdEx:])
@@ -1234,59 +1340,62 @@ var (
 	ErrIntOverflowJoin   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("lorawan-stack/api/join.proto", fileDescriptor_join_be0cf60f6ae1ac14) }
+func init() { proto.RegisterFile("lorawan-stack/api/join.proto", fileDescriptor_join_eee116a7ff48b57c) }
 func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/join.proto", fileDescriptor_join_be0cf60f6ae1ac14)
+	golang_proto.RegisterFile("lorawan-stack/api/join.proto", fileDescriptor_join_eee116a7ff48b57c)
 }
 
-var fileDescriptor_join_be0cf60f6ae1ac14 = []byte{
-	// 765 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x31, 0x6c, 0xdb, 0x46,
-	0x14, 0xbd, 0x8b, 0x65, 0x49, 0x39, 0x19, 0xae, 0x4b, 0x14, 0x09, 0xeb, 0x16, 0x47, 0xc1, 0x93,
-	0x0a, 0x54, 0x24, 0xaa, 0x14, 0x1d, 0x9a, 0x02, 0x45, 0x24, 0xa1, 0xa8, 0xd2, 0xb8, 0x28, 0x68,
-	0xa0, 0x05, 0xb2, 0x10, 0x27, 0xde, 0x89, 0xbe, 0x8a, 0xe2, 0xb1, 0xbc, 0x93, 0x64, 0x6d, 0xee,
-	0x96, 0xa9, 0xe8, 0x98, 0x31, 0xe8, 0x94, 0x31, 0xa3, 0xc7, 0x8c, 0x1e, 0x3d, 0x06, 0x1d, 0xe4,
-	0xe8, 0xb8, 0x64, 0xcc, 0x98, 0xb1, 0x10, 0x49, 0x45, 0x71, 0xe4, 0x21, 0x9d, 0xf8, 0xef, 0xff,
-	0xf7, 0x3f, 0xdf, 0xbd, 0x7b, 0x1f, 0x7d, 0x1e, 0x8a, 0x84, 0x4c, 0x49, 0xd4, 0x94, 0x8a, 0xf8,
-	0x43, 0x87, 0xc4, 0xdc, 0xf9, 0x5d, 0xf0, 0xc8, 0x8e, 0x13, 0xa1, 0x84, 0xb1, 0xab, 0x54, 0x64,
-	0x17, 0x08, 0x7b, 0x72, 0x67, 0xbf, 0x19, 0x70, 0x75, 0x3c, 0xee, 0xdb, 0xbe, 0x18, 0x39, 0x81,
-	0x08, 0x84, 0x93, 0xc1, 0xfa, 0xe3, 0x41, 0x76, 0xca, 0x0e, 0x59, 0x94, 0xb7, 0xef, 0x7f, 0xf3,
-	0x0e, 0x7c, 0x34, 0xe5, 0x6a, 0x28, 0xa6, 0x4e, 0x20, 0x9a, 0x59, 0xb1, 0x39, 0x21, 0x21, 0xa7,
-	0x44, 0x89, 0x44, 0x3a, 0x6f, 0xc3, 0xa2, 0x0f, 0x07, 0x42, 0x04, 0x21, 0x5b, 0x4f, 0xa7, 0xe3,
-	0x84, 0x28, 0x2e, 0x0a, 0x5a, 0xfb, 0xd7, 0x90, 0x1e, 0xb2, 0x99, 0x2c, 0xaa, 0xd6, 0x66, 0x75,
-	0x75, 0x85, 0x0c, 0x70, 0xf0, 0xd7, 0x36, 0xaa, 0xdd, 0x17, 0x3c, 0x72, 0xd9, 0x1f, 0x63, 0x26,
-	0x95, 0xd1, 0x40, 0xb5, 0x84, 0x4c, 0xbd, 0x98, 0xcc, 0x42, 0x41, 0xa8, 0x09, 0xeb, 0xb0, 0xb1,
-	0xd3, 0xae, 0xe8, 0x4b, 0x6b, 0xeb, 0x14, 0xde, 0x76, 0x51, 0x42, 0xa6, 0xbf, 0xe4, 0x25, 0xe3,
-	0x2b, 0x54, 0x59, 0xa1, 0x6e, 0xd4, 0x61, 0xa3, 0xd6, 0xba, 0x6d, 0x5f, 0x55, 0xc8, 0x3e, 0x64,
-	0x52, 0x92, 0x80, 0xb9, 0x2b, 0x9c, 0xf1, 0x1b, 0xaa, 0x52, 0x36, 0xf1, 0x08, 0xa5, 0x89, 0xb9,
-	0x95, 0x4d, 0xfe, 0xee, 0x7c, 0x6e, 0x81, 0x7f, 0xe7, 0xd6, 0xd7, 0x81, 0xb0, 0xd5, 0x31, 0x53,
-	0xc7, 0x3c, 0x0a, 0xa4, 0x1d, 0x31, 0x35, 0x15, 0xc9, 0xd0, 0xb9, 0x4a, 0x3e, 0x1e, 0x06, 0x8e,
-	0x9a, 0xc5, 0x4c, 0xda, 0x5d, 0x36, 0xb9, 0x47, 0x69, 0xe2, 0x56, 0x68, 0x1e, 0x18, 0x14, 0x7d,
-	0x22, 0x59, 0xc8, 0x7c, 0xc5, 0xa8, 0x37, 0x22, 0xbe, 0x37, 0x61, 0x89, 0xe4, 0x22, 0x32, 0x4b,
-	0x75, 0xd8, 0xd8, 0x6d, 0xed, 0x6f, 0x10, 0xbb, 0xd7, 0xf9, 0x35, 0x47, 0xb4, 0x6f, 0xe9, 0xb9,
-	0x65, 0x1c, 0x15, 0xbd, 0xeb, 0xbc, 0x6b, 0xac, 0xe6, 0x1d, 0x12, 0xbf, 0xc8, 0x19, 0x0f, 0x51,
-	0x39, 0x62, 0xca, 0xe3, 0xd4, 0xdc, 0xce, 0xc8, 0x77, 0x0a, 0xf2, 0xad, 0xff, 0x45, 0xfe, 0x67,
-	0xa6, 0x7a, 0x5d, 0x3d, 0xb7, 0xb6, 0xb3, 0xc0, 0xdd, 0x8e, 0x98, 0xea, 0x51, 0xe3, 0x10, 0x7d,
-	0x4c, 0xc5, 0x34, 0x0a, 0x79, 0x34, 0xf4, 0x24, 0x53, 0x6a, 0x39, 0xca, 0x2c, 0x67, 0xba, 0x6e,
-	0xd0, 0xef, 0x3e, 0x38, 0x2a, 0x10, 0xed, 0xd2, 0x92, 0x82, 0xbb, 0xb7, 0x6a, 0x5d, 0xe5, 0x8d,
-	0x16, 0xaa, 0x26, 0x27, 0x1e, 0x65, 0x21, 0x99, 0x99, 0x95, 0x4c, 0x84, 0x8d, 0xd7, 0x71, 0x4f,
-	0xba, 0xcb, 0xb2, 0x5b, 0x49, 0xf2, 0xc0, 0xb8, 0x8b, 0x2a, 0xfe, 0xc0, 0x0b, 0xb9, 0x54, 0x66,
-	0x35, 0xfb, 0xf1, 0xad, 0xf7, 0x5b, 0x3a, 0x3f, 0x3c, 0xe0, 0x52, 0xb5, 0x91, 0x9e, 0x5b, 0xe5,
-	0x3c, 0x76, 0xcb, 0xfe, 0x60, 0xf9, 0x35, 0xee, 0xa2, 0x8f, 0x7c, 0x91, 0x24, 0x2c, 0xcc, 0xbc,
-	0xe9, 0x71, 0x2a, 0x4d, 0x54, 0xdf, 0x6a, 0xdc, 0x6c, 0x1b, 0x7a, 0x6e, 0xed, 0x76, 0xd6, 0xa5,
-	0x5e, 0x57, 0xba, 0xbb, 0xef, 0x40, 0x7b, 0x54, 0x7e, 0x5b, 0x3a, 0x7b, 0x62, 0x81, 0xfb, 0xa5,
-	0xea, 0xcd, 0x3d, 0x74, 0xf0, 0xe7, 0x0d, 0xb4, 0x93, 0x1b, 0x52, 0xc6, 0x22, 0x92, 0xcc, 0xf8,
-	0xe2, 0x3a, 0x47, 0x56, 0xf5, 0xa5, 0x55, 0x8a, 0xf7, 0x4e, 0x0e, 0xae, 0x58, 0xf2, 0x47, 0xb4,
-	0x23, 0x99, 0x5c, 0xbe, 0x95, 0xb7, 0xdc, 0x81, 0xc2, 0x97, 0x9f, 0xbd, 0x7f, 0x8d, 0xa3, 0x1c,
-	0xf3, 0x13, 0x9b, 0xc9, 0x76, 0x75, 0x29, 0xe0, 0xc5, 0xdc, 0x82, 0x6e, 0x4d, 0xae, 0xd3, 0xc6,
-	0xf7, 0xa8, 0x1a, 0xf2, 0x01, 0x53, 0x7c, 0xc4, 0x32, 0xa7, 0xd6, 0x5a, 0x9f, 0xda, 0xf9, 0x22,
-	0xda, 0xab, 0x45, 0xb4, 0xbb, 0xc5, 0x22, 0xe6, 0x33, 0x1e, 0x5f, 0x5a, 0xd0, 0x7d, 0xdb, 0x74,
-	0x9d, 0x1e, 0xa5, 0x0f, 0xd5, 0xa3, 0xfd, 0x0f, 0x3c, 0x5f, 0x60, 0x78, 0xb1, 0xc0, 0xf0, 0xc5,
-	0x02, 0x83, 0x97, 0x0b, 0x0c, 0x5e, 0x2d, 0x30, 0x78, 0xbd, 0xc0, 0xe0, 0xcd, 0x02, 0xc3, 0x53,
-	0x8d, 0xe1, 0x23, 0x8d, 0xc1, 0x53, 0x8d, 0xe1, 0x33, 0x8d, 0xc1, 0x99, 0xc6, 0xe0, 0xb9, 0xc6,
-	0xe0, 0x5c, 0x63, 0x78, 0xa1, 0x31, 0x7c, 0xa1, 0x31, 0x78, 0xa9, 0x31, 0x7c, 0xa5, 0x31, 0x78,
-	0xad, 0x31, 0x7c, 0xa3, 0x31, 0x38, 0x4d, 0x31, 0x78, 0x94, 0x62, 0xf8, 0x77, 0x8a, 0xc1, 0xe3,
-	0x14, 0xc3, 0x27, 0x29, 0x06, 0x4f, 0x53, 0x0c, 0x9e, 0xa5, 0x18, 0x9e, 0xa5, 0x18, 0x3e, 0x4f,
-	0x31, 0x7c, 0xf8, 0xe5, 0x87, 0xfa, 0x58, 0x45, 0x71, 0xbf, 0x5f, 0xce, 0x84, 0xb8, 0xf3, 0x5f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x88, 0xb8, 0x16, 0x36, 0x05, 0x00, 0x00,
+var fileDescriptor_join_eee116a7ff48b57c = []byte{
+	// 811 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6c, 0xdb, 0xc6,
+	0x1b, 0xbd, 0x8b, 0x65, 0x49, 0x3e, 0x19, 0xfe, 0xf9, 0x77, 0x08, 0x1c, 0x56, 0x2d, 0x8e, 0x86,
+	0x27, 0x15, 0xa8, 0x29, 0x54, 0x09, 0x3a, 0x34, 0x01, 0x0a, 0xcb, 0x42, 0x51, 0xa7, 0x71, 0x51,
+	0xd0, 0x40, 0x0b, 0x64, 0x21, 0xce, 0xbc, 0x33, 0x7d, 0x15, 0xcd, 0x63, 0x79, 0x67, 0xc9, 0xda,
+	0x3c, 0x66, 0x2a, 0x3a, 0x66, 0x0c, 0x3a, 0x65, 0xcc, 0xe8, 0x31, 0xa3, 0x47, 0x8f, 0x41, 0x07,
+	0x39, 0x3a, 0x2e, 0x19, 0xb3, 0x14, 0xc8, 0x58, 0xe8, 0x48, 0xc5, 0x7f, 0x87, 0x74, 0xe2, 0xc7,
+	0x7b, 0xef, 0x7d, 0x7c, 0xfc, 0xee, 0x7d, 0xe8, 0x8b, 0x58, 0x66, 0x74, 0x48, 0x93, 0x75, 0xa5,
+	0x69, 0xd8, 0x6f, 0xd3, 0x54, 0xb4, 0x7f, 0x93, 0x22, 0xf1, 0xd2, 0x4c, 0x6a, 0x89, 0x97, 0xb4,
+	0x4e, 0xbc, 0x92, 0xe1, 0x0d, 0xee, 0x37, 0xd7, 0x23, 0xa1, 0xf7, 0x0f, 0x77, 0xbd, 0x50, 0x1e,
+	0xb4, 0x23, 0x19, 0xc9, 0xb6, 0xa5, 0xed, 0x1e, 0xee, 0xd9, 0x37, 0xfb, 0x62, 0xab, 0x42, 0xde,
+	0xfc, 0xe6, 0x12, 0xfd, 0x60, 0x28, 0x74, 0x5f, 0x0e, 0xdb, 0x91, 0x5c, 0xb7, 0xe0, 0xfa, 0x80,
+	0xc6, 0x82, 0x51, 0x2d, 0x33, 0xd5, 0xfe, 0x58, 0x96, 0x3a, 0x12, 0x49, 0x19, 0xc5, 0xfc, 0xa2,
+	0x3b, 0x3b, 0xcc, 0xa8, 0x16, 0xb2, 0xb4, 0xd5, 0xbc, 0xc5, 0x74, 0x9f, 0x8f, 0x54, 0x89, 0xba,
+	0x37, 0xd1, 0xd9, 0x2f, 0x58, 0xc2, 0xda, 0x1f, 0xf3, 0xa8, 0xf1, 0x58, 0x8a, 0xc4, 0xe7, 0xbf,
+	0x1f, 0x72, 0xa5, 0x71, 0x0b, 0x35, 0x32, 0x3a, 0x0c, 0x52, 0x3a, 0x8a, 0x25, 0x65, 0x0e, 0x5c,
+	0x85, 0xad, 0xc5, 0x6e, 0xcd, 0x9c, 0xbb, 0x73, 0xc7, 0xf0, 0x9e, 0x8f, 0x32, 0x3a, 0xfc, 0xb9,
+	0x80, 0xf0, 0xd7, 0xa8, 0x36, 0x63, 0xdd, 0x59, 0x85, 0xad, 0x46, 0xe7, 0x9e, 0x77, 0x75, 0x42,
+	0xde, 0x36, 0x57, 0x8a, 0x46, 0xdc, 0x9f, 0xf1, 0xf0, 0xaf, 0xa8, 0xce, 0xf8, 0x20, 0xa0, 0x8c,
+	0x65, 0xce, 0x9c, 0xed, 0xfc, 0xe8, 0x74, 0xec, 0x82, 0xbf, 0xc7, 0xee, 0x83, 0x48, 0x7a, 0x7a,
+	0x9f, 0xeb, 0x7d, 0x91, 0x44, 0xca, 0x4b, 0xb8, 0x1e, 0xca, 0xac, 0xdf, 0xbe, 0x6a, 0x3e, 0xed,
+	0x47, 0x6d, 0x3d, 0x4a, 0xb9, 0xf2, 0x7a, 0x7c, 0xb0, 0xc1, 0x58, 0xe6, 0xd7, 0x58, 0x51, 0x60,
+	0x86, 0xee, 0x2a, 0x1e, 0xf3, 0x50, 0x73, 0x16, 0x1c, 0xd0, 0x30, 0x18, 0xf0, 0x4c, 0x09, 0x99,
+	0x38, 0x95, 0x55, 0xd8, 0x5a, 0xea, 0x34, 0x6f, 0x18, 0xdb, 0xd8, 0xfc, 0xa5, 0x60, 0x74, 0x57,
+	0xcc, 0xd8, 0xc5, 0x3b, 0xa5, 0xf6, 0xe2, 0xdc, 0xc7, 0xb3, 0x7e, 0xdb, 0x34, 0x2c, 0xcf, 0xf0,
+	0x53, 0x54, 0x4d, 0xb8, 0x0e, 0x04, 0x73, 0xe6, 0xad, 0xf9, 0xcd, 0xd2, 0x7c, 0xe7, 0x3f, 0x99,
+	0xff, 0x89, 0xeb, 0xad, 0x9e, 0x19, 0xbb, 0xf3, 0xb6, 0xf0, 0xe7, 0x13, 0xae, 0xb7, 0x18, 0xde,
+	0x46, 0xff, 0x67, 0x72, 0x98, 0xc4, 0x22, 0xe9, 0x07, 0x8a, 0x6b, 0x3d, 0x6d, 0xe5, 0x54, 0xed,
+	0x5c, 0x6f, 0xd8, 0xef, 0x3d, 0xd9, 0x29, 0x19, 0xdd, 0xca, 0xd4, 0x82, 0xbf, 0x3c, 0x93, 0xce,
+	0xce, 0x71, 0x07, 0xd5, 0xb3, 0xa3, 0x80, 0xf1, 0x98, 0x8e, 0x9c, 0x9a, 0x1d, 0xc2, 0x8d, 0xdb,
+	0xf1, 0x8f, 0x7a, 0x53, 0xd8, 0xaf, 0x65, 0x45, 0x81, 0x1f, 0xa2, 0x5a, 0xb8, 0x17, 0xc4, 0x42,
+	0x69, 0xa7, 0x6e, 0x3f, 0xbc, 0x72, 0x5d, 0xb2, 0xf9, 0xfd, 0x13, 0xa1, 0x74, 0x17, 0x99, 0xb1,
+	0x5b, 0x2d, 0x6a, 0xbf, 0x1a, 0xee, 0x4d, 0x9f, 0xf8, 0x21, 0xfa, 0x5f, 0x28, 0xb3, 0x8c, 0xc7,
+	0x36, 0x9b, 0x81, 0x60, 0xca, 0x41, 0xab, 0x73, 0xad, 0x85, 0x2e, 0x36, 0x63, 0x77, 0x69, 0xf3,
+	0x02, 0xda, 0xea, 0x29, 0x7f, 0xe9, 0x12, 0x75, 0x8b, 0xa9, 0x6f, 0x2b, 0x27, 0x2f, 0x5c, 0xf0,
+	0xb8, 0x52, 0x5f, 0x58, 0x46, 0x6b, 0xff, 0xdc, 0x41, 0x8b, 0x45, 0x20, 0x55, 0x2a, 0x13, 0xc5,
+	0xf1, 0x97, 0xb7, 0x25, 0xb2, 0x6e, 0xce, 0xdd, 0x4a, 0xba, 0x7c, 0xb4, 0x76, 0x25, 0x92, 0x3f,
+	0xa0, 0x45, 0xc5, 0xd5, 0xf4, 0xae, 0x82, 0xe9, 0x0e, 0x94, 0xb9, 0xfc, 0xfc, 0xfa, 0x6f, 0xec,
+	0x14, 0x9c, 0x1f, 0xf9, 0x48, 0x75, 0xeb, 0xd3, 0x01, 0x9e, 0x8d, 0x5d, 0xe8, 0x37, 0xd4, 0xc5,
+	0x31, 0xfe, 0x0e, 0xd5, 0x63, 0xb1, 0xc7, 0xb5, 0x38, 0xe0, 0x36, 0xa9, 0x8d, 0xce, 0x67, 0x5e,
+	0xb1, 0x88, 0xde, 0x6c, 0x11, 0xbd, 0x5e, 0xb9, 0x88, 0x45, 0x8f, 0xe7, 0xe7, 0x2e, 0xf4, 0x3f,
+	0x8a, 0x6e, 0x9b, 0x47, 0xe5, 0x53, 0xe7, 0x81, 0x1f, 0xa0, 0x95, 0x32, 0x45, 0x81, 0xe2, 0xd9,
+	0x80, 0x67, 0x76, 0x65, 0xb8, 0x52, 0x36, 0x78, 0x0b, 0xfe, 0xdd, 0x12, 0xdd, 0xb1, 0xe0, 0x46,
+	0x81, 0xe1, 0x47, 0xa8, 0x49, 0xd3, 0x34, 0x16, 0x61, 0xf1, 0xc9, 0x6b, 0xca, 0xaa, 0x55, 0x3a,
+	0x97, 0x18, 0x57, 0xd4, 0xdd, 0xbf, 0xe0, 0xe9, 0x84, 0xc0, 0xb3, 0x09, 0x81, 0x6f, 0x26, 0x04,
+	0xbc, 0x9d, 0x10, 0xf0, 0x6e, 0x42, 0xc0, 0xfb, 0x09, 0x01, 0x1f, 0x26, 0x04, 0x1e, 0x1b, 0x02,
+	0x9f, 0x19, 0x02, 0x5e, 0x1a, 0x02, 0x5f, 0x19, 0x02, 0x4e, 0x0c, 0x01, 0xaf, 0x0d, 0x01, 0xa7,
+	0x86, 0xc0, 0x33, 0x43, 0xe0, 0x1b, 0x43, 0xc0, 0x5b, 0x43, 0xe0, 0x3b, 0x43, 0xc0, 0x7b, 0x43,
+	0xe0, 0x07, 0x43, 0xc0, 0x71, 0x4e, 0xc0, 0xb3, 0x9c, 0xc0, 0x3f, 0x73, 0x02, 0x9e, 0xe7, 0x04,
+	0xbe, 0xc8, 0x09, 0x78, 0x99, 0x13, 0xf0, 0x2a, 0x27, 0xf0, 0x24, 0x27, 0xf0, 0x75, 0x4e, 0xe0,
+	0xd3, 0xaf, 0x3e, 0x75, 0x77, 0x74, 0x92, 0xee, 0xee, 0x56, 0xed, 0xf0, 0xef, 0xff, 0x1b, 0x00,
+	0x00, 0xff, 0xff, 0xeb, 0xd5, 0xd7, 0x93, 0xaa, 0x05, 0x00, 0x00,
 }
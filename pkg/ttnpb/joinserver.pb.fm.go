@@ -813,3 +813,241 @@ func (dst *ProvisionEndDevicesRequest_IdentifiersFromData) SetFields(src *Provis
 	}
 	return nil
 }
+
+var StreamJoinEventsRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"dev_eui",
+	"join_eui",
+	"outcome",
+}
+
+var StreamJoinEventsRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"dev_eui",
+	"join_eui",
+	"outcome",
+}
+
+func (dst *StreamJoinEventsRequest) SetFields(src *StreamJoinEventsRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				newDst := &dst.ApplicationIdentifiers
+				var newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIdentifiers
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIdentifiers = src.ApplicationIdentifiers
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIdentifiers = zero
+				}
+			}
+		case "join_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinEUI = src.JoinEUI
+			} else {
+				dst.JoinEUI = nil
+			}
+		case "dev_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevEUI = src.DevEUI
+			} else {
+				dst.DevEUI = nil
+			}
+		case "outcome":
+			if len(subs) > 0 {
+				return fmt.Errorf("'outcome' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Outcome = src.Outcome
+			} else {
+				var zero string
+				dst.Outcome = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var EndDeviceSessionsFieldPathsNested = []string{
+	"sessions",
+}
+
+var EndDeviceSessionsFieldPathsTopLevel = []string{
+	"sessions",
+}
+
+func (dst *EndDeviceSessions) SetFields(src *EndDeviceSessions, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "sessions":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sessions' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Sessions = src.Sessions
+			} else {
+				dst.Sessions = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var SessionKeysBatchRequestFieldPathsNested = []string{
+	"dev_eui",
+	"session_key_ids",
+}
+
+var SessionKeysBatchRequestFieldPathsTopLevel = []string{
+	"dev_eui",
+	"session_key_ids",
+}
+
+func (dst *SessionKeysBatchRequest) SetFields(src *SessionKeysBatchRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "dev_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevEUI = src.DevEUI
+			} else {
+				var zero go_thethings_network_lorawan_stack_pkg_types.EUI64
+				dst.DevEUI = zero
+			}
+		case "session_key_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'session_key_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SessionKeyIDs = src.SessionKeyIDs
+			} else {
+				dst.SessionKeyIDs = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var NwkSKeysBatchResponseFieldPathsNested = []string{
+	"nwk_s_keys",
+}
+
+var NwkSKeysBatchResponseFieldPathsTopLevel = []string{
+	"nwk_s_keys",
+}
+
+func (dst *NwkSKeysBatchResponse) SetFields(src *NwkSKeysBatchResponse, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "nwk_s_keys":
+			if len(subs) > 0 {
+				return fmt.Errorf("'nwk_s_keys' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NwkSKeys = src.NwkSKeys
+			} else {
+				dst.NwkSKeys = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var GetHomeNetIDRequestFieldPathsNested = []string{
+	"dev_eui",
+	"join_eui",
+}
+
+var GetHomeNetIDRequestFieldPathsTopLevel = []string{
+	"dev_eui",
+	"join_eui",
+}
+
+func (dst *GetHomeNetIDRequest) SetFields(src *GetHomeNetIDRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "join_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinEUI = src.JoinEUI
+			} else {
+				var zero go_thethings_network_lorawan_stack_pkg_types.EUI64
+				dst.JoinEUI = zero
+			}
+		case "dev_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevEUI = src.DevEUI
+			} else {
+				var zero go_thethings_network_lorawan_stack_pkg_types.EUI64
+				dst.DevEUI = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var GetHomeNetIDResponseFieldPathsNested = []string{
+	"net_id",
+}
+
+var GetHomeNetIDResponseFieldPathsTopLevel = []string{
+	"net_id",
+}
+
+func (dst *GetHomeNetIDResponse) SetFields(src *GetHomeNetIDResponse, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "net_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'net_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NetID = src.NetID
+			} else {
+				var zero go_thethings_network_lorawan_stack_pkg_types.NetID
+				dst.NetID = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
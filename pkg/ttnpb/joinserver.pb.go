@@ -51,7 +51,7 @@ type SessionKeyRequest struct {
 func (m *SessionKeyRequest) Reset()      { *m = SessionKeyRequest{} }
 func (*SessionKeyRequest) ProtoMessage() {}
 func (*SessionKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{0}
+	return fileDescriptor_joinserver_2f23d45eb420f48c, []int{0}
 }
 func (m *SessionKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -101,7 +101,7 @@ type NwkSKeysResponse struct {
 func (m *NwkSKeysResponse) Reset()      { *m = NwkSKeysResponse{} }
 func (*NwkSKeysResponse) ProtoMessage() {}
 func (*NwkSKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{1}
+	return fileDescriptor_joinserver_2f23d45eb420f48c, []int{1}
 }
 func (m *NwkSKeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,7 +161,7 @@ type AppSKeyResponse struct {
 func (m *AppSKeyResponse) Reset()      { *m = AppSKeyResponse{} }
 func (*AppSKeyResponse) ProtoMessage() {}
 func (*AppSKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{2}
+	return fileDescriptor_joinserver_2f23d45eb420f48c, []int{2}
 }
 func (m *AppSKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,7 +210,7 @@ type CryptoServicePayloadRequest struct {
 func (m *CryptoServicePayloadRequest) Reset()      { *m = CryptoServicePayloadRequest{} }
 func (*CryptoServicePayloadRequest) ProtoMessage() {}
 func (*CryptoServicePayloadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{3}
+	return fileDescriptor_joinserver_2f23d45eb420f48c, []int{3}
 }
 func (m *CryptoServicePayloadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -276,7 +276,7 @@ type CryptoServicePayloadResponse struct {
 func (m *CryptoServicePayloadResponse) Reset()      { *m = CryptoServicePayloadResponse{} }
 func (*CryptoServicePayloadResponse) ProtoMessage() {}
 func (*CryptoServicePayloadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{4}
+	return fileDescriptor_joinserver_2f23d45eb420f48c, []int{4}
 }
 func (m *CryptoServicePayloadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -323,7 +323,7 @@ type JoinAcceptMICRequest struct {
 func (m *JoinAcceptMICRequest) Reset()      { *m = JoinAcceptMICRequest{} }
 func (*JoinAcceptMICRequest) ProtoMessage() {}
 func (*JoinAcceptMICRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{5}
+	return fileDescriptor_joinserver_2f23d45eb420f48c, []int{5}
 }
 func (m *JoinAcceptMICRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -374,7 +374,7 @@ type DeriveSessionKeysRequest struct {
 func (m *DeriveSessionKeysRequest) Reset()      { *m = DeriveSessionKeysRequest{} }
 func (*DeriveSessionKeysRequest) ProtoMessage() {}
 func (*DeriveSessionKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{6}
+	return fileDescriptor_joinserver_2f23d45eb420f48c, []int{6}
 }
 func (m *DeriveSessionKeysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -435,7 +435,7 @@ type GetRootKeysRequest struct {
 func (m *GetRootKeysRequest) Reset()      { *m = GetRootKeysRequest{} }
 func (*GetRootKeysRequest) ProtoMessage() {}
 func (*GetRootKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{7}
+	return fileDescriptor_joinserver_2f23d45eb420f48c, []int{7}
 }
 func (m *GetRootKeysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -496,7 +496,7 @@ type ProvisionEndDevicesRequest struct {
 func (m *ProvisionEndDevicesRequest) Reset()      { *m = ProvisionEndDevicesRequest{} }
 func (*ProvisionEndDevicesRequest) ProtoMessage() {}
 func (*ProvisionEndDevicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{8}
+	return fileDescriptor_joinserver_2f23d45eb420f48c, []int{8}
 }
 func (m *ProvisionEndDevicesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -693,7 +693,7 @@ func (m *ProvisionEndDevicesRequest_IdentifiersList) Reset() {
 }
 func (*ProvisionEndDevicesRequest_IdentifiersList) ProtoMessage() {}
 func (*ProvisionEndDevicesRequest_IdentifiersList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{8, 0}
+	return fileDescriptor_joinserver_2f23d45eb420f48c, []int{8, 0}
 }
 func (m *ProvisionEndDevicesRequest_IdentifiersList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -742,7 +742,7 @@ func (m *ProvisionEndDevicesRequest_IdentifiersRange) Reset() {
 }
 func (*ProvisionEndDevicesRequest_IdentifiersRange) ProtoMessage() {}
 func (*ProvisionEndDevicesRequest_IdentifiersRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{8, 1}
+	return fileDescriptor_joinserver_2f23d45eb420f48c, []int{8, 1}
 }
 func (m *ProvisionEndDevicesRequest_IdentifiersRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -782,7 +782,7 @@ func (m *ProvisionEndDevicesRequest_IdentifiersFromData) Reset() {
 }
 func (*ProvisionEndDevicesRequest_IdentifiersFromData) ProtoMessage() {}
 func (*ProvisionEndDevicesRequest_IdentifiersFromData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{8, 2}
+	return fileDescriptor_joinserver_2f23d45eb420f48c, []int{8, 2}
 }
 func (m *ProvisionEndDevicesRequest_IdentifiersFromData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,6 +811,280 @@ func (m *ProvisionEndDevicesRequest_IdentifiersFromData) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProvisionEndDevicesRequest_IdentifiersFromData proto.InternalMessageInfo
 
+type StreamJoinEventsRequest struct {
+	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
+	// If set, only events of devices with this JoinEUI are streamed.
+	JoinEUI *go_thethings_network_lorawan_stack_pkg_types.EUI64 `protobuf:"bytes,2,opt,name=join_eui,json=joinEui,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.EUI64" json:"join_eui,omitempty"`
+	// If set, only events of the device with this DevEUI are streamed.
+	DevEUI *go_thethings_network_lorawan_stack_pkg_types.EUI64 `protobuf:"bytes,3,opt,name=dev_eui,json=devEui,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.EUI64" json:"dev_eui,omitempty"`
+	// If set, only events with this outcome are streamed.
+	// Supported values are empty (all outcomes), accept and reject.
+	Outcome              string   `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamJoinEventsRequest) Reset()      { *m = StreamJoinEventsRequest{} }
+func (*StreamJoinEventsRequest) ProtoMessage() {}
+func (*StreamJoinEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_joinserver_2f23d45eb420f48c, []int{9}
+}
+func (m *StreamJoinEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamJoinEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamJoinEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *StreamJoinEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamJoinEventsRequest.Merge(dst, src)
+}
+func (m *StreamJoinEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamJoinEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamJoinEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamJoinEventsRequest proto.InternalMessageInfo
+
+func (m *StreamJoinEventsRequest) GetOutcome() string {
+	if m != nil {
+		return m.Outcome
+	}
+	return ""
+}
+
+type EndDeviceSessions struct {
+	// The sessions of the end device, ordered by session key ID.
+	// The session keys contain the session key ID and the KEK labels of the keys, but not the (encrypted) keys.
+	Sessions             []*SessionKeys `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *EndDeviceSessions) Reset()      { *m = EndDeviceSessions{} }
+func (*EndDeviceSessions) ProtoMessage() {}
+func (*EndDeviceSessions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_joinserver_2f23d45eb420f48c, []int{10}
+}
+func (m *EndDeviceSessions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceSessions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceSessions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *EndDeviceSessions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceSessions.Merge(dst, src)
+}
+func (m *EndDeviceSessions) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceSessions) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceSessions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndDeviceSessions proto.InternalMessageInfo
+
+func (m *EndDeviceSessions) GetSessions() []*SessionKeys {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+type SessionKeysBatchRequest struct {
+	// LoRaWAN DevEUI.
+	DevEUI go_thethings_network_lorawan_stack_pkg_types.EUI64 `protobuf:"bytes,1,opt,name=dev_eui,json=devEui,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.EUI64" json:"dev_eui"`
+	// Join Server issued identifiers of the session keys.
+	SessionKeyIDs        [][]byte `protobuf:"bytes,2,rep,name=session_key_ids,json=sessionKeyIds,proto3" json:"session_key_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionKeysBatchRequest) Reset()      { *m = SessionKeysBatchRequest{} }
+func (*SessionKeysBatchRequest) ProtoMessage() {}
+func (*SessionKeysBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_joinserver_2f23d45eb420f48c, []int{11}
+}
+func (m *SessionKeysBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionKeysBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionKeysBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SessionKeysBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionKeysBatchRequest.Merge(dst, src)
+}
+func (m *SessionKeysBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionKeysBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionKeysBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionKeysBatchRequest proto.InternalMessageInfo
+
+func (m *SessionKeysBatchRequest) GetSessionKeyIDs() [][]byte {
+	if m != nil {
+		return m.SessionKeyIDs
+	}
+	return nil
+}
+
+type NwkSKeysBatchResponse struct {
+	// The NwkSKeys of the session keys, in the same order as the requested session key IDs.
+	// The NwkSKeys of session keys that are not found are empty.
+	NwkSKeys             []*NwkSKeysResponse `protobuf:"bytes,1,rep,name=nwk_s_keys,json=nwkSKeys,proto3" json:"nwk_s_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *NwkSKeysBatchResponse) Reset()      { *m = NwkSKeysBatchResponse{} }
+func (*NwkSKeysBatchResponse) ProtoMessage() {}
+func (*NwkSKeysBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_joinserver_2f23d45eb420f48c, []int{12}
+}
+func (m *NwkSKeysBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NwkSKeysBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NwkSKeysBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *NwkSKeysBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NwkSKeysBatchResponse.Merge(dst, src)
+}
+func (m *NwkSKeysBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NwkSKeysBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NwkSKeysBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NwkSKeysBatchResponse proto.InternalMessageInfo
+
+func (m *NwkSKeysBatchResponse) GetNwkSKeys() []*NwkSKeysResponse {
+	if m != nil {
+		return m.NwkSKeys
+	}
+	return nil
+}
+
+type GetHomeNetIDRequest struct {
+	// LoRaWAN JoinEUI (or AppEUI).
+	JoinEUI go_thethings_network_lorawan_stack_pkg_types.EUI64 `protobuf:"bytes,1,opt,name=join_eui,json=joinEui,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.EUI64" json:"join_eui"`
+	// LoRaWAN DevEUI.
+	DevEUI               go_thethings_network_lorawan_stack_pkg_types.EUI64 `protobuf:"bytes,2,opt,name=dev_eui,json=devEui,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.EUI64" json:"dev_eui"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *GetHomeNetIDRequest) Reset()      { *m = GetHomeNetIDRequest{} }
+func (*GetHomeNetIDRequest) ProtoMessage() {}
+func (*GetHomeNetIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_joinserver_2f23d45eb420f48c, []int{13}
+}
+func (m *GetHomeNetIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetHomeNetIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetHomeNetIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetHomeNetIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHomeNetIDRequest.Merge(dst, src)
+}
+func (m *GetHomeNetIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetHomeNetIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHomeNetIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHomeNetIDRequest proto.InternalMessageInfo
+
+type GetHomeNetIDResponse struct {
+	// The NetID of the home network of the device.
+	NetID                go_thethings_network_lorawan_stack_pkg_types.NetID `protobuf:"bytes,1,opt,name=net_id,json=netId,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.NetID" json:"net_id"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *GetHomeNetIDResponse) Reset()      { *m = GetHomeNetIDResponse{} }
+func (*GetHomeNetIDResponse) ProtoMessage() {}
+func (*GetHomeNetIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_joinserver_2f23d45eb420f48c, []int{14}
+}
+func (m *GetHomeNetIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetHomeNetIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetHomeNetIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetHomeNetIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHomeNetIDResponse.Merge(dst, src)
+}
+func (m *GetHomeNetIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetHomeNetIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHomeNetIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHomeNetIDResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*SessionKeyRequest)(nil), "ttn.lorawan.v3.SessionKeyRequest")
 	golang_proto.RegisterType((*SessionKeyRequest)(nil), "ttn.lorawan.v3.SessionKeyRequest")
@@ -836,6 +1110,18 @@ func init() {
 	golang_proto.RegisterType((*ProvisionEndDevicesRequest_IdentifiersRange)(nil), "ttn.lorawan.v3.ProvisionEndDevicesRequest.IdentifiersRange")
 	proto.RegisterType((*ProvisionEndDevicesRequest_IdentifiersFromData)(nil), "ttn.lorawan.v3.ProvisionEndDevicesRequest.IdentifiersFromData")
 	golang_proto.RegisterType((*ProvisionEndDevicesRequest_IdentifiersFromData)(nil), "ttn.lorawan.v3.ProvisionEndDevicesRequest.IdentifiersFromData")
+	proto.RegisterType((*StreamJoinEventsRequest)(nil), "ttn.lorawan.v3.StreamJoinEventsRequest")
+	golang_proto.RegisterType((*StreamJoinEventsRequest)(nil), "ttn.lorawan.v3.StreamJoinEventsRequest")
+	proto.RegisterType((*EndDeviceSessions)(nil), "ttn.lorawan.v3.EndDeviceSessions")
+	golang_proto.RegisterType((*EndDeviceSessions)(nil), "ttn.lorawan.v3.EndDeviceSessions")
+	proto.RegisterType((*SessionKeysBatchRequest)(nil), "ttn.lorawan.v3.SessionKeysBatchRequest")
+	golang_proto.RegisterType((*SessionKeysBatchRequest)(nil), "ttn.lorawan.v3.SessionKeysBatchRequest")
+	proto.RegisterType((*NwkSKeysBatchResponse)(nil), "ttn.lorawan.v3.NwkSKeysBatchResponse")
+	golang_proto.RegisterType((*NwkSKeysBatchResponse)(nil), "ttn.lorawan.v3.NwkSKeysBatchResponse")
+	proto.RegisterType((*GetHomeNetIDRequest)(nil), "ttn.lorawan.v3.GetHomeNetIDRequest")
+	golang_proto.RegisterType((*GetHomeNetIDRequest)(nil), "ttn.lorawan.v3.GetHomeNetIDRequest")
+	proto.RegisterType((*GetHomeNetIDResponse)(nil), "ttn.lorawan.v3.GetHomeNetIDResponse")
+	golang_proto.RegisterType((*GetHomeNetIDResponse)(nil), "ttn.lorawan.v3.GetHomeNetIDResponse")
 }
 func (this *SessionKeyRequest) Equal(that interface{}) bool {
 	if that == nil {
@@ -1286,84 +1572,292 @@ func (this *ProvisionEndDevicesRequest_IdentifiersFromData) Equal(that interface
 	}
 	return true
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// NsJsClient is the client API for NsJs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type NsJsClient interface {
-	HandleJoin(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinResponse, error)
-	GetNwkSKeys(ctx context.Context, in *SessionKeyRequest, opts ...grpc.CallOption) (*NwkSKeysResponse, error)
-}
-
-type nsJsClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewNsJsClient(cc *grpc.ClientConn) NsJsClient {
-	return &nsJsClient{cc}
-}
-
-func (c *nsJsClient) HandleJoin(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinResponse, error) {
-	out := new(JoinResponse)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsJs/HandleJoin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *StreamJoinEventsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
 
-func (c *nsJsClient) GetNwkSKeys(ctx context.Context, in *SessionKeyRequest, opts ...grpc.CallOption) (*NwkSKeysResponse, error) {
-	out := new(NwkSKeysResponse)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsJs/GetNwkSKeys", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*StreamJoinEventsRequest)
+	if !ok {
+		that2, ok := that.(StreamJoinEventsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-// NsJsServer is the server API for NsJs service.
-type NsJsServer interface {
-	HandleJoin(context.Context, *JoinRequest) (*JoinResponse, error)
-	GetNwkSKeys(context.Context, *SessionKeyRequest) (*NwkSKeysResponse, error)
-}
-
-func RegisterNsJsServer(s *grpc.Server, srv NsJsServer) {
-	s.RegisterService(&_NsJs_serviceDesc, srv)
-}
-
-func _NsJs_HandleJoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JoinRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(NsJsServer).HandleJoin(ctx, in)
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.NsJs/HandleJoin",
+	if that1.JoinEUI == nil {
+		if this.JoinEUI != nil {
+			return false
+		}
+	} else if !this.JoinEUI.Equal(*that1.JoinEUI) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsJsServer).HandleJoin(ctx, req.(*JoinRequest))
+	if that1.DevEUI == nil {
+		if this.DevEUI != nil {
+			return false
+		}
+	} else if !this.DevEUI.Equal(*that1.DevEUI) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _NsJs_GetNwkSKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SessionKeyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	if this.Outcome != that1.Outcome {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(NsJsServer).GetNwkSKeys(ctx, in)
+	return true
+}
+func (this *EndDeviceSessions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EndDeviceSessions)
+	if !ok {
+		that2, ok := that.(EndDeviceSessions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Sessions) != len(that1.Sessions) {
+		return false
+	}
+	for i := range this.Sessions {
+		if !this.Sessions[i].Equal(that1.Sessions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SessionKeysBatchRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SessionKeysBatchRequest)
+	if !ok {
+		that2, ok := that.(SessionKeysBatchRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DevEUI.Equal(that1.DevEUI) {
+		return false
+	}
+	if len(this.SessionKeyIDs) != len(that1.SessionKeyIDs) {
+		return false
+	}
+	for i := range this.SessionKeyIDs {
+		if !bytes.Equal(this.SessionKeyIDs[i], that1.SessionKeyIDs[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *NwkSKeysBatchResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NwkSKeysBatchResponse)
+	if !ok {
+		that2, ok := that.(NwkSKeysBatchResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.NwkSKeys) != len(that1.NwkSKeys) {
+		return false
+	}
+	for i := range this.NwkSKeys {
+		if !this.NwkSKeys[i].Equal(that1.NwkSKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GetHomeNetIDRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetHomeNetIDRequest)
+	if !ok {
+		that2, ok := that.(GetHomeNetIDRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.JoinEUI.Equal(that1.JoinEUI) {
+		return false
+	}
+	if !this.DevEUI.Equal(that1.DevEUI) {
+		return false
+	}
+	return true
+}
+func (this *GetHomeNetIDResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetHomeNetIDResponse)
+	if !ok {
+		that2, ok := that.(GetHomeNetIDResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.NetID.Equal(that1.NetID) {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NsJsClient is the client API for NsJs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NsJsClient interface {
+	HandleJoin(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinResponse, error)
+	GetNwkSKeys(ctx context.Context, in *SessionKeyRequest, opts ...grpc.CallOption) (*NwkSKeysResponse, error)
+	// GetNwkSKeysBatch returns the NwkSKeys of multiple session keys of the device at once.
+	GetNwkSKeysBatch(ctx context.Context, in *SessionKeysBatchRequest, opts ...grpc.CallOption) (*NwkSKeysBatchResponse, error)
+	// GetHomeNetID returns the NetID of the home network of the device.
+	GetHomeNetID(ctx context.Context, in *GetHomeNetIDRequest, opts ...grpc.CallOption) (*GetHomeNetIDResponse, error)
+}
+
+type nsJsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNsJsClient(cc *grpc.ClientConn) NsJsClient {
+	return &nsJsClient{cc}
+}
+
+func (c *nsJsClient) HandleJoin(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinResponse, error) {
+	out := new(JoinResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsJs/HandleJoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsJsClient) GetNwkSKeys(ctx context.Context, in *SessionKeyRequest, opts ...grpc.CallOption) (*NwkSKeysResponse, error) {
+	out := new(NwkSKeysResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsJs/GetNwkSKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsJsClient) GetNwkSKeysBatch(ctx context.Context, in *SessionKeysBatchRequest, opts ...grpc.CallOption) (*NwkSKeysBatchResponse, error) {
+	out := new(NwkSKeysBatchResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsJs/GetNwkSKeysBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsJsClient) GetHomeNetID(ctx context.Context, in *GetHomeNetIDRequest, opts ...grpc.CallOption) (*GetHomeNetIDResponse, error) {
+	out := new(GetHomeNetIDResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsJs/GetHomeNetID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NsJsServer is the server API for NsJs service.
+type NsJsServer interface {
+	HandleJoin(context.Context, *JoinRequest) (*JoinResponse, error)
+	GetNwkSKeys(context.Context, *SessionKeyRequest) (*NwkSKeysResponse, error)
+	// GetNwkSKeysBatch returns the NwkSKeys of multiple session keys of the device at once.
+	GetNwkSKeysBatch(context.Context, *SessionKeysBatchRequest) (*NwkSKeysBatchResponse, error)
+	// GetHomeNetID returns the NetID of the home network of the device.
+	GetHomeNetID(context.Context, *GetHomeNetIDRequest) (*GetHomeNetIDResponse, error)
+}
+
+func RegisterNsJsServer(s *grpc.Server, srv NsJsServer) {
+	s.RegisterService(&_NsJs_serviceDesc, srv)
+}
+
+func _NsJs_HandleJoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsJsServer).HandleJoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsJs/HandleJoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsJsServer).HandleJoin(ctx, req.(*JoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsJs_GetNwkSKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsJsServer).GetNwkSKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -1375,6 +1869,42 @@ func _NsJs_GetNwkSKeys_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NsJs_GetNwkSKeysBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionKeysBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsJsServer).GetNwkSKeysBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsJs/GetNwkSKeysBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsJsServer).GetNwkSKeysBatch(ctx, req.(*SessionKeysBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsJs_GetHomeNetID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHomeNetIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsJsServer).GetHomeNetID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsJs/GetHomeNetID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsJsServer).GetHomeNetID(ctx, req.(*GetHomeNetIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NsJs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.NsJs",
 	HandlerType: (*NsJsServer)(nil),
@@ -1387,6 +1917,14 @@ var _NsJs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetNwkSKeys",
 			Handler:    _NsJs_GetNwkSKeys_Handler,
 		},
+		{
+			MethodName: "GetNwkSKeysBatch",
+			Handler:    _NsJs_GetNwkSKeysBatch_Handler,
+		},
+		{
+			MethodName: "GetHomeNetID",
+			Handler:    _NsJs_GetHomeNetID_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/joinserver.proto",
@@ -1801,6 +2339,14 @@ type JsEndDeviceRegistryClient interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// StreamJoinEvents streams the join events of the devices of the application that match the given filter.
+	StreamJoinEvents(ctx context.Context, in *StreamJoinEventsRequest, opts ...grpc.CallOption) (JsEndDeviceRegistry_StreamJoinEventsClient, error)
+	// PrecomputeKeys derives the session keys of the LoRaWAN 1.1 device for the expected next join, so that the
+	// join-accept of that join can be created without deriving the keys.
+	PrecomputeKeys(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// ListSessions returns the sessions of the device that matches the given identifiers, ordered by session key ID.
+	// The session keys contain the session key ID and the KEK labels of the keys, but not the (encrypted) keys.
+	ListSessions(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceSessions, error)
 }
 
 type jsEndDeviceRegistryClient struct {
@@ -1870,28 +2416,86 @@ func (c *jsEndDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIde
 	return out, nil
 }
 
-// JsEndDeviceRegistryServer is the server API for JsEndDeviceRegistry service.
-type JsEndDeviceRegistryServer interface {
-	// Get returns the device that matches the given identifiers.
-	// If there are multiple matches, an error will be returned.
-	Get(context.Context, *GetEndDeviceRequest) (*EndDevice, error)
-	// Set creates or updates the device.
-	Set(context.Context, *SetEndDeviceRequest) (*EndDevice, error)
-	// Provision returns end devices that are provisioned using the given vendor-specific data.
-	// The devices are not set in the registry.
-	Provision(*ProvisionEndDevicesRequest, JsEndDeviceRegistry_ProvisionServer) error
-	// Delete deletes the device that matches the given identifiers.
-	// If there are multiple matches, an error will be returned.
-	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
-}
-
-func RegisterJsEndDeviceRegistryServer(s *grpc.Server, srv JsEndDeviceRegistryServer) {
-	s.RegisterService(&_JsEndDeviceRegistry_serviceDesc, srv)
-}
-
-func _JsEndDeviceRegistry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEndDeviceRequest)
-	if err := dec(in); err != nil {
+func (c *jsEndDeviceRegistryClient) StreamJoinEvents(ctx context.Context, in *StreamJoinEventsRequest, opts ...grpc.CallOption) (JsEndDeviceRegistry_StreamJoinEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_JsEndDeviceRegistry_serviceDesc.Streams[1], "/ttn.lorawan.v3.JsEndDeviceRegistry/StreamJoinEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jsEndDeviceRegistryStreamJoinEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JsEndDeviceRegistry_StreamJoinEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type jsEndDeviceRegistryStreamJoinEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *jsEndDeviceRegistryStreamJoinEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jsEndDeviceRegistryClient) PrecomputeKeys(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.JsEndDeviceRegistry/PrecomputeKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jsEndDeviceRegistryClient) ListSessions(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceSessions, error) {
+	out := new(EndDeviceSessions)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.JsEndDeviceRegistry/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JsEndDeviceRegistryServer is the server API for JsEndDeviceRegistry service.
+type JsEndDeviceRegistryServer interface {
+	// Get returns the device that matches the given identifiers.
+	// If there are multiple matches, an error will be returned.
+	Get(context.Context, *GetEndDeviceRequest) (*EndDevice, error)
+	// Set creates or updates the device.
+	Set(context.Context, *SetEndDeviceRequest) (*EndDevice, error)
+	// Provision returns end devices that are provisioned using the given vendor-specific data.
+	// The devices are not set in the registry.
+	Provision(*ProvisionEndDevicesRequest, JsEndDeviceRegistry_ProvisionServer) error
+	// Delete deletes the device that matches the given identifiers.
+	// If there are multiple matches, an error will be returned.
+	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+	// StreamJoinEvents streams the join events of the devices of the application that match the given filter.
+	StreamJoinEvents(*StreamJoinEventsRequest, JsEndDeviceRegistry_StreamJoinEventsServer) error
+	// PrecomputeKeys derives the session keys of the LoRaWAN 1.1 device for the expected next join, so that the
+	// join-accept of that join can be created without deriving the keys.
+	PrecomputeKeys(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+	// ListSessions returns the sessions of the device that matches the given identifiers, ordered by session key ID.
+	// The session keys contain the session key ID and the KEK labels of the keys, but not the (encrypted) keys.
+	ListSessions(context.Context, *EndDeviceIdentifiers) (*EndDeviceSessions, error)
+}
+
+func RegisterJsEndDeviceRegistryServer(s *grpc.Server, srv JsEndDeviceRegistryServer) {
+	s.RegisterService(&_JsEndDeviceRegistry_serviceDesc, srv)
+}
+
+func _JsEndDeviceRegistry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEndDeviceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
@@ -1964,6 +2568,63 @@ func _JsEndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JsEndDeviceRegistry_StreamJoinEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamJoinEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JsEndDeviceRegistryServer).StreamJoinEvents(m, &jsEndDeviceRegistryStreamJoinEventsServer{stream})
+}
+
+type JsEndDeviceRegistry_StreamJoinEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type jsEndDeviceRegistryStreamJoinEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *jsEndDeviceRegistryStreamJoinEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JsEndDeviceRegistry_PrecomputeKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsEndDeviceRegistryServer).PrecomputeKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.JsEndDeviceRegistry/PrecomputeKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsEndDeviceRegistryServer).PrecomputeKeys(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JsEndDeviceRegistry_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsEndDeviceRegistryServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.JsEndDeviceRegistry/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsEndDeviceRegistryServer).ListSessions(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _JsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.JsEndDeviceRegistry",
 	HandlerType: (*JsEndDeviceRegistryServer)(nil),
@@ -1980,6 +2641,14 @@ var _JsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _JsEndDeviceRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "PrecomputeKeys",
+			Handler:    _JsEndDeviceRegistry_PrecomputeKeys_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _JsEndDeviceRegistry_ListSessions_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1987,6 +2656,11 @@ var _JsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 			Handler:       _JsEndDeviceRegistry_Provision_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamJoinEvents",
+			Handler:       _JsEndDeviceRegistry_StreamJoinEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "lorawan-stack/api/joinserver.proto",
 }
@@ -2511,99 +3185,305 @@ func (m *ProvisionEndDevicesRequest_IdentifiersFromData) MarshalTo(dAtA []byte)
 	return i, nil
 }
 
-func encodeVarintJoinserver(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StreamJoinEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func NewPopulatedSessionKeyRequest(r randyJoinserver, easy bool) *SessionKeyRequest {
-	this := &SessionKeyRequest{}
-	v1 := r.Intn(100)
-	this.SessionKeyID = make([]byte, v1)
-	for i := 0; i < v1; i++ {
-		this.SessionKeyID[i] = byte(r.Intn(256))
+
+func (m *StreamJoinEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintJoinserver(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
+	n26, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	v2 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
-	this.DevEUI = *v2
-	if !easy && r.Intn(10) != 0 {
+	i += n26
+	if m.JoinEUI != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintJoinserver(dAtA, i, uint64(m.JoinEUI.Size()))
+		n27, err := m.JoinEUI.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
 	}
-	return this
+	if m.DevEUI != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintJoinserver(dAtA, i, uint64(m.DevEUI.Size()))
+		n28, err := m.DevEUI.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if len(m.Outcome) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintJoinserver(dAtA, i, uint64(len(m.Outcome)))
+		i += copy(dAtA[i:], m.Outcome)
+	}
+	return i, nil
 }
 
-func NewPopulatedNwkSKeysResponse(r randyJoinserver, easy bool) *NwkSKeysResponse {
-	this := &NwkSKeysResponse{}
-	v3 := NewPopulatedKeyEnvelope(r, easy)
-	this.FNwkSIntKey = *v3
-	v4 := NewPopulatedKeyEnvelope(r, easy)
-	this.SNwkSIntKey = *v4
-	v5 := NewPopulatedKeyEnvelope(r, easy)
-	this.NwkSEncKey = *v5
-	if !easy && r.Intn(10) != 0 {
+func (m *EndDeviceSessions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedAppSKeyResponse(r randyJoinserver, easy bool) *AppSKeyResponse {
-	this := &AppSKeyResponse{}
-	v6 := NewPopulatedKeyEnvelope(r, easy)
-	this.AppSKey = *v6
-	if !easy && r.Intn(10) != 0 {
+func (m *EndDeviceSessions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for _, msg := range m.Sessions {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintJoinserver(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	return this
+	return i, nil
 }
 
-func NewPopulatedCryptoServicePayloadRequest(r randyJoinserver, easy bool) *CryptoServicePayloadRequest {
-	this := &CryptoServicePayloadRequest{}
-	v7 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v7
-	this.LoRaWANVersion = MACVersion([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
-	v8 := r.Intn(100)
-	this.Payload = make([]byte, v8)
-	for i := 0; i < v8; i++ {
-		this.Payload[i] = byte(r.Intn(256))
-	}
-	this.ProvisionerID = randStringJoinserver(r)
-	if r.Intn(10) != 0 {
-		this.ProvisioningData = types.NewPopulatedStruct(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *SessionKeysBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedCryptoServicePayloadResponse(r randyJoinserver, easy bool) *CryptoServicePayloadResponse {
-	this := &CryptoServicePayloadResponse{}
-	v9 := r.Intn(100)
-	this.Payload = make([]byte, v9)
-	for i := 0; i < v9; i++ {
-		this.Payload[i] = byte(r.Intn(256))
+func (m *SessionKeysBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintJoinserver(dAtA, i, uint64(m.DevEUI.Size()))
+	n29, err := m.DevEUI.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if !easy && r.Intn(10) != 0 {
+	i += n29
+	if len(m.SessionKeyIDs) > 0 {
+		for _, b := range m.SessionKeyIDs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintJoinserver(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
-	return this
+	return i, nil
 }
 
-func NewPopulatedJoinAcceptMICRequest(r randyJoinserver, easy bool) *JoinAcceptMICRequest {
-	this := &JoinAcceptMICRequest{}
-	v10 := NewPopulatedCryptoServicePayloadRequest(r, easy)
-	this.CryptoServicePayloadRequest = *v10
-	this.JoinRequestType = r.Uint32()
-	v11 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedDevNonce(r)
-	this.DevNonce = *v11
-	if !easy && r.Intn(10) != 0 {
+func (m *NwkSKeysBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedDeriveSessionKeysRequest(r randyJoinserver, easy bool) *DeriveSessionKeysRequest {
-	this := &DeriveSessionKeysRequest{}
-	v12 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v12
-	this.LoRaWANVersion = MACVersion([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+func (m *NwkSKeysBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.NwkSKeys) > 0 {
+		for _, msg := range m.NwkSKeys {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintJoinserver(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GetHomeNetIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHomeNetIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintJoinserver(dAtA, i, uint64(m.JoinEUI.Size()))
+	n30, err := m.JoinEUI.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n30
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintJoinserver(dAtA, i, uint64(m.DevEUI.Size()))
+	n31, err := m.DevEUI.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n31
+	return i, nil
+}
+
+func (m *GetHomeNetIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHomeNetIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintJoinserver(dAtA, i, uint64(m.NetID.Size()))
+	n32, err := m.NetID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n32
+	return i, nil
+}
+
+func encodeVarintJoinserver(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedSessionKeyRequest(r randyJoinserver, easy bool) *SessionKeyRequest {
+	this := &SessionKeyRequest{}
+	v1 := r.Intn(100)
+	this.SessionKeyID = make([]byte, v1)
+	for i := 0; i < v1; i++ {
+		this.SessionKeyID[i] = byte(r.Intn(256))
+	}
+	v2 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.DevEUI = *v2
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedNwkSKeysResponse(r randyJoinserver, easy bool) *NwkSKeysResponse {
+	this := &NwkSKeysResponse{}
+	v3 := NewPopulatedKeyEnvelope(r, easy)
+	this.FNwkSIntKey = *v3
+	v4 := NewPopulatedKeyEnvelope(r, easy)
+	this.SNwkSIntKey = *v4
+	v5 := NewPopulatedKeyEnvelope(r, easy)
+	this.NwkSEncKey = *v5
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAppSKeyResponse(r randyJoinserver, easy bool) *AppSKeyResponse {
+	this := &AppSKeyResponse{}
+	v6 := NewPopulatedKeyEnvelope(r, easy)
+	this.AppSKey = *v6
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCryptoServicePayloadRequest(r randyJoinserver, easy bool) *CryptoServicePayloadRequest {
+	this := &CryptoServicePayloadRequest{}
+	v7 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v7
+	this.LoRaWANVersion = MACVersion([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	v8 := r.Intn(100)
+	this.Payload = make([]byte, v8)
+	for i := 0; i < v8; i++ {
+		this.Payload[i] = byte(r.Intn(256))
+	}
+	this.ProvisionerID = randStringJoinserver(r)
+	if r.Intn(10) != 0 {
+		this.ProvisioningData = types.NewPopulatedStruct(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCryptoServicePayloadResponse(r randyJoinserver, easy bool) *CryptoServicePayloadResponse {
+	this := &CryptoServicePayloadResponse{}
+	v9 := r.Intn(100)
+	this.Payload = make([]byte, v9)
+	for i := 0; i < v9; i++ {
+		this.Payload[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedJoinAcceptMICRequest(r randyJoinserver, easy bool) *JoinAcceptMICRequest {
+	this := &JoinAcceptMICRequest{}
+	v10 := NewPopulatedCryptoServicePayloadRequest(r, easy)
+	this.CryptoServicePayloadRequest = *v10
+	this.JoinRequestType = r.Uint32()
+	v11 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedDevNonce(r)
+	this.DevNonce = *v11
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeriveSessionKeysRequest(r randyJoinserver, easy bool) *DeriveSessionKeysRequest {
+	this := &DeriveSessionKeysRequest{}
+	v12 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v12
+	this.LoRaWANVersion = MACVersion([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
 	v13 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedJoinNonce(r)
 	this.JoinNonce = *v13
 	v14 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedDevNonce(r)
@@ -2705,6 +3585,70 @@ func NewPopulatedProvisionEndDevicesRequest_IdentifiersFromData(r randyJoinserve
 	return this
 }
 
+func NewPopulatedStreamJoinEventsRequest(r randyJoinserver, easy bool) *StreamJoinEventsRequest {
+	this := &StreamJoinEventsRequest{}
+	v22 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v22
+	this.JoinEUI = go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.DevEUI = go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.Outcome = randStringJoinserver(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSessionKeysBatchRequest(r randyJoinserver, easy bool) *SessionKeysBatchRequest {
+	this := &SessionKeysBatchRequest{}
+	v23 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.DevEUI = *v23
+	v24 := r.Intn(10)
+	this.SessionKeyIDs = make([][]byte, v24)
+	for i := 0; i < v24; i++ {
+		v25 := r.Intn(100)
+		this.SessionKeyIDs[i] = make([]byte, v25)
+		for j := 0; j < v25; j++ {
+			this.SessionKeyIDs[i][j] = byte(r.Intn(256))
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedNwkSKeysBatchResponse(r randyJoinserver, easy bool) *NwkSKeysBatchResponse {
+	this := &NwkSKeysBatchResponse{}
+	if r.Intn(10) != 0 {
+		v26 := r.Intn(5)
+		this.NwkSKeys = make([]*NwkSKeysResponse, v26)
+		for i := 0; i < v26; i++ {
+			this.NwkSKeys[i] = NewPopulatedNwkSKeysResponse(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetHomeNetIDRequest(r randyJoinserver, easy bool) *GetHomeNetIDRequest {
+	this := &GetHomeNetIDRequest{}
+	v27 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.JoinEUI = *v27
+	v28 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.DevEUI = *v28
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetHomeNetIDResponse(r randyJoinserver, easy bool) *GetHomeNetIDResponse {
+	this := &GetHomeNetIDResponse{}
+	v29 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedNetID(r)
+	this.NetID = *v29
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyJoinserver interface {
 	Float32() float32
 	Float64() float64
@@ -2724,9 +3668,9 @@ func randUTF8RuneJoinserver(r randyJoinserver) rune {
 	return rune(ru + 61)
 }
 func randStringJoinserver(r randyJoinserver) string {
-	v22 := r.Intn(100)
-	tmps := make([]rune, v22)
-	for i := 0; i < v22; i++ {
+	v30 := r.Intn(100)
+	tmps := make([]rune, v30)
+	for i := 0; i < v30; i++ {
 		tmps[i] = randUTF8RuneJoinserver(r)
 	}
 	return string(tmps)
@@ -2748,11 +3692,11 @@ func randFieldJoinserver(dAtA []byte, r randyJoinserver, fieldNumber int, wire i
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(key))
-		v23 := r.Int63()
+		v31 := r.Int63()
 		if r.Intn(2) == 0 {
-			v23 *= -1
+			v31 *= -1
 		}
-		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(v23))
+		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(v31))
 	case 1:
 		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3025,53 +3969,147 @@ func (m *ProvisionEndDevicesRequest_IdentifiersFromData) Size() (n int) {
 	return n
 }
 
-func sovJoinserver(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *StreamJoinEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	if m.JoinEUI != nil {
+		l = m.JoinEUI.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	if m.DevEUI != nil {
+		l = m.DevEUI.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	l = len(m.Outcome)
+	if l > 0 {
+		n += 1 + l + sovJoinserver(uint64(l))
 	}
 	return n
 }
-func sozJoinserver(x uint64) (n int) {
-	return sovJoinserver((x << 1) ^ uint64((int64(x) >> 63)))
-}
-func (this *SessionKeyRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EndDeviceSessions) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&SessionKeyRequest{`,
-		`SessionKeyID:` + fmt.Sprintf("%v", this.SessionKeyID) + `,`,
-		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *NwkSKeysResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovJoinserver(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&NwkSKeysResponse{`,
-		`FNwkSIntKey:` + strings.Replace(strings.Replace(this.FNwkSIntKey.String(), "KeyEnvelope", "KeyEnvelope", 1), `&`, ``, 1) + `,`,
-		`SNwkSIntKey:` + strings.Replace(strings.Replace(this.SNwkSIntKey.String(), "KeyEnvelope", "KeyEnvelope", 1), `&`, ``, 1) + `,`,
-		`NwkSEncKey:` + strings.Replace(strings.Replace(this.NwkSEncKey.String(), "KeyEnvelope", "KeyEnvelope", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *AppSKeyResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SessionKeysBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&AppSKeyResponse{`,
-		`AppSKey:` + strings.Replace(strings.Replace(this.AppSKey.String(), "KeyEnvelope", "KeyEnvelope", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CryptoServicePayloadRequest) String() string {
+	var l int
+	_ = l
+	l = m.DevEUI.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	if len(m.SessionKeyIDs) > 0 {
+		for _, b := range m.SessionKeyIDs {
+			l = len(b)
+			n += 1 + l + sovJoinserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NwkSKeysBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NwkSKeys) > 0 {
+		for _, e := range m.NwkSKeys {
+			l = e.Size()
+			n += 1 + l + sovJoinserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetHomeNetIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.JoinEUI.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	l = m.DevEUI.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	return n
+}
+
+func (m *GetHomeNetIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetID.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	return n
+}
+
+func sovJoinserver(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozJoinserver(x uint64) (n int) {
+	return sovJoinserver((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *SessionKeyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SessionKeyRequest{`,
+		`SessionKeyID:` + fmt.Sprintf("%v", this.SessionKeyID) + `,`,
+		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NwkSKeysResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NwkSKeysResponse{`,
+		`FNwkSIntKey:` + strings.Replace(strings.Replace(this.FNwkSIntKey.String(), "KeyEnvelope", "KeyEnvelope", 1), `&`, ``, 1) + `,`,
+		`SNwkSIntKey:` + strings.Replace(strings.Replace(this.SNwkSIntKey.String(), "KeyEnvelope", "KeyEnvelope", 1), `&`, ``, 1) + `,`,
+		`NwkSEncKey:` + strings.Replace(strings.Replace(this.NwkSEncKey.String(), "KeyEnvelope", "KeyEnvelope", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AppSKeyResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AppSKeyResponse{`,
+		`AppSKey:` + strings.Replace(strings.Replace(this.AppSKey.String(), "KeyEnvelope", "KeyEnvelope", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CryptoServicePayloadRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
@@ -3210,6 +4248,71 @@ func (this *ProvisionEndDevicesRequest_IdentifiersFromData) String() string {
 	}, "")
 	return s
 }
+func (this *StreamJoinEventsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamJoinEventsRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationIdentifiers.String(), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
+		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
+		`Outcome:` + fmt.Sprintf("%v", this.Outcome) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceSessions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceSessions{`,
+		`Sessions:` + strings.Replace(fmt.Sprintf("%v", this.Sessions), "SessionKeys", "SessionKeys", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SessionKeysBatchRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SessionKeysBatchRequest{`,
+		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
+		`SessionKeyIDs:` + fmt.Sprintf("%v", this.SessionKeyIDs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NwkSKeysBatchResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NwkSKeysBatchResponse{`,
+		`NwkSKeys:` + strings.Replace(fmt.Sprintf("%v", this.NwkSKeys), "NwkSKeysResponse", "NwkSKeysResponse", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetHomeNetIDRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetHomeNetIDRequest{`,
+		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
+		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetHomeNetIDResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetHomeNetIDResponse{`,
+		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringJoinserver(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -4288,13 +5391,557 @@ func (m *GetRootKeysRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProvisionerID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.ProvisionerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProvisioningData == nil {
+				m.ProvisioningData = &types.Struct{}
+			}
+			if err := m.ProvisioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProvisionEndDevicesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProvisionEndDevicesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProvisionEndDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProvisionerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProvisioningData = append(m.ProvisioningData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProvisioningData == nil {
+				m.ProvisioningData = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ProvisionEndDevicesRequest_IdentifiersList{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.EndDevices = &ProvisionEndDevicesRequest_List{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ProvisionEndDevicesRequest_IdentifiersRange{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.EndDevices = &ProvisionEndDevicesRequest_Range{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ProvisionEndDevicesRequest_IdentifiersFromData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.EndDevices = &ProvisionEndDevicesRequest_FromData{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProvisionEndDevicesRequest_IdentifiersList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiersList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiersList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v go_thethings_network_lorawan_stack_pkg_types.EUI64
+			m.JoinEUI = &v
+			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndDeviceIDs = append(m.EndDeviceIDs, EndDeviceIdentifiers{})
+			if err := m.EndDeviceIDs[len(m.EndDeviceIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProvisionEndDevicesRequest_IdentifiersRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiersRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiersRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v go_thethings_network_lorawan_stack_pkg_types.EUI64
+			m.JoinEUI = &v
+			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDevEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartDevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProvisionEndDevicesRequest_IdentifiersFromData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiersFromData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiersFromData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -4304,22 +5951,21 @@ func (m *GetRootKeysRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProvisioningData == nil {
-				m.ProvisioningData = &types.Struct{}
-			}
-			if err := m.ProvisioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v go_thethings_network_lorawan_stack_pkg_types.EUI64
+			m.JoinEUI = &v
+			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4344,7 +5990,7 @@ func (m *GetRootKeysRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProvisionEndDevicesRequest) Unmarshal(dAtA []byte) error {
+func (m *StreamJoinEventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4367,10 +6013,10 @@ func (m *ProvisionEndDevicesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProvisionEndDevicesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamJoinEventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProvisionEndDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamJoinEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4405,9 +6051,9 @@ func (m *ProvisionEndDevicesRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -4417,24 +6063,27 @@ func (m *ProvisionEndDevicesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProvisionerID = string(dAtA[iNdEx:postIndex])
+			var v go_thethings_network_lorawan_stack_pkg_types.EUI64
+			m.JoinEUI = &v
+			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4458,16 +6107,17 @@ func (m *ProvisionEndDevicesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProvisioningData = append(m.ProvisioningData[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProvisioningData == nil {
-				m.ProvisioningData = []byte{}
+			var v go_thethings_network_lorawan_stack_pkg_types.EUI64
+			m.DevEUI = &v
+			if err := m.DevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -4477,59 +6127,74 @@ func (m *ProvisionEndDevicesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ProvisionEndDevicesRequest_IdentifiersList{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Outcome = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.EndDevices = &ProvisionEndDevicesRequest_List{v}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowJoinserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthJoinserver
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDeviceSessions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ProvisionEndDevicesRequest_IdentifiersRange{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.EndDevices = &ProvisionEndDevicesRequest_Range{v}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDeviceSessions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDeviceSessions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4553,11 +6218,10 @@ func (m *ProvisionEndDevicesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ProvisionEndDevicesRequest_IdentifiersFromData{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sessions = append(m.Sessions, &SessionKeys{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.EndDevices = &ProvisionEndDevicesRequest_FromData{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4580,7 +6244,7 @@ func (m *ProvisionEndDevicesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProvisionEndDevicesRequest_IdentifiersList) Unmarshal(dAtA []byte) error {
+func (m *SessionKeysBatchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4603,15 +6267,15 @@ func (m *ProvisionEndDevicesRequest_IdentifiersList) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdentifiersList: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionKeysBatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdentifiersList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionKeysBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4635,15 +6299,92 @@ func (m *ProvisionEndDevicesRequest_IdentifiersList) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v go_thethings_network_lorawan_stack_pkg_types.EUI64
-			m.JoinEUI = &v
-			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeyIDs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionKeyIDs = append(m.SessionKeyIDs, make([]byte, postIndex-iNdEx))
+			copy(m.SessionKeyIDs[len(m.SessionKeyIDs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NwkSKeysBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NwkSKeysBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NwkSKeysBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NwkSKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4667,8 +6408,8 @@ func (m *ProvisionEndDevicesRequest_IdentifiersList) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndDeviceIDs = append(m.EndDeviceIDs, EndDeviceIdentifiers{})
-			if err := m.EndDeviceIDs[len(m.EndDeviceIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NwkSKeys = append(m.NwkSKeys, &NwkSKeysResponse{})
+			if err := m.NwkSKeys[len(m.NwkSKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4693,7 +6434,7 @@ func (m *ProvisionEndDevicesRequest_IdentifiersList) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *ProvisionEndDevicesRequest_IdentifiersRange) Unmarshal(dAtA []byte) error {
+func (m *GetHomeNetIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4716,10 +6457,10 @@ func (m *ProvisionEndDevicesRequest_IdentifiersRange) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdentifiersRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetHomeNetIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdentifiersRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetHomeNetIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4748,15 +6489,13 @@ func (m *ProvisionEndDevicesRequest_IdentifiersRange) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v go_thethings_network_lorawan_stack_pkg_types.EUI64
-			m.JoinEUI = &v
 			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartDevEUI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4780,7 +6519,7 @@ func (m *ProvisionEndDevicesRequest_IdentifiersRange) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartDevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4805,7 +6544,7 @@ func (m *ProvisionEndDevicesRequest_IdentifiersRange) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *ProvisionEndDevicesRequest_IdentifiersFromData) Unmarshal(dAtA []byte) error {
+func (m *GetHomeNetIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4828,15 +6567,15 @@ func (m *ProvisionEndDevicesRequest_IdentifiersFromData) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdentifiersFromData: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetHomeNetIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdentifiersFromData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetHomeNetIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4860,9 +6599,7 @@ func (m *ProvisionEndDevicesRequest_IdentifiersFromData) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v go_thethings_network_lorawan_stack_pkg_types.EUI64
-			m.JoinEUI = &v
-			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4993,117 +6730,139 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("lorawan-stack/api/joinserver.proto", fileDescriptor_joinserver_07deb919e17409ec)
+	proto.RegisterFile("lorawan-stack/api/joinserver.proto", fileDescriptor_joinserver_2f23d45eb420f48c)
 }
 func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/joinserver.proto", fileDescriptor_joinserver_07deb919e17409ec)
-}
-
-var fileDescriptor_joinserver_07deb919e17409ec = []byte{
-	// 1678 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4f, 0x6c, 0x13, 0x57,
-	0x1a, 0x9f, 0x97, 0x38, 0x4e, 0xf2, 0x12, 0x3b, 0xc9, 0x03, 0xed, 0x66, 0x4d, 0x34, 0x0e, 0x26,
-	0xac, 0xb2, 0x01, 0xdb, 0xc8, 0xec, 0x66, 0x77, 0xb3, 0x02, 0xd6, 0x8e, 0xbd, 0x89, 0x13, 0x12,
-	0x45, 0xe3, 0x65, 0xd9, 0x06, 0x12, 0x77, 0x62, 0xbf, 0x98, 0x89, 0x9d, 0x99, 0xe9, 0xbc, 0x67,
-	0xa7, 0x2e, 0x45, 0x42, 0x3d, 0x54, 0x1c, 0x2b, 0x55, 0x95, 0x7a, 0xac, 0xda, 0x1e, 0x50, 0x7b,
-	0x41, 0x9c, 0x38, 0x72, 0xe0, 0xc0, 0x91, 0xaa, 0x17, 0xd4, 0x83, 0x21, 0xe3, 0x1e, 0x50, 0x4f,
-	0x5c, 0x5a, 0xa1, 0x56, 0x6a, 0xab, 0x99, 0x79, 0xfe, 0x37, 0x76, 0x82, 0x9d, 0x06, 0xa4, 0xde,
-	0xde, 0x9f, 0xef, 0xfb, 0xbd, 0xef, 0xfb, 0x7d, 0xdf, 0x7b, 0xfe, 0x8d, 0xa1, 0x2f, 0xa7, 0x68,
-	0xe2, 0x8e, 0x28, 0xfb, 0x09, 0x15, 0x53, 0xd9, 0xa0, 0xa8, 0x4a, 0xc1, 0x2d, 0x45, 0x92, 0x09,
-	0xd6, 0x0a, 0x58, 0x0b, 0xa8, 0x9a, 0x42, 0x15, 0xe4, 0xa6, 0x54, 0x0e, 0x30, 0xbb, 0x40, 0xe1,
-	0xac, 0xc7, 0x9f, 0x91, 0xe8, 0xb5, 0xfc, 0x46, 0x20, 0xa5, 0x6c, 0x07, 0x33, 0x4a, 0x46, 0x09,
-	0x9a, 0x66, 0x1b, 0xf9, 0x4d, 0x73, 0x66, 0x4e, 0xcc, 0x91, 0xe5, 0xee, 0x99, 0xae, 0x33, 0xdf,
-	0xde, 0x91, 0x68, 0x56, 0xd9, 0x09, 0x66, 0x14, 0xbf, 0xb9, 0xe9, 0x2f, 0x88, 0x39, 0x29, 0x2d,
-	0x52, 0x45, 0x23, 0xc1, 0xea, 0x90, 0xf9, 0x8d, 0x65, 0x14, 0x25, 0x93, 0xc3, 0x66, 0x4c, 0xa2,
-	0x2c, 0x2b, 0x54, 0xa4, 0x92, 0x22, 0x13, 0xb6, 0x7b, 0x8c, 0xed, 0x56, 0xcf, 0xc6, 0xdb, 0x2a,
-	0x2d, 0xda, 0x5c, 0xab, 0x9b, 0x84, 0x6a, 0xf9, 0x14, 0x65, 0xbb, 0x2d, 0x72, 0xc6, 0x72, 0x3a,
-	0x99, 0xc6, 0x05, 0x29, 0x85, 0x99, 0xcd, 0x89, 0x66, 0x1b, 0x29, 0x8d, 0x65, 0x2a, 0x6d, 0x4a,
-	0x58, 0xab, 0xc4, 0x30, 0xd6, 0x9a, 0x3c, 0xb6, 0xeb, 0x6d, 0xde, 0xad, 0x90, 0xb8, 0xa7, 0x7b,
-	0x16, 0x17, 0x19, 0xb8, 0xef, 0x0b, 0x00, 0x47, 0x12, 0x98, 0x10, 0x49, 0x91, 0x17, 0x71, 0x51,
-	0xc0, 0x6f, 0xe5, 0x31, 0xa1, 0x68, 0x1a, 0xba, 0x89, 0xb5, 0x98, 0xcc, 0xe2, 0x62, 0x52, 0x4a,
-	0x8f, 0x82, 0x71, 0x30, 0x39, 0x18, 0x19, 0xd6, 0x4b, 0xde, 0xc1, 0x9a, 0x79, 0x3c, 0x2a, 0x0c,
-	0x92, 0xda, 0x2c, 0x8d, 0xd6, 0x60, 0x6f, 0x1a, 0x17, 0x92, 0x38, 0x2f, 0x8d, 0x76, 0x99, 0x0e,
-	0xd1, 0x87, 0x25, 0x2f, 0xf7, 0x4d, 0xc9, 0x1b, 0xca, 0x28, 0x01, 0x7a, 0x0d, 0xd3, 0x6b, 0x92,
-	0x9c, 0x21, 0x01, 0x19, 0xd3, 0x1d, 0x45, 0xcb, 0x06, 0x1b, 0x23, 0x53, 0xb3, 0x99, 0x20, 0x2d,
-	0xaa, 0x98, 0x04, 0x62, 0x97, 0xe2, 0xd3, 0x7f, 0xd5, 0x4b, 0x5e, 0x67, 0x14, 0x17, 0x62, 0x97,
-	0xe2, 0x82, 0x33, 0x8d, 0x0b, 0xb1, 0xbc, 0xe4, 0xfb, 0x0e, 0xc0, 0xe1, 0xe5, 0x9d, 0x6c, 0x62,
-	0x11, 0x17, 0x89, 0x80, 0x89, 0xaa, 0xc8, 0x04, 0xa3, 0x39, 0x38, 0xb4, 0x99, 0x94, 0x77, 0xb2,
-	0x49, 0x92, 0x94, 0x64, 0x6a, 0xc4, 0x6b, 0x06, 0x3b, 0x10, 0x3a, 0x16, 0x68, 0xec, 0xa8, 0xc0,
-	0x22, 0x2e, 0xc6, 0xe4, 0x02, 0xce, 0x29, 0x2a, 0x8e, 0x38, 0x8c, 0xc0, 0x84, 0x81, 0x4d, 0x03,
-	0x2e, 0x2e, 0xd3, 0x45, 0x5c, 0x34, 0x80, 0x88, 0x0d, 0xa8, 0xab, 0x6d, 0x20, 0x52, 0x07, 0x14,
-	0x85, 0x2e, 0x0b, 0x06, 0xcb, 0x29, 0x13, 0xa6, 0xbb, 0x5d, 0x18, 0x28, 0xef, 0x64, 0x13, 0x31,
-	0x39, 0xb5, 0x88, 0x8b, 0xbe, 0x15, 0x38, 0x14, 0x56, 0xd5, 0x84, 0x59, 0x15, 0x96, 0xea, 0x39,
-	0xd8, 0x2f, 0xaa, 0x6a, 0x92, 0x74, 0x96, 0x64, 0xaf, 0x68, 0xc1, 0xf8, 0x7e, 0xea, 0x82, 0xc7,
-	0x66, 0xb5, 0xa2, 0x4a, 0x95, 0x04, 0xd6, 0x8c, 0x2e, 0x5c, 0x11, 0x8b, 0x39, 0x45, 0x4c, 0x57,
-	0xaa, 0xfe, 0x6f, 0xd8, 0x2d, 0xa5, 0x09, 0x03, 0x9e, 0xb0, 0x03, 0xc7, 0xe4, 0x74, 0xd4, 0xec,
-	0xdd, 0x78, 0xad, 0x43, 0x23, 0x7d, 0xc6, 0x09, 0x8f, 0x4a, 0x5e, 0x20, 0x18, 0xae, 0xe8, 0x32,
-	0x1c, 0x62, 0x1e, 0xc9, 0x02, 0xd6, 0x8c, 0xbe, 0x30, 0x29, 0x74, 0x87, 0x3c, 0x76, 0xb4, 0xa5,
-	0xf0, 0xec, 0xff, 0x2c, 0x8b, 0x08, 0xd2, 0x4b, 0x5e, 0xf7, 0x45, 0x45, 0x10, 0x2f, 0x87, 0x97,
-	0xd9, 0x9a, 0xe0, 0x66, 0xa6, 0x6c, 0x8e, 0x46, 0x61, 0xaf, 0x6a, 0x05, 0x6b, 0x92, 0x39, 0x28,
-	0x54, 0xa6, 0x48, 0x84, 0x6e, 0x55, 0x53, 0x0a, 0x92, 0x61, 0x86, 0x35, 0xa3, 0x55, 0x1d, 0xe3,
-	0x60, 0xb2, 0x3f, 0x32, 0xa3, 0x97, 0xbc, 0xae, 0x95, 0xda, 0x4e, 0x3c, 0xaa, 0x3f, 0xf1, 0x9e,
-	0x84, 0xc7, 0xd7, 0xaf, 0x88, 0xfe, 0x77, 0xce, 0xf8, 0xff, 0xb9, 0x36, 0x79, 0x61, 0xe6, 0x8a,
-	0x7f, 0xed, 0x42, 0x65, 0xfa, 0x97, 0xeb, 0xa1, 0xd3, 0x37, 0x26, 0xde, 0x5d, 0x9f, 0x78, 0xfb,
-	0xa4, 0xe0, 0xaa, 0x43, 0x8c, 0xa7, 0x51, 0x14, 0x8e, 0x54, 0x17, 0x24, 0x39, 0x93, 0x4c, 0x8b,
-	0x54, 0x1c, 0xed, 0x31, 0x59, 0xfa, 0x63, 0xc0, 0x7a, 0x03, 0x02, 0x95, 0x37, 0x20, 0x90, 0x30,
-	0xdf, 0x00, 0x61, 0xb8, 0xde, 0x23, 0x2a, 0x52, 0xd1, 0xf7, 0x0f, 0x38, 0xd6, 0x9a, 0x7c, 0x56,
-	0xdc, 0xba, 0x14, 0x41, 0x43, 0x8a, 0xbe, 0x9f, 0x01, 0x3c, 0xba, 0xa0, 0x48, 0x72, 0x38, 0x95,
-	0xc2, 0x2a, 0x5d, 0x8a, 0xcf, 0x56, 0x0a, 0xb6, 0x0e, 0x87, 0x98, 0x4d, 0x52, 0xb3, 0x96, 0x58,
-	0xf1, 0x4e, 0xd9, 0xe9, 0xde, 0xa7, 0xec, 0x75, 0x35, 0x74, 0xab, 0x8d, 0x0d, 0x31, 0x05, 0x47,
-	0x8c, 0x97, 0xa6, 0x02, 0x9e, 0x34, 0x6e, 0xa7, 0x59, 0x50, 0x97, 0x30, 0x64, 0x6c, 0x30, 0xbb,
-	0xff, 0x16, 0x55, 0x8c, 0x56, 0x61, 0xbf, 0x71, 0xf5, 0x65, 0x45, 0x4e, 0x61, 0xab, 0x46, 0x91,
-	0x73, 0xec, 0xf2, 0xff, 0xad, 0xa3, 0xcb, 0x1f, 0xc5, 0x85, 0x65, 0x03, 0x44, 0xe8, 0x4b, 0xb3,
-	0x91, 0xef, 0x7b, 0x07, 0x1c, 0x8d, 0x62, 0x4d, 0x2a, 0xe0, 0xda, 0xdb, 0x43, 0x7e, 0x07, 0x5d,
-	0xbb, 0x06, 0xa1, 0xc9, 0x5f, 0x3d, 0x29, 0xe7, 0x19, 0x29, 0xd3, 0x1d, 0x91, 0x62, 0x94, 0xdf,
-	0x62, 0xa5, 0x7f, 0xab, 0x32, 0x6c, 0xa4, 0xdc, 0x71, 0xa8, 0x94, 0xa3, 0x55, 0xe8, 0x94, 0x31,
-	0x35, 0xae, 0x53, 0x8f, 0x09, 0x3c, 0x7b, 0xa0, 0x87, 0x7c, 0x19, 0xd3, 0x78, 0x54, 0x2f, 0x79,
-	0x7b, 0xcc, 0x81, 0xd0, 0x23, 0x63, 0x1a, 0x6f, 0x75, 0x65, 0x9d, 0xaf, 0xe5, 0xca, 0xf6, 0x76,
-	0x7a, 0x65, 0x7f, 0x01, 0x10, 0xcd, 0x61, 0x2a, 0x28, 0x0a, 0x3d, 0xdc, 0x8e, 0x6b, 0x66, 0xa0,
-	0xeb, 0xb5, 0x30, 0xd0, 0xdd, 0x29, 0x03, 0x0f, 0xfa, 0xa0, 0xa7, 0x1a, 0x4f, 0x35, 0xb3, 0x2a,
-	0x13, 0x6f, 0xc0, 0x21, 0x51, 0x55, 0x73, 0x52, 0xca, 0x14, 0x4d, 0xc9, 0x1a, 0x2b, 0x7f, 0xb6,
-	0xb3, 0x12, 0xae, 0x99, 0xb5, 0xe6, 0xc5, 0x2d, 0xd6, 0x5b, 0x10, 0xb4, 0xbe, 0x07, 0x45, 0x7f,
-	0x6f, 0x45, 0x91, 0x0f, 0xf2, 0xfb, 0x53, 0xd4, 0xcc, 0xcf, 0xa9, 0xbd, 0xf8, 0x19, 0x6c, 0xa6,
-	0x01, 0xad, 0x40, 0x47, 0x4e, 0x22, 0xd4, 0xbc, 0x64, 0x03, 0xa1, 0x19, 0x7b, 0x72, 0x7b, 0x33,
-	0x14, 0xa8, 0x4b, 0xf6, 0xa2, 0x44, 0xe8, 0x3c, 0x27, 0x98, 0x48, 0x28, 0x01, 0x7b, 0x34, 0x51,
-	0xce, 0x60, 0xf6, 0x3b, 0xf2, 0xaf, 0x83, 0x41, 0x0a, 0x06, 0xc4, 0x3c, 0x27, 0x58, 0x58, 0x68,
-	0x0d, 0xf6, 0x6f, 0x6a, 0xca, 0xb6, 0x95, 0x8b, 0xd3, 0x04, 0x3e, 0x7f, 0x30, 0xe0, 0xff, 0x68,
-	0xca, 0xb6, 0x91, 0xf9, 0x3c, 0x27, 0xf4, 0x6d, 0xb2, 0xb1, 0xe7, 0x2b, 0x00, 0x87, 0x6c, 0xf9,
-	0xa0, 0xab, 0xb0, 0xcf, 0x7c, 0xe2, 0x0c, 0xc9, 0x67, 0x69, 0xc4, 0xf0, 0x81, 0xe5, 0x5e, 0xaf,
-	0xf1, 0xca, 0x19, 0x7a, 0xaf, 0xd7, 0x80, 0x8c, 0xe5, 0x25, 0xf4, 0x26, 0x74, 0xd7, 0x34, 0xb3,
-	0xd9, 0x5e, 0x5d, 0xe3, 0xdd, 0x6d, 0x5f, 0xba, 0xa3, 0x46, 0x73, 0x19, 0x8a, 0xb5, 0xb6, 0x1b,
-	0x25, 0xc2, 0x20, 0xae, 0xd9, 0x12, 0xcf, 0x13, 0x00, 0x87, 0xed, 0x84, 0xbe, 0xe2, 0xa4, 0xb6,
-	0xa1, 0x8b, 0x50, 0x51, 0xa3, 0xc9, 0x46, 0xa9, 0x1c, 0xff, 0x4d, 0x52, 0x79, 0x20, 0x61, 0x40,
-	0x32, 0xbd, 0x3c, 0x40, 0x2a, 0x93, 0xbc, 0xe4, 0x21, 0xf0, 0x48, 0x8b, 0xc2, 0xbe, 0xda, 0x1c,
-	0x23, 0x2e, 0x38, 0x50, 0x2b, 0x1c, 0x09, 0x7d, 0x06, 0xa0, 0x63, 0x99, 0x2c, 0x10, 0x34, 0x07,
-	0xe1, 0xbc, 0x28, 0xa7, 0x73, 0xd8, 0xf0, 0x40, 0x4d, 0xe2, 0x75, 0xa1, 0x26, 0x2a, 0x3c, 0x63,
-	0xad, 0x37, 0x99, 0x5a, 0x12, 0xe0, 0xc0, 0x1c, 0xa6, 0x95, 0x8f, 0x01, 0x74, 0xdc, 0x6e, 0xdc,
-	0xf4, 0x4d, 0xe3, 0x19, 0xb7, 0x9b, 0xd8, 0xbf, 0x24, 0x42, 0xff, 0x87, 0x8e, 0xb0, 0x11, 0xe4,
-	0x0a, 0x84, 0x73, 0x98, 0x32, 0xf1, 0xdd, 0x0e, 0xb4, 0xb7, 0xc5, 0x6b, 0x57, 0x2f, 0xdc, 0x43,
-	0x3f, 0x38, 0xe0, 0xd1, 0x65, 0x8b, 0xcc, 0x06, 0x25, 0x86, 0xb2, 0xd0, 0x5d, 0x97, 0xf3, 0x52,
-	0x7c, 0x16, 0x75, 0x22, 0xdd, 0x3c, 0xa7, 0xdb, 0x33, 0x66, 0x9c, 0xa5, 0xa0, 0xab, 0x41, 0x46,
-	0xa2, 0x89, 0x56, 0x14, 0xdb, 0x55, 0x66, 0x87, 0x87, 0xc8, 0x70, 0x24, 0x26, 0xa7, 0x0c, 0x8b,
-	0x1a, 0xd8, 0xab, 0x4c, 0x4a, 0x85, 0x47, 0xd8, 0x79, 0x02, 0xde, 0x7a, 0x2d, 0x27, 0x5e, 0x85,
-	0x6e, 0x4b, 0x8c, 0x56, 0xbb, 0x6f, 0xd2, 0xee, 0xbf, 0x97, 0x58, 0x7d, 0x79, 0x13, 0xa2, 0x8b,
-	0xb0, 0xdf, 0x6a, 0x6c, 0xa3, 0xf7, 0x7c, 0x76, 0xf3, 0x66, 0x35, 0xe2, 0xd9, 0xef, 0x0b, 0x30,
-	0xf4, 0x00, 0xc0, 0xd1, 0xba, 0x9f, 0xde, 0xc6, 0xe6, 0x5b, 0x85, 0x2e, 0x2b, 0xd0, 0x4a, 0xab,
-	0xb7, 0x9f, 0xc7, 0xcb, 0x3a, 0x9e, 0xa5, 0x11, 0x56, 0xd5, 0x43, 0x49, 0xe3, 0x7d, 0x27, 0x3c,
-	0xb2, 0x40, 0xaa, 0xcf, 0xb8, 0x80, 0x33, 0x12, 0xa1, 0x5a, 0x11, 0xdd, 0x05, 0xb0, 0x7b, 0x0e,
-	0x53, 0x74, 0xa2, 0xc5, 0x01, 0x75, 0xd6, 0xd6, 0x09, 0x7f, 0xda, 0xf3, 0x47, 0xc3, 0x97, 0x7d,
-	0xef, 0xeb, 0x6f, 0x3f, 0xec, 0xc2, 0x28, 0x15, 0xdc, 0x22, 0xc1, 0x3a, 0x21, 0x42, 0x82, 0xd7,
-	0x1b, 0x7f, 0x7f, 0x02, 0x36, 0xb9, 0x63, 0x9b, 0xdf, 0x08, 0xb2, 0x17, 0xaf, 0xc9, 0xaf, 0x3a,
-	0xbc, 0x81, 0x7e, 0x04, 0xb0, 0x3b, 0xd1, 0x2a, 0xe8, 0x44, 0x67, 0x41, 0xdf, 0x05, 0x66, 0xd4,
-	0x5f, 0x02, 0xcf, 0x95, 0xe6, 0xb0, 0xd9, 0xdf, 0x4c, 0x1d, 0x85, 0x5c, 0xe7, 0x53, 0x0b, 0x77,
-	0x06, 0x4c, 0xad, 0xc6, 0x7d, 0xd1, 0xc3, 0x38, 0x61, 0x06, 0x4c, 0xa1, 0xcf, 0x01, 0xec, 0xaf,
-	0x4a, 0x10, 0x34, 0xd5, 0xbe, 0x3a, 0xd9, 0x8f, 0x89, 0x65, 0x93, 0x88, 0x79, 0xcf, 0x6c, 0x73,
-	0x94, 0x2f, 0x0b, 0xad, 0x2a, 0xf5, 0xfc, 0xb5, 0x20, 0xcf, 0x00, 0xf4, 0x11, 0x80, 0xce, 0x28,
-	0xce, 0x61, 0x8a, 0x51, 0x5b, 0x5a, 0xc3, 0xf3, 0x87, 0x26, 0x4d, 0x1d, 0xdb, 0x56, 0x69, 0xd1,
-	0xb7, 0x64, 0x86, 0x36, 0x37, 0x15, 0xeb, 0x3c, 0x34, 0x5b, 0x5d, 0x8c, 0xb5, 0xc8, 0xa7, 0xe0,
-	0xe1, 0x2e, 0x0f, 0x1e, 0xed, 0xf2, 0xe0, 0xf1, 0x2e, 0xcf, 0x3d, 0xdd, 0xe5, 0xb9, 0x67, 0xbb,
-	0x3c, 0xf7, 0x7c, 0x97, 0xe7, 0x5e, 0xec, 0xf2, 0xe0, 0xa6, 0xce, 0x83, 0x5b, 0x3a, 0xcf, 0xdd,
-	0xd6, 0x79, 0x70, 0x47, 0xe7, 0xb9, 0x7b, 0x3a, 0xcf, 0xdd, 0xd7, 0x79, 0xee, 0xa1, 0xce, 0x83,
-	0x47, 0x3a, 0x0f, 0x1e, 0xeb, 0x3c, 0xf7, 0x54, 0xe7, 0xc1, 0x33, 0x9d, 0xe7, 0x9e, 0xeb, 0x3c,
-	0x78, 0xa1, 0xf3, 0xdc, 0xcd, 0x32, 0xcf, 0xdd, 0x2a, 0xf3, 0xe0, 0x83, 0x32, 0xcf, 0x7d, 0x5c,
-	0xe6, 0xc1, 0x27, 0x65, 0x9e, 0xbb, 0x5d, 0xe6, 0xb9, 0x3b, 0x65, 0x1e, 0xdc, 0x2b, 0xf3, 0xe0,
-	0x7e, 0x99, 0x07, 0xab, 0xa7, 0xdb, 0x95, 0x05, 0x54, 0x56, 0x37, 0x36, 0x9c, 0x26, 0x07, 0x67,
-	0x7f, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x21, 0x35, 0x73, 0x81, 0xf6, 0x15, 0x00, 0x00,
+	golang_proto.RegisterFile("lorawan-stack/api/joinserver.proto", fileDescriptor_joinserver_2f23d45eb420f48c)
+}
+
+var fileDescriptor_joinserver_2f23d45eb420f48c = []byte{
+	// 2023 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xde, 0x91, 0x64, 0xfd, 0x3c, 0x51, 0x94, 0x3c, 0x56, 0x6a, 0x95, 0x36, 0x96, 0xf6, 0x46,
+	0x6e, 0x15, 0xc5, 0x22, 0x0d, 0xa6, 0x75, 0x1a, 0x15, 0xf9, 0x11, 0x45, 0x56, 0xa2, 0x65, 0x0b,
+	0xc2, 0x32, 0x4e, 0x6a, 0x39, 0x12, 0xbd, 0x22, 0x47, 0xf4, 0x8a, 0xe2, 0xee, 0x76, 0x67, 0x48,
+	0x95, 0x8d, 0x0d, 0x04, 0x3d, 0xe5, 0x58, 0xa0, 0x28, 0xd0, 0x63, 0x50, 0xf4, 0x10, 0xb4, 0x87,
+	0x1a, 0xe9, 0xc5, 0xc7, 0x14, 0xc8, 0xc1, 0x47, 0x17, 0x05, 0x8a, 0xa0, 0x07, 0x39, 0x5a, 0xf5,
+	0x10, 0xf4, 0x94, 0x4b, 0x8b, 0xa0, 0x05, 0xda, 0x62, 0x76, 0x87, 0xe4, 0xf2, 0x47, 0x32, 0x69,
+	0xd3, 0x06, 0x72, 0xdb, 0xdd, 0x79, 0xf3, 0xed, 0x7b, 0xdf, 0x7b, 0x6f, 0xf6, 0x7d, 0x0b, 0xca,
+	0xae, 0x69, 0x6b, 0x7b, 0x9a, 0x31, 0x47, 0x99, 0x96, 0x2d, 0x44, 0x35, 0x4b, 0x8f, 0xee, 0x98,
+	0xba, 0x41, 0x89, 0x5d, 0x26, 0x76, 0xc4, 0xb2, 0x4d, 0x66, 0xe2, 0x20, 0x63, 0x46, 0x44, 0xd8,
+	0x45, 0xca, 0xaf, 0x84, 0xe6, 0xf2, 0x3a, 0xbb, 0x5d, 0xda, 0x8a, 0x64, 0xcd, 0x62, 0x34, 0x6f,
+	0xe6, 0xcd, 0xa8, 0x6b, 0xb6, 0x55, 0xda, 0x76, 0xef, 0xdc, 0x1b, 0xf7, 0xca, 0xdb, 0x1e, 0xba,
+	0xec, 0x33, 0x2f, 0xee, 0xe9, 0xac, 0x60, 0xee, 0x45, 0xf3, 0xe6, 0x9c, 0xbb, 0x38, 0x57, 0xd6,
+	0x76, 0xf5, 0x9c, 0xc6, 0x4c, 0x9b, 0x46, 0x6b, 0x97, 0x62, 0xdf, 0xd9, 0xbc, 0x69, 0xe6, 0x77,
+	0x89, 0xeb, 0x93, 0x66, 0x18, 0x26, 0xd3, 0x98, 0x6e, 0x1a, 0x54, 0xac, 0x9e, 0x11, 0xab, 0xb5,
+	0x77, 0x93, 0xa2, 0xc5, 0x2a, 0x4d, 0x5b, 0x6b, 0x8b, 0x94, 0xd9, 0xa5, 0x2c, 0x13, 0xab, 0x6d,
+	0x62, 0x26, 0x46, 0x2e, 0x93, 0x23, 0x65, 0x3d, 0x4b, 0x84, 0x8d, 0xdc, 0xc6, 0xa6, 0x4c, 0x0c,
+	0x56, 0x7d, 0xfd, 0x8b, 0xad, 0xeb, 0x7a, 0x8e, 0x18, 0x4c, 0xdf, 0xd6, 0x89, 0x5d, 0x35, 0x3a,
+	0xdb, 0x9e, 0x5c, 0xb1, 0x1a, 0x6e, 0x5d, 0xad, 0x92, 0x7c, 0xe4, 0xf6, 0x02, 0xa9, 0x08, 0x70,
+	0xe5, 0x77, 0x08, 0x4e, 0xa6, 0x09, 0xa5, 0xba, 0x69, 0xac, 0x90, 0x8a, 0x4a, 0x7e, 0x52, 0x22,
+	0x94, 0xe1, 0xcb, 0x10, 0xa4, 0xde, 0xc3, 0x4c, 0x81, 0x54, 0x32, 0x7a, 0x6e, 0x0a, 0x9d, 0x43,
+	0x33, 0x81, 0xf8, 0x84, 0xb3, 0x1f, 0x0e, 0xd4, 0xcd, 0x53, 0x09, 0x35, 0x40, 0xeb, 0x77, 0x39,
+	0xbc, 0x01, 0x43, 0x39, 0x52, 0xce, 0x90, 0x92, 0x3e, 0xd5, 0xe7, 0x6e, 0x48, 0x3c, 0xd8, 0x0f,
+	0x4b, 0x7f, 0xdb, 0x0f, 0xc7, 0xf2, 0x66, 0x84, 0xdd, 0x26, 0xec, 0xb6, 0x6e, 0xe4, 0x69, 0xc4,
+	0x20, 0x6c, 0xcf, 0xb4, 0x0b, 0xd1, 0x46, 0xcf, 0xac, 0x42, 0x3e, 0xca, 0x2a, 0x16, 0xa1, 0x91,
+	0xe4, 0xf5, 0xd4, 0xe5, 0xef, 0x39, 0xfb, 0xe1, 0xc1, 0x04, 0x29, 0x27, 0xaf, 0xa7, 0xd4, 0xc1,
+	0x1c, 0x29, 0x27, 0x4b, 0xba, 0xf2, 0x0f, 0x04, 0x13, 0xab, 0x7b, 0x85, 0xf4, 0x0a, 0xa9, 0x50,
+	0x95, 0x50, 0xcb, 0x34, 0x28, 0xc1, 0x4b, 0x30, 0xbe, 0x9d, 0x31, 0xf6, 0x0a, 0x19, 0x9a, 0xd1,
+	0x0d, 0xc6, 0xfd, 0x75, 0x9d, 0x1d, 0x8d, 0x9d, 0x89, 0x34, 0x56, 0x5c, 0x64, 0x85, 0x54, 0x92,
+	0x46, 0x99, 0xec, 0x9a, 0x16, 0x89, 0x0f, 0x70, 0xc7, 0xd4, 0xd1, 0x6d, 0x0e, 0x97, 0x32, 0xd8,
+	0x0a, 0xa9, 0x70, 0x20, 0xda, 0x04, 0xd4, 0xd7, 0x31, 0x10, 0xf5, 0x01, 0x25, 0x60, 0xcc, 0x83,
+	0x21, 0x46, 0xd6, 0x85, 0xe9, 0xef, 0x14, 0x06, 0x8c, 0xbd, 0x42, 0x3a, 0x69, 0x64, 0x57, 0x48,
+	0x45, 0x59, 0x83, 0xf1, 0x05, 0xcb, 0x4a, 0xbb, 0x59, 0x11, 0xa1, 0xbe, 0x0e, 0x23, 0x9a, 0x65,
+	0x65, 0x68, 0x77, 0x41, 0x0e, 0x69, 0x1e, 0x8c, 0xf2, 0x9f, 0x3e, 0x38, 0xb3, 0x68, 0x57, 0x2c,
+	0x66, 0xa6, 0x89, 0xcd, 0xab, 0x74, 0x4d, 0xab, 0xec, 0x9a, 0x5a, 0xae, 0x9a, 0xf5, 0xb7, 0xa0,
+	0x5f, 0xcf, 0x51, 0x01, 0x3c, 0xdd, 0x0c, 0x9c, 0x34, 0x72, 0x09, 0xb7, 0xb6, 0x53, 0xf5, 0x0a,
+	0x8d, 0x0f, 0xf3, 0x37, 0x3c, 0xdc, 0x0f, 0x23, 0x95, 0x6f, 0xc5, 0xef, 0xc2, 0xb8, 0xd8, 0x91,
+	0x29, 0x13, 0x9b, 0xd7, 0x85, 0x4b, 0x61, 0x30, 0x16, 0x6a, 0x46, 0xbb, 0xb6, 0xb0, 0xf8, 0x8e,
+	0x67, 0x11, 0xc7, 0xce, 0x7e, 0x38, 0x78, 0xd5, 0x54, 0xb5, 0x77, 0x17, 0x56, 0xc5, 0x33, 0x35,
+	0x28, 0x4c, 0xc5, 0x3d, 0x9e, 0x82, 0x21, 0xcb, 0x73, 0xd6, 0x25, 0x33, 0xa0, 0x56, 0x6f, 0xb1,
+	0x06, 0x41, 0xcb, 0x36, 0xcb, 0x3a, 0x37, 0x23, 0x36, 0x2f, 0xd5, 0x81, 0x73, 0x68, 0x66, 0x24,
+	0x3e, 0xef, 0xec, 0x87, 0xc7, 0xd6, 0xea, 0x2b, 0xa9, 0x84, 0xf3, 0x28, 0x7c, 0x01, 0xce, 0x6f,
+	0xde, 0xd4, 0xe6, 0x7e, 0x76, 0x69, 0xee, 0xb5, 0x8d, 0x99, 0x37, 0xe7, 0x6f, 0xce, 0x6d, 0xbc,
+	0x59, 0xbd, 0x7d, 0xe9, 0xfd, 0xd8, 0xc5, 0xbb, 0xd3, 0x77, 0x36, 0xa7, 0x7f, 0x7a, 0x41, 0x1d,
+	0xf3, 0x21, 0xa6, 0x72, 0x38, 0x01, 0x27, 0x6b, 0x0f, 0x74, 0x23, 0x9f, 0xc9, 0x69, 0x4c, 0x9b,
+	0x3a, 0xe1, 0xb2, 0x74, 0x3a, 0xe2, 0x9d, 0x11, 0x91, 0xea, 0x19, 0x11, 0x49, 0xbb, 0x67, 0x84,
+	0x3a, 0xe1, 0xdf, 0x91, 0xd0, 0x98, 0xa6, 0xfc, 0x00, 0xce, 0xb6, 0x27, 0x5f, 0x24, 0xd7, 0x17,
+	0x22, 0x6a, 0x08, 0x51, 0xf9, 0x2f, 0x82, 0xc9, 0x2b, 0xa6, 0x6e, 0x2c, 0x64, 0xb3, 0xc4, 0x62,
+	0xd7, 0x52, 0x8b, 0xd5, 0x84, 0x6d, 0xc2, 0xb8, 0xb0, 0xc9, 0xd8, 0xde, 0x23, 0x91, 0xbc, 0x97,
+	0x9b, 0xe9, 0x3e, 0x26, 0xed, 0xbe, 0x1c, 0x06, 0xad, 0xc6, 0x82, 0x98, 0x85, 0x93, 0xfc, 0xa4,
+	0xa9, 0x82, 0x67, 0x78, 0x77, 0xba, 0x09, 0x1d, 0x53, 0xc7, 0xf9, 0x82, 0xb0, 0x7b, 0xbb, 0x62,
+	0x11, 0xbc, 0x0e, 0x23, 0xbc, 0xf5, 0x0d, 0xd3, 0xc8, 0x12, 0x2f, 0x47, 0xf1, 0xd7, 0x45, 0xf3,
+	0x7f, 0xbf, 0xab, 0xe6, 0x4f, 0x90, 0xf2, 0x2a, 0x07, 0x51, 0x87, 0x73, 0xe2, 0x4a, 0xf9, 0xe7,
+	0x00, 0x4c, 0x25, 0x88, 0xad, 0x97, 0x49, 0xfd, 0xec, 0xa1, 0xdf, 0x80, 0xaa, 0xdd, 0x00, 0x70,
+	0xf9, 0xf3, 0x93, 0xf2, 0x86, 0x20, 0xe5, 0x72, 0x57, 0xa4, 0xf0, 0xf4, 0x7b, 0xac, 0x8c, 0xec,
+	0x54, 0x2f, 0x1b, 0x29, 0x1f, 0xe8, 0x29, 0xe5, 0x78, 0x1d, 0x06, 0x0d, 0xc2, 0x78, 0x3b, 0x9d,
+	0x70, 0x81, 0x17, 0x9f, 0xe8, 0x20, 0x5f, 0x25, 0x2c, 0x95, 0x70, 0xf6, 0xc3, 0x27, 0xdc, 0x0b,
+	0xf5, 0x84, 0x41, 0x58, 0xaa, 0x5d, 0xcb, 0x0e, 0x3e, 0x97, 0x96, 0x1d, 0xea, 0xb6, 0x65, 0xff,
+	0x87, 0x00, 0x2f, 0x11, 0xa6, 0x9a, 0x26, 0xeb, 0x6d, 0xc5, 0xb5, 0x32, 0xd0, 0xf7, 0x5c, 0x18,
+	0xe8, 0xef, 0x96, 0x81, 0xcf, 0x86, 0x21, 0x54, 0xf3, 0xa7, 0x16, 0x59, 0x8d, 0x89, 0x1b, 0x30,
+	0xae, 0x59, 0xd6, 0xae, 0x9e, 0x75, 0x87, 0xaa, 0x4c, 0x9d, 0x95, 0xef, 0x34, 0xb3, 0xb2, 0x50,
+	0x37, 0x6b, 0xcf, 0x4b, 0x50, 0xf3, 0x5b, 0x50, 0xbc, 0x79, 0x04, 0x45, 0xaf, 0xb6, 0xa3, 0x48,
+	0x01, 0xf9, 0x78, 0x8a, 0x5a, 0xf9, 0x79, 0xf9, 0x28, 0x7e, 0x02, 0xad, 0x34, 0xe0, 0x35, 0x18,
+	0xd8, 0xd5, 0x29, 0x73, 0x9b, 0x6c, 0x34, 0x36, 0xdf, 0x1c, 0xdc, 0xd1, 0x0c, 0x45, 0x7c, 0xc1,
+	0x5e, 0xd5, 0x29, 0x5b, 0x96, 0x54, 0x17, 0x09, 0xa7, 0xe1, 0x84, 0xad, 0x19, 0x79, 0x22, 0xbe,
+	0x23, 0x3f, 0x7c, 0x32, 0x48, 0x95, 0x43, 0x2c, 0x4b, 0xaa, 0x87, 0x85, 0x37, 0x60, 0x64, 0xdb,
+	0x36, 0x8b, 0x5e, 0x2c, 0x83, 0x2e, 0xf0, 0x1b, 0x4f, 0x06, 0xfc, 0x23, 0xdb, 0x2c, 0xf2, 0xc8,
+	0x97, 0x25, 0x75, 0x78, 0x5b, 0x5c, 0x87, 0xfe, 0x8c, 0x60, 0xbc, 0x29, 0x1e, 0xfc, 0x1e, 0x0c,
+	0xbb, 0x47, 0x1c, 0x1f, 0xf9, 0xbc, 0x19, 0x71, 0xe1, 0x89, 0xc7, 0xbd, 0x21, 0x7e, 0xca, 0xf1,
+	0x79, 0x6f, 0x88, 0x43, 0x26, 0x4b, 0x3a, 0xbe, 0x05, 0xc1, 0xfa, 0x4c, 0xed, 0x96, 0x57, 0xdf,
+	0xb9, 0xfe, 0x8e, 0x9b, 0x6e, 0x92, 0x17, 0x17, 0x9f, 0x58, 0xeb, 0xab, 0x09, 0xaa, 0x06, 0x48,
+	0xdd, 0x96, 0x86, 0x1e, 0x21, 0x98, 0x68, 0x26, 0xf4, 0x19, 0x07, 0x55, 0x84, 0x31, 0xca, 0x34,
+	0x9b, 0x65, 0x1a, 0x47, 0xe5, 0xd4, 0x53, 0x8d, 0xca, 0xa3, 0x69, 0x0e, 0x29, 0xe6, 0xe5, 0x51,
+	0x5a, 0xbd, 0x29, 0xe9, 0x21, 0x0a, 0xa7, 0xda, 0x24, 0xf6, 0xd9, 0xc6, 0x18, 0x1f, 0x83, 0xd1,
+	0x7a, 0xe2, 0xa8, 0x72, 0xd0, 0x07, 0xa7, 0xd3, 0xcc, 0x26, 0x5a, 0xd1, 0xb5, 0x74, 0x25, 0xd0,
+	0x73, 0x38, 0x43, 0xfc, 0x31, 0xf6, 0xf5, 0x3c, 0x8f, 0x37, 0xea, 0x62, 0xc7, 0xfb, 0xb4, 0xbf,
+	0xd5, 0x2b, 0xa1, 0x83, 0x63, 0x30, 0x64, 0x96, 0x58, 0xd6, 0x2c, 0x12, 0x31, 0xcd, 0x4e, 0x39,
+	0x8f, 0xc2, 0x93, 0x80, 0x37, 0x67, 0xee, 0x68, 0xee, 0x10, 0x78, 0xc7, 0x26, 0x3b, 0x24, 0xcb,
+	0x5e, 0x9a, 0x56, 0xab, 0x86, 0x8a, 0x0a, 0x27, 0x6b, 0x75, 0x2e, 0xc6, 0x24, 0x8a, 0x5f, 0x85,
+	0x61, 0x21, 0xd0, 0x38, 0xab, 0xfd, 0xed, 0x04, 0x83, 0x7f, 0xa4, 0xaa, 0x19, 0xcf, 0x0f, 0xdc,
+	0xff, 0x28, 0x2c, 0x29, 0x7f, 0x44, 0x70, 0xda, 0xb7, 0x1e, 0xd7, 0x58, 0xf6, 0x76, 0x35, 0x6f,
+	0x3e, 0xad, 0x87, 0x7a, 0xaf, 0xf5, 0xf0, 0x6b, 0x30, 0xde, 0x28, 0x41, 0xbd, 0xde, 0x0f, 0xc4,
+	0x4f, 0xf2, 0x0f, 0x80, 0x5f, 0x83, 0x52, 0x75, 0xcc, 0x2f, 0x42, 0xa9, 0x42, 0xe0, 0x85, 0xaa,
+	0x4a, 0x14, 0x1e, 0x8b, 0x11, 0xfb, 0x2a, 0x80, 0x27, 0xcc, 0xb8, 0x00, 0x16, 0x7c, 0x9c, 0x6b,
+	0xe6, 0xa3, 0x59, 0x60, 0xc6, 0x03, 0xce, 0x7e, 0x78, 0xb8, 0xf6, 0x74, 0xd8, 0x10, 0x57, 0xca,
+	0x5f, 0x11, 0x9c, 0x5a, 0x22, 0x6c, 0xd9, 0x2c, 0x12, 0x6f, 0xbc, 0x11, 0xc4, 0xdc, 0x6a, 0xe9,
+	0xac, 0xe4, 0x53, 0x31, 0xd3, 0x5a, 0x79, 0xcf, 0x58, 0x66, 0xdb, 0x30, 0xd9, 0x18, 0x97, 0xa0,
+	0xaf, 0x3e, 0x13, 0xa2, 0x5e, 0xcf, 0x84, 0x31, 0xa7, 0x0f, 0x06, 0x56, 0xe9, 0x15, 0x8a, 0x97,
+	0x00, 0x96, 0x35, 0x23, 0xb7, 0x4b, 0x78, 0xd4, 0xb8, 0xa5, 0x5a, 0xaf, 0xd4, 0x65, 0x47, 0xe8,
+	0x6c, 0xfb, 0x45, 0xe1, 0xad, 0x0a, 0xa3, 0x4b, 0x84, 0x55, 0xf3, 0x86, 0xcf, 0x1f, 0x5d, 0xf7,
+	0x55, 0xbc, 0xc7, 0x96, 0x02, 0xde, 0x82, 0x09, 0x1f, 0xa6, 0x5b, 0x5c, 0xf8, 0xbb, 0xc7, 0x34,
+	0x94, 0xbf, 0x61, 0x42, 0x17, 0x8e, 0x82, 0x6f, 0x2c, 0xd2, 0x1b, 0x10, 0xf0, 0xb3, 0x8f, 0x5f,
+	0x6c, 0xde, 0xd6, 0xa6, 0xe6, 0x42, 0xd3, 0xc7, 0x1b, 0x79, 0xd0, 0xb1, 0x1f, 0xc3, 0xc0, 0x02,
+	0xe7, 0x78, 0x0d, 0x60, 0x89, 0x30, 0xf1, 0x77, 0xa1, 0x13, 0x66, 0xc2, 0x6d, 0x8e, 0x62, 0xff,
+	0x9f, 0x89, 0xd8, 0xbf, 0x06, 0x60, 0x72, 0xd5, 0x4b, 0x7c, 0x83, 0xd4, 0xc4, 0x05, 0x08, 0xfa,
+	0x52, 0x76, 0x2d, 0xb5, 0x88, 0xbb, 0xd1, 0xa6, 0xa1, 0x8b, 0x9d, 0x19, 0x0b, 0xea, 0xb2, 0x30,
+	0xd6, 0xa0, 0x93, 0xf1, 0x74, 0xbb, 0x0a, 0x69, 0x96, 0xd1, 0x5d, 0xbe, 0xc4, 0xe0, 0xe7, 0x6c,
+	0x96, 0x5b, 0xd4, 0xc1, 0x9e, 0x65, 0x50, 0x16, 0x9c, 0x12, 0xef, 0x53, 0xc9, 0xce, 0x73, 0x79,
+	0xe3, 0x7b, 0x10, 0xf4, 0xd4, 0x76, 0xad, 0x79, 0x66, 0x9a, 0xf7, 0x1f, 0xa5, 0xc6, 0x3b, 0xe8,
+	0xa1, 0xab, 0x30, 0xe2, 0xf5, 0x10, 0xaf, 0x3d, 0xa5, 0x4d, 0xdd, 0x36, 0xc9, 0xad, 0xd0, 0x71,
+	0xbf, 0xb8, 0x62, 0x9f, 0x21, 0x98, 0xf2, 0xcd, 0x05, 0x8d, 0xc5, 0xb7, 0x0e, 0x63, 0x9e, 0xa3,
+	0xd5, 0x52, 0xef, 0x3c, 0x8e, 0xc7, 0x55, 0xbc, 0x08, 0x63, 0xc1, 0xb2, 0x7a, 0x12, 0xc6, 0x9f,
+	0x46, 0xe0, 0xd4, 0x15, 0x5a, 0xfb, 0x7e, 0xab, 0x24, 0xaf, 0x53, 0x66, 0x57, 0xf0, 0x27, 0x08,
+	0xfa, 0x97, 0x08, 0x6b, 0x7b, 0x08, 0xf8, 0xac, 0xbd, 0x37, 0x7c, 0xfb, 0xc8, 0xa9, 0x58, 0x29,
+	0xfc, 0xfc, 0x2f, 0x7f, 0xff, 0x65, 0x1f, 0xc1, 0xd9, 0xe8, 0x0e, 0x8d, 0xfa, 0xa6, 0x24, 0x1a,
+	0x7d, 0xbf, 0x71, 0xc0, 0x8e, 0x34, 0xcd, 0x62, 0x4d, 0xf7, 0x77, 0xa3, 0x62, 0xa4, 0x6b, 0xd9,
+	0x57, 0xbb, 0xbc, 0x8b, 0xff, 0x8d, 0xa0, 0x3f, 0xdd, 0xce, 0xe9, 0x74, 0x77, 0x4e, 0x7f, 0x82,
+	0x5c, 0xaf, 0x7f, 0x8f, 0x42, 0x37, 0x5b, 0xdd, 0x16, 0xff, 0xd9, 0xbb, 0x72, 0xd9, 0xb7, 0xa7,
+	0xee, 0xee, 0x3c, 0x9a, 0x5d, 0x4f, 0x29, 0x89, 0x5e, 0xbc, 0x61, 0x1e, 0xcd, 0xe2, 0xdf, 0x22,
+	0x18, 0xa9, 0x69, 0x2c, 0x3c, 0xdb, 0xb9, 0xfc, 0x3a, 0x8e, 0x89, 0x55, 0x97, 0x88, 0xe5, 0xd0,
+	0x62, 0xab, 0x97, 0x8f, 0x73, 0xad, 0xa6, 0x65, 0xe7, 0xea, 0x4e, 0x5e, 0x42, 0xf8, 0x57, 0x08,
+	0x06, 0x13, 0x64, 0x97, 0x30, 0x82, 0x3b, 0x12, 0x53, 0xa1, 0x6f, 0xb5, 0xfc, 0x34, 0x48, 0x16,
+	0x2d, 0x56, 0x51, 0xae, 0xb9, 0xae, 0x2d, 0xcd, 0x26, 0xbb, 0x77, 0xad, 0x29, 0x2f, 0x6e, 0xed,
+	0xbc, 0x0d, 0x13, 0xcd, 0x42, 0xa1, 0xcd, 0x17, 0xb6, 0xbd, 0x94, 0x08, 0xbd, 0xd0, 0x12, 0x09,
+	0x5f, 0xbe, 0x84, 0xf0, 0x3d, 0x04, 0xc1, 0x35, 0x9b, 0x64, 0xcd, 0xa2, 0x55, 0x62, 0xc4, 0x3d,
+	0xd2, 0x9e, 0x2e, 0xea, 0x5b, 0x6e, 0xd4, 0xeb, 0xca, 0xf5, 0x9e, 0x44, 0x1d, 0xb5, 0x6a, 0xbe,
+	0xcd, 0xf1, 0xd1, 0x94, 0xd7, 0xd1, 0x1f, 0x10, 0x04, 0xb8, 0xc2, 0xae, 0x8d, 0xf2, 0x9d, 0x39,
+	0x7c, 0xfe, 0x48, 0xab, 0x2a, 0x90, 0xf2, 0x8e, 0xeb, 0xfb, 0x1a, 0x5e, 0xed, 0x8d, 0xef, 0x55,
+	0xc9, 0x10, 0xff, 0x0d, 0x7a, 0x70, 0x20, 0xa3, 0x87, 0x07, 0x32, 0xfa, 0xfc, 0x40, 0x96, 0xbe,
+	0x38, 0x90, 0xa5, 0x2f, 0x0f, 0x64, 0xe9, 0xab, 0x03, 0x59, 0xfa, 0xfa, 0x40, 0x46, 0x1f, 0x38,
+	0x32, 0xfa, 0xd0, 0x91, 0xa5, 0x8f, 0x1d, 0x19, 0xdd, 0x73, 0x64, 0xe9, 0xbe, 0x23, 0x4b, 0x9f,
+	0x3a, 0xb2, 0xf4, 0xc0, 0x91, 0xd1, 0x43, 0x47, 0x46, 0x9f, 0x3b, 0xb2, 0xf4, 0x85, 0x23, 0xa3,
+	0x2f, 0x1d, 0x59, 0xfa, 0xca, 0x91, 0xd1, 0xd7, 0x8e, 0x2c, 0x7d, 0x70, 0x28, 0x4b, 0x1f, 0x1e,
+	0xca, 0xe8, 0x17, 0x87, 0xb2, 0xf4, 0xeb, 0x43, 0x19, 0x7d, 0x74, 0x28, 0x4b, 0x1f, 0x1f, 0xca,
+	0xd2, 0xbd, 0x43, 0x19, 0xdd, 0x3f, 0x94, 0xd1, 0xa7, 0x87, 0x32, 0x5a, 0xbf, 0xd8, 0xe9, 0xf4,
+	0xc9, 0x0c, 0x6b, 0x6b, 0x6b, 0xd0, 0x4d, 0xe4, 0x2b, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x93,
+	0xfb, 0x42, 0x52, 0xb2, 0x1c, 0x00, 0x00,
 }
@@ -162,3 +162,44 @@ func (this *ProvisionEndDevicesRequest_IdentifiersRange) Validate() error {
 func (this *ProvisionEndDevicesRequest_IdentifiersFromData) Validate() error {
 	return nil
 }
+
+var _regex_StreamJoinEventsRequest_Outcome = regexp.MustCompile(`^(|accept|reject)$`)
+
+func (this *StreamJoinEventsRequest) Validate() error {
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.ApplicationIdentifiers)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("ApplicationIdentifiers", err)
+	}
+	if !_regex_StreamJoinEventsRequest_Outcome.MatchString(this.Outcome) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Outcome", fmt.Errorf(`value '%v' must be a string conforming to regex "^(|accept|reject)$"`, this.Outcome))
+	}
+	return nil
+}
+func (this *EndDeviceSessions) Validate() error {
+	for _, item := range this.Sessions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Sessions", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *SessionKeysBatchRequest) Validate() error {
+	return nil
+}
+func (this *NwkSKeysBatchResponse) Validate() error {
+	for _, item := range this.NwkSKeys {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("NwkSKeys", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *GetHomeNetIDRequest) Validate() error {
+	return nil
+}
+func (this *GetHomeNetIDResponse) Validate() error {
+	return nil
+}
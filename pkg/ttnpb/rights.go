@@ -25,12 +25,13 @@ var (
 	AllGatewayRights      = &Rights{}
 	AllOrganizationRights = &Rights{}
 	AllClusterRights      = &Rights{}
+	AllReadRights         = &Rights{}
 	AllRights             = &Rights{}
 )
 
 func init() {
 	for k, v := range Right_value {
-		if v == 0 {
+		if v == 0 || Right(v) == RIGHT_ALL_READ {
 			continue
 		}
 		switch {
@@ -46,12 +47,16 @@ func init() {
 		if strings.HasSuffix(k, "_READ") || strings.HasSuffix(k, "_INFO") {
 			AllClusterRights.Rights = append(AllClusterRights.Rights, Right(v))
 		}
+		if strings.HasSuffix(k, "_READ") || strings.HasSuffix(k, "_INFO") || strings.HasSuffix(k, "_LIST") {
+			AllReadRights.Rights = append(AllReadRights.Rights, Right(v))
+		}
 		AllRights.Rights = append(AllRights.Rights, Right(v))
 	}
 	AllUserRights = AllUserRights.Sorted()
 	AllApplicationRights = AllApplicationRights.Sorted()
 	AllGatewayRights = AllGatewayRights.Sorted()
 	AllOrganizationRights = AllOrganizationRights.Sorted()
+	AllReadRights = AllReadRights.Sorted()
 	AllRights = AllRights.Sorted()
 }
 
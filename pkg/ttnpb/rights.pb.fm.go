@@ -33,6 +33,9 @@ func (dst *Rights) SetFields(src *Rights, paths ...string) error {
 }
 
 var APIKeyFieldPathsNested = []string{
+	"deleted_at",
+	"entity_scope",
+	"expires_at",
 	"id",
 	"key",
 	"name",
@@ -40,6 +43,9 @@ var APIKeyFieldPathsNested = []string{
 }
 
 var APIKeyFieldPathsTopLevel = []string{
+	"deleted_at",
+	"entity_scope",
+	"expires_at",
 	"id",
 	"key",
 	"name",
@@ -88,6 +94,33 @@ func (dst *APIKey) SetFields(src *APIKey, paths ...string) error {
 			} else {
 				dst.Rights = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "deleted_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'deleted_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeletedAt = src.DeletedAt
+			} else {
+				dst.DeletedAt = nil
+			}
+		case "entity_scope":
+			if len(subs) > 0 {
+				return fmt.Errorf("'entity_scope' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EntityScope = src.EntityScope
+			} else {
+				dst.EntityScope = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
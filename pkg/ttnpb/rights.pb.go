@@ -8,9 +8,14 @@ import golang_proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
+import _ "github.com/gogo/protobuf/types"
+
+import time "time"
 
 import strconv "strconv"
 
+import github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+
 import strings "strings"
 import reflect "reflect"
 
@@ -21,6 +26,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -148,6 +154,9 @@ const (
 	RIGHT_SEND_INVITES Right = 54
 	// The pseudo-right for all (current and future) possible rights.
 	RIGHT_ALL Right = 55
+	// The pseudo-right for all read rights that the caller holds.
+	// This is expanded into concrete rights when creating user API keys and is never stored.
+	RIGHT_ALL_READ Right = 56
 )
 
 var Right_name = map[int32]string{
@@ -207,6 +216,7 @@ var Right_name = map[int32]string{
 	53: "RIGHT_ORGANIZATION_ALL",
 	54: "RIGHT_SEND_INVITES",
 	55: "RIGHT_ALL",
+	56: "RIGHT_ALL_READ",
 }
 var Right_value = map[string]int32{
 	"right_invalid":                            0,
@@ -265,10 +275,11 @@ var Right_value = map[string]int32{
 	"RIGHT_ORGANIZATION_ALL":                   53,
 	"RIGHT_SEND_INVITES":                       54,
 	"RIGHT_ALL":                                55,
+	"RIGHT_ALL_READ":                           56,
 }
 
 func (Right) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rights_adc4ed83d2eec3d9, []int{0}
+	return fileDescriptor_rights_37f0f126fcfad562, []int{0}
 }
 
 type Rights struct {
@@ -280,7 +291,7 @@ type Rights struct {
 func (m *Rights) Reset()      { *m = Rights{} }
 func (*Rights) ProtoMessage() {}
 func (*Rights) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rights_adc4ed83d2eec3d9, []int{0}
+	return fileDescriptor_rights_37f0f126fcfad562, []int{0}
 }
 func (m *Rights) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -326,15 +337,24 @@ type APIKey struct {
 	// User-defined (friendly) name for the API key.
 	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	// Rights that are granted to this API key.
-	Rights               []Right  `protobuf:"varint,4,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Rights []Right `protobuf:"varint,4,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	// Time after which the API key is no longer valid.
+	// API keys without expiry are valid until they are deleted.
+	ExpiresAt *time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	// Time at which the API key was deleted.
+	// Deleted API keys are not valid, but can be restored until they are purged.
+	DeletedAt *time.Time `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3,stdtime" json:"deleted_at,omitempty"`
+	// Entities that the API key can be used against.
+	// API keys without entity scope can be used against any entity that the owner of the API key has rights on.
+	EntityScope          []*EntityIdentifiers `protobuf:"bytes,7,rep,name=entity_scope,json=entityScope,proto3" json:"entity_scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *APIKey) Reset()      { *m = APIKey{} }
 func (*APIKey) ProtoMessage() {}
 func (*APIKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rights_adc4ed83d2eec3d9, []int{1}
+	return fileDescriptor_rights_37f0f126fcfad562, []int{1}
 }
 func (m *APIKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,6 +411,27 @@ func (m *APIKey) GetRights() []Right {
 	return nil
 }
 
+func (m *APIKey) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *APIKey) GetDeletedAt() *time.Time {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return nil
+}
+
+func (m *APIKey) GetEntityScope() []*EntityIdentifiers {
+	if m != nil {
+		return m.EntityScope
+	}
+	return nil
+}
+
 type APIKeys struct {
 	APIKeys              []*APIKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -400,7 +441,7 @@ type APIKeys struct {
 func (m *APIKeys) Reset()      { *m = APIKeys{} }
 func (*APIKeys) ProtoMessage() {}
 func (*APIKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rights_adc4ed83d2eec3d9, []int{2}
+	return fileDescriptor_rights_37f0f126fcfad562, []int{2}
 }
 func (m *APIKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,7 +487,7 @@ type Collaborator struct {
 func (m *Collaborator) Reset()      { *m = Collaborator{} }
 func (*Collaborator) ProtoMessage() {}
 func (*Collaborator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rights_adc4ed83d2eec3d9, []int{3}
+	return fileDescriptor_rights_37f0f126fcfad562, []int{3}
 }
 func (m *Collaborator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -491,7 +532,7 @@ type Collaborators struct {
 func (m *Collaborators) Reset()      { *m = Collaborators{} }
 func (*Collaborators) ProtoMessage() {}
 func (*Collaborators) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rights_adc4ed83d2eec3d9, []int{4}
+	return fileDescriptor_rights_37f0f126fcfad562, []int{4}
 }
 func (m *Collaborators) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -613,6 +654,28 @@ func (this *APIKey) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if that1.DeletedAt == nil {
+		if this.DeletedAt != nil {
+			return false
+		}
+	} else if !this.DeletedAt.Equal(*that1.DeletedAt) {
+		return false
+	}
+	if len(this.EntityScope) != len(that1.EntityScope) {
+		return false
+	}
+	for i := range this.EntityScope {
+		if !this.EntityScope[i].Equal(that1.EntityScope[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *APIKeys) Equal(that interface{}) bool {
@@ -790,6 +853,38 @@ func (m *APIKey) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRights(dAtA, i, uint64(j3))
 		i += copy(dAtA[i:], dAtA4[:j3])
 	}
+	if m.ExpiresAt != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRights(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)))
+		n5, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.DeletedAt != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintRights(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt)))
+		n6, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DeletedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.EntityScope) > 0 {
+		for _, msg := range m.EntityScope {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintRights(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -841,27 +936,27 @@ func (m *Collaborator) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintRights(dAtA, i, uint64(m.OrganizationOrUserIdentifiers.Size()))
-	n5, err := m.OrganizationOrUserIdentifiers.MarshalTo(dAtA[i:])
+	n7, err := m.OrganizationOrUserIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n7
 	if len(m.Rights) > 0 {
-		dAtA7 := make([]byte, len(m.Rights)*10)
-		var j6 int
+		dAtA9 := make([]byte, len(m.Rights)*10)
+		var j8 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j6++
+				j8++
 			}
-			dAtA7[j6] = uint8(num)
-			j6++
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRights(dAtA, i, uint64(j6))
-		i += copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintRights(dAtA, i, uint64(j8))
+		i += copy(dAtA[i:], dAtA9[:j8])
 	}
 	return i, nil
 }
@@ -910,7 +1005,7 @@ func NewPopulatedRights(r randyRights, easy bool) *Rights {
 	v1 := r.Intn(10)
 	this.Rights = make([]Right, v1)
 	for i := 0; i < v1; i++ {
-		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56}[r.Intn(57)])
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -925,7 +1020,20 @@ func NewPopulatedAPIKey(r randyRights, easy bool) *APIKey {
 	v2 := r.Intn(10)
 	this.Rights = make([]Right, v2)
 	for i := 0; i < v2; i++ {
-		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56}[r.Intn(57)])
+	}
+	if r.Intn(10) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.DeletedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		v3 := r.Intn(5)
+		this.EntityScope = make([]*EntityIdentifiers, v3)
+		for i := 0; i < v3; i++ {
+			this.EntityScope[i] = NewPopulatedEntityIdentifiers(r, easy)
+		}
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -935,9 +1043,9 @@ func NewPopulatedAPIKey(r randyRights, easy bool) *APIKey {
 func NewPopulatedAPIKeys(r randyRights, easy bool) *APIKeys {
 	this := &APIKeys{}
 	if r.Intn(10) != 0 {
-		v3 := r.Intn(5)
-		this.APIKeys = make([]*APIKey, v3)
-		for i := 0; i < v3; i++ {
+		v4 := r.Intn(5)
+		this.APIKeys = make([]*APIKey, v4)
+		for i := 0; i < v4; i++ {
 			this.APIKeys[i] = NewPopulatedAPIKey(r, easy)
 		}
 	}
@@ -948,12 +1056,12 @@ func NewPopulatedAPIKeys(r randyRights, easy bool) *APIKeys {
 
 func NewPopulatedCollaborator(r randyRights, easy bool) *Collaborator {
 	this := &Collaborator{}
-	v4 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v4
-	v5 := r.Intn(10)
-	this.Rights = make([]Right, v5)
-	for i := 0; i < v5; i++ {
-		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
+	v5 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v5
+	v6 := r.Intn(10)
+	this.Rights = make([]Right, v6)
+	for i := 0; i < v6; i++ {
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56}[r.Intn(57)])
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -963,9 +1071,9 @@ func NewPopulatedCollaborator(r randyRights, easy bool) *Collaborator {
 func NewPopulatedCollaborators(r randyRights, easy bool) *Collaborators {
 	this := &Collaborators{}
 	if r.Intn(10) != 0 {
-		v6 := r.Intn(5)
-		this.Collaborators = make([]*Collaborator, v6)
-		for i := 0; i < v6; i++ {
+		v7 := r.Intn(5)
+		this.Collaborators = make([]*Collaborator, v7)
+		for i := 0; i < v7; i++ {
 			this.Collaborators[i] = NewPopulatedCollaborator(r, easy)
 		}
 	}
@@ -993,9 +1101,9 @@ func randUTF8RuneRights(r randyRights) rune {
 	return rune(ru + 61)
 }
 func randStringRights(r randyRights) string {
-	v7 := r.Intn(100)
-	tmps := make([]rune, v7)
-	for i := 0; i < v7; i++ {
+	v8 := r.Intn(100)
+	tmps := make([]rune, v8)
+	for i := 0; i < v8; i++ {
 		tmps[i] = randUTF8RuneRights(r)
 	}
 	return string(tmps)
@@ -1017,11 +1125,11 @@ func randFieldRights(dAtA []byte, r randyRights, fieldNumber int, wire int) []by
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateRights(dAtA, uint64(key))
-		v8 := r.Int63()
+		v9 := r.Int63()
 		if r.Intn(2) == 0 {
-			v8 *= -1
+			v9 *= -1
 		}
-		dAtA = encodeVarintPopulateRights(dAtA, uint64(v8))
+		dAtA = encodeVarintPopulateRights(dAtA, uint64(v9))
 	case 1:
 		dAtA = encodeVarintPopulateRights(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1087,6 +1195,20 @@ func (m *APIKey) Size() (n int) {
 		}
 		n += 1 + sovRights(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovRights(uint64(l))
+	}
+	if m.DeletedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt)
+		n += 1 + l + sovRights(uint64(l))
+	}
+	if len(m.EntityScope) > 0 {
+		for _, e := range m.EntityScope {
+			l = e.Size()
+			n += 1 + l + sovRights(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1170,6 +1292,9 @@ func (this *APIKey) String() string {
 		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`DeletedAt:` + strings.Replace(fmt.Sprintf("%v", this.DeletedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`EntityScope:` + strings.Replace(fmt.Sprintf("%v", this.EntityScope), "EntityIdentifiers", "EntityIdentifiers", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1511,6 +1636,103 @@ func (m *APIKey) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletedAt == nil {
+				m.DeletedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.DeletedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityScope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityScope = append(m.EntityScope, &EntityIdentifiers{})
+			if err := m.EntityScope[len(m.EntityScope)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRights(dAtA[iNdEx:])
@@ -1946,80 +2168,87 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("lorawan-stack/api/rights.proto", fileDescriptor_rights_adc4ed83d2eec3d9)
+	proto.RegisterFile("lorawan-stack/api/rights.proto", fileDescriptor_rights_37f0f126fcfad562)
 }
 func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/rights.proto", fileDescriptor_rights_adc4ed83d2eec3d9)
-}
-
-var fileDescriptor_rights_adc4ed83d2eec3d9 = []byte{
-	// 1080 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0x3d, 0x50, 0xdb, 0x48,
-	0x14, 0xc7, 0xb5, 0x86, 0x38, 0xc9, 0x23, 0x90, 0x65, 0x03, 0xc4, 0x18, 0x58, 0x83, 0x21, 0x84,
-	0xe3, 0xc0, 0xbe, 0x83, 0xbb, 0x4b, 0x77, 0x33, 0xb2, 0x2d, 0xc8, 0x0e, 0x8a, 0xc5, 0x48, 0x22,
-	0x4c, 0x68, 0x34, 0x02, 0x1c, 0xa3, 0x81, 0x58, 0x1e, 0x5b, 0x24, 0xc3, 0x55, 0x29, 0x29, 0xaf,
-	0xbc, 0xf2, 0xe6, 0xae, 0x49, 0x99, 0x32, 0x65, 0x4a, 0xba, 0xa3, 0x4c, 0xc5, 0xc4, 0x72, 0x93,
-	0x32, 0x65, 0xca, 0x1b, 0x4b, 0x32, 0xfa, 0xb0, 0x9c, 0xa4, 0x93, 0xf7, 0xfd, 0xde, 0xd7, 0xff,
-	0xbd, 0xdd, 0x31, 0xd0, 0x13, 0xb3, 0xa1, 0xbf, 0xd2, 0x6b, 0xab, 0x4d, 0x4b, 0x3f, 0x38, 0xce,
-	0xeb, 0x75, 0x23, 0xdf, 0x30, 0xaa, 0x47, 0x56, 0x33, 0x57, 0x6f, 0x98, 0x96, 0x49, 0x46, 0x2c,
-	0xab, 0x96, 0xf3, 0x98, 0xdc, 0xcb, 0xf5, 0xf4, 0x6a, 0xd5, 0xb0, 0x8e, 0x4e, 0xf7, 0x73, 0x07,
-	0xe6, 0x8b, 0x7c, 0xd5, 0xac, 0x9a, 0x79, 0x07, 0xdb, 0x3f, 0x7d, 0xee, 0xfc, 0x72, 0x7e, 0x38,
-	0x5f, 0xae, 0x7b, 0x7a, 0xbe, 0x37, 0xbc, 0x71, 0x58, 0xa9, 0x59, 0xc6, 0x73, 0xa3, 0xd2, 0xf0,
-	0x72, 0x64, 0x1f, 0x41, 0x52, 0x76, 0x72, 0x92, 0x55, 0x48, 0xba, 0xd9, 0x53, 0x68, 0x76, 0x60,
-	0x69, 0x64, 0x6d, 0x3c, 0x17, 0x4e, 0x9f, 0x73, 0x38, 0xd9, 0x83, 0xb2, 0xa7, 0x90, 0xe4, 0xb7,
-	0xd9, 0x56, 0xe5, 0x8c, 0x4c, 0x40, 0xc2, 0x38, 0x4c, 0xa1, 0x59, 0xb4, 0x74, 0xbb, 0x90, 0xb4,
-	0xaf, 0x32, 0x09, 0x56, 0x92, 0x13, 0xc6, 0x21, 0xc1, 0x30, 0x70, 0x5c, 0x39, 0x4b, 0x25, 0x3a,
-	0x06, 0xb9, 0xf3, 0x49, 0x08, 0x0c, 0xd6, 0xf4, 0x17, 0x95, 0xd4, 0x80, 0x73, 0xe4, 0x7c, 0x07,
-	0xd2, 0x0e, 0x7e, 0x4f, 0x5a, 0x06, 0x37, 0xdd, 0xb4, 0x4d, 0xf2, 0x3b, 0xdc, 0xd2, 0xeb, 0x86,
-	0x76, 0x5c, 0x39, 0x73, 0x4b, 0x1e, 0x5a, 0x9b, 0x88, 0xfa, 0xba, 0x68, 0x61, 0xc8, 0xbe, 0xca,
-	0x74, 0xdd, 0xe4, 0x9b, 0x7a, 0xdd, 0xe8, 0x7c, 0x64, 0xcf, 0x11, 0xdc, 0x29, 0x9a, 0x27, 0x27,
-	0xfa, 0xbe, 0xd9, 0xd0, 0x2d, 0xb3, 0x41, 0x18, 0x0c, 0x18, 0x87, 0x4d, 0xa7, 0x93, 0xa1, 0xb5,
-	0xd5, 0x68, 0x2c, 0xa9, 0x51, 0xd5, 0x6b, 0xc6, 0x1f, 0xba, 0x65, 0x98, 0x35, 0xa9, 0xb1, 0xd3,
-	0xac, 0x34, 0x98, 0xaf, 0x66, 0xe1, 0xd6, 0xc5, 0x55, 0x86, 0xbb, 0xbc, 0xca, 0x20, 0xb9, 0x13,
-	0x23, 0xd0, 0x55, 0xe2, 0x7b, 0xba, 0x52, 0x60, 0x38, 0x58, 0x49, 0x93, 0x14, 0x60, 0xf8, 0x20,
-	0x78, 0xe0, 0x35, 0x38, 0x1d, 0x0d, 0x13, 0xf4, 0x92, 0xc3, 0x2e, 0xcb, 0xff, 0x8d, 0xc0, 0x0d,
-	0x27, 0x0d, 0x19, 0x85, 0x61, 0x27, 0x91, 0x66, 0xd4, 0x5e, 0xea, 0x27, 0xc6, 0x21, 0xe6, 0xc8,
-	0x3d, 0xb8, 0x2b, 0xb3, 0xcd, 0xc7, 0xaa, 0xb6, 0xa3, 0x08, 0xb2, 0xc6, 0xca, 0x1b, 0x12, 0x46,
-	0x64, 0x06, 0x26, 0x03, 0x87, 0x8a, 0xa0, 0xaa, 0xac, 0xbc, 0xa9, 0x68, 0x05, 0x5e, 0x61, 0x45,
-	0x9c, 0x20, 0xb3, 0x30, 0x1d, 0x67, 0xe6, 0xb7, 0x99, 0xb6, 0x25, 0x3c, 0x53, 0xf0, 0x00, 0x19,
-	0x87, 0xd1, 0x00, 0x51, 0x12, 0x44, 0x41, 0x15, 0xf0, 0x20, 0x99, 0x83, 0x99, 0xc0, 0x31, 0xbf,
-	0xa3, 0x3e, 0x96, 0x64, 0xb6, 0x27, 0x94, 0xb4, 0xa2, 0xc8, 0x84, 0xb2, 0xaa, 0xe0, 0x1b, 0x91,
-	0xd8, 0xfc, 0xf6, 0xb6, 0xc8, 0x8a, 0xbc, 0xca, 0xa4, 0xb2, 0xa2, 0x89, 0x4c, 0x51, 0x71, 0x92,
-	0x64, 0x81, 0xf6, 0x23, 0x8a, 0xb2, 0xc0, 0xab, 0x02, 0xbe, 0x49, 0xa6, 0x21, 0x15, 0x60, 0x36,
-	0x79, 0x55, 0xd8, 0xe5, 0x9f, 0x79, 0x11, 0x6e, 0x11, 0x0a, 0xe9, 0x38, 0xab, 0xe7, 0x7d, 0x9b,
-	0x4c, 0xc1, 0xfd, 0x80, 0xdd, 0xab, 0xcd, 0x75, 0x86, 0x88, 0x36, 0x5d, 0xa3, 0xe7, 0x3b, 0x14,
-	0x69, 0x51, 0x92, 0x37, 0xf9, 0x32, 0xdb, 0x0b, 0x36, 0x70, 0x87, 0xcc, 0x43, 0xa6, 0x2f, 0xe2,
-	0xc5, 0x19, 0x26, 0x04, 0x46, 0x82, 0x5d, 0x8a, 0x22, 0x1e, 0x21, 0x69, 0x98, 0x70, 0xcf, 0x02,
-	0x4d, 0xbb, 0x23, 0xbb, 0x4b, 0x16, 0x60, 0xb6, 0xd7, 0x16, 0x99, 0x1c, 0x26, 0x0f, 0x61, 0xfe,
-	0x2b, 0xd4, 0xf5, 0x00, 0x47, 0xc9, 0x0a, 0x2c, 0x7d, 0x05, 0x2c, 0x4a, 0xa2, 0xc8, 0x17, 0x24,
-	0x99, 0x57, 0x25, 0x59, 0xc1, 0xc4, 0x97, 0x3b, 0x48, 0x7b, 0x53, 0xbf, 0xe7, 0x0f, 0x2c, 0x6c,
-	0x7d, 0xca, 0x8a, 0x82, 0xa2, 0xc9, 0x02, 0x5f, 0xc2, 0x63, 0xbe, 0x26, 0x71, 0xcc, 0xae, 0xcc,
-	0x54, 0x01, 0x8f, 0xc7, 0x57, 0x1f, 0x0c, 0xe4, 0x56, 0x3f, 0x41, 0x96, 0x60, 0xe1, 0x1b, 0xd1,
-	0x5c, 0xf2, 0x7e, 0x7c, 0x6d, 0xaa, 0xcc, 0x6f, 0x6c, 0xb0, 0xa2, 0x5b, 0x5b, 0x8a, 0x2c, 0x42,
-	0xb6, 0x3f, 0xb3, 0xb3, 0xed, 0x95, 0x37, 0x19, 0x9f, 0xb5, 0xcb, 0x95, 0xa4, 0xdd, 0xb2, 0x47,
-	0xa6, 0xe3, 0x07, 0x29, 0xb2, 0xf2, 0x16, 0x9e, 0x22, 0x93, 0x30, 0xde, 0x6b, 0xeb, 0xcc, 0x7f,
-	0x9a, 0x8c, 0x01, 0x76, 0x4d, 0xee, 0xd6, 0x39, 0xa7, 0x33, 0x64, 0x02, 0x88, 0x7b, 0xea, 0x2d,
-	0xb2, 0xbb, 0x11, 0xd4, 0xbf, 0x49, 0xdd, 0xf3, 0xc8, 0x36, 0x64, 0x7c, 0xd1, 0x7b, 0x88, 0xeb,
-	0x4d, 0x98, 0xf5, 0xbb, 0xea, 0x81, 0xc2, 0x5b, 0x30, 0x47, 0x52, 0x30, 0x16, 0x26, 0xbd, 0x0d,
-	0xc8, 0xfa, 0x17, 0xae, 0x6b, 0x09, 0x29, 0x3c, 0xef, 0x2f, 0x6f, 0xd4, 0x1e, 0x50, 0x6d, 0xa1,
-	0xb7, 0x51, 0x47, 0xb1, 0x07, 0xfe, 0x8d, 0xbc, 0xae, 0x50, 0xe5, 0xd5, 0x1d, 0x6f, 0xb5, 0x16,
-	0x49, 0x06, 0xa6, 0x22, 0x6e, 0x92, 0xa7, 0xaa, 0x03, 0x3c, 0xf4, 0x1f, 0xab, 0x2e, 0xd0, 0xd1,
-	0x75, 0xc9, 0x7f, 0x05, 0x82, 0x37, 0xd4, 0x15, 0xf7, 0x07, 0xf2, 0x00, 0xe6, 0x62, 0x8c, 0x11,
-	0x85, 0x97, 0x7d, 0xf1, 0xe2, 0xb1, 0x6b, 0x99, 0x7f, 0xf4, 0x77, 0x3b, 0x9e, 0x7c, 0x22, 0x3c,
-	0x29, 0x08, 0xb2, 0x82, 0x57, 0xfc, 0x6e, 0x43, 0xa0, 0x27, 0xf5, 0x6a, 0x9f, 0x8c, 0xbd, 0xef,
-	0x68, 0x8e, 0x2c, 0xc3, 0xe2, 0xb7, 0x48, 0xef, 0x35, 0xca, 0xfb, 0x03, 0x0a, 0xb1, 0xe1, 0x77,
-	0xf5, 0x27, 0xff, 0xa2, 0xc4, 0x53, 0x5e, 0xb4, 0x9f, 0xfd, 0xbd, 0x0b, 0x71, 0xa1, 0x77, 0x76,
-	0xad, 0x8f, 0xc2, 0x91, 0xf7, 0x76, 0xbd, 0x5f, 0x17, 0xa5, 0x92, 0xc6, 0x87, 0x37, 0x14, 0xff,
-	0xe2, 0x5f, 0xbb, 0x30, 0x2b, 0x8a, 0xf8, 0x57, 0x7f, 0xb9, 0x14, 0xa1, 0x5c, 0xd2, 0x58, 0xf9,
-	0x29, 0x53, 0x05, 0x05, 0xff, 0x46, 0x86, 0xe1, 0xb6, 0x77, 0x1d, 0x45, 0x11, 0x3f, 0x4a, 0x0f,
-	0x9e, 0xff, 0x4b, 0xb9, 0xc2, 0x3f, 0xe8, 0xa2, 0x45, 0xd1, 0x65, 0x8b, 0xa2, 0x0f, 0x2d, 0xca,
-	0x7d, 0x6c, 0x51, 0xee, 0x53, 0x8b, 0x72, 0x9f, 0x5b, 0x94, 0xfb, 0xd2, 0xa2, 0xe8, 0xb5, 0x4d,
-	0xd1, 0xb9, 0x4d, 0xb9, 0x37, 0x36, 0x45, 0x6f, 0x6d, 0xca, 0xbd, 0xb3, 0x29, 0xf7, 0xde, 0xa6,
-	0xdc, 0x85, 0x4d, 0xd1, 0xa5, 0x4d, 0xd1, 0x07, 0x9b, 0x72, 0x1f, 0x6d, 0x8a, 0x3e, 0xd9, 0x94,
-	0xfb, 0x6c, 0x53, 0xf4, 0xc5, 0xa6, 0xdc, 0xeb, 0x36, 0xe5, 0xce, 0xdb, 0x14, 0xfd, 0xd9, 0xa6,
-	0xdc, 0x5f, 0x6d, 0x8a, 0xfe, 0x6e, 0x53, 0xee, 0x4d, 0x9b, 0x72, 0x6f, 0xdb, 0x14, 0xbd, 0x6b,
-	0x53, 0xf4, 0xbe, 0x4d, 0xd1, 0xde, 0x4a, 0xd5, 0xcc, 0x59, 0x47, 0x15, 0xeb, 0xc8, 0xa8, 0x55,
-	0x9b, 0xb9, 0x5a, 0xc5, 0x7a, 0x65, 0x36, 0x8e, 0xf3, 0xe1, 0x7f, 0x77, 0xf5, 0xe3, 0x6a, 0xde,
-	0xb2, 0x6a, 0xf5, 0xfd, 0xfd, 0xa4, 0xf3, 0xc7, 0x6e, 0xfd, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x8c, 0x7c, 0x30, 0xcb, 0x5e, 0x0a, 0x00, 0x00,
+	golang_proto.RegisterFile("lorawan-stack/api/rights.proto", fileDescriptor_rights_37f0f126fcfad562)
+}
+
+var fileDescriptor_rights_37f0f126fcfad562 = []byte{
+	// 1200 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0x3f, 0x50, 0xdb, 0xc8,
+	0x17, 0xc7, 0xb5, 0x36, 0x31, 0xb0, 0x06, 0x22, 0x36, 0x40, 0x8c, 0x81, 0xb5, 0x31, 0x84, 0xf8,
+	0xc7, 0x0f, 0xe4, 0x3b, 0x73, 0x77, 0xb9, 0xea, 0x66, 0x64, 0x5b, 0x10, 0x0d, 0x8a, 0xcd, 0x48,
+	0x22, 0x4c, 0x68, 0x34, 0x02, 0x2b, 0x46, 0x83, 0xb1, 0x3c, 0xb6, 0x92, 0x1c, 0x57, 0xa5, 0xa4,
+	0x4c, 0x79, 0xe5, 0xcd, 0x5d, 0x93, 0x32, 0x65, 0xca, 0x34, 0x37, 0x43, 0x49, 0x99, 0x8a, 0x8b,
+	0xe5, 0x26, 0x65, 0xca, 0x94, 0x37, 0x96, 0xd6, 0xe8, 0x8f, 0xe5, 0x24, 0xd7, 0xc9, 0xfb, 0x3e,
+	0xef, 0xed, 0x7b, 0xdf, 0xf7, 0xf6, 0x8d, 0x21, 0xae, 0x1b, 0x2d, 0xf5, 0x85, 0xda, 0xd8, 0x6c,
+	0x9b, 0xea, 0xf1, 0x69, 0x4e, 0x6d, 0xea, 0xb9, 0x96, 0x5e, 0x3b, 0x31, 0xdb, 0x4c, 0xb3, 0x65,
+	0x98, 0x06, 0x9a, 0x32, 0xcd, 0x06, 0x43, 0x18, 0xe6, 0xf9, 0x56, 0x72, 0xb3, 0xa6, 0x9b, 0x27,
+	0xcf, 0x8e, 0x98, 0x63, 0xe3, 0x2c, 0x57, 0x33, 0x6a, 0x46, 0xce, 0xc6, 0x8e, 0x9e, 0x3d, 0xb5,
+	0x7f, 0xd9, 0x3f, 0xec, 0x2f, 0xc7, 0x3d, 0x99, 0xaa, 0x19, 0x46, 0xad, 0xae, 0xb9, 0x94, 0xa9,
+	0x9f, 0x69, 0x6d, 0x53, 0x3d, 0x6b, 0x12, 0x60, 0x65, 0xf0, 0x7e, 0xbd, 0xaa, 0x35, 0x4c, 0xfd,
+	0xa9, 0xae, 0xb5, 0x48, 0x12, 0x99, 0x07, 0x30, 0x26, 0xda, 0x49, 0xa1, 0x4d, 0x18, 0x73, 0xd2,
+	0x4b, 0x80, 0x74, 0x34, 0x3b, 0x95, 0x9f, 0x65, 0xfc, 0xf9, 0x31, 0x36, 0x27, 0x12, 0x28, 0xf3,
+	0x77, 0x04, 0xc6, 0xd8, 0x3d, 0x7e, 0x57, 0x3b, 0x47, 0x73, 0x30, 0xa2, 0x57, 0x13, 0x20, 0x0d,
+	0xb2, 0xe3, 0x85, 0x98, 0x75, 0x9d, 0x8a, 0xf0, 0x25, 0x31, 0xa2, 0x57, 0x11, 0x0d, 0xa3, 0xa7,
+	0xda, 0x79, 0x22, 0xd2, 0x33, 0x88, 0xbd, 0x4f, 0x84, 0xe0, 0x48, 0x43, 0x3d, 0xd3, 0x12, 0x51,
+	0xfb, 0xc8, 0xfe, 0xf6, 0xdc, 0x3b, 0xf2, 0x0d, 0xf7, 0xa2, 0x22, 0x84, 0xda, 0xaf, 0x4d, 0xbd,
+	0xa5, 0xb5, 0x15, 0xd5, 0x4c, 0xdc, 0x4a, 0x83, 0x6c, 0x3c, 0x9f, 0x64, 0x1c, 0x2d, 0x98, 0xbe,
+	0x16, 0x8c, 0xdc, 0xd7, 0xa2, 0x30, 0x76, 0x79, 0x9d, 0x02, 0xaf, 0xfe, 0x49, 0x01, 0x71, 0x9c,
+	0xf8, 0xb1, 0x66, 0x2f, 0x48, 0x55, 0xab, 0x6b, 0xa6, 0x56, 0xed, 0x05, 0x89, 0xfd, 0x97, 0x20,
+	0xc4, 0x8f, 0x35, 0x51, 0x09, 0x4e, 0xf4, 0xd4, 0x34, 0xcf, 0x95, 0xf6, 0xb1, 0xd1, 0xd4, 0x12,
+	0xa3, 0xe9, 0x68, 0x36, 0x9e, 0x5f, 0x0e, 0xa6, 0xcf, 0xd9, 0x0c, 0xef, 0x2a, 0x2f, 0xc6, 0x1d,
+	0x37, 0xa9, 0xe7, 0x95, 0xe1, 0xe1, 0xa8, 0x23, 0x63, 0x1b, 0xfd, 0x02, 0xc7, 0xd4, 0xa6, 0xae,
+	0x9c, 0x6a, 0xe7, 0x4e, 0x0f, 0xe2, 0xf9, 0xb9, 0x60, 0x30, 0x07, 0x2d, 0xc4, 0xad, 0xeb, 0x54,
+	0xdf, 0x4d, 0x1c, 0x55, 0x9b, 0x7a, 0xef, 0x23, 0x73, 0x01, 0xe0, 0x44, 0xd1, 0xa8, 0xd7, 0xd5,
+	0x23, 0xa3, 0xa5, 0x9a, 0x46, 0x0b, 0xf1, 0x30, 0xaa, 0x57, 0xdb, 0x76, 0x67, 0xe2, 0xf9, 0xcd,
+	0x60, 0xac, 0x4a, 0xab, 0xa6, 0x36, 0xf4, 0xdf, 0x54, 0x53, 0x37, 0x1a, 0x95, 0xd6, 0x7e, 0x5b,
+	0x6b, 0x79, 0x92, 0xb4, 0x4b, 0xa6, 0xae, 0xae, 0x53, 0x40, 0xec, 0xc5, 0xf0, 0x74, 0x29, 0xf2,
+	0x2d, 0xd3, 0x21, 0xc1, 0x49, 0x6f, 0x26, 0x6d, 0x54, 0x80, 0x93, 0xc7, 0xde, 0x03, 0x52, 0xe0,
+	0x62, 0x30, 0x8c, 0xd7, 0x4b, 0xf4, 0xbb, 0xac, 0x77, 0xa7, 0xe0, 0x2d, 0xfb, 0x1a, 0x34, 0x0d,
+	0x27, 0xed, 0x8b, 0x14, 0xbd, 0xf1, 0x5c, 0xad, 0xeb, 0x55, 0x9a, 0x42, 0x77, 0xe0, 0x6d, 0x91,
+	0xdf, 0x79, 0x28, 0x2b, 0xfb, 0x12, 0x27, 0x2a, 0x7c, 0x79, 0xbb, 0x42, 0x03, 0xb4, 0x04, 0xe7,
+	0x3d, 0x87, 0x12, 0x27, 0xcb, 0x7c, 0x79, 0x47, 0x52, 0x0a, 0xac, 0xc4, 0x17, 0xe9, 0x08, 0x4a,
+	0xc3, 0xc5, 0x30, 0x33, 0xbb, 0xc7, 0x2b, 0xbb, 0xdc, 0x13, 0x89, 0x8e, 0xa2, 0x59, 0x38, 0xed,
+	0x21, 0x4a, 0x9c, 0xc0, 0xc9, 0x1c, 0x3d, 0x82, 0x96, 0xe1, 0x92, 0xe7, 0x98, 0xdd, 0x97, 0x1f,
+	0x56, 0x44, 0xfe, 0x90, 0x2b, 0x29, 0x45, 0x81, 0xe7, 0xca, 0xb2, 0x44, 0xdf, 0x0a, 0xc4, 0x66,
+	0xf7, 0xf6, 0x04, 0xbe, 0xc8, 0xca, 0x7c, 0xa5, 0x2c, 0x29, 0x02, 0x2f, 0xc9, 0x74, 0x0c, 0x65,
+	0x20, 0x1e, 0x46, 0x14, 0x45, 0x8e, 0x95, 0x39, 0x7a, 0x14, 0x2d, 0xc2, 0x84, 0x87, 0xd9, 0x61,
+	0x65, 0xee, 0x80, 0x7d, 0x42, 0x22, 0x8c, 0x21, 0x0c, 0x93, 0x61, 0x56, 0xe2, 0x3d, 0x8e, 0x16,
+	0xe0, 0x5d, 0x8f, 0x9d, 0xe4, 0xe6, 0x38, 0xc3, 0x80, 0x36, 0x7d, 0x23, 0xf1, 0x8d, 0x07, 0x4a,
+	0xac, 0x88, 0x3b, 0x6c, 0x99, 0x3f, 0xf4, 0x16, 0x30, 0x81, 0x56, 0x60, 0x6a, 0x28, 0x42, 0xe2,
+	0x4c, 0x22, 0x04, 0xa7, 0xbc, 0x55, 0x0a, 0x02, 0x3d, 0x85, 0x92, 0x70, 0xce, 0x39, 0xf3, 0x14,
+	0xed, 0xb4, 0xec, 0x36, 0x5a, 0x85, 0xe9, 0x41, 0x5b, 0xa0, 0x73, 0x34, 0xba, 0x0f, 0x57, 0xbe,
+	0x40, 0xdd, 0x34, 0x70, 0x1a, 0x6d, 0xc0, 0xec, 0x17, 0xc0, 0x62, 0x45, 0x10, 0xd8, 0x42, 0x45,
+	0x64, 0xe5, 0x8a, 0x28, 0xd1, 0xc8, 0x95, 0xdb, 0x4b, 0x93, 0xae, 0xdf, 0x71, 0x1b, 0xe6, 0xb7,
+	0x3e, 0xe6, 0x8b, 0x9c, 0xa4, 0x88, 0x1c, 0x5b, 0xa2, 0x67, 0x5c, 0x4d, 0xc2, 0x98, 0x03, 0x91,
+	0x97, 0x39, 0x7a, 0x36, 0x3c, 0x7b, 0x6f, 0x20, 0x27, 0xfb, 0x39, 0x94, 0x85, 0xab, 0x5f, 0x89,
+	0xe6, 0x90, 0x77, 0xc3, 0x73, 0x93, 0x45, 0x76, 0x7b, 0x9b, 0x2f, 0x3a, 0xb9, 0x25, 0xd0, 0x1a,
+	0xcc, 0x0c, 0x67, 0xf6, 0xf7, 0x48, 0x7a, 0xf3, 0xe1, 0xb7, 0xf6, 0xb9, 0x52, 0xe5, 0xa0, 0x4c,
+	0xc8, 0x64, 0x78, 0x23, 0x05, 0xbe, 0xbc, 0x4b, 0x2f, 0xa0, 0x79, 0x38, 0x3b, 0x68, 0xeb, 0xf5,
+	0x7f, 0x11, 0xcd, 0x40, 0xda, 0x31, 0x39, 0x53, 0x67, 0x9f, 0x2e, 0xa1, 0x39, 0x88, 0x9c, 0x53,
+	0x32, 0xc8, 0xce, 0x44, 0x60, 0xf7, 0x25, 0xf5, 0xcf, 0x03, 0xd3, 0x90, 0x72, 0x45, 0x1f, 0x20,
+	0x6e, 0x26, 0x21, 0xed, 0x56, 0x35, 0x00, 0xf9, 0xa7, 0x60, 0x19, 0x25, 0xe0, 0x8c, 0x9f, 0x24,
+	0x13, 0x90, 0x71, 0x1f, 0x5c, 0xdf, 0xe2, 0x53, 0x78, 0xc5, 0x1d, 0xde, 0xa0, 0xdd, 0xa3, 0xda,
+	0xea, 0x60, 0xa1, 0xb6, 0x62, 0xf7, 0xdc, 0x17, 0x79, 0x93, 0xa1, 0xcc, 0xca, 0xfb, 0x64, 0xb4,
+	0xd6, 0x50, 0x0a, 0x2e, 0x04, 0xdc, 0x2a, 0x44, 0x55, 0x1b, 0xb8, 0xef, 0x2e, 0xab, 0x3e, 0xd0,
+	0xd3, 0x35, 0xeb, 0x6e, 0x01, 0xef, 0x0b, 0x75, 0xc4, 0xfd, 0x1f, 0xba, 0x07, 0x97, 0x43, 0x8c,
+	0x01, 0x85, 0xd7, 0x5d, 0xf1, 0xc2, 0xb1, 0x1b, 0x99, 0xff, 0xef, 0xce, 0x76, 0x38, 0xf9, 0x88,
+	0x7b, 0x54, 0xe0, 0x44, 0x89, 0xde, 0x70, 0xab, 0xf5, 0x81, 0x44, 0xea, 0xcd, 0x21, 0x37, 0x0e,
+	0xee, 0x51, 0x06, 0xad, 0xc3, 0xb5, 0xaf, 0x91, 0x64, 0x1b, 0xe5, 0xdc, 0x06, 0xf9, 0x58, 0xff,
+	0x5e, 0xfd, 0xce, 0x7d, 0x28, 0xe1, 0x14, 0x89, 0xf6, 0xbd, 0x3b, 0x77, 0x3e, 0xce, 0xb7, 0x67,
+	0xf3, 0x43, 0x14, 0x0e, 0xec, 0xdb, 0xad, 0x61, 0x55, 0x94, 0x4a, 0x0a, 0xeb, 0x9f, 0x50, 0xfa,
+	0x07, 0xf7, 0xd9, 0xf9, 0x59, 0x41, 0xa0, 0x7f, 0x74, 0x87, 0x4b, 0xe2, 0xca, 0x25, 0x85, 0x2f,
+	0x3f, 0xe6, 0x65, 0x4e, 0xa2, 0x7f, 0x42, 0x93, 0x70, 0x9c, 0x3c, 0x47, 0x41, 0xa0, 0x1f, 0xb8,
+	0x6b, 0x99, 0x15, 0x04, 0x67, 0x7e, 0x7e, 0x4e, 0x8e, 0x5c, 0xfc, 0x85, 0xa9, 0xc2, 0x9f, 0xe0,
+	0xb2, 0x83, 0xc1, 0x55, 0x07, 0x83, 0xf7, 0x1d, 0x4c, 0x7d, 0xe8, 0x60, 0xea, 0x63, 0x07, 0x53,
+	0x9f, 0x3a, 0x98, 0xfa, 0xdc, 0xc1, 0xe0, 0xa5, 0x85, 0xc1, 0x85, 0x85, 0xa9, 0xd7, 0x16, 0x06,
+	0x6f, 0x2c, 0x4c, 0xbd, 0xb5, 0x30, 0xf5, 0xce, 0xc2, 0xd4, 0xa5, 0x85, 0xc1, 0x95, 0x85, 0xc1,
+	0x7b, 0x0b, 0x53, 0x1f, 0x2c, 0x0c, 0x3e, 0x5a, 0x98, 0xfa, 0x64, 0x61, 0xf0, 0xd9, 0xc2, 0xd4,
+	0xcb, 0x2e, 0xa6, 0x2e, 0xba, 0x18, 0xbc, 0xea, 0x62, 0xea, 0xf7, 0x2e, 0x06, 0x7f, 0x74, 0x31,
+	0xf5, 0xba, 0x8b, 0xa9, 0x37, 0x5d, 0x0c, 0xde, 0x76, 0x31, 0x78, 0xd7, 0xc5, 0xe0, 0x70, 0xa3,
+	0x66, 0x30, 0xe6, 0x89, 0x66, 0x9e, 0xe8, 0x8d, 0x5a, 0x9b, 0x69, 0x68, 0xe6, 0x0b, 0xa3, 0x75,
+	0x9a, 0xf3, 0xff, 0x85, 0x6d, 0x9e, 0xd6, 0x72, 0xa6, 0xd9, 0x68, 0x1e, 0x1d, 0xc5, 0xec, 0x3f,
+	0x69, 0x5b, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x83, 0xe1, 0x9d, 0xcc, 0x64, 0x0b, 0x00, 0x00,
 }
@@ -74,6 +74,15 @@ func TestRights(t *testing.T) {
 		a.So(ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_ALL).Implied().GetRights(), should.Contain, ttnpb.RIGHT_GATEWAY_DELETE)
 		a.So(ttnpb.RightsFrom(ttnpb.RIGHT_ORGANIZATION_ALL).Implied().GetRights(), should.Contain, ttnpb.RIGHT_ORGANIZATION_DELETE)
 		a.So(ttnpb.RightsFrom(ttnpb.RIGHT_USER_ALL).Implied().GetRights(), should.Contain, ttnpb.RIGHT_USER_DELETE)
+		a.So(ttnpb.RightsFrom(ttnpb.RIGHT_ALL).Implied().GetRights(), should.NotContain, ttnpb.RIGHT_ALL_READ)
+	})
+	t.Run("AllReadRights", func(t *testing.T) {
+		a := assertions.New(t)
+		a.So(ttnpb.AllReadRights.GetRights(), should.Contain, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ)
+		a.So(ttnpb.AllReadRights.GetRights(), should.Contain, ttnpb.RIGHT_USER_APPLICATIONS_LIST)
+		a.So(ttnpb.AllReadRights.GetRights(), should.NotContain, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE)
+		a.So(ttnpb.AllReadRights.GetRights(), should.NotContain, ttnpb.RIGHT_ALL_READ)
+		a.So(ttnpb.AllClusterRights.GetRights(), should.NotContain, ttnpb.RIGHT_ALL_READ)
 	})
 	t.Run("IncludesAll", func(t *testing.T) {
 		a := assertions.New(t)
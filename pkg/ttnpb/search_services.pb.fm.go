@@ -216,3 +216,174 @@ func (dst *SearchEndDevicesRequest) SetFields(src *SearchEndDevicesRequest, path
 	}
 	return nil
 }
+
+var SearchAPIKeysRequestFieldPathsNested = []string{
+	"limit",
+	"page",
+	"right",
+}
+
+var SearchAPIKeysRequestFieldPathsTopLevel = []string{
+	"limit",
+	"page",
+	"right",
+}
+
+func (dst *SearchAPIKeysRequest) SetFields(src *SearchAPIKeysRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "right":
+			if len(subs) > 0 {
+				return fmt.Errorf("'right' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Right = src.Right
+			} else {
+				var zero Right
+				dst.Right = zero
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var EntityAPIKeyFieldPathsNested = []string{
+	"api_key",
+	"api_key.deleted_at",
+	"api_key.entity_scope",
+	"api_key.expires_at",
+	"api_key.id",
+	"api_key.key",
+	"api_key.name",
+	"api_key.rights",
+	"entity_ids",
+	"entity_ids.ids",
+	"entity_ids.ids.application_ids",
+	"entity_ids.ids.application_ids.application_id",
+	"entity_ids.ids.client_ids",
+	"entity_ids.ids.client_ids.client_id",
+	"entity_ids.ids.device_ids",
+	"entity_ids.ids.device_ids.application_ids",
+	"entity_ids.ids.device_ids.application_ids.application_id",
+	"entity_ids.ids.device_ids.dev_addr",
+	"entity_ids.ids.device_ids.dev_eui",
+	"entity_ids.ids.device_ids.device_id",
+	"entity_ids.ids.device_ids.join_eui",
+	"entity_ids.ids.gateway_ids",
+	"entity_ids.ids.gateway_ids.eui",
+	"entity_ids.ids.gateway_ids.gateway_id",
+	"entity_ids.ids.organization_ids",
+	"entity_ids.ids.organization_ids.organization_id",
+	"entity_ids.ids.user_ids",
+	"entity_ids.ids.user_ids.email",
+	"entity_ids.ids.user_ids.user_id",
+}
+
+var EntityAPIKeyFieldPathsTopLevel = []string{
+	"api_key",
+	"entity_ids",
+}
+
+func (dst *EntityAPIKey) SetFields(src *EntityAPIKey, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "entity_ids":
+			if len(subs) > 0 {
+				newDst := dst.EntityIDs
+				if newDst == nil {
+					newDst = &EntityIdentifiers{}
+					dst.EntityIDs = newDst
+				}
+				var newSrc *EntityIdentifiers
+				if src != nil {
+					newSrc = src.EntityIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EntityIDs = src.EntityIDs
+				} else {
+					dst.EntityIDs = nil
+				}
+			}
+		case "api_key":
+			if len(subs) > 0 {
+				newDst := dst.APIKey
+				if newDst == nil {
+					newDst = &APIKey{}
+					dst.APIKey = newDst
+				}
+				var newSrc *APIKey
+				if src != nil {
+					newSrc = src.APIKey
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.APIKey = src.APIKey
+				} else {
+					dst.APIKey = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var EntityAPIKeysFieldPathsNested = []string{
+	"api_keys",
+}
+
+var EntityAPIKeysFieldPathsTopLevel = []string{
+	"api_keys",
+}
+
+func (dst *EntityAPIKeys) SetFields(src *EntityAPIKeys, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "api_keys":
+			if len(subs) > 0 {
+				return fmt.Errorf("'api_keys' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.APIKeys = src.APIKeys
+			} else {
+				dst.APIKeys = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -53,7 +53,7 @@ type SearchEntitiesRequest struct {
 func (m *SearchEntitiesRequest) Reset()      { *m = SearchEntitiesRequest{} }
 func (*SearchEntitiesRequest) ProtoMessage() {}
 func (*SearchEntitiesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_search_services_8548a8c7795e28db, []int{0}
+	return fileDescriptor_search_services_0696fdd945d30f62, []int{0}
 }
 func (m *SearchEntitiesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -141,7 +141,7 @@ type SearchEndDevicesRequest struct {
 func (m *SearchEndDevicesRequest) Reset()      { *m = SearchEndDevicesRequest{} }
 func (*SearchEndDevicesRequest) ProtoMessage() {}
 func (*SearchEndDevicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_search_services_8548a8c7795e28db, []int{1}
+	return fileDescriptor_search_services_0696fdd945d30f62, []int{1}
 }
 func (m *SearchEndDevicesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -226,6 +226,170 @@ func (m *SearchEndDevicesRequest) GetFieldMask() types.FieldMask {
 	return types.FieldMask{}
 }
 
+type SearchAPIKeysRequest struct {
+	// Find API keys that grant this right, directly or through a right that implies it.
+	Right Right `protobuf:"varint,1,opt,name=right,proto3,enum=ttn.lorawan.v3.Right" json:"right,omitempty"`
+	// Limit the number of results per page.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Page number for pagination. 0 is interpreted as 1.
+	Page                 uint32   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchAPIKeysRequest) Reset()      { *m = SearchAPIKeysRequest{} }
+func (*SearchAPIKeysRequest) ProtoMessage() {}
+func (*SearchAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_search_services_0696fdd945d30f62, []int{2}
+}
+func (m *SearchAPIKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchAPIKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchAPIKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SearchAPIKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchAPIKeysRequest.Merge(dst, src)
+}
+func (m *SearchAPIKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchAPIKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchAPIKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchAPIKeysRequest proto.InternalMessageInfo
+
+func (m *SearchAPIKeysRequest) GetRight() Right {
+	if m != nil {
+		return m.Right
+	}
+	return RIGHT_INVALID
+}
+
+func (m *SearchAPIKeysRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *SearchAPIKeysRequest) GetPage() uint32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+type EntityAPIKey struct {
+	// The entity that the API key belongs to.
+	EntityIDs *EntityIdentifiers `protobuf:"bytes,1,opt,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
+	// The API key. The ID is masked and the secret is not included.
+	APIKey               *APIKey  `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EntityAPIKey) Reset()      { *m = EntityAPIKey{} }
+func (*EntityAPIKey) ProtoMessage() {}
+func (*EntityAPIKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_search_services_0696fdd945d30f62, []int{3}
+}
+func (m *EntityAPIKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntityAPIKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntityAPIKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *EntityAPIKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntityAPIKey.Merge(dst, src)
+}
+func (m *EntityAPIKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntityAPIKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntityAPIKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntityAPIKey proto.InternalMessageInfo
+
+func (m *EntityAPIKey) GetEntityIDs() *EntityIdentifiers {
+	if m != nil {
+		return m.EntityIDs
+	}
+	return nil
+}
+
+func (m *EntityAPIKey) GetAPIKey() *APIKey {
+	if m != nil {
+		return m.APIKey
+	}
+	return nil
+}
+
+type EntityAPIKeys struct {
+	APIKeys              []*EntityAPIKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *EntityAPIKeys) Reset()      { *m = EntityAPIKeys{} }
+func (*EntityAPIKeys) ProtoMessage() {}
+func (*EntityAPIKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_search_services_0696fdd945d30f62, []int{4}
+}
+func (m *EntityAPIKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntityAPIKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntityAPIKeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *EntityAPIKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntityAPIKeys.Merge(dst, src)
+}
+func (m *EntityAPIKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntityAPIKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntityAPIKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntityAPIKeys proto.InternalMessageInfo
+
+func (m *EntityAPIKeys) GetAPIKeys() []*EntityAPIKey {
+	if m != nil {
+		return m.APIKeys
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SearchEntitiesRequest)(nil), "ttn.lorawan.v3.SearchEntitiesRequest")
 	golang_proto.RegisterType((*SearchEntitiesRequest)(nil), "ttn.lorawan.v3.SearchEntitiesRequest")
@@ -235,6 +399,12 @@ func init() {
 	golang_proto.RegisterType((*SearchEndDevicesRequest)(nil), "ttn.lorawan.v3.SearchEndDevicesRequest")
 	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.SearchEndDevicesRequest.AttributesContainEntry")
 	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.SearchEndDevicesRequest.AttributesContainEntry")
+	proto.RegisterType((*SearchAPIKeysRequest)(nil), "ttn.lorawan.v3.SearchAPIKeysRequest")
+	golang_proto.RegisterType((*SearchAPIKeysRequest)(nil), "ttn.lorawan.v3.SearchAPIKeysRequest")
+	proto.RegisterType((*EntityAPIKey)(nil), "ttn.lorawan.v3.EntityAPIKey")
+	golang_proto.RegisterType((*EntityAPIKey)(nil), "ttn.lorawan.v3.EntityAPIKey")
+	proto.RegisterType((*EntityAPIKeys)(nil), "ttn.lorawan.v3.EntityAPIKeys")
+	golang_proto.RegisterType((*EntityAPIKeys)(nil), "ttn.lorawan.v3.EntityAPIKeys")
 }
 func (this *SearchEntitiesRequest) Equal(that interface{}) bool {
 	if that == nil {
@@ -330,6 +500,92 @@ func (this *SearchEndDevicesRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SearchAPIKeysRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SearchAPIKeysRequest)
+	if !ok {
+		that2, ok := that.(SearchAPIKeysRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Right != that1.Right {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.Page != that1.Page {
+		return false
+	}
+	return true
+}
+func (this *EntityAPIKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EntityAPIKey)
+	if !ok {
+		that2, ok := that.(EntityAPIKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EntityIDs.Equal(that1.EntityIDs) {
+		return false
+	}
+	if !this.APIKey.Equal(that1.APIKey) {
+		return false
+	}
+	return true
+}
+func (this *EntityAPIKeys) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EntityAPIKeys)
+	if !ok {
+		that2, ok := that.(EntityAPIKeys)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.APIKeys) != len(that1.APIKeys) {
+		return false
+	}
+	for i := range this.APIKeys {
+		if !this.APIKeys[i].Equal(that1.APIKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -348,6 +604,9 @@ type EntityRegistrySearchClient interface {
 	SearchGateways(ctx context.Context, in *SearchEntitiesRequest, opts ...grpc.CallOption) (*Gateways, error)
 	SearchOrganizations(ctx context.Context, in *SearchEntitiesRequest, opts ...grpc.CallOption) (*Organizations, error)
 	SearchUsers(ctx context.Context, in *SearchEntitiesRequest, opts ...grpc.CallOption) (*Users, error)
+	// SearchAPIKeys finds the API keys of all entities that grant the given right.
+	// This RPC is only available to admins.
+	SearchAPIKeys(ctx context.Context, in *SearchAPIKeysRequest, opts ...grpc.CallOption) (*EntityAPIKeys, error)
 }
 
 type entityRegistrySearchClient struct {
@@ -403,6 +662,15 @@ func (c *entityRegistrySearchClient) SearchUsers(ctx context.Context, in *Search
 	return out, nil
 }
 
+func (c *entityRegistrySearchClient) SearchAPIKeys(ctx context.Context, in *SearchAPIKeysRequest, opts ...grpc.CallOption) (*EntityAPIKeys, error) {
+	out := new(EntityAPIKeys)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.EntityRegistrySearch/SearchAPIKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EntityRegistrySearchServer is the server API for EntityRegistrySearch service.
 type EntityRegistrySearchServer interface {
 	SearchApplications(context.Context, *SearchEntitiesRequest) (*Applications, error)
@@ -410,6 +678,9 @@ type EntityRegistrySearchServer interface {
 	SearchGateways(context.Context, *SearchEntitiesRequest) (*Gateways, error)
 	SearchOrganizations(context.Context, *SearchEntitiesRequest) (*Organizations, error)
 	SearchUsers(context.Context, *SearchEntitiesRequest) (*Users, error)
+	// SearchAPIKeys finds the API keys of all entities that grant the given right.
+	// This RPC is only available to admins.
+	SearchAPIKeys(context.Context, *SearchAPIKeysRequest) (*EntityAPIKeys, error)
 }
 
 func RegisterEntityRegistrySearchServer(s *grpc.Server, srv EntityRegistrySearchServer) {
@@ -506,6 +777,24 @@ func _EntityRegistrySearch_SearchUsers_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EntityRegistrySearch_SearchAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityRegistrySearchServer).SearchAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.EntityRegistrySearch/SearchAPIKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityRegistrySearchServer).SearchAPIKeys(ctx, req.(*SearchAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _EntityRegistrySearch_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.EntityRegistrySearch",
 	HandlerType: (*EntityRegistrySearchServer)(nil),
@@ -530,6 +819,10 @@ var _EntityRegistrySearch_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SearchUsers",
 			Handler:    _EntityRegistrySearch_SearchUsers_Handler,
 		},
+		{
+			MethodName: "SearchAPIKeys",
+			Handler:    _EntityRegistrySearch_SearchAPIKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/search_services.proto",
@@ -747,6 +1040,107 @@ func (m *SearchEndDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *SearchAPIKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchAPIKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Right != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintSearchServices(dAtA, i, uint64(m.Right))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSearchServices(dAtA, i, uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintSearchServices(dAtA, i, uint64(m.Page))
+	}
+	return i, nil
+}
+
+func (m *EntityAPIKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntityAPIKey) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.EntityIDs != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSearchServices(dAtA, i, uint64(m.EntityIDs.Size()))
+		n4, err := m.EntityIDs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.APIKey != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSearchServices(dAtA, i, uint64(m.APIKey.Size()))
+		n5, err := m.APIKey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+
+func (m *EntityAPIKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntityAPIKeys) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.APIKeys) > 0 {
+		for _, msg := range m.APIKeys {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintSearchServices(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func encodeVarintSearchServices(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -799,10 +1193,47 @@ func NewPopulatedSearchEndDevicesRequest(r randySearchServices, easy bool) *Sear
 	return this
 }
 
-type randySearchServices interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
+func NewPopulatedSearchAPIKeysRequest(r randySearchServices, easy bool) *SearchAPIKeysRequest {
+	this := &SearchAPIKeysRequest{}
+	this.Right = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56}[r.Intn(57)])
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEntityAPIKey(r randySearchServices, easy bool) *EntityAPIKey {
+	this := &EntityAPIKey{}
+	if r.Intn(10) != 0 {
+		this.EntityIDs = NewPopulatedEntityIdentifiers(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.APIKey = NewPopulatedAPIKey(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEntityAPIKeys(r randySearchServices, easy bool) *EntityAPIKeys {
+	this := &EntityAPIKeys{}
+	if r.Intn(10) != 0 {
+		v6 := r.Intn(5)
+		this.APIKeys = make([]*EntityAPIKey, v6)
+		for i := 0; i < v6; i++ {
+			this.APIKeys[i] = NewPopulatedEntityAPIKey(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randySearchServices interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
 	Int31() int32
 	Uint32() uint32
 	Intn(n int) int
@@ -818,9 +1249,9 @@ func randUTF8RuneSearchServices(r randySearchServices) rune {
 	return rune(ru + 61)
 }
 func randStringSearchServices(r randySearchServices) string {
-	v6 := r.Intn(100)
-	tmps := make([]rune, v6)
-	for i := 0; i < v6; i++ {
+	v7 := r.Intn(100)
+	tmps := make([]rune, v7)
+	for i := 0; i < v7; i++ {
 		tmps[i] = randUTF8RuneSearchServices(r)
 	}
 	return string(tmps)
@@ -842,11 +1273,11 @@ func randFieldSearchServices(dAtA []byte, r randySearchServices, fieldNumber int
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateSearchServices(dAtA, uint64(key))
-		v7 := r.Int63()
+		v8 := r.Int63()
 		if r.Intn(2) == 0 {
-			v7 *= -1
+			v8 *= -1
 		}
-		dAtA = encodeVarintPopulateSearchServices(dAtA, uint64(v7))
+		dAtA = encodeVarintPopulateSearchServices(dAtA, uint64(v8))
 	case 1:
 		dAtA = encodeVarintPopulateSearchServices(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -947,6 +1378,56 @@ func (m *SearchEndDevicesRequest) Size() (n int) {
 	return n
 }
 
+func (m *SearchAPIKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Right != 0 {
+		n += 1 + sovSearchServices(uint64(m.Right))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovSearchServices(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovSearchServices(uint64(m.Page))
+	}
+	return n
+}
+
+func (m *EntityAPIKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntityIDs != nil {
+		l = m.EntityIDs.Size()
+		n += 1 + l + sovSearchServices(uint64(l))
+	}
+	if m.APIKey != nil {
+		l = m.APIKey.Size()
+		n += 1 + l + sovSearchServices(uint64(l))
+	}
+	return n
+}
+
+func (m *EntityAPIKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.APIKeys) > 0 {
+		for _, e := range m.APIKeys {
+			l = e.Size()
+			n += 1 + l + sovSearchServices(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovSearchServices(x uint64) (n int) {
 	for {
 		n++
@@ -1012,6 +1493,39 @@ func (this *SearchEndDevicesRequest) String() string {
 	}, "")
 	return s
 }
+func (this *SearchAPIKeysRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SearchAPIKeysRequest{`,
+		`Right:` + fmt.Sprintf("%v", this.Right) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EntityAPIKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EntityAPIKey{`,
+		`EntityIDs:` + strings.Replace(fmt.Sprintf("%v", this.EntityIDs), "EntityIdentifiers", "EntityIdentifiers", 1) + `,`,
+		`APIKey:` + strings.Replace(fmt.Sprintf("%v", this.APIKey), "APIKey", "APIKey", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EntityAPIKeys) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EntityAPIKeys{`,
+		`APIKeys:` + strings.Replace(fmt.Sprintf("%v", this.APIKeys), "EntityAPIKey", "EntityAPIKey", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringSearchServices(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1707,6 +2221,310 @@ func (m *SearchEndDevicesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SearchAPIKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSearchServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchAPIKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchAPIKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
+			}
+			m.Right = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Right |= (Right(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSearchServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EntityAPIKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSearchServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntityAPIKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntityAPIKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EntityIDs == nil {
+				m.EntityIDs = &EntityIdentifiers{}
+			}
+			if err := m.EntityIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.APIKey == nil {
+				m.APIKey = &APIKey{}
+			}
+			if err := m.APIKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSearchServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EntityAPIKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSearchServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntityAPIKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntityAPIKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIKeys = append(m.APIKeys, &EntityAPIKey{})
+			if err := m.APIKeys[len(m.APIKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSearchServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSearchServices(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1813,73 +2631,84 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("lorawan-stack/api/search_services.proto", fileDescriptor_search_services_8548a8c7795e28db)
+	proto.RegisterFile("lorawan-stack/api/search_services.proto", fileDescriptor_search_services_0696fdd945d30f62)
 }
 func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/search_services.proto", fileDescriptor_search_services_8548a8c7795e28db)
-}
-
-var fileDescriptor_search_services_8548a8c7795e28db = []byte{
-	// 965 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x3d, 0x6c, 0x23, 0x45,
-	0x14, 0xde, 0x89, 0x9d, 0x90, 0x8c, 0x89, 0xed, 0x4c, 0x92, 0xb3, 0x65, 0xe5, 0xc6, 0x91, 0x0f,
-	0xb8, 0x14, 0xdc, 0x5a, 0xf8, 0x1a, 0x84, 0x0e, 0xa2, 0xe4, 0x6c, 0x50, 0x4e, 0x42, 0x48, 0x46,
-	0x57, 0x40, 0x63, 0x8d, 0xbd, 0x93, 0xf5, 0x60, 0x67, 0xd6, 0xec, 0x8c, 0x1d, 0xf9, 0x10, 0xd2,
-	0x89, 0xea, 0x4a, 0x24, 0x1a, 0x44, 0x81, 0x10, 0xd5, 0x95, 0x27, 0xd1, 0x9c, 0xa8, 0xae, 0x42,
-	0x29, 0x23, 0xd1, 0x5c, 0x65, 0x9d, 0x77, 0x29, 0xae, 0xbc, 0x32, 0x25, 0xda, 0xd9, 0x5d, 0xaf,
-	0xbd, 0x4e, 0x90, 0x53, 0xd1, 0xcd, 0xcf, 0xf7, 0xbe, 0xef, 0xcd, 0xbc, 0x6f, 0xde, 0x2e, 0xbc,
-	0xdd, 0xb5, 0x6c, 0x72, 0x4a, 0xf8, 0x1d, 0x21, 0x49, 0xab, 0x53, 0x26, 0x3d, 0x56, 0x16, 0x94,
-	0xd8, 0xad, 0x76, 0x43, 0x50, 0x7b, 0xc0, 0x5a, 0x54, 0xe8, 0x3d, 0xdb, 0x92, 0x16, 0x4a, 0x4b,
-	0xc9, 0xf5, 0x00, 0xac, 0x0f, 0xee, 0x16, 0xee, 0x98, 0x4c, 0xb6, 0xfb, 0x4d, 0xbd, 0x65, 0x9d,
-	0x94, 0x4d, 0xcb, 0xb4, 0xca, 0x0a, 0xd6, 0xec, 0x1f, 0xab, 0x99, 0x9a, 0xa8, 0x91, 0x1f, 0x5e,
-	0xd8, 0x31, 0x2d, 0xcb, 0xec, 0x52, 0x25, 0x40, 0x38, 0xb7, 0x24, 0x91, 0xcc, 0xe2, 0x01, 0x79,
-	0x61, 0x37, 0xd8, 0x9d, 0x70, 0x1c, 0x33, 0xda, 0x35, 0x1a, 0x27, 0x44, 0x74, 0x02, 0xc4, 0xad,
-	0xf9, 0x3c, 0x49, 0xaf, 0xd7, 0x65, 0x2d, 0xc5, 0x13, 0x80, 0xf0, 0x3c, 0xa8, 0xd5, 0x65, 0x94,
-	0xcb, 0x60, 0xbf, 0x34, 0xbf, 0x4f, 0xb9, 0xd1, 0x30, 0xa8, 0x77, 0xd0, 0x00, 0x53, 0x9c, 0xc7,
-	0x98, 0x44, 0xd2, 0x53, 0x32, 0xbc, 0x3a, 0x13, 0x66, 0x50, 0x2e, 0xd9, 0x31, 0xa3, 0x76, 0x78,
-	0xa0, 0x77, 0xe6, 0x41, 0x96, 0x6d, 0x12, 0xce, 0x1e, 0x4d, 0xe7, 0xbb, 0x33, 0x8f, 0xea, 0x0b,
-	0x6a, 0xfb, 0xbb, 0xa5, 0x5f, 0x12, 0x70, 0xfb, 0x4b, 0x55, 0x8b, 0x1a, 0x97, 0x4c, 0x32, 0x2a,
-	0xea, 0xf4, 0xdb, 0x3e, 0x15, 0x12, 0x95, 0x61, 0x8a, 0x19, 0x8d, 0x96, 0xc5, 0x25, 0x61, 0x5c,
-	0xe4, 0xc1, 0x2e, 0xd8, 0x5b, 0x3b, 0x4c, 0x3b, 0xa3, 0x22, 0x3c, 0xaa, 0xde, 0x0f, 0x56, 0xeb,
-	0x90, 0x19, 0xe1, 0x18, 0xdd, 0x82, 0xeb, 0x9c, 0x9c, 0xd0, 0x28, 0x64, 0xc9, 0x0b, 0xa9, 0xbf,
-	0xed, 0x2d, 0x4e, 0x40, 0x1f, 0xc0, 0x2d, 0x83, 0x8a, 0x96, 0xcd, 0x7a, 0x5e, 0x8a, 0x11, 0x36,
-	0xa1, 0xb0, 0x9b, 0x53, 0x7b, 0x93, 0x90, 0x0e, 0x44, 0x44, 0x4a, 0x9b, 0x35, 0xfb, 0x92, 0x8a,
-	0x30, 0x22, 0x9f, 0xdc, 0x4d, 0xec, 0xa5, 0x2a, 0xf7, 0xf4, 0x59, 0xc7, 0xe8, 0x97, 0x9e, 0x45,
-	0x3f, 0x98, 0xc4, 0x07, 0xac, 0x35, 0x2e, 0xed, 0x61, 0x7d, 0x83, 0xc4, 0xd7, 0xd1, 0x3e, 0x84,
-	0x91, 0x2d, 0xf2, 0x2b, 0xbb, 0x60, 0x2f, 0x55, 0x29, 0xe8, 0xbe, 0x73, 0xf4, 0xd0, 0x39, 0xfa,
-	0xa7, 0x1e, 0xe4, 0x73, 0x22, 0x3a, 0x87, 0xc9, 0xb3, 0x51, 0x51, 0xab, 0xaf, 0x1d, 0x87, 0x0b,
-	0x85, 0x2a, 0xbc, 0x71, 0xb9, 0x1a, 0xca, 0xc2, 0x44, 0x87, 0x0e, 0xfd, 0x8b, 0xac, 0x7b, 0x43,
-	0xb4, 0x05, 0x97, 0x07, 0xa4, 0xdb, 0xa7, 0xc1, 0x4d, 0xf9, 0x93, 0x8f, 0x96, 0x3e, 0x04, 0x0f,
-	0x92, 0xab, 0xcb, 0xd9, 0x95, 0xd2, 0x1f, 0xcb, 0x30, 0x17, 0x1e, 0xc8, 0xa8, 0x2a, 0x03, 0x4d,
-	0xca, 0xf3, 0x15, 0xcc, 0x4c, 0x79, 0xb3, 0xc1, 0x0c, 0xbf, 0x44, 0xa9, 0xca, 0x7b, 0xf1, 0x2b,
-	0x39, 0x88, 0x60, 0x47, 0x91, 0x87, 0x0e, 0x57, 0xbd, 0xcc, 0xcf, 0x47, 0x45, 0x50, 0x4f, 0x93,
-	0x69, 0x84, 0x88, 0x57, 0x7e, 0xe9, 0xfa, 0x95, 0x4f, 0x5c, 0xa3, 0xf2, 0xc9, 0xab, 0x2b, 0x7f,
-	0x72, 0x69, 0xe5, 0x97, 0x55, 0xe5, 0x3f, 0xb9, 0xaa, 0xf2, 0xb1, 0x8b, 0xba, 0x46, 0xed, 0xef,
-	0xc1, 0xac, 0x41, 0x07, 0x0d, 0xda, 0x67, 0x51, 0x76, 0x2b, 0xea, 0xf0, 0xc8, 0x19, 0x15, 0xd3,
-	0x55, 0x3a, 0xa8, 0x3d, 0x3c, 0x9a, 0x5c, 0x40, 0xda, 0xa0, 0x83, 0x5a, 0x9f, 0x4d, 0x92, 0xdd,
-	0x87, 0x1b, 0xdf, 0x58, 0x8c, 0xcf, 0x86, 0xbf, 0xa5, 0xc2, 0x37, 0x9d, 0x51, 0x31, 0xf3, 0xc0,
-	0x62, 0x7c, 0x3a, 0x3e, 0xe3, 0xa1, 0x63, 0x04, 0x9e, 0x3c, 0x31, 0x0c, 0x3b, 0x22, 0x58, 0x8d,
-	0x08, 0xaa, 0x74, 0x70, 0x60, 0x18, 0x76, 0x44, 0x60, 0xcc, 0x2e, 0xc4, 0xbc, 0xbb, 0xf6, 0x3f,
-	0x79, 0xb7, 0xf2, 0x57, 0x12, 0x6e, 0xa9, 0x07, 0x38, 0xac, 0x53, 0x93, 0x09, 0x69, 0x0f, 0xfd,
-	0xd2, 0xa0, 0x53, 0x88, 0xfc, 0xd1, 0x94, 0x23, 0x05, 0x7a, 0x77, 0xa1, 0x27, 0x5c, 0xd8, 0xf9,
-	0x0f, 0x5b, 0x8b, 0xd2, 0xce, 0x0f, 0x7f, 0xff, 0xf3, 0xd3, 0xd2, 0x0d, 0xb4, 0x15, 0x7c, 0x58,
-	0xa6, 0xfb, 0xb6, 0x40, 0x6d, 0xb8, 0xee, 0x93, 0xde, 0x57, 0x8d, 0x7a, 0x61, 0xcd, 0x5c, 0x1c,
-	0x16, 0xc4, 0x97, 0x72, 0x4a, 0x6e, 0x03, 0x65, 0x42, 0xb9, 0x56, 0x40, 0xdc, 0x81, 0x69, 0x9f,
-	0xea, 0x33, 0xbf, 0x9d, 0x2f, 0x2c, 0x95, 0x8f, 0xc3, 0x42, 0x82, 0x52, 0x5e, 0x69, 0x21, 0x94,
-	0x0d, 0xb5, 0xcc, 0x90, 0xfa, 0x11, 0xdc, 0xf4, 0xc9, 0xbe, 0x98, 0xea, 0xfa, 0x0b, 0x2b, 0xde,
-	0x8c, 0xc3, 0x66, 0x58, 0x4a, 0x37, 0x95, 0x6c, 0x0e, 0x6d, 0x87, 0xb2, 0xd6, 0x8c, 0x48, 0x13,
-	0xa6, 0x7c, 0xda, 0x87, 0x82, 0xda, 0x0b, 0x6b, 0x6e, 0xc7, 0x61, 0x2a, 0xba, 0xb4, 0xad, 0xb4,
-	0x32, 0x68, 0x3d, 0xd4, 0xf2, 0x3e, 0x50, 0xa2, 0xf2, 0x27, 0x80, 0xb9, 0xc9, 0x7b, 0x8e, 0x79,
-	0xe9, 0x57, 0x00, 0xb3, 0xf1, 0x17, 0x8f, 0x6e, 0x2f, 0xd8, 0x13, 0x0a, 0x85, 0x38, 0x30, 0x82,
-	0x94, 0x6a, 0x2a, 0x99, 0x7d, 0xf4, 0xf1, 0x65, 0x56, 0x2a, 0x7f, 0x17, 0x6b, 0xba, 0xfa, 0xec,
-	0xfc, 0xfb, 0xb2, 0xff, 0x9d, 0x17, 0x87, 0xbf, 0x83, 0xb3, 0x31, 0x06, 0xe7, 0x63, 0x0c, 0x5e,
-	0x8e, 0xb1, 0xf6, 0x6a, 0x8c, 0xb5, 0xd7, 0x63, 0xac, 0xbd, 0x19, 0x63, 0xed, 0x62, 0x8c, 0xc1,
-	0x63, 0x07, 0x83, 0x27, 0x0e, 0xd6, 0x9e, 0x3a, 0x18, 0x3c, 0x73, 0xb0, 0xf6, 0xdc, 0xc1, 0xda,
-	0x0b, 0x07, 0x6b, 0x67, 0x0e, 0x06, 0xe7, 0x0e, 0x06, 0x2f, 0x1d, 0xac, 0xbd, 0x72, 0x30, 0x78,
-	0xed, 0x60, 0xed, 0x8d, 0x83, 0xc1, 0x85, 0x83, 0xb5, 0xc7, 0x2e, 0xd6, 0x9e, 0xb8, 0x18, 0xfc,
-	0xe8, 0x62, 0xed, 0x67, 0x17, 0x83, 0xdf, 0x5c, 0xac, 0x3d, 0x75, 0xb1, 0xf6, 0xcc, 0xc5, 0xe0,
-	0xb9, 0x8b, 0xc1, 0x0b, 0x17, 0x83, 0xaf, 0xdf, 0x37, 0x2d, 0x5d, 0xb6, 0xa9, 0x6c, 0x33, 0x6e,
-	0x0a, 0x9d, 0x53, 0x79, 0x6a, 0xd9, 0x9d, 0xf2, 0xec, 0x2f, 0x40, 0xaf, 0x63, 0x96, 0xa5, 0xe4,
-	0xbd, 0x66, 0x73, 0x45, 0x75, 0x85, 0xbb, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x10, 0x40, 0xc0,
-	0xa4, 0xa1, 0x09, 0x00, 0x00,
+	golang_proto.RegisterFile("lorawan-stack/api/search_services.proto", fileDescriptor_search_services_0696fdd945d30f62)
+}
+
+var fileDescriptor_search_services_0696fdd945d30f62 = []byte{
+	// 1145 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xde, 0x49, 0x62, 0x27, 0x19, 0xd7, 0x4e, 0x3a, 0xf9, 0x95, 0x95, 0x8e, 0xc3, 0xb6, 0xd0,
+	0x48, 0x90, 0xb5, 0x70, 0x2f, 0x08, 0x0a, 0x51, 0x52, 0x07, 0x94, 0x56, 0xa8, 0x68, 0x51, 0x0e,
+	0x70, 0xb1, 0xc6, 0xde, 0xc9, 0x7a, 0xb0, 0xbd, 0x6b, 0x76, 0xc6, 0x8e, 0x5c, 0x84, 0x54, 0x71,
+	0xea, 0x11, 0x89, 0x0b, 0x42, 0x08, 0x21, 0x4e, 0x3d, 0x56, 0x42, 0x42, 0x15, 0xa7, 0x1e, 0x73,
+	0x8c, 0xc4, 0xa5, 0x27, 0xab, 0x5e, 0x73, 0xe8, 0xb1, 0xc7, 0x1e, 0xd1, 0xce, 0xee, 0xfa, 0x67,
+	0xd7, 0x01, 0xe7, 0xc4, 0x6d, 0x67, 0xe6, 0x7b, 0xdf, 0xf7, 0xe6, 0xbd, 0x6f, 0x66, 0x16, 0xde,
+	0xac, 0xdb, 0x0e, 0x39, 0x25, 0xd6, 0x2e, 0x17, 0xa4, 0x52, 0xcb, 0x93, 0x26, 0xcb, 0x73, 0x4a,
+	0x9c, 0x4a, 0xb5, 0xc4, 0xa9, 0xd3, 0x66, 0x15, 0xca, 0xb5, 0xa6, 0x63, 0x0b, 0x1b, 0x65, 0x84,
+	0xb0, 0xb4, 0x00, 0xac, 0xb5, 0x6f, 0x65, 0x77, 0x4d, 0x26, 0xaa, 0xad, 0xb2, 0x56, 0xb1, 0x1b,
+	0x79, 0xd3, 0x36, 0xed, 0xbc, 0x84, 0x95, 0x5b, 0x27, 0x72, 0x24, 0x07, 0xf2, 0xcb, 0x0f, 0xcf,
+	0x6e, 0x99, 0xb6, 0x6d, 0xd6, 0xa9, 0x14, 0x20, 0x96, 0x65, 0x0b, 0x22, 0x98, 0x6d, 0x05, 0xe4,
+	0xd9, 0xed, 0x60, 0x75, 0xc0, 0x71, 0xc2, 0x68, 0xdd, 0x28, 0x35, 0x08, 0xaf, 0x05, 0x88, 0xeb,
+	0xf1, 0x3c, 0x49, 0xb3, 0x59, 0x67, 0x15, 0xc9, 0x13, 0x80, 0x70, 0x1c, 0x54, 0xa9, 0x33, 0x6a,
+	0x89, 0x60, 0x5d, 0x8d, 0xaf, 0x53, 0xcb, 0x28, 0x19, 0xd4, 0xdb, 0x68, 0x80, 0xc9, 0xc5, 0x31,
+	0x26, 0x11, 0xf4, 0x94, 0x74, 0x2e, 0xce, 0x84, 0x19, 0xd4, 0x12, 0xec, 0x84, 0x51, 0x27, 0xdc,
+	0xd0, 0x8d, 0x38, 0xc8, 0x76, 0x4c, 0x62, 0xb1, 0x07, 0xff, 0x91, 0xaf, 0xc3, 0xcc, 0xaa, 0x08,
+	0x59, 0xb6, 0xe2, 0xeb, 0x2d, 0x4e, 0x1d, 0x7f, 0x55, 0xfd, 0x69, 0x16, 0xae, 0x7d, 0x2e, 0x7b,
+	0x75, 0x68, 0x09, 0x26, 0x18, 0xe5, 0x3a, 0xfd, 0xba, 0x45, 0xb9, 0x40, 0x79, 0x98, 0x62, 0x46,
+	0xa9, 0x62, 0x5b, 0x82, 0x30, 0x8b, 0x6f, 0x82, 0x6d, 0xb0, 0xb3, 0x78, 0x90, 0x71, 0xbb, 0x39,
+	0x78, 0x54, 0xbc, 0x13, 0xcc, 0xea, 0x90, 0x19, 0xe1, 0x37, 0xba, 0x0e, 0xd3, 0x16, 0x69, 0xd0,
+	0x61, 0xc8, 0x8c, 0x17, 0xa2, 0x5f, 0xf1, 0x26, 0x07, 0xa0, 0x77, 0xe1, 0xaa, 0x41, 0x79, 0xc5,
+	0x61, 0x4d, 0x6f, 0x0b, 0x43, 0xec, 0xac, 0xc4, 0xae, 0x8c, 0xac, 0x0d, 0x42, 0x6a, 0x10, 0x11,
+	0x21, 0x1c, 0x56, 0x6e, 0x09, 0xca, 0xc3, 0x88, 0xcd, 0xb9, 0xed, 0xd9, 0x9d, 0x54, 0xe1, 0xb6,
+	0x36, 0xee, 0x28, 0x6d, 0xe2, 0x5e, 0xb4, 0xfd, 0x41, 0x7c, 0xc0, 0x7a, 0x68, 0x09, 0xa7, 0xa3,
+	0x5f, 0x25, 0xd1, 0x79, 0xb4, 0x07, 0xe1, 0xd0, 0x36, 0x9b, 0xc9, 0x6d, 0xb0, 0x93, 0x2a, 0x64,
+	0x35, 0xdf, 0x59, 0x5a, 0xe8, 0x2c, 0xed, 0x63, 0x0f, 0xf2, 0x29, 0xe1, 0xb5, 0x83, 0xb9, 0xb3,
+	0x6e, 0x4e, 0xd1, 0x17, 0x4f, 0xc2, 0x89, 0x6c, 0x11, 0xae, 0x4f, 0x56, 0x43, 0xcb, 0x70, 0xb6,
+	0x46, 0x3b, 0x7e, 0x21, 0x75, 0xef, 0x13, 0xad, 0xc2, 0x44, 0x9b, 0xd4, 0x5b, 0x34, 0xa8, 0x94,
+	0x3f, 0x78, 0x7f, 0xe6, 0x3d, 0x70, 0x77, 0x6e, 0x21, 0xb1, 0x9c, 0x54, 0x7f, 0x4f, 0xc0, 0x8d,
+	0x70, 0x43, 0x46, 0x51, 0x1a, 0x6c, 0xd0, 0x9e, 0x2f, 0xe0, 0xd2, 0x88, 0x77, 0x4b, 0xcc, 0xf0,
+	0x5b, 0x94, 0x2a, 0xbc, 0x15, 0x2d, 0xc9, 0xfe, 0x10, 0x76, 0x34, 0xf4, 0xd8, 0xc1, 0x82, 0x97,
+	0xf9, 0x79, 0x37, 0x07, 0xf4, 0x0c, 0x19, 0x45, 0xf0, 0x68, 0xe7, 0x67, 0x2e, 0xdf, 0xf9, 0xd9,
+	0x4b, 0x74, 0x7e, 0xee, 0xe2, 0xce, 0x37, 0x26, 0x76, 0x3e, 0x21, 0x3b, 0xff, 0xd1, 0x45, 0x9d,
+	0x8f, 0x14, 0xea, 0x12, 0xbd, 0xbf, 0x0d, 0x97, 0x0d, 0xda, 0x2e, 0xd1, 0x16, 0x1b, 0x66, 0x97,
+	0x94, 0x9b, 0x47, 0x6e, 0x37, 0x97, 0x29, 0xd2, 0xf6, 0xe1, 0xf1, 0xd1, 0xa0, 0x00, 0x19, 0x83,
+	0xb6, 0x0f, 0x5b, 0x6c, 0x90, 0xec, 0x1e, 0xbc, 0xfa, 0x95, 0xcd, 0xac, 0xf1, 0xf0, 0x79, 0x19,
+	0xbe, 0xe2, 0x76, 0x73, 0x4b, 0x77, 0x6d, 0x66, 0x8d, 0xc6, 0x2f, 0x79, 0xe8, 0x08, 0x81, 0x27,
+	0x4f, 0x0c, 0xc3, 0x19, 0x12, 0x2c, 0x0c, 0x09, 0x8a, 0xb4, 0xbd, 0x6f, 0x18, 0xce, 0x90, 0xc0,
+	0x18, 0x9f, 0x88, 0x78, 0x77, 0xf1, 0x7f, 0xf2, 0xae, 0xda, 0x80, 0xab, 0x7e, 0x2f, 0xf6, 0x3f,
+	0x3b, 0xba, 0x47, 0x3b, 0x03, 0xc7, 0xbe, 0x0d, 0x13, 0xf2, 0x62, 0x92, 0x2c, 0x99, 0xc2, 0x5a,
+	0xb4, 0x81, 0xba, 0xb7, 0xa8, 0xfb, 0x18, 0x8f, 0xbe, 0xce, 0x1a, 0x4c, 0x48, 0xfa, 0xb4, 0xee,
+	0x0f, 0x10, 0x82, 0x73, 0x4d, 0x62, 0x52, 0xe9, 0xaf, 0xb4, 0x2e, 0xbf, 0xd5, 0x9f, 0x01, 0xbc,
+	0x22, 0xcf, 0x7b, 0xc7, 0xd7, 0x43, 0xf7, 0x21, 0xa4, 0x72, 0x3c, 0x72, 0x28, 0xde, 0x88, 0x8a,
+	0xf9, 0x11, 0xa3, 0xe7, 0x21, 0xed, 0x76, 0x73, 0x8b, 0xc1, 0x74, 0x91, 0xeb, 0x8b, 0x34, 0x40,
+	0x70, 0xf4, 0x01, 0x9c, 0x27, 0x4d, 0x56, 0xf2, 0x0a, 0x30, 0x23, 0xd9, 0xd6, 0x63, 0x47, 0x4c,
+	0x2a, 0x1f, 0x40, 0xb7, 0x9b, 0x4b, 0xfa, 0xdf, 0x7a, 0x92, 0x34, 0xd9, 0x3d, 0xda, 0x51, 0x8f,
+	0x61, 0x7a, 0x34, 0x3b, 0x8e, 0x8a, 0x70, 0x21, 0x60, 0xf3, 0x92, 0xf3, 0xac, 0xbc, 0x35, 0x39,
+	0xb9, 0x80, 0x34, 0xe5, 0x76, 0x73, 0xf3, 0x61, 0x29, 0xe7, 0x7d, 0x56, 0x5e, 0xf8, 0x23, 0x01,
+	0x57, 0x7d, 0x98, 0x4e, 0x4d, 0xc6, 0x85, 0xd3, 0xf1, 0x6b, 0x8e, 0x4e, 0x21, 0x0a, 0xaa, 0x3f,
+	0x3c, 0xd4, 0x1c, 0xbd, 0x39, 0xd5, 0x3d, 0x99, 0xdd, 0xfa, 0x97, 0xbb, 0x83, 0xab, 0x5b, 0xdf,
+	0xfd, 0xf5, 0xf7, 0x0f, 0x33, 0xeb, 0x68, 0x35, 0x78, 0xdd, 0x47, 0x1f, 0x4f, 0x8e, 0xaa, 0x30,
+	0xed, 0x93, 0xde, 0x91, 0xaf, 0xe5, 0xd4, 0x9a, 0x1b, 0x51, 0x58, 0x10, 0xaf, 0x6e, 0x48, 0xb9,
+	0xab, 0x68, 0x29, 0x94, 0xab, 0x04, 0xc4, 0x35, 0x98, 0xf1, 0xa9, 0x3e, 0xf1, 0xdf, 0xd4, 0xa9,
+	0xa5, 0x36, 0xa3, 0xb0, 0x90, 0x40, 0xdd, 0x94, 0x5a, 0x08, 0x2d, 0x87, 0x5a, 0x66, 0x48, 0xfd,
+	0x00, 0xae, 0xf8, 0x64, 0xf7, 0x47, 0x9e, 0xde, 0xa9, 0x15, 0xaf, 0x45, 0x61, 0x63, 0x2c, 0xea,
+	0x35, 0x29, 0xbb, 0x81, 0xd6, 0x42, 0x59, 0x7b, 0x4c, 0xa4, 0x0c, 0x53, 0x3e, 0xed, 0x31, 0xa7,
+	0xce, 0xd4, 0x9a, 0xb1, 0x83, 0x25, 0xa3, 0xd5, 0x35, 0xa9, 0xb5, 0x84, 0xd2, 0xa1, 0x56, 0x4b,
+	0x92, 0x5a, 0x61, 0xdb, 0x42, 0x7f, 0xde, 0x98, 0xac, 0x32, 0x7e, 0x98, 0xe3, 0x1b, 0x1b, 0x33,
+	0x79, 0xbc, 0x9e, 0xa4, 0xc9, 0x76, 0x3d, 0xcb, 0x17, 0xfe, 0x04, 0x70, 0x63, 0x70, 0x49, 0x47,
+	0xbc, 0xfb, 0x0b, 0x80, 0xcb, 0xd1, 0x6b, 0x1c, 0xdd, 0x9c, 0xf2, 0xa2, 0xcf, 0x66, 0xe3, 0x29,
+	0x85, 0x10, 0xf5, 0x50, 0xe6, 0xb3, 0x87, 0x3e, 0x9c, 0x64, 0xdd, 0xfc, 0x37, 0x91, 0x97, 0x54,
+	0x1b, 0x1f, 0x7f, 0x9b, 0xf7, 0x7f, 0xee, 0xf8, 0xc1, 0x6f, 0xe0, 0xac, 0x87, 0xc1, 0x79, 0x0f,
+	0x83, 0xe7, 0x3d, 0xac, 0xbc, 0xe8, 0x61, 0xe5, 0x65, 0x0f, 0x2b, 0xaf, 0x7a, 0x58, 0x79, 0xdd,
+	0xc3, 0xe0, 0xa1, 0x8b, 0xc1, 0x23, 0x17, 0x2b, 0x8f, 0x5d, 0x0c, 0x9e, 0xb8, 0x58, 0x79, 0xea,
+	0x62, 0xe5, 0x99, 0x8b, 0x95, 0x33, 0x17, 0x83, 0x73, 0x17, 0x83, 0xe7, 0x2e, 0x56, 0x5e, 0xb8,
+	0x18, 0xbc, 0x74, 0xb1, 0xf2, 0xca, 0xc5, 0xe0, 0xb5, 0x8b, 0x95, 0x87, 0x7d, 0xac, 0x3c, 0xea,
+	0x63, 0xf0, 0x7d, 0x1f, 0x2b, 0x3f, 0xf6, 0x31, 0xf8, 0xb5, 0x8f, 0x95, 0xc7, 0x7d, 0xac, 0x3c,
+	0xe9, 0x63, 0xf0, 0xb4, 0x8f, 0xc1, 0xb3, 0x3e, 0x06, 0x5f, 0xbe, 0x63, 0xda, 0x9a, 0xa8, 0x52,
+	0x51, 0x65, 0x96, 0xc9, 0x35, 0x8b, 0x8a, 0x53, 0xdb, 0xa9, 0xe5, 0xc7, 0xff, 0xeb, 0x9a, 0x35,
+	0x33, 0x2f, 0x84, 0xd5, 0x2c, 0x97, 0x93, 0xf2, 0xaa, 0xbf, 0xf5, 0x4f, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x8b, 0xd5, 0x40, 0xd0, 0x96, 0x0b, 0x00, 0x00,
 }
@@ -149,6 +149,23 @@ func request_EndDeviceRegistrySearch_SearchEndDevices_0(ctx context.Context, mar
 
 }
 
+var (
+	filter_EntityRegistrySearch_SearchAPIKeys_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+)
+
+func request_EntityRegistrySearch_SearchAPIKeys_0(ctx context.Context, marshaler runtime.Marshaler, client EntityRegistrySearchClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq SearchAPIKeysRequest
+	var metadata runtime.ServerMetadata
+
+	if err := runtime.PopulateQueryParameters(&protoReq, req.URL.Query(), filter_EntityRegistrySearch_SearchAPIKeys_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.SearchAPIKeys(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
 // RegisterEntityRegistrySearchHandlerFromEndpoint is same as RegisterEntityRegistrySearchHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
 func RegisterEntityRegistrySearchHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
@@ -287,6 +304,26 @@ func RegisterEntityRegistrySearchHandlerClient(ctx context.Context, mux *runtime
 
 	})
 
+	mux.Handle("GET", pattern_EntityRegistrySearch_SearchAPIKeys_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_EntityRegistrySearch_SearchAPIKeys_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_EntityRegistrySearch_SearchAPIKeys_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
@@ -300,6 +337,8 @@ var (
 	pattern_EntityRegistrySearch_SearchOrganizations_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"search", "organizations"}, ""))
 
 	pattern_EntityRegistrySearch_SearchUsers_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"search", "users"}, ""))
+
+	pattern_EntityRegistrySearch_SearchAPIKeys_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"search", "api-keys"}, ""))
 )
 
 var (
@@ -312,6 +351,8 @@ var (
 	forward_EntityRegistrySearch_SearchOrganizations_0 = runtime.ForwardResponseMessage
 
 	forward_EntityRegistrySearch_SearchUsers_0 = runtime.ForwardResponseMessage
+
+	forward_EntityRegistrySearch_SearchAPIKeys_0 = runtime.ForwardResponseMessage
 )
 
 // RegisterEndDeviceRegistrySearchHandlerFromEndpoint is same as RegisterEndDeviceRegistrySearchHandler but
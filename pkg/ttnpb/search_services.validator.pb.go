@@ -36,3 +36,29 @@ func (this *SearchEndDevicesRequest) Validate() error {
 	}
 	return nil
 }
+func (this *SearchAPIKeysRequest) Validate() error {
+	return nil
+}
+func (this *EntityAPIKey) Validate() error {
+	if this.EntityIDs != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.EntityIDs); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("EntityIDs", err)
+		}
+	}
+	if this.APIKey != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.APIKey); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("APIKey", err)
+		}
+	}
+	return nil
+}
+func (this *EntityAPIKeys) Validate() error {
+	for _, item := range this.APIKeys {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("APIKeys", err)
+			}
+		}
+	}
+	return nil
+}
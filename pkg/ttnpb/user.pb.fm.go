@@ -684,6 +684,8 @@ func (dst *UpdateUserPasswordRequest) SetFields(src *UpdateUserPasswordRequest,
 }
 
 var CreateUserAPIKeyRequestFieldPathsNested = []string{
+	"dry_run",
+	"entity_scope",
 	"name",
 	"rights",
 	"user_ids",
@@ -692,6 +694,8 @@ var CreateUserAPIKeyRequestFieldPathsNested = []string{
 }
 
 var CreateUserAPIKeyRequestFieldPathsTopLevel = []string{
+	"dry_run",
+	"entity_scope",
 	"name",
 	"rights",
 	"user_ids",
@@ -737,6 +741,25 @@ func (dst *CreateUserAPIKeyRequest) SetFields(src *CreateUserAPIKeyRequest, path
 			} else {
 				dst.Rights = nil
 			}
+		case "dry_run":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dry_run' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DryRun = src.DryRun
+			} else {
+				var zero bool
+				dst.DryRun = zero
+			}
+		case "entity_scope":
+			if len(subs) > 0 {
+				return fmt.Errorf("'entity_scope' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EntityScope = src.EntityScope
+			} else {
+				dst.EntityScope = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -747,6 +770,9 @@ func (dst *CreateUserAPIKeyRequest) SetFields(src *CreateUserAPIKeyRequest, path
 
 var UpdateUserAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.deleted_at",
+	"api_key.entity_scope",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
 	"api_key.name",
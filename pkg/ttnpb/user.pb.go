@@ -71,7 +71,7 @@ type User struct {
 func (m *User) Reset()      { *m = User{} }
 func (*User) ProtoMessage() {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{0}
+	return fileDescriptor_user_6d1494bf7248634c, []int{0}
 }
 func (m *User) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,7 +232,7 @@ type Picture struct {
 func (m *Picture) Reset()      { *m = Picture{} }
 func (*Picture) ProtoMessage() {}
 func (*Picture) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{1}
+	return fileDescriptor_user_6d1494bf7248634c, []int{1}
 }
 func (m *Picture) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -288,7 +288,7 @@ type Picture_Embedded struct {
 func (m *Picture_Embedded) Reset()      { *m = Picture_Embedded{} }
 func (*Picture_Embedded) ProtoMessage() {}
 func (*Picture_Embedded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{1, 0}
+	return fileDescriptor_user_6d1494bf7248634c, []int{1, 0}
 }
 func (m *Picture_Embedded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,7 +340,7 @@ type Users struct {
 func (m *Users) Reset()      { *m = Users{} }
 func (*Users) ProtoMessage() {}
 func (*Users) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{2}
+	return fileDescriptor_user_6d1494bf7248634c, []int{2}
 }
 func (m *Users) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +386,7 @@ type GetUserRequest struct {
 func (m *GetUserRequest) Reset()      { *m = GetUserRequest{} }
 func (*GetUserRequest) ProtoMessage() {}
 func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{3}
+	return fileDescriptor_user_6d1494bf7248634c, []int{3}
 }
 func (m *GetUserRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -432,7 +432,7 @@ type CreateUserRequest struct {
 func (m *CreateUserRequest) Reset()      { *m = CreateUserRequest{} }
 func (*CreateUserRequest) ProtoMessage() {}
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{4}
+	return fileDescriptor_user_6d1494bf7248634c, []int{4}
 }
 func (m *CreateUserRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,7 +478,7 @@ type UpdateUserRequest struct {
 func (m *UpdateUserRequest) Reset()      { *m = UpdateUserRequest{} }
 func (*UpdateUserRequest) ProtoMessage() {}
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{5}
+	return fileDescriptor_user_6d1494bf7248634c, []int{5}
 }
 func (m *UpdateUserRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -523,7 +523,7 @@ type CreateTemporaryPasswordRequest struct {
 func (m *CreateTemporaryPasswordRequest) Reset()      { *m = CreateTemporaryPasswordRequest{} }
 func (*CreateTemporaryPasswordRequest) ProtoMessage() {}
 func (*CreateTemporaryPasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{6}
+	return fileDescriptor_user_6d1494bf7248634c, []int{6}
 }
 func (m *CreateTemporaryPasswordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -563,7 +563,7 @@ type UpdateUserPasswordRequest struct {
 func (m *UpdateUserPasswordRequest) Reset()      { *m = UpdateUserPasswordRequest{} }
 func (*UpdateUserPasswordRequest) ProtoMessage() {}
 func (*UpdateUserPasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{7}
+	return fileDescriptor_user_6d1494bf7248634c, []int{7}
 }
 func (m *UpdateUserPasswordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -607,17 +607,21 @@ func (m *UpdateUserPasswordRequest) GetOld() string {
 }
 
 type CreateUserAPIKeyRequest struct {
-	UserIdentifiers      `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights               []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	Name            string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights          []Right `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	// If set, the rights are validated, but the API key is not created.
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Entities that the API key can be used against.
+	EntityScope          []*EntityIdentifiers `protobuf:"bytes,5,rep,name=entity_scope,json=entityScope,proto3" json:"entity_scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *CreateUserAPIKeyRequest) Reset()      { *m = CreateUserAPIKeyRequest{} }
 func (*CreateUserAPIKeyRequest) ProtoMessage() {}
 func (*CreateUserAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{8}
+	return fileDescriptor_user_6d1494bf7248634c, []int{8}
 }
 func (m *CreateUserAPIKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,6 +664,20 @@ func (m *CreateUserAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateUserAPIKeyRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *CreateUserAPIKeyRequest) GetEntityScope() []*EntityIdentifiers {
+	if m != nil {
+		return m.EntityScope
+	}
+	return nil
+}
+
 type UpdateUserAPIKeyRequest struct {
 	UserIdentifiers      `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
 	APIKey               `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
@@ -670,7 +688,7 @@ type UpdateUserAPIKeyRequest struct {
 func (m *UpdateUserAPIKeyRequest) Reset()      { *m = UpdateUserAPIKeyRequest{} }
 func (*UpdateUserAPIKeyRequest) ProtoMessage() {}
 func (*UpdateUserAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{9}
+	return fileDescriptor_user_6d1494bf7248634c, []int{9}
 }
 func (m *UpdateUserAPIKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -714,7 +732,7 @@ type Invitation struct {
 func (m *Invitation) Reset()      { *m = Invitation{} }
 func (*Invitation) ProtoMessage() {}
 func (*Invitation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{10}
+	return fileDescriptor_user_6d1494bf7248634c, []int{10}
 }
 func (m *Invitation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -801,7 +819,7 @@ type Invitations struct {
 func (m *Invitations) Reset()      { *m = Invitations{} }
 func (*Invitations) ProtoMessage() {}
 func (*Invitations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{11}
+	return fileDescriptor_user_6d1494bf7248634c, []int{11}
 }
 func (m *Invitations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -846,7 +864,7 @@ type SendInvitationRequest struct {
 func (m *SendInvitationRequest) Reset()      { *m = SendInvitationRequest{} }
 func (*SendInvitationRequest) ProtoMessage() {}
 func (*SendInvitationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{12}
+	return fileDescriptor_user_6d1494bf7248634c, []int{12}
 }
 func (m *SendInvitationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +909,7 @@ type DeleteInvitationRequest struct {
 func (m *DeleteInvitationRequest) Reset()      { *m = DeleteInvitationRequest{} }
 func (*DeleteInvitationRequest) ProtoMessage() {}
 func (*DeleteInvitationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{13}
+	return fileDescriptor_user_6d1494bf7248634c, []int{13}
 }
 func (m *DeleteInvitationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -937,7 +955,7 @@ type UserSessionIdentifiers struct {
 func (m *UserSessionIdentifiers) Reset()      { *m = UserSessionIdentifiers{} }
 func (*UserSessionIdentifiers) ProtoMessage() {}
 func (*UserSessionIdentifiers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{14}
+	return fileDescriptor_user_6d1494bf7248634c, []int{14}
 }
 func (m *UserSessionIdentifiers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -986,7 +1004,7 @@ type UserSession struct {
 func (m *UserSession) Reset()      { *m = UserSession{} }
 func (*UserSession) ProtoMessage() {}
 func (*UserSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{15}
+	return fileDescriptor_user_6d1494bf7248634c, []int{15}
 }
 func (m *UserSession) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,7 +1070,7 @@ type UserSessions struct {
 func (m *UserSessions) Reset()      { *m = UserSessions{} }
 func (*UserSessions) ProtoMessage() {}
 func (*UserSessions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{16}
+	return fileDescriptor_user_6d1494bf7248634c, []int{16}
 }
 func (m *UserSessions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1104,7 +1122,7 @@ type ListUserSessionsRequest struct {
 func (m *ListUserSessionsRequest) Reset()      { *m = ListUserSessionsRequest{} }
 func (*ListUserSessionsRequest) ProtoMessage() {}
 func (*ListUserSessionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_user_80523f4a1b6083b3, []int{17}
+	return fileDescriptor_user_6d1494bf7248634c, []int{17}
 }
 func (m *ListUserSessionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,6 +1569,17 @@ func (this *CreateUserAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.DryRun != that1.DryRun {
+		return false
+	}
+	if len(this.EntityScope) != len(that1.EntityScope) {
+		return false
+	}
+	for i := range this.EntityScope {
+		if !this.EntityScope[i].Equal(that1.EntityScope[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *UpdateUserAPIKeyRequest) Equal(that interface{}) bool {
@@ -2326,6 +2355,28 @@ func (m *CreateUserAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintUser(dAtA, i, uint64(j18))
 		i += copy(dAtA[i:], dAtA19[:j18])
 	}
+	if m.DryRun {
+		dAtA[i] = 0x20
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.EntityScope) > 0 {
+		for _, msg := range m.EntityScope {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintUser(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -2839,7 +2890,15 @@ func NewPopulatedCreateUserAPIKeyRequest(r randyUser, easy bool) *CreateUserAPIK
 	v18 := r.Intn(10)
 	this.Rights = make([]Right, v18)
 	for i := 0; i < v18; i++ {
-		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56}[r.Intn(57)])
+	}
+	this.DryRun = bool(r.Intn(2) == 0)
+	if r.Intn(10) != 0 {
+		v19 := r.Intn(5)
+		this.EntityScope = make([]*EntityIdentifiers, v19)
+		for i := 0; i < v19; i++ {
+			this.EntityScope[i] = NewPopulatedEntityIdentifiers(r, easy)
+		}
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -2848,10 +2907,10 @@ func NewPopulatedCreateUserAPIKeyRequest(r randyUser, easy bool) *CreateUserAPIK
 
 func NewPopulatedUpdateUserAPIKeyRequest(r randyUser, easy bool) *UpdateUserAPIKeyRequest {
 	this := &UpdateUserAPIKeyRequest{}
-	v19 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v19
-	v20 := NewPopulatedAPIKey(r, easy)
-	this.APIKey = *v20
+	v20 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v20
+	v21 := NewPopulatedAPIKey(r, easy)
+	this.APIKey = *v21
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2861,12 +2920,12 @@ func NewPopulatedInvitation(r randyUser, easy bool) *Invitation {
 	this := &Invitation{}
 	this.Email = randStringUser(r)
 	this.Token = randStringUser(r)
-	v21 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.ExpiresAt = *v21
 	v22 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v22
+	this.ExpiresAt = *v22
 	v23 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v23
+	this.CreatedAt = *v23
+	v24 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v24
 	if r.Intn(10) != 0 {
 		this.AcceptedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
 	}
@@ -2881,9 +2940,9 @@ func NewPopulatedInvitation(r randyUser, easy bool) *Invitation {
 func NewPopulatedInvitations(r randyUser, easy bool) *Invitations {
 	this := &Invitations{}
 	if r.Intn(10) != 0 {
-		v24 := r.Intn(5)
-		this.Invitations = make([]*Invitation, v24)
-		for i := 0; i < v24; i++ {
+		v25 := r.Intn(5)
+		this.Invitations = make([]*Invitation, v25)
+		for i := 0; i < v25; i++ {
 			this.Invitations[i] = NewPopulatedInvitation(r, easy)
 		}
 	}
@@ -2910,8 +2969,8 @@ func NewPopulatedDeleteInvitationRequest(r randyUser, easy bool) *DeleteInvitati
 
 func NewPopulatedUserSessionIdentifiers(r randyUser, easy bool) *UserSessionIdentifiers {
 	this := &UserSessionIdentifiers{}
-	v25 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v25
+	v26 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v26
 	this.SessionID = randStringUser(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -2920,13 +2979,13 @@ func NewPopulatedUserSessionIdentifiers(r randyUser, easy bool) *UserSessionIden
 
 func NewPopulatedUserSession(r randyUser, easy bool) *UserSession {
 	this := &UserSession{}
-	v26 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v26
+	v27 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v27
 	this.SessionID = randStringUser(r)
-	v27 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v27
 	v28 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v28
+	this.CreatedAt = *v28
+	v29 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v29
 	if r.Intn(10) != 0 {
 		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
 	}
@@ -2938,9 +2997,9 @@ func NewPopulatedUserSession(r randyUser, easy bool) *UserSession {
 func NewPopulatedUserSessions(r randyUser, easy bool) *UserSessions {
 	this := &UserSessions{}
 	if r.Intn(10) != 0 {
-		v29 := r.Intn(5)
-		this.Sessions = make([]*UserSession, v29)
-		for i := 0; i < v29; i++ {
+		v30 := r.Intn(5)
+		this.Sessions = make([]*UserSession, v30)
+		for i := 0; i < v30; i++ {
 			this.Sessions[i] = NewPopulatedUserSession(r, easy)
 		}
 	}
@@ -2951,8 +3010,8 @@ func NewPopulatedUserSessions(r randyUser, easy bool) *UserSessions {
 
 func NewPopulatedListUserSessionsRequest(r randyUser, easy bool) *ListUserSessionsRequest {
 	this := &ListUserSessionsRequest{}
-	v30 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v30
+	v31 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v31
 	this.Order = randStringUser(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
@@ -2980,9 +3039,9 @@ func randUTF8RuneUser(r randyUser) rune {
 	return rune(ru + 61)
 }
 func randStringUser(r randyUser) string {
-	v31 := r.Intn(100)
-	tmps := make([]rune, v31)
-	for i := 0; i < v31; i++ {
+	v32 := r.Intn(100)
+	tmps := make([]rune, v32)
+	for i := 0; i < v32; i++ {
 		tmps[i] = randUTF8RuneUser(r)
 	}
 	return string(tmps)
@@ -3004,11 +3063,11 @@ func randFieldUser(dAtA []byte, r randyUser, fieldNumber int, wire int) []byte {
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateUser(dAtA, uint64(key))
-		v32 := r.Int63()
+		v33 := r.Int63()
 		if r.Intn(2) == 0 {
-			v32 *= -1
+			v33 *= -1
 		}
-		dAtA = encodeVarintPopulateUser(dAtA, uint64(v32))
+		dAtA = encodeVarintPopulateUser(dAtA, uint64(v33))
 	case 1:
 		dAtA = encodeVarintPopulateUser(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3252,6 +3311,15 @@ func (m *CreateUserAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovUser(uint64(l)) + l
 	}
+	if m.DryRun {
+		n += 2
+	}
+	if len(m.EntityScope) > 0 {
+		for _, e := range m.EntityScope {
+			l = e.Size()
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3569,6 +3637,8 @@ func (this *CreateUserAPIKeyRequest) String() string {
 		`UserIdentifiers:` + strings.Replace(strings.Replace(this.UserIdentifiers.String(), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`EntityScope:` + strings.Replace(fmt.Sprintf("%v", this.EntityScope), "EntityIdentifiers", "EntityIdentifiers", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5423,6 +5493,57 @@ func (m *CreateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityScope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityScope = append(m.EntityScope, &EntityIdentifiers{})
+			if err := m.EntityScope[len(m.EntityScope)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -6701,101 +6822,104 @@ var (
 	ErrIntOverflowUser   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("lorawan-stack/api/user.proto", fileDescriptor_user_80523f4a1b6083b3) }
+func init() { proto.RegisterFile("lorawan-stack/api/user.proto", fileDescriptor_user_6d1494bf7248634c) }
 func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/user.proto", fileDescriptor_user_80523f4a1b6083b3)
+	golang_proto.RegisterFile("lorawan-stack/api/user.proto", fileDescriptor_user_6d1494bf7248634c)
 }
 
-var fileDescriptor_user_80523f4a1b6083b3 = []byte{
-	// 1435 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_user_6d1494bf7248634c = []byte{
+	// 1485 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x3f, 0x70, 0x13, 0xc7,
-	0x1a, 0xbf, 0xb5, 0x24, 0x5b, 0xfa, 0xe4, 0x3f, 0xf8, 0xc0, 0x58, 0x4f, 0x86, 0xb5, 0xe6, 0x1e,
-	0x85, 0xdf, 0x7b, 0x58, 0x9a, 0x31, 0xf3, 0x80, 0x04, 0x12, 0x22, 0x63, 0x87, 0xf1, 0x90, 0xcc,
-	0x30, 0x67, 0x93, 0xc9, 0xa4, 0xd1, 0x9c, 0x75, 0x2b, 0x79, 0x47, 0xba, 0x3f, 0xdc, 0xae, 0xec,
-	0x28, 0x15, 0x4d, 0x66, 0x28, 0x28, 0xe8, 0x92, 0xa1, 0x49, 0x86, 0x8a, 0x92, 0x92, 0x92, 0x92,
-	0x22, 0x05, 0x25, 0x15, 0xe0, 0x53, 0x43, 0x49, 0x49, 0x99, 0xd9, 0xbd, 0x3b, 0xdd, 0x59, 0x96,
-	0x07, 0x1b, 0xcc, 0xa4, 0xdb, 0xdd, 0xef, 0xf7, 0xfd, 0xbe, 0xbf, 0xfb, 0xed, 0x1d, 0x9c, 0x69,
-	0x3b, 0x9e, 0xb1, 0x63, 0xd8, 0x8b, 0x8c, 0x1b, 0xf5, 0x56, 0xc5, 0x70, 0x69, 0xa5, 0xc3, 0x88,
-	0x57, 0x76, 0x3d, 0x87, 0x3b, 0xea, 0x24, 0xe7, 0x76, 0x39, 0x44, 0x94, 0xb7, 0x2f, 0x14, 0x17,
-	0x9b, 0x94, 0x6f, 0x75, 0x36, 0xcb, 0x75, 0xc7, 0xaa, 0x34, 0x9d, 0xa6, 0x53, 0x91, 0xb0, 0xcd,
-	0x4e, 0x43, 0xee, 0xe4, 0x46, 0xae, 0x02, 0xf5, 0xe2, 0xc5, 0x04, 0xdc, 0xda, 0xa1, 0xbc, 0xe5,
-	0xec, 0x54, 0x9a, 0xce, 0xa2, 0x14, 0x2e, 0x6e, 0x1b, 0x6d, 0x6a, 0x1a, 0xdc, 0xf1, 0x58, 0xa5,
-	0xbf, 0x0c, 0xf5, 0xe6, 0x9a, 0x8e, 0xd3, 0x6c, 0x93, 0x98, 0x9d, 0x58, 0x2e, 0xef, 0x86, 0xc2,
-	0xd2, 0xa0, 0xb0, 0x41, 0x49, 0xdb, 0xac, 0x59, 0x06, 0x6b, 0x85, 0x88, 0xf9, 0x41, 0x04, 0xa7,
-	0x16, 0x61, 0xdc, 0xb0, 0xdc, 0x10, 0x80, 0xf7, 0x07, 0x5d, 0x6f, 0x53, 0x62, 0xf3, 0x50, 0x7e,
-	0x6e, 0x88, 0xdc, 0xb1, 0xb9, 0x51, 0xe7, 0x35, 0x6a, 0x37, 0xa2, 0xe8, 0xce, 0xee, 0x47, 0x11,
-	0xbb, 0x63, 0xb1, 0x50, 0xfc, 0xef, 0xfd, 0x62, 0x6a, 0x12, 0x9b, 0xd3, 0x06, 0x25, 0x1e, 0x3b,
-	0xd8, 0x13, 0x8f, 0x36, 0xb7, 0x78, 0x28, 0xd7, 0x1e, 0xe6, 0x20, 0x7d, 0x9b, 0x11, 0x4f, 0xbd,
-	0x02, 0x29, 0x6a, 0xb2, 0x02, 0x2a, 0xa1, 0x85, 0xfc, 0xd2, 0x7c, 0x79, 0x6f, 0x5d, 0xca, 0x02,
-	0xb2, 0x16, 0x93, 0x2f, 0x67, 0x9f, 0xbf, 0x9a, 0x57, 0x5e, 0xbc, 0x9a, 0x47, 0xba, 0xd0, 0x52,
-	0xaf, 0x03, 0xd4, 0x3d, 0x62, 0x70, 0x62, 0xd6, 0x0c, 0x5e, 0x18, 0x91, 0x1c, 0xc5, 0x72, 0x90,
-	0xa5, 0x72, 0x94, 0xa5, 0xf2, 0x46, 0x94, 0xa5, 0x40, 0xfd, 0xc1, 0xeb, 0x79, 0xa4, 0xe7, 0x42,
-	0xbd, 0x2a, 0x17, 0x24, 0x1d, 0xd7, 0x8c, 0x48, 0x52, 0x47, 0x21, 0x09, 0xf5, 0xaa, 0x5c, 0x55,
-	0x21, 0x6d, 0x1b, 0x16, 0x29, 0xa4, 0x4b, 0x68, 0x21, 0xa7, 0xcb, 0xb5, 0x5a, 0x82, 0xbc, 0x49,
-	0x58, 0xdd, 0xa3, 0x2e, 0xa7, 0x8e, 0x5d, 0xc8, 0x48, 0x51, 0xf2, 0x48, 0x5d, 0x01, 0x30, 0x38,
-	0xf7, 0xe8, 0x66, 0x87, 0x13, 0x56, 0x18, 0x2d, 0xa5, 0x16, 0xf2, 0x4b, 0xe7, 0x86, 0xe5, 0xa0,
-	0x5c, 0xed, 0xc3, 0x56, 0x6d, 0xee, 0x75, 0xf5, 0x84, 0x9e, 0xfa, 0x35, 0x8c, 0x27, 0xab, 0x58,
-	0x18, 0x93, 0x3c, 0x73, 0x83, 0x3c, 0xd7, 0x03, 0xcc, 0x9a, 0xdd, 0x70, 0xf4, 0x7c, 0x3d, 0xde,
-	0xa8, 0x4b, 0x30, 0xe3, 0x7a, 0xd4, 0x32, 0xbc, 0x6e, 0x8d, 0x58, 0x06, 0x6d, 0xd7, 0x0c, 0xd3,
-	0xf4, 0x08, 0x63, 0x85, 0xac, 0xf4, 0xf8, 0x64, 0x28, 0x5c, 0x15, 0xb2, 0x6a, 0x20, 0x52, 0xdb,
-	0xa0, 0x0d, 0xd5, 0xa9, 0x85, 0x2d, 0x1f, 0x24, 0x33, 0xf7, 0xc1, 0x64, 0xa6, 0x65, 0x22, 0xf1,
-	0x10, 0x13, 0x3f, 0x44, 0x44, 0x55, 0xae, 0x16, 0x21, 0xeb, 0x1a, 0x8c, 0xed, 0x38, 0x9e, 0x59,
-	0x00, 0xe9, 0x54, 0x7f, 0xaf, 0x6e, 0xc0, 0xc9, 0x68, 0x5d, 0x4b, 0xd4, 0x31, 0x7f, 0x84, 0x3a,
-	0x4e, 0x47, 0x04, 0xb7, 0xfb, 0xf5, 0xbc, 0x08, 0xb3, 0x1e, 0xb9, 0xd3, 0xa1, 0x1e, 0xa9, 0x0d,
-	0xb0, 0x17, 0xc6, 0x4b, 0x68, 0x21, 0xab, 0xcf, 0x84, 0xe2, 0x5b, 0x7b, 0x54, 0xd5, 0xff, 0x41,
-	0x86, 0x71, 0x81, 0x9a, 0x28, 0xa1, 0x85, 0xc9, 0xa5, 0x99, 0xc1, 0x22, 0xac, 0x0b, 0xa1, 0x1e,
-	0x60, 0xd4, 0x53, 0x90, 0x31, 0x4c, 0x8b, 0xda, 0x85, 0x49, 0x49, 0x19, 0x6c, 0xd4, 0x45, 0x50,
-	0x39, 0xb1, 0x5c, 0xc7, 0x13, 0xc9, 0xed, 0x87, 0x3d, 0x25, 0xc3, 0x9e, 0xee, 0x4b, 0x22, 0xbb,
-	0x6a, 0x13, 0xce, 0xee, 0x87, 0xd7, 0x12, 0xd7, 0xe2, 0xc4, 0xa1, 0x32, 0x81, 0x64, 0x26, 0x8a,
-	0xfb, 0xf8, 0xaf, 0xf7, 0xef, 0xc9, 0x70, 0x43, 0xe4, 0x67, 0x97, 0x7a, 0x84, 0x09, 0x43, 0xd3,
-	0x9f, 0x64, 0x68, 0x35, 0x20, 0xaa, 0x72, 0xf5, 0x1b, 0x98, 0x72, 0x3d, 0xa7, 0x41, 0xdb, 0xa4,
-	0xe6, 0xd2, 0x3a, 0xef, 0x78, 0xa4, 0xa0, 0x4a, 0xea, 0xd9, 0xc1, 0x6c, 0xde, 0x0a, 0xc4, 0xfa,
-	0x64, 0x88, 0x0f, 0xf7, 0xc5, 0xaf, 0x60, 0x6a, 0xe0, 0xc2, 0xa8, 0x27, 0x20, 0xd5, 0x22, 0x5d,
-	0x39, 0x67, 0x72, 0xba, 0x58, 0x8a, 0xec, 0x6f, 0x1b, 0xed, 0x0e, 0x91, 0x73, 0x23, 0xa7, 0x07,
-	0x9b, 0x2f, 0x47, 0x2e, 0x23, 0xed, 0x3d, 0x82, 0xb1, 0x90, 0x4a, 0xbd, 0x0a, 0x59, 0x62, 0x6d,
-	0x12, 0xd3, 0x24, 0x66, 0x38, 0xa4, 0x4a, 0x07, 0x78, 0x51, 0x5e, 0x0d, 0x71, 0x7a, 0x5f, 0x43,
-	0xbd, 0x0c, 0x19, 0x46, 0x7f, 0x21, 0xac, 0x30, 0x22, 0xef, 0xa4, 0x76, 0x90, 0xea, 0xba, 0x00,
-	0x05, 0x37, 0x3b, 0x50, 0x28, 0x5e, 0x81, 0x6c, 0xc4, 0xa7, 0xce, 0x41, 0xce, 0xa2, 0x16, 0xa9,
-	0xf1, 0xae, 0x4b, 0xc2, 0x08, 0xb2, 0xe2, 0x60, 0xa3, 0xeb, 0x12, 0x31, 0x79, 0x4c, 0x83, 0x1b,
-	0x32, 0x8a, 0x71, 0x5d, 0xae, 0x8b, 0x97, 0x01, 0x62, 0xc6, 0x64, 0xe8, 0x13, 0x1f, 0x0a, 0xfd,
-	0x02, 0x64, 0xc4, 0xbc, 0x61, 0xea, 0x7f, 0x21, 0x23, 0xde, 0x4b, 0x31, 0x99, 0x85, 0xe7, 0xa7,
-	0x86, 0x4d, 0x25, 0x3d, 0x80, 0x68, 0xbf, 0x21, 0x98, 0xbc, 0x41, 0xb8, 0x3c, 0x22, 0x77, 0x3a,
-	0x84, 0x71, 0x75, 0x05, 0xb2, 0x42, 0x56, 0xfb, 0xa8, 0xd9, 0x3e, 0xd6, 0x91, 0x22, 0xa6, 0x5e,
-	0x03, 0x88, 0x1f, 0xc1, 0x03, 0xe7, 0xfb, 0xb7, 0x02, 0xf2, 0xbd, 0xc1, 0x5a, 0xcb, 0x69, 0x41,
-	0xa1, 0xe7, 0x1a, 0xd1, 0x81, 0xe6, 0xc1, 0x74, 0xd0, 0xc0, 0x49, 0xdf, 0x96, 0x20, 0x2d, 0x0c,
-	0x84, 0x7e, 0x0d, 0x8d, 0x2c, 0xe1, 0x8c, 0xc4, 0xaa, 0xff, 0x81, 0x13, 0xd4, 0xde, 0xa6, 0xdc,
-	0x10, 0x73, 0xbb, 0xc6, 0x9d, 0x16, 0xb1, 0xc3, 0xe4, 0x4d, 0xc5, 0xe7, 0x1b, 0xe2, 0x58, 0xbb,
-	0x87, 0x60, 0x3a, 0x98, 0x06, 0x9f, 0x6a, 0xf4, 0x93, 0xc3, 0x6f, 0x00, 0x0e, 0xc2, 0xdf, 0x18,
-	0xbc, 0x6d, 0xc7, 0x5a, 0x27, 0xed, 0x57, 0x04, 0xff, 0x8a, 0x43, 0xfe, 0x2c, 0x36, 0x44, 0x17,
-	0xdb, 0x64, 0x27, 0x4c, 0xba, 0x58, 0x8a, 0x13, 0xa7, 0x6d, 0xca, 0x17, 0x3b, 0xa7, 0x8b, 0xa5,
-	0xf6, 0x08, 0xc1, 0x6c, 0x5c, 0xef, 0xea, 0xad, 0xb5, 0x9b, 0xa4, 0x7b, 0xbc, 0x5e, 0x44, 0xef,
-	0xfc, 0x48, 0xe2, 0x9d, 0x5f, 0x84, 0xd1, 0xe0, 0xdb, 0xa6, 0x90, 0x2a, 0xa5, 0x86, 0x0d, 0x7d,
-	0x5d, 0x48, 0xf5, 0x10, 0xa4, 0x3d, 0x44, 0x30, 0x1b, 0x27, 0xeb, 0x73, 0x38, 0xf9, 0x05, 0x8c,
-	0x19, 0x2e, 0xad, 0x89, 0x4b, 0x1f, 0x34, 0xcd, 0xe9, 0x41, 0x92, 0xc0, 0x6a, 0x42, 0x77, 0xd4,
-	0x70, 0xe9, 0x4d, 0xd2, 0xd5, 0xee, 0xa7, 0x00, 0xd6, 0xfa, 0x0d, 0x2d, 0x06, 0x85, 0x7c, 0xde,
-	0xc3, 0xa9, 0x13, 0x6c, 0xc4, 0x69, 0xf2, 0x06, 0x04, 0x1b, 0xf1, 0x1d, 0x95, 0x78, 0x0c, 0x8e,
-	0xf4, 0x1d, 0x45, 0xfa, 0xb3, 0x7f, 0xef, 0x17, 0x5d, 0xfa, 0x38, 0xbe, 0xe8, 0x32, 0x1f, 0xf7,
-	0x45, 0x57, 0x85, 0xbc, 0x51, 0xaf, 0x13, 0x37, 0x64, 0x19, 0x3d, 0xe4, 0xa7, 0x0c, 0x44, 0x4a,
-	0xf2, 0x21, 0x8b, 0x29, 0x36, 0xbb, 0x85, 0xb1, 0x43, 0x15, 0x34, 0x66, 0x58, 0xee, 0x6a, 0x37,
-	0x21, 0x1f, 0x57, 0x83, 0xa9, 0x57, 0x21, 0x1f, 0x4f, 0x9b, 0x68, 0x34, 0x17, 0x07, 0x09, 0x63,
-	0x0d, 0x3d, 0x09, 0xd7, 0xfe, 0x0f, 0x33, 0xeb, 0xc4, 0x36, 0x13, 0xe2, 0xb0, 0xeb, 0xce, 0xec,
-	0xa9, 0xf2, 0xf2, 0xa8, 0xff, 0x7a, 0x7e, 0xe4, 0x47, 0x14, 0x56, 0x5b, 0xbb, 0x04, 0xb3, 0x2b,
-	0xa4, 0x4d, 0x38, 0x39, 0xaa, 0xe2, 0x7d, 0x04, 0xa7, 0x45, 0x70, 0xeb, 0x84, 0x31, 0xea, 0xd8,
-	0x89, 0x18, 0x8f, 0xa9, 0xcf, 0xcf, 0x03, 0xb0, 0x80, 0xbb, 0x46, 0xcd, 0xa0, 0x19, 0x97, 0x27,
-	0xfc, 0x57, 0xf3, 0xb9, 0xc8, 0xe2, 0x8a, 0x9e, 0x63, 0x91, 0x71, 0xed, 0xaf, 0x11, 0xc8, 0x27,
-	0xdc, 0xf9, 0x27, 0x7c, 0x18, 0x68, 0xef, 0xd4, 0x71, 0xb4, 0x77, 0xfa, 0xe3, 0xda, 0xfb, 0xda,
-	0x9e, 0xdb, 0x9a, 0x39, 0x64, 0x77, 0xc7, 0x37, 0x55, 0xbb, 0x01, 0xe3, 0x89, 0x6c, 0x32, 0xf5,
-	0x12, 0x64, 0xc3, 0x38, 0xa3, 0xc6, 0x9c, 0x1b, 0x96, 0xce, 0x10, 0xaf, 0xf7, 0xc1, 0xda, 0x1f,
-	0x08, 0x66, 0xbf, 0xa3, 0x8c, 0x27, 0xd9, 0x8e, 0x77, 0x1e, 0x9e, 0x82, 0x8c, 0xe3, 0x99, 0xc4,
-	0x8b, 0xe6, 0x95, 0xdc, 0x88, 0xd3, 0x36, 0xb5, 0x68, 0x50, 0x86, 0x09, 0x3d, 0xd8, 0x88, 0x01,
-	0xef, 0x1a, 0xcd, 0xe0, 0x47, 0x6e, 0x42, 0x97, 0xeb, 0xe5, 0x47, 0xe8, 0xf9, 0x2e, 0x46, 0x2f,
-	0x76, 0x31, 0x7a, 0xb9, 0x8b, 0x95, 0x37, 0xbb, 0x58, 0x79, 0xbb, 0x8b, 0x95, 0x77, 0xbb, 0x58,
-	0x79, 0xbf, 0x8b, 0xd1, 0x5d, 0x1f, 0xa3, 0x7b, 0x3e, 0x56, 0x1e, 0xfb, 0x18, 0x3d, 0xf1, 0xb1,
-	0xf2, 0xd4, 0xc7, 0xca, 0x33, 0x1f, 0x2b, 0xcf, 0x7d, 0x8c, 0x5e, 0xf8, 0x18, 0xbd, 0xf4, 0xb1,
-	0xf2, 0xc6, 0xc7, 0xe8, 0xad, 0x8f, 0x95, 0x77, 0x3e, 0x46, 0xef, 0x7d, 0xac, 0xdc, 0xed, 0x61,
-	0xe5, 0x5e, 0x0f, 0xa3, 0x07, 0x3d, 0xac, 0xfc, 0xde, 0xc3, 0xe8, 0xcf, 0x1e, 0x56, 0x1e, 0xf7,
-	0xb0, 0xf2, 0xa4, 0x87, 0xd1, 0xd3, 0x1e, 0x46, 0xcf, 0x7a, 0x18, 0xfd, 0x74, 0xbe, 0xe9, 0x94,
-	0xf9, 0x16, 0xe1, 0x5b, 0xd4, 0x6e, 0xb2, 0xb2, 0x4d, 0xf8, 0x8e, 0xe3, 0xb5, 0x2a, 0x7b, 0x7f,
-	0xac, 0xdd, 0x56, 0xb3, 0xc2, 0xb9, 0xed, 0x6e, 0x6e, 0x8e, 0xca, 0xa2, 0x5d, 0xf8, 0x3b, 0x00,
-	0x00, 0xff, 0xff, 0x66, 0x05, 0xca, 0x8f, 0xf9, 0x10, 0x00, 0x00,
+	0x1a, 0xbf, 0xb5, 0x24, 0x5b, 0xfa, 0xe4, 0x3f, 0xf8, 0xc0, 0x58, 0x4f, 0x86, 0xb5, 0xde, 0x3d,
+	0x0a, 0xbf, 0xf7, 0xb0, 0x34, 0x63, 0xe6, 0x01, 0x2f, 0x90, 0x10, 0x19, 0x3b, 0x8c, 0x87, 0x64,
+	0x86, 0x39, 0x9b, 0x4c, 0x26, 0xcd, 0xcd, 0x59, 0xb7, 0x92, 0x77, 0xa4, 0xfb, 0xc3, 0xed, 0xca,
+	0x8e, 0x52, 0x91, 0x22, 0x33, 0x14, 0x14, 0x74, 0xc9, 0xd0, 0x24, 0x93, 0x8a, 0x92, 0x92, 0x92,
+	0x92, 0x22, 0x05, 0x25, 0x15, 0x60, 0xa9, 0xa1, 0xa4, 0xa4, 0xcc, 0xec, 0xde, 0x9d, 0xee, 0x2c,
+	0xcb, 0x83, 0x0d, 0x66, 0xd2, 0xdd, 0xee, 0xf7, 0xfb, 0x7e, 0xdf, 0xdf, 0xfd, 0x76, 0x0f, 0xce,
+	0xb4, 0x5c, 0xdf, 0xdc, 0x31, 0x9d, 0x45, 0xc6, 0xcd, 0x5a, 0xb3, 0x62, 0x7a, 0xb4, 0xd2, 0x66,
+	0xc4, 0x2f, 0x7b, 0xbe, 0xcb, 0x5d, 0x75, 0x92, 0x73, 0xa7, 0x1c, 0x22, 0xca, 0xdb, 0x17, 0x8a,
+	0x8b, 0x0d, 0xca, 0xb7, 0xda, 0x9b, 0xe5, 0x9a, 0x6b, 0x57, 0x1a, 0x6e, 0xc3, 0xad, 0x48, 0xd8,
+	0x66, 0xbb, 0x2e, 0x57, 0x72, 0x21, 0xbf, 0x02, 0xf5, 0xe2, 0xc5, 0x04, 0xdc, 0xde, 0xa1, 0xbc,
+	0xe9, 0xee, 0x54, 0x1a, 0xee, 0xa2, 0x14, 0x2e, 0x6e, 0x9b, 0x2d, 0x6a, 0x99, 0xdc, 0xf5, 0x59,
+	0xa5, 0xff, 0x19, 0xea, 0xcd, 0x35, 0x5c, 0xb7, 0xd1, 0x22, 0x31, 0x3b, 0xb1, 0x3d, 0xde, 0x09,
+	0x85, 0xa5, 0x41, 0x61, 0x9d, 0x92, 0x96, 0x65, 0xd8, 0x26, 0x6b, 0x86, 0x88, 0xf9, 0x41, 0x04,
+	0xa7, 0x36, 0x61, 0xdc, 0xb4, 0xbd, 0x10, 0x80, 0xf7, 0x07, 0x5d, 0x6b, 0x51, 0xe2, 0xf0, 0x50,
+	0x7e, 0x6e, 0x88, 0xdc, 0x75, 0xb8, 0x59, 0xe3, 0x06, 0x75, 0xea, 0x51, 0x74, 0x67, 0xf7, 0xa3,
+	0x88, 0xd3, 0xb6, 0x59, 0x28, 0xfe, 0xd7, 0x7e, 0x31, 0xb5, 0x88, 0xc3, 0x69, 0x9d, 0x12, 0x9f,
+	0x1d, 0xec, 0x89, 0x4f, 0x1b, 0x5b, 0x3c, 0x94, 0x6b, 0x0f, 0x73, 0x90, 0xbe, 0xcd, 0x88, 0xaf,
+	0x5e, 0x81, 0x14, 0xb5, 0x58, 0x01, 0x95, 0xd0, 0x42, 0x7e, 0x69, 0xbe, 0xbc, 0xb7, 0x2e, 0x65,
+	0x01, 0x59, 0x8b, 0xc9, 0x97, 0xb3, 0xcf, 0x5e, 0xce, 0x2b, 0xcf, 0x5f, 0xce, 0x23, 0x5d, 0x68,
+	0xa9, 0xd7, 0x01, 0x6a, 0x3e, 0x31, 0x39, 0xb1, 0x0c, 0x93, 0x17, 0x46, 0x24, 0x47, 0xb1, 0x1c,
+	0x64, 0xa9, 0x1c, 0x65, 0xa9, 0xbc, 0x11, 0x65, 0x29, 0x50, 0x7f, 0xf0, 0x6a, 0x1e, 0xe9, 0xb9,
+	0x50, 0xaf, 0xca, 0x05, 0x49, 0xdb, 0xb3, 0x22, 0x92, 0xd4, 0x51, 0x48, 0x42, 0xbd, 0x2a, 0x57,
+	0x55, 0x48, 0x3b, 0xa6, 0x4d, 0x0a, 0xe9, 0x12, 0x5a, 0xc8, 0xe9, 0xf2, 0x5b, 0x2d, 0x41, 0xde,
+	0x22, 0xac, 0xe6, 0x53, 0x8f, 0x53, 0xd7, 0x29, 0x64, 0xa4, 0x28, 0xb9, 0xa5, 0xae, 0x00, 0x98,
+	0x9c, 0xfb, 0x74, 0xb3, 0xcd, 0x09, 0x2b, 0x8c, 0x96, 0x52, 0x0b, 0xf9, 0xa5, 0x73, 0xc3, 0x72,
+	0x50, 0xae, 0xf6, 0x61, 0xab, 0x0e, 0xf7, 0x3b, 0x7a, 0x42, 0x4f, 0xfd, 0x02, 0xc6, 0x93, 0x55,
+	0x2c, 0x8c, 0x49, 0x9e, 0xb9, 0x41, 0x9e, 0xeb, 0x01, 0x66, 0xcd, 0xa9, 0xbb, 0x7a, 0xbe, 0x16,
+	0x2f, 0xd4, 0x25, 0x98, 0xf1, 0x7c, 0x6a, 0x9b, 0x7e, 0xc7, 0x20, 0xb6, 0x49, 0x5b, 0x86, 0x69,
+	0x59, 0x3e, 0x61, 0xac, 0x90, 0x95, 0x1e, 0x9f, 0x0c, 0x85, 0xab, 0x42, 0x56, 0x0d, 0x44, 0x6a,
+	0x0b, 0xb4, 0xa1, 0x3a, 0x46, 0xd8, 0xf2, 0x41, 0x32, 0x73, 0xef, 0x4d, 0x66, 0x5a, 0x26, 0x12,
+	0x0f, 0x31, 0xf1, 0x6d, 0x44, 0x54, 0xe5, 0x6a, 0x11, 0xb2, 0x9e, 0xc9, 0xd8, 0x8e, 0xeb, 0x5b,
+	0x05, 0x90, 0x4e, 0xf5, 0xd7, 0xea, 0x06, 0x9c, 0x8c, 0xbe, 0x8d, 0x44, 0x1d, 0xf3, 0x47, 0xa8,
+	0xe3, 0x74, 0x44, 0x70, 0xbb, 0x5f, 0xcf, 0x8b, 0x30, 0xeb, 0x93, 0x3b, 0x6d, 0xea, 0x13, 0x63,
+	0x80, 0xbd, 0x30, 0x5e, 0x42, 0x0b, 0x59, 0x7d, 0x26, 0x14, 0xdf, 0xda, 0xa3, 0xaa, 0xfe, 0x17,
+	0x32, 0x8c, 0x0b, 0xd4, 0x44, 0x09, 0x2d, 0x4c, 0x2e, 0xcd, 0x0c, 0x16, 0x61, 0x5d, 0x08, 0xf5,
+	0x00, 0xa3, 0x9e, 0x82, 0x8c, 0x69, 0xd9, 0xd4, 0x29, 0x4c, 0x4a, 0xca, 0x60, 0xa1, 0x2e, 0x82,
+	0xca, 0x89, 0xed, 0xb9, 0xbe, 0x48, 0x6e, 0x3f, 0xec, 0x29, 0x19, 0xf6, 0x74, 0x5f, 0x12, 0xd9,
+	0x55, 0x1b, 0x70, 0x76, 0x3f, 0xdc, 0x48, 0x1c, 0x8b, 0x13, 0x87, 0xca, 0x04, 0x92, 0x99, 0x28,
+	0xee, 0xe3, 0xbf, 0xde, 0x3f, 0x27, 0xc3, 0x0d, 0x91, 0x1f, 0x3c, 0xea, 0x13, 0x26, 0x0c, 0x4d,
+	0x7f, 0x94, 0xa1, 0xd5, 0x80, 0xa8, 0xca, 0xd5, 0x2f, 0x61, 0xca, 0xf3, 0xdd, 0x3a, 0x6d, 0x11,
+	0xc3, 0xa3, 0x35, 0xde, 0xf6, 0x49, 0x41, 0x95, 0xd4, 0xb3, 0x83, 0xd9, 0xbc, 0x15, 0x88, 0xf5,
+	0xc9, 0x10, 0x1f, 0xae, 0x8b, 0x9f, 0xc3, 0xd4, 0xc0, 0x81, 0x51, 0x4f, 0x40, 0xaa, 0x49, 0x3a,
+	0x72, 0xce, 0xe4, 0x74, 0xf1, 0x29, 0xb2, 0xbf, 0x6d, 0xb6, 0xda, 0x44, 0xce, 0x8d, 0x9c, 0x1e,
+	0x2c, 0x3e, 0x1b, 0xb9, 0x8c, 0xb4, 0x77, 0x08, 0xc6, 0x42, 0x2a, 0xf5, 0x2a, 0x64, 0x89, 0xbd,
+	0x49, 0x2c, 0x8b, 0x58, 0xe1, 0x90, 0x2a, 0x1d, 0xe0, 0x45, 0x79, 0x35, 0xc4, 0xe9, 0x7d, 0x0d,
+	0xf5, 0x32, 0x64, 0x18, 0xfd, 0x91, 0xb0, 0xc2, 0x88, 0x3c, 0x93, 0xda, 0x41, 0xaa, 0xeb, 0x02,
+	0x14, 0x9c, 0xec, 0x40, 0xa1, 0x78, 0x05, 0xb2, 0x11, 0x9f, 0x3a, 0x07, 0x39, 0x9b, 0xda, 0xc4,
+	0xe0, 0x1d, 0x8f, 0x84, 0x11, 0x64, 0xc5, 0xc6, 0x46, 0xc7, 0x23, 0x62, 0xf2, 0x58, 0x26, 0x37,
+	0x65, 0x14, 0xe3, 0xba, 0xfc, 0x2e, 0x5e, 0x06, 0x88, 0x19, 0x93, 0xa1, 0x4f, 0xbc, 0x2f, 0xf4,
+	0x0b, 0x90, 0x11, 0xf3, 0x86, 0xa9, 0xff, 0x81, 0x8c, 0xb8, 0x2f, 0xc5, 0x64, 0x16, 0x9e, 0x9f,
+	0x1a, 0x36, 0x95, 0xf4, 0x00, 0xa2, 0xfd, 0x82, 0x60, 0xf2, 0x06, 0xe1, 0x72, 0x8b, 0xdc, 0x69,
+	0x13, 0xc6, 0xd5, 0x15, 0xc8, 0x0a, 0x99, 0xf1, 0x41, 0xb3, 0x7d, 0xac, 0x2d, 0x45, 0x4c, 0xbd,
+	0x06, 0x10, 0x5f, 0x82, 0x07, 0xce, 0xf7, 0xaf, 0x04, 0xe4, 0x1b, 0x93, 0x35, 0x97, 0xd3, 0x82,
+	0x42, 0xcf, 0xd5, 0xa3, 0x0d, 0xcd, 0x87, 0xe9, 0xa0, 0x81, 0x93, 0xbe, 0x2d, 0x41, 0x5a, 0x18,
+	0x08, 0xfd, 0x1a, 0x1a, 0x59, 0xc2, 0x19, 0x89, 0x55, 0xff, 0x0d, 0x27, 0xa8, 0xb3, 0x4d, 0xb9,
+	0x29, 0xe6, 0xb6, 0xc1, 0xdd, 0x26, 0x71, 0xc2, 0xe4, 0x4d, 0xc5, 0xfb, 0x1b, 0x62, 0x5b, 0xbb,
+	0x87, 0x60, 0x3a, 0x98, 0x06, 0x1f, 0x6b, 0xf4, 0xa3, 0xc3, 0xaf, 0x03, 0x0e, 0xc2, 0xdf, 0x18,
+	0x3c, 0x6d, 0xc7, 0x5a, 0x27, 0xed, 0x67, 0x04, 0xff, 0x88, 0x43, 0xfe, 0x24, 0x36, 0x44, 0x17,
+	0x3b, 0x64, 0x27, 0x4c, 0xba, 0xf8, 0x14, 0x3b, 0x6e, 0xcb, 0x92, 0x37, 0x76, 0x4e, 0x17, 0x9f,
+	0xda, 0x4f, 0x23, 0x30, 0x1b, 0xd7, 0xbb, 0x7a, 0x6b, 0xed, 0x26, 0xe9, 0x1c, 0xaf, 0x17, 0xd1,
+	0x3d, 0x3f, 0x92, 0xb8, 0xe7, 0x17, 0x61, 0x34, 0x78, 0xdb, 0x14, 0x52, 0xa5, 0xd4, 0xb0, 0xa1,
+	0xaf, 0x0b, 0xa9, 0x1e, 0x82, 0xd4, 0x59, 0x18, 0xb3, 0xfc, 0x8e, 0xe1, 0xb7, 0x1d, 0xf9, 0x5a,
+	0xc8, 0xea, 0xa3, 0x96, 0xdf, 0xd1, 0xdb, 0xe2, 0x35, 0x30, 0x2e, 0x6c, 0xf3, 0x8e, 0xc1, 0x6a,
+	0xae, 0x47, 0x0a, 0x19, 0x79, 0xf2, 0xfe, 0x39, 0xc8, 0xb6, 0x2a, 0x31, 0x09, 0x3f, 0xf5, 0x7c,
+	0xa0, 0xb6, 0x2e, 0xb4, 0xb4, 0x87, 0x08, 0x66, 0xe3, 0x5a, 0x7c, 0x8a, 0x1c, 0xfc, 0x1f, 0xc6,
+	0x4c, 0x8f, 0x1a, 0x62, 0xa6, 0x04, 0x3d, 0x79, 0x7a, 0x90, 0x24, 0xb0, 0x9a, 0xd0, 0x1d, 0x35,
+	0x3d, 0x7a, 0x93, 0x74, 0xb4, 0xfb, 0x29, 0x80, 0xb5, 0xfe, 0x79, 0x11, 0x73, 0x48, 0xbe, 0x1e,
+	0xc2, 0xa1, 0x16, 0x2c, 0xc4, 0x6e, 0xf2, 0x80, 0x05, 0x0b, 0xf1, 0x4c, 0x4b, 0xdc, 0x35, 0x47,
+	0x7a, 0xa6, 0x91, 0xfe, 0xd5, 0xb2, 0xf7, 0xc1, 0x98, 0x3e, 0x8e, 0x07, 0x63, 0xe6, 0xc3, 0x1e,
+	0x8c, 0x55, 0xc8, 0x9b, 0xb5, 0x1a, 0xf1, 0x42, 0x96, 0xd1, 0x43, 0xbe, 0x94, 0x20, 0x52, 0x92,
+	0xf7, 0x64, 0x4c, 0xb1, 0xd9, 0x29, 0x8c, 0x1d, 0xaa, 0xa0, 0x31, 0xc3, 0x72, 0x47, 0xbb, 0x09,
+	0xf9, 0xb8, 0x1a, 0x4c, 0xbd, 0x0a, 0xf9, 0x78, 0x98, 0x45, 0x93, 0xbf, 0x38, 0x48, 0x18, 0x6b,
+	0xe8, 0x49, 0xb8, 0xf6, 0x3f, 0x98, 0x59, 0x27, 0x8e, 0x95, 0x10, 0x87, 0x5d, 0x77, 0x66, 0x4f,
+	0x95, 0x97, 0x47, 0xbb, 0xaf, 0xe6, 0x47, 0xbe, 0x43, 0x61, 0xb5, 0xb5, 0x4b, 0x30, 0xbb, 0x42,
+	0x5a, 0x84, 0x93, 0xa3, 0x2a, 0xde, 0x47, 0x70, 0x5a, 0x04, 0xb7, 0x4e, 0x18, 0xa3, 0xae, 0x93,
+	0x88, 0xf1, 0x98, 0xfa, 0xfc, 0x3c, 0x00, 0x0b, 0xb8, 0x0d, 0x6a, 0x05, 0xcd, 0xb8, 0x3c, 0xd1,
+	0x7d, 0x39, 0x9f, 0x8b, 0x2c, 0xae, 0xe8, 0x39, 0x16, 0x19, 0xd7, 0xfe, 0x1c, 0x81, 0x7c, 0xc2,
+	0x9d, 0xbf, 0xc3, 0x87, 0x81, 0xf6, 0x4e, 0x1d, 0x47, 0x7b, 0xa7, 0x3f, 0xac, 0xbd, 0xaf, 0xed,
+	0x39, 0xad, 0x99, 0x43, 0x76, 0x77, 0x7c, 0x52, 0xb5, 0x1b, 0x30, 0x9e, 0xc8, 0x26, 0x53, 0x2f,
+	0x41, 0x36, 0x8c, 0x33, 0x6a, 0xcc, 0xb9, 0x61, 0xe9, 0x0c, 0xf1, 0x7a, 0x1f, 0xac, 0xfd, 0x86,
+	0x60, 0xf6, 0x6b, 0xca, 0x78, 0x92, 0xed, 0x78, 0xe7, 0xe1, 0x29, 0xc8, 0xb8, 0xbe, 0x45, 0xfc,
+	0x68, 0x5e, 0xc9, 0x85, 0xd8, 0x6d, 0x51, 0x9b, 0x06, 0x65, 0x98, 0xd0, 0x83, 0x85, 0xb8, 0x3f,
+	0x3c, 0xb3, 0x11, 0xfc, 0x27, 0x4e, 0xe8, 0xf2, 0x7b, 0xf9, 0x0f, 0xf4, 0x6c, 0x17, 0xa3, 0xe7,
+	0xbb, 0x18, 0xbd, 0xd8, 0xc5, 0xca, 0xeb, 0x5d, 0xac, 0xbc, 0xd9, 0xc5, 0xca, 0xdb, 0x5d, 0xac,
+	0xbc, 0xdb, 0xc5, 0xe8, 0x6e, 0x17, 0xa3, 0x7b, 0x5d, 0xac, 0x3c, 0xea, 0x62, 0xf4, 0xb8, 0x8b,
+	0x95, 0x27, 0x5d, 0xac, 0x3c, 0xed, 0x62, 0xe5, 0x59, 0x17, 0xa3, 0xe7, 0x5d, 0x8c, 0x5e, 0x74,
+	0xb1, 0xf2, 0xba, 0x8b, 0xd1, 0x9b, 0x2e, 0x56, 0xde, 0x76, 0x31, 0x7a, 0xd7, 0xc5, 0xca, 0xdd,
+	0x1e, 0x56, 0xee, 0xf5, 0x30, 0x7a, 0xd0, 0xc3, 0xca, 0xaf, 0x3d, 0x8c, 0x7e, 0xef, 0x61, 0xe5,
+	0x51, 0x0f, 0x2b, 0x8f, 0x7b, 0x18, 0x3d, 0xe9, 0x61, 0xf4, 0xb4, 0x87, 0xd1, 0xf7, 0xe7, 0x1b,
+	0x6e, 0x99, 0x6f, 0x11, 0xbe, 0x45, 0x9d, 0x06, 0x2b, 0x3b, 0x84, 0xef, 0xb8, 0x7e, 0xb3, 0xb2,
+	0xf7, 0xbf, 0xdd, 0x6b, 0x36, 0x2a, 0x9c, 0x3b, 0xde, 0xe6, 0xe6, 0xa8, 0x2c, 0xda, 0x85, 0xbf,
+	0x02, 0x00, 0x00, 0xff, 0xff, 0x3e, 0xbf, 0x94, 0x71, 0x58, 0x11, 0x00, 0x00,
 }
@@ -126,6 +126,13 @@ func (this *CreateUserAPIKeyRequest) Validate() error {
 	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.UserIdentifiers)); err != nil {
 		return github_com_mwitkow_go_proto_validators.FieldError("UserIdentifiers", err)
 	}
+	for _, item := range this.EntityScope {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("EntityScope", err)
+			}
+		}
+	}
 	return nil
 }
 func (this *UpdateUserAPIKeyRequest) Validate() error {
@@ -15,6 +15,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"database/sql/driver"
 	"encoding/hex"
 	"strings"
@@ -27,11 +28,18 @@ type AES128Key [16]byte
 func (key *AES128Key) IsZero() bool { return key == nil || *key == [16]byte{} }
 
 // String implements the Stringer interface.
-func (key AES128Key) String() string { return strings.ToUpper(hex.EncodeToString(key[:])) }
+// The key is redacted, so that it does not end up in logs. Use MarshalText to encode the key.
+func (key AES128Key) String() string { return "AES128Key(****)" }
 
 // GoString implements the GoStringer interface.
 func (key AES128Key) GoString() string { return key.String() }
 
+// Fingerprint returns a short hash of the key, which can be used to correlate keys without exposing them.
+func (key AES128Key) Fingerprint() string {
+	sum := sha256.Sum256(key[:])
+	return strings.ToUpper(hex.EncodeToString(sum[:4]))
+}
+
 // Size implements the Sizer interface.
 func (key AES128Key) Size() int { return 16 }
 
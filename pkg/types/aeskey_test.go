@@ -16,6 +16,8 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/smartystreets/assertions"
@@ -53,4 +55,25 @@ func TestAES128(t *testing.T) {
 		a.So(unmarshaledKey.UnmarshalText(textBytes), should.BeNil)
 		a.So(aes.Equal(unmarshaledKey), should.BeTrue)
 	}
+
+	// Redaction
+	{
+		for _, s := range []string{
+			aes.String(),
+			aes.GoString(),
+			fmt.Sprint(aes),
+			fmt.Sprintf("%s %v %+v %#v", aes, aes, aes, aes),
+			fmt.Sprintf("%v", &aes),
+			fmt.Sprintf("%v", struct{ Key AES128Key }{aes}),
+			aes.Fingerprint(),
+		} {
+			a.So(s, should.NotContainSubstring, "1234AE003AB7380152310B533AB73801")
+			a.So(strings.ToUpper(s), should.NotContainSubstring, "1234AE")
+		}
+		a.So(aes.String(), should.Equal, "AES128Key(****)")
+
+		a.So(aes.Fingerprint(), should.HaveLength, 8)
+		a.So(aes.Fingerprint(), should.Equal, aes.Fingerprint())
+		a.So(aes.Fingerprint(), should.NotEqual, AES128Key{}.Fingerprint())
+	}
 }
@@ -16,13 +16,30 @@ package types
 
 import (
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/hex"
+	"math"
 	"strings"
 )
 
 // DevNonce is randomly generated in the join procedure.
 type DevNonce [2]byte
 
+// NewDevNonce returns the DevNonce of the given counter value.
+func NewDevNonce(v uint16) (dn DevNonce) {
+	binary.BigEndian.PutUint16(dn[:], v)
+	return dn
+}
+
+// Uint16 returns the counter value of the DevNonce.
+func (dn DevNonce) Uint16() uint16 { return binary.BigEndian.Uint16(dn[:]) }
+
+// IsMax returns true iff the DevNonce is the maximum counter value.
+func (dn DevNonce) IsMax() bool { return dn.Uint16() == math.MaxUint16 }
+
+// Increment returns the next DevNonce. The counter wraps to zero after the maximum value.
+func (dn DevNonce) Increment() DevNonce { return NewDevNonce(dn.Uint16() + 1) }
+
 // IsZero returns true iff the type is zero.
 func (dn DevNonce) IsZero() bool { return dn == [2]byte{} }
 
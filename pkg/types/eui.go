@@ -152,18 +152,35 @@ func (prefix EUI64Prefix) firstNumericEUI64Covered() uint64 {
 }
 
 func (prefix EUI64Prefix) lastNumericEUI64Covered() uint64 {
-	return prefix.firstNumericEUI64Covered() + prefix.NbItems() - 1
+	return prefix.firstNumericEUI64Covered() | (math.MaxUint64 >> prefix.Length)
 }
 
 // LastEUI64Covered returns the last EUI64 covered, in the numeric order.
 func (prefix EUI64Prefix) LastEUI64Covered() EUI64 {
 	result := EUI64{}
+	result.UnmarshalNumber(prefix.lastNumericEUI64Covered())
+	return result
+}
 
-	lastEUI64Numeric := prefix.lastNumericEUI64Covered()
-	hex := fmt.Sprintf("%08X", lastEUI64Numeric)
-	result.UnmarshalText([]byte(hex))
+// Range returns the first and last EUI64 covered, in the numeric order.
+func (prefix EUI64Prefix) Range() (first, last EUI64) {
+	return prefix.FirstEUI64Covered(), prefix.LastEUI64Covered()
+}
 
-	return result
+// ForEach calls f for each EUI64 covered, in the numeric order, until f returns false.
+// Prefixes with a short length cover a vast number of EUI64s; use Range to operate on the bounds instead.
+func (prefix EUI64Prefix) ForEach(f func(EUI64) bool) error {
+	if prefix.Length > 64 {
+		return errInvalidEUIPrefix
+	}
+	first, last := prefix.firstNumericEUI64Covered(), prefix.lastNumericEUI64Covered()
+	for n := first; ; n++ {
+		var eui EUI64
+		eui.UnmarshalNumber(n)
+		if !f(eui) || n == last {
+			return nil
+		}
+	}
 }
 
 // IsZero returns true iff the type is zero.
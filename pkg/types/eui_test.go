@@ -66,3 +66,108 @@ func TestEUI64(t *testing.T) {
 		a.So(unmarshaledEui, should.Equal, eui)
 	}
 }
+
+func TestEUI64PrefixMatches(t *testing.T) {
+	for _, tc := range []struct {
+		Prefix   EUI64Prefix
+		Matching []EUI64
+		Missing  []EUI64
+	}{
+		{
+			Prefix: EUI64Prefix{EUI64{0xff, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, 42},
+			Matching: []EUI64{
+				{0xff, 0x42, 0xff, 0xff, 0xff, 0xc0, 0x00, 0x00},
+				{0xff, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			},
+			Missing: []EUI64{
+				{0xff, 0x42, 0xff, 0xff, 0xff, 0xbf, 0xff, 0xff},
+				{0xff, 0x42, 0xff, 0xff, 0xff, 0x7f, 0xff, 0xff},
+				{0xff, 0x42, 0xff, 0xff, 0xfe, 0xff, 0xff, 0xff},
+			},
+		},
+		{
+			Prefix: EUI64Prefix{EUI64{0x10, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, 12},
+			Matching: []EUI64{
+				{0x10, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+				{0x10, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			},
+			Missing: []EUI64{
+				{0x10, 0x10, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+				{0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				{0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			},
+		},
+		{
+			Prefix: EUI64Prefix{EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00}, 56},
+			Matching: []EUI64{
+				{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00},
+				{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			},
+			Missing: []EUI64{
+				{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe, 0xff},
+				{0x43, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			},
+		},
+	} {
+		t.Run(tc.Prefix.String(), func(t *testing.T) {
+			a := assertions.New(t)
+			for _, eui := range tc.Matching {
+				a.So(tc.Prefix.Matches(eui), should.BeTrue)
+				a.So(eui.HasPrefix(tc.Prefix), should.BeTrue)
+			}
+			for _, eui := range tc.Missing {
+				a.So(tc.Prefix.Matches(eui), should.BeFalse)
+				a.So(eui.HasPrefix(tc.Prefix), should.BeFalse)
+			}
+		})
+	}
+}
+
+func TestEUI64PrefixRange(t *testing.T) {
+	a := assertions.New(t)
+
+	prefix := EUI64Prefix{EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x12}, 56}
+	first, last := prefix.Range()
+	a.So(first, should.Equal, EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00})
+	a.So(last, should.Equal, EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+
+	var euis []EUI64
+	err := prefix.ForEach(func(eui EUI64) bool {
+		euis = append(euis, eui)
+		return true
+	})
+	a.So(err, should.BeNil)
+	if a.So(euis, should.HaveLength, 256) {
+		a.So(euis[0], should.Equal, first)
+		a.So(euis[255], should.Equal, last)
+	}
+
+	prefix = EUI64Prefix{EUI64{0xff, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, 42}
+	first, last = prefix.Range()
+	a.So(first, should.Equal, EUI64{0xff, 0x42, 0xff, 0xff, 0xff, 0xc0, 0x00, 0x00})
+	a.So(last, should.Equal, EUI64{0xff, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+
+	// Iteration stops when the callback returns false.
+	euis = nil
+	err = prefix.ForEach(func(eui EUI64) bool {
+		euis = append(euis, eui)
+		return len(euis) < 3
+	})
+	a.So(err, should.BeNil)
+	a.So(euis, should.Resemble, []EUI64{
+		{0xff, 0x42, 0xff, 0xff, 0xff, 0xc0, 0x00, 0x00},
+		{0xff, 0x42, 0xff, 0xff, 0xff, 0xc0, 0x00, 0x01},
+		{0xff, 0x42, 0xff, 0xff, 0xff, 0xc0, 0x00, 0x02},
+	})
+
+	// Leading zeros and full prefixes.
+	first, last = EUI64Prefix{EUI64{}, 56}.Range()
+	a.So(first, should.Equal, EUI64{})
+	a.So(last, should.Equal, EUI64{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff})
+	first, last = EUI64Prefix{EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}, 64}.Range()
+	a.So(first, should.Equal, EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42})
+	a.So(last, should.Equal, first)
+
+	err = EUI64Prefix{EUI64{}, 65}.ForEach(func(EUI64) bool { return true })
+	a.So(err, should.NotBeNil)
+}
@@ -23,6 +23,27 @@ import (
 // JoinNonce is randomly generated in the join procedure.
 type JoinNonce [3]byte
 
+// MaxJoinNonce is the maximum value of the 24-bit JoinNonce counter.
+const MaxJoinNonce = 1<<24 - 1
+
+// NewJoinNonce returns the JoinNonce of the given counter value.
+// Only the 24 least significant bits of the value are used.
+func NewJoinNonce(v uint32) (jn JoinNonce) {
+	jn[0], jn[1], jn[2] = byte(v>>16), byte(v>>8), byte(v)
+	return jn
+}
+
+// Uint32 returns the counter value of the JoinNonce.
+func (jn JoinNonce) Uint32() uint32 {
+	return uint32(jn[0])<<16 | uint32(jn[1])<<8 | uint32(jn[2])
+}
+
+// IsMax returns true iff the JoinNonce is the maximum counter value.
+func (jn JoinNonce) IsMax() bool { return jn.Uint32() == MaxJoinNonce }
+
+// Increment returns the next JoinNonce. The counter wraps to zero after the maximum value.
+func (jn JoinNonce) Increment() JoinNonce { return NewJoinNonce(jn.Uint32() + 1) }
+
 // IsZero returns true iff the type is zero.
 func (jn JoinNonce) IsZero() bool { return jn == [3]byte{} }
 
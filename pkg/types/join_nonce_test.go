@@ -49,3 +49,33 @@ func TestJoinNonce(t *testing.T) {
 		a.So(tc.JoinNonce.String(), should.Equal, tc.String)
 	}
 }
+
+func TestJoinNonceCounter(t *testing.T) {
+	a := assertions.New(t)
+
+	a.So(NewJoinNonce(0x42fffe), should.Equal, JoinNonce{0x42, 0xff, 0xfe})
+	a.So(NewJoinNonce(0x42fffe).Uint32(), should.Equal, 0x42fffe)
+	a.So(NewJoinNonce(0x1000001), should.Equal, JoinNonce{0x00, 0x00, 0x01})
+
+	a.So(JoinNonce{0x00, 0x00, 0x01}.Increment(), should.Equal, JoinNonce{0x00, 0x00, 0x02})
+	a.So(JoinNonce{0x00, 0x00, 0xff}.Increment(), should.Equal, JoinNonce{0x00, 0x01, 0x00})
+	a.So(JoinNonce{0x00, 0xff, 0xff}.Increment(), should.Equal, JoinNonce{0x01, 0x00, 0x00})
+
+	a.So(JoinNonce{0xff, 0xff, 0xfe}.IsMax(), should.BeFalse)
+	a.So(JoinNonce{0xff, 0xff, 0xfe}.Increment().IsMax(), should.BeTrue)
+	a.So(JoinNonce{0xff, 0xff, 0xff}.Uint32(), should.Equal, MaxJoinNonce)
+	a.So(JoinNonce{0xff, 0xff, 0xff}.Increment(), should.Equal, JoinNonce{0x00, 0x00, 0x00})
+}
+
+func TestDevNonceCounter(t *testing.T) {
+	a := assertions.New(t)
+
+	a.So(NewDevNonce(0x2442), should.Equal, DevNonce{0x24, 0x42})
+	a.So(DevNonce{0x24, 0x42}.Uint16(), should.Equal, 0x2442)
+
+	a.So(DevNonce{0x00, 0xff}.Increment(), should.Equal, DevNonce{0x01, 0x00})
+
+	a.So(DevNonce{0xff, 0xfe}.IsMax(), should.BeFalse)
+	a.So(DevNonce{0xff, 0xfe}.Increment().IsMax(), should.BeTrue)
+	a.So(DevNonce{0xff, 0xff}.Increment(), should.Equal, DevNonce{0x00, 0x00})
+}
@@ -78,6 +78,18 @@ func (id *NetID) UnmarshalText(data []byte) error {
 	return unmarshalTextBytes(id[:], data)
 }
 
+// FromConfigString implements the config.Configurable interface.
+func (id NetID) FromConfigString(in string) (interface{}, error) {
+	var netID NetID
+	if err := netID.UnmarshalText([]byte(in)); err != nil {
+		return nil, err
+	}
+	return netID, nil
+}
+
+// ConfigString implements the config.Stringer interface.
+func (id NetID) ConfigString() string { return id.String() }
+
 // Value implements driver.Valuer interface.
 func (id NetID) Value() (driver.Value, error) {
 	return id.MarshalText()
@@ -92,3 +92,53 @@ func TestNetID(t *testing.T) {
 		})
 	}
 }
+
+func TestNetIDText(t *testing.T) {
+	a := assertions.New(t)
+
+	text, err := NetID{0x42, 0xff, 0xff}.MarshalText()
+	a.So(err, should.BeNil)
+	a.So(string(text), should.Equal, "42FFFF")
+
+	for _, tc := range []struct {
+		Text  string
+		NetID NetID
+	}{
+		{"42FFFF", NetID{0x42, 0xff, 0xff}},
+		{"42ffff", NetID{0x42, 0xff, 0xff}},
+		{"000013", NetID{0x00, 0x00, 0x13}},
+	} {
+		var netID NetID
+		err := netID.UnmarshalText([]byte(tc.Text))
+		a.So(err, should.BeNil)
+		a.So(netID, should.Equal, tc.NetID)
+
+		v, err := NetID{}.FromConfigString(tc.Text)
+		a.So(err, should.BeNil)
+		a.So(v, should.Equal, tc.NetID)
+	}
+
+	for _, tc := range []struct {
+		Text     string
+		ErrorDef error
+	}{
+		{"42FF", errInvalidLength},
+		{"42FFFFFF", errInvalidLength},
+		{"42FFF", errInvalidHex},
+		{"42FFXX", errInvalidHex},
+		{"0x42FF", errInvalidHex},
+	} {
+		netID := NetID{0x42, 0x42, 0x42}
+		err := netID.UnmarshalText([]byte(tc.Text))
+		a.So(err, should.HaveSameErrorDefinitionAs, tc.ErrorDef)
+		a.So(netID, should.Equal, NetID{})
+
+		_, err = NetID{}.FromConfigString(tc.Text)
+		a.So(err, should.HaveSameErrorDefinitionAs, tc.ErrorDef)
+	}
+
+	var netID NetID
+	err = netID.UnmarshalJSON([]byte(`"42FFFF"`))
+	a.So(err, should.BeNil)
+	a.So(netID, should.Equal, NetID{0x42, 0xff, 0xff})
+}
@@ -46,6 +46,7 @@ var (
 	errScanArgumentType = errors.DefineInternal("src_type", "invalid type for src") // DB schema problem.
 	errInvalidJSON      = errors.DefineInvalidArgument("invalid_json", "invalid JSON: `{json}`")
 	errInvalidLength    = errors.DefineInvalidArgument("invalid_length", "invalid slice length")
+	errInvalidHex       = errors.DefineInvalidArgument("invalid_hex", "invalid hex `{hex}`")
 )
 
 func marshalJSONHexBytes(data []byte) ([]byte, error) {
@@ -83,7 +84,7 @@ func unmarshalTextBytes(dst, data []byte) error {
 	b := make([]byte, hex.DecodedLen(len(data)))
 	n, err := hex.Decode(b, data)
 	if err != nil {
-		return err
+		return errInvalidHex.WithCause(err).WithAttributes("hex", string(data))
 	}
 	if n != len(dst) || copy(dst, b) != len(dst) {
 		return errInvalidLength
@@ -41,6 +41,15 @@ var defaultNamespace = [...]string{
 // NewRedis respects TEST_REDIS, REDIS_ADDRESS and REDIS_DB environment variables.
 // Client returned logs commands executed.
 func NewRedis(t testing.TB, namespace ...string) (*ttnredis.Client, func()) {
+	return newRedis(t, false, namespace...)
+}
+
+// NewRedisWithHashTags is like NewRedis, but the returned client uses hash tags in keys.
+func NewRedisWithHashTags(t testing.TB, namespace ...string) (*ttnredis.Client, func()) {
+	return newRedis(t, true, namespace...)
+}
+
+func newRedis(t testing.TB, hashTags bool, namespace ...string) (*ttnredis.Client, func()) {
 	if os.Getenv("TEST_REDIS") != "1" {
 		t.Skip("TEST_REDIS is not set to `1`, skipping Redis tests")
 		panic("New called outside test")
@@ -51,6 +60,7 @@ func NewRedis(t testing.TB, namespace ...string) (*ttnredis.Client, func()) {
 			Address:   defaultAddress,
 			Database:  defaultDatabase,
 			Namespace: defaultNamespace[:],
+			HashTags:  hashTags,
 		},
 		Namespace: append(append([]string{ulid.MustNew(ulid.Now(), Randy).String()}, namespace...), t.Name()),
 	}